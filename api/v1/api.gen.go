@@ -921,6 +921,15 @@ type AuditLogsResponse struct {
 	Total int `json:"total"`
 }
 
+// BuildWarning Non-fatal issue detected while building a DAG
+type BuildWarning struct {
+	// Code Stable warning code, e.g. deprecated_max_active_runs
+	Code string `json:"code"`
+
+	// Message Human-readable warning message
+	Message string `json:"message"`
+}
+
 // ChangePasswordRequest Request body for changing password
 type ChangePasswordRequest struct {
 	// CurrentPassword Current password for verification
@@ -1397,9 +1406,15 @@ type DAGRunDetails struct {
 	// DagRunId Unique identifier for the DAG-run. The special value 'latest' can be used to reference the most recent DAG-run.
 	DagRunId DAGRunId `json:"dagRunId"`
 
+	// FailedPrecondition Precondition that must be satisfied before running a step or DAG-run
+	FailedPrecondition *Condition `json:"failedPrecondition,omitempty"`
+
 	// FinishedAt RFC 3339 timestamp when the DAG-run finished
 	FinishedAt string `json:"finishedAt"`
 
+	// HandlerChains Nodes of the handler steps that follow the first step of handlers configured as a list, keyed by handler (onInit, onExit, onSuccess, onFailure, onAbort, onWait, onRetry). The first node is in the handler's own field.
+	HandlerChains *map[string][]Node `json:"handlerChains,omitempty"`
+
 	// Labels List of labels for categorizing and filtering DAG runs
 	Labels *[]string `json:"labels,omitempty"`
 
@@ -1421,9 +1436,18 @@ type DAGRunDetails struct {
 	// OnFailure Status of an individual step within a DAG-run
 	OnFailure *Node `json:"onFailure,omitempty"`
 
+	// OnInit Status of an individual step within a DAG-run
+	OnInit *Node `json:"onInit,omitempty"`
+
+	// OnRetry Status of an individual step within a DAG-run
+	OnRetry *Node `json:"onRetry,omitempty"`
+
 	// OnSuccess Status of an individual step within a DAG-run
 	OnSuccess *Node `json:"onSuccess,omitempty"`
 
+	// OnWait Status of an individual step within a DAG-run
+	OnWait *Node `json:"onWait,omitempty"`
+
 	// Params Runtime parameters passed to the DAG-run in JSON format
 	Params         *string `json:"params,omitempty"`
 	ParentDAGRunId *string `json:"parentDAGRunId,omitempty"`
@@ -1811,14 +1835,26 @@ type HandlerOn struct {
 	// Abort Individual task definition that performs a specific operation in a DAG-run
 	Abort *Step `json:"abort,omitempty"`
 
+	// Chains Steps that follow the first step of handlers configured as a list, keyed by handler (init, failure, success, abort, exit, wait, retry). The first step is in the handler's own field.
+	Chains *map[string][]Step `json:"chains,omitempty"`
+
 	// Exit Individual task definition that performs a specific operation in a DAG-run
 	Exit *Step `json:"exit,omitempty"`
 
 	// Failure Individual task definition that performs a specific operation in a DAG-run
 	Failure *Step `json:"failure,omitempty"`
 
+	// Init Individual task definition that performs a specific operation in a DAG-run
+	Init *Step `json:"init,omitempty"`
+
+	// Retry Individual task definition that performs a specific operation in a DAG-run
+	Retry *Step `json:"retry,omitempty"`
+
 	// Success Individual task definition that performs a specific operation in a DAG-run
 	Success *Step `json:"success,omitempty"`
+
+	// Wait Individual task definition that performs a specific operation in a DAG-run
+	Wait *Step `json:"wait,omitempty"`
 }
 
 // HealthResponse Response object for the health check endpoint
@@ -19213,6 +19249,9 @@ type GetDAGDetails200JSONResponse struct {
 
 	// Suspended Whether the DAG is suspended
 	Suspended bool `json:"suspended"`
+
+	// Warnings Non-fatal warnings detected while building the DAG
+	Warnings *[]BuildWarning `json:"warnings,omitempty"`
 }
 
 func (response GetDAGDetails200JSONResponse) VisitGetDAGDetailsResponse(w http.ResponseWriter) error {
@@ -19422,6 +19461,9 @@ type GetDAGSpec200JSONResponse struct {
 
 	// Spec The DAG spec in YAML format
 	Spec string `json:"spec"`
+
+	// Warnings Non-fatal warnings detected while building the DAG
+	Warnings *[]BuildWarning `json:"warnings,omitempty"`
 }
 
 func (response GetDAGSpec200JSONResponse) VisitGetDAGSpecResponse(w http.ResponseWriter) error {
@@ -29670,620 +29712,621 @@ func (sh *strictHandler) UpdateWorkspace(w http.ResponseWriter, r *http.Request,
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+z9e3fcNpI3jr8V/Pq351h+tiXbmcw8M56zfyiWL9rIttaSJ2d27HXQJLobKzbQIUBJ",
-	"nTx+79+DKgAESYBk62Y58T+J1SRxrSoU6vKp3yaZXK2lYEKrydPfJmta0hXTrIS/9o8Pf2Sbw9z8O2cq",
-	"K/lacykmTyeV4L9UjPCcCc3nnJVEzoleMrJ/fEjO2GYynXDz3prq5WQ6EXTFJk8nZ9DYdFKyXypesnzy",
-	"VJcVm05UtmQranpZcXHExEIvJ0+fTCd6szafKV1ysZh8/jyd7C+Y0CdMKS5FbFzvU+Oi5kOi8Mv46JRv",
-	"9lojLDWf00wfm7Y743vHCqr5OSPUvkbmvGDEDIRccL3kAkZ7sP9yt6xE/VbOS5ZpWW72yOtKaSKkJkrT",
-	"UsNX5MGjB0SW5MGHD/D/TApNuSAP9vYe7LnJ/lKxclPP1s49PVFaFG/nk6f/ak95TbVmpWnxf/71P48+",
-	"fPjw4ePe//m3SWctpr9NhNRIVO4L87ZZpcijnf/5f/8yjx99fPjhw96HD3s79s//928PE5/8z7/2d/+b",
-	"7v768enk8+eP4eK/Y1lVKn7Oujvw05LpJSuJlqR0bxUbwi7XVOREMKVZ3l13zlRiHX0jk3DxcjanVaEn",
-	"T+e0UMyvzUzKglGBhFLlXB/JxRFfcd0d52t6yVfViohqNUMyZkKbceDIdVUKsmO7IU8eP36YGF8BzUfH",
-	"9uTx4+lkhf3AX+ZPLuyfftBcaLZgJQz6YP/lC16wN9B2e8iGck2vjucO9l8CecfZbe7a6SPCfyvZfPJ0",
-	"8v9/VIupR/hUPQrHYscWH1drTPHhiGsOJRzGu0o8kyIrmWYxIXV4EIzG8Pnfycox9YzBA7VmGacFeVBQ",
-	"zZR+QGjBqYqPPKeLd9WQ2Kq5eUhgzmUZjs3ID3ZJV+vC9PXd4+++f/zk8ZNPT757/Pjx40lTHNDdX/d3",
-	"//vx7t8+7X78916RwIShsX9NcHqTj5Z/ce1GrRnIO7c6WpIF0/B4JZU2nG3EvX31Gss2sOnYQDjyE0bL",
-	"bHk7429xdjCBKw/4iCv9rCqVLLtDfrumhjQyeAxkUUiac7GAcQp2qcmaLoCzZJGz0g03JSmxocZou8dn",
-	"PazRctF1e1uC8c/j5OK7SowSP2mKHBRBkeWimp3yFXtRylW3Z9QQcqqZ5isGWzjnhWbm83rZuCCHJ2/J",
-	"X//y+Il5ZUWtUmG++VUKlli/eSlXpvvR5Pde8EszVqXpat0Y/ansjp2J/LZGruU1x/38nAlzdG/POcgo",
-	"zHxPCrlwZ/p1OMYN5ib0iD9fhVv+vC2zuBEf0wUX1IzztcwjjFM/JyuZsz3yXjHyMy7Kz/6cwsWcM5YT",
-	"LuZccM123XLPC3nxdyJXXBtxW5nP5XyumMbPzWZTzWe84HpjhJntLq0zNwYcXY0JdmBOTXvG+R/sdn6M",
-	"XRteMRo59d74TSu4aGyZ4T6Y/owtuBBAWyhiQo2rNf6l6aR1menbqNEk1RrdaBpqKJ/DhHMkF89Fbnix",
-	"O6gXIB48cc/YXJZGl+IKxAHZaUmKFKEz20E4TPwCjlzNdjU+7u7hkVy8xb3u2ciA+9QZX5MdQ4g1YaWG",
-	"5YkoQnLhwj1OLdyJOQtGLR2dm7+2Xznle7jC2qUW7ogL5gjNLBmcaCWz7G2YYOfJ7owqlm+xcgNkdkwX",
-	"kVUCXacmea7ZCnZxznS2rEUoV+TJw7T8SEiNJ4O0f8zK+LjaQ1qzEvWycEh/ejwlK3oJo0urRGvbx7CY",
-	"H3VZ/K+KVeyGNMxfTFv5jaiYflijxVur8y+paL5jK6nZm+h5GSqaJbxHBJ5TccOFbyl+kBUyo0WUWetB",
-	"xK5qp0tGkvbByLiaSnAZtr2dMox3r3HEhvvHcjLbwLjWJTvnslJEQSOkZGothWLXITQcT4LK6hOh7rEq",
-	"dJS4vrPceyX17LsmiQ1SGI76NdXjh74yLxMl+HrN4jP48zUm8Octxy+rIkWWSlYFOTxI2H/xw+sYf080",
-	"1ZUysiV6GdOVal0E98g7tmYUr/7e+G5WENd0VRWarwtG8Gum0ByzLkAA4PASZ7GuVGM94XQYuuvgBCaf",
-	"/eRoWdKNnRtbD19wlWbrxPK677dkal0yuhqw4wKxKZ3LChR9pXNWmsNkoZK6CrQ69gpoB2HGc0p5cSVF",
-	"3Vxoh1V0bZrfQld5r1i5lYOmUqyMb1CFTV2H/n+S5Zla0yxmd3ePiGIF2NT3yAtz4nOlCRW5kyX7x4dq",
-	"Clc1WhRTYqXA1GwrJRe+ETPmPfJ2xbVmOVkxKpT5ANvMZVatzK1Q03LBdNCmk0eR1vCeWLdmX03dBf3H",
-	"7QuNW6C/fD/t8Zyg5yJtKjWraamv9sR1V9W628zNF/RsdG6tS7lmpeYMvs1KRjXL9yMy6Zl5ZO7X2ts3",
-	"pqM09qlr9YdNolW8b5cobTtfNz7o3Purci1hs+pfI23wMf6/2IdnbHNcsjm/jF2FSqXJX0m2pCXNNCsV",
-	"qKWuvYymBlNQpd+r+CofUUPilV6aRrKRCy6qoqCzwkv5To8iKotfVSsqds0FyXxMrEmx83EpCzZo91Ks",
-	"fGfe+zydVOs8RUMwO3x+BTLybLSfZUwNnk8/tV43bFKLq38Zmph6O6oEKdvuIdz/acAc4SRD8q7NNXL2",
-	"vyzTZtTIju+cjphky0CLbPIkXXPLz32ztVzfnqT9OD0w0EDSozNPzXFgR6kSo1OjtQY3zojW0B23ig98",
-	"wYTer/TyuJTnPGel1US6wkUKwTLgIatRGQaVghFVzfyLuzOanbHcuv0N75G1bbg72yyTldB4inblHBXv",
-	"2Lxkahk89n7c6STD8bA8/phdrnnJFDLOOJbg8YE4fu+euikGqIeWXPEfqFoey4JnETH+sqSiKiiYSVfm",
-	"hF7Di97wOqNqSbSURWdF7dH5A1vScx67i7kn5GLJBBGSlFXBUOVlilDfo2ILc44HxlRaFPJiYg4QsYlY",
-	"UvHB2I47HRFuzn3BWR52qc4+Wa1pVsjsLNqtmcAWDOPW/l2F8rXDN+ntgk+e/tYhYneWjl4pJswRETlE",
-	"TQ/EPgUmq21JT4k5jXD1rLr0cDKN0H2CWgMlqBsas2CXxD62tGAYmHKhNGHU3DCXrCgixNHPEq7HqVuk",
-	"JDc8W1L9jv1Ssdj9zT4AK6TR5alREjRZMaXogpmffZxRlyHo4pkUml3qiEQ72H9JSjZnJRMZU9YxAS8b",
-	"1XM0OR3sv7R9dAlqOrHDjGhMipUPFOFiXWliO+1V86eTlcxZ5A50dPQa/DTmhm2Wo1JR8abonMX9Pc+B",
-	"5AhdG1FNCyOxV2uNK5JTsWClrJTbfhUlO5UODXsL/6AFyQrOhN6150HugsLMqHfevz88IOffP3Ra32ot",
-	"tZELmVWU98hbUWzM1LzlCDWFXdcKE/lacqH/TvhCyDJ4z1CLe7oXXZmE5eIErRZ2Ta385coPfEees7Lk",
-	"OfOXloeDTOHoIc0LUsz5okfFOSTwnqHVOV9UZfzyYQdkNrzoj+twVzOkrm3klTcFLBnZP7RnPldOhCUI",
-	"pYCjMWUtqseVVWXJhC42xH0DZqQxKro5HOvTdZCDT+vXjWbMZnUwyeC3P7m3cd96zpBATvSLolAS7dIL",
-	"WrooSqfVqpice8ELFm+4jnSUpbuYDEgaH+LS5Yk1y8yFjJQVcG4jvKuf9N0gP/as0Gu2kmWPgn+w/3JX",
-	"uSGs4GVcqojwd7/HTsOcLpxNbWx8WWsqb7yuB52kJ8UKtqCanQi6VksZ2Xz3xKwlJbl936jVu7DvDxQp",
-	"+Jxlm8zaI1lkqqql6MpqVgTHAPpUerRc5dV+p8eUFbi3YY6rdcGa+mzAbFSdjdSP4VXfV3LJhogA5Z/d",
-	"fSODzzm7iPEENOQFYuwqZvjDsIQi2hwTS3rOXMOGbdRYTcATSlcDWBRyRgucU/RaBQednBN8kbx+/vrt",
-	"u3/urfKYLMaxHfCIiv1OSr8oPmyZWFNjd3NSS59QV/a9wsUFoaIT1z2e9bag1YYFbdwlzrHPYd68FXTJ",
-	"trVNKb4w2qfIrMPNj4EL/ZfvJ13LcEsTip5Mz2hRbHllObWfxQZumnyHPqztG8UPo83C37U4sDcxqhRX",
-	"muLdsCzBF1fxT1bDn8Jrn1CBjEqLiu/7G9PgCN8f2pfNh44uR8zrjIn38PpnHNAxjmdUl/XrUREWJivA",
-	"5BokEpJsUrzZNIqUXAN91amYK6ZpTjXtNy6PZI36zEuyzWlcmCfZY03LdmJIl+a5LuK9Nqyb4yZReb/L",
-	"iPMmbmQc2pgDpikv0sePfY3k8J65wmVFBdETVkQq8KtY/TVxYrsF35JnO9pE+sq5ZctO8kcaVDXBDjbj",
-	"iLv+7ATmv8W3+EHi4qRqHpy0uhjaVz+Q1gls92ldysy8KBbW9mLUsaGDbknVMRNm82sZk74k+RvSBeUa",
-	"AqmctwbMANELk7/0R46m/pNG0+KZjFlUTs0jQrOsWlWFYQpizmRzsL8/OWh4ENzJHAlzq0/pC1memU4j",
-	"dtjWHobC030VjnRoB3/ieul3sc6s2JKwpm0b3pXoOz61yBQ+TqNi3kl4CJzmWnXkRWIlQHfuEU58VYct",
-	"pP0gtbrfL0aHVPXgrI2Q2RkTBM5tGA9XmmcKHfNHR68jSiNcM7gU8KVqeOEfx3Qt1DPGvg10Nu7lz+n5",
-	"WkUsMltZkIwWBSnRYFlboGKTnVdivB7ken3hPkofyE5tG3MbQ/XFj+Tj0KRfBGNuuW/tE1yA+mAkFBwV",
-	"8HPX/1MuIFpAXcPtYs0ZdVO9kzgU80jGw2urY4E0pqRkC640K70XK+FqaTjSI37pWUJsbzUxbKYpQXqn",
-	"mHQq4eUSdmPNyhVH6RO4lrwFz0qmrpVpRtVytIelNqiZPnHD85yjLfi40W730GvFJbByFwaOhsVHOVdg",
-	"sF7RdVdO9rKuve5EfAzmdxRNlmTZJcsqve3llqvncCmKzspd/sbor8G7I4xM/qLUdTMcErybES3JjJm9",
-	"N8c7WGeb01rbxOkrihR4mh5g4xbWHOJ/GWlpRuhDtpy507Ru1CRQkdI+/aKQFy9Kxk6tbTXmLAbfRXR6",
-	"c/vlcUEztoREoeh7EBJ4AqboeCcSJrSl2l0vDPpJYvo3HnMJPc+ega3b+oIJVkIUsZ36J+fdid7Kf7Fb",
-	"EO3BamrW6DTg83NDDdqctnaouZQjSOZtIl7phItFwQiuO542QCnW/LCtyE6cqClJHjtS8d0RU0orcNYv",
-	"6KjdsK2/hSQmllGRsaJIhUI4Ag/7TJDRYcoIhg4Y3IgtTWspCkmuUtul0o1TsxEluwIxHS7YzEUxNpxi",
-	"eKD3n2mDzq11urMBV9eKXr5XMeNzN9HBNSjOJcapYTqJ1SQng7HX3YW03J5awmfddSLLamUozXmBwQkh",
-	"DVPZ2OLmwuGNNWlYZ5dr9Nmhg3vOWZFbS3st5qErDIsdbaddJ04Ra78gOVfrgm5Y7iMD6l660SOeMDuy",
-	"pZopBjMJJmC9BAAaYM5S68neavwlu+Aij2Xeeke5WW8EdIBwZsy9glWD+I9wUmRdqSV4LzAEnMxodmax",
-	"SmqHon/KtWLFHKJwBanWGI1NcrZmImci2+yNdBVg7yearQfDNmYyR/0Sh2yOc+rtH2nKGqMwJsJaJz+y",
-	"ze45Laogsh8iw+1+7ZHTJVOMXPCiMNtIzykvMALCMPQ5L6WAoKRzWnLzO9xklCGIXyq8obO12hupeoZL",
-	"lZL57olNARy9Umk37amFAUEvbZz0VbUa8uq7Ji6oOZF2mbAZWWY/QwU54udPZi2c1uSb16Q+xnvsURfC",
-	"jAacRfQwsWAyb2TOnH7UssQ4uBldMsyNIvZS7PQo70gzF+Wm87peSg89VLJzzi5+5CKPHVrwEMIskB9E",
-	"E4wo6HVFy7NcXsDK2nCkFeYHzrig5WbMKNLU5kfi7r1U5FaJogXhouCCOae6PRo6uEh2wMmLUSuoA9tc",
-	"247Dtt1UjUwyc/VdqGgEuV3aXrU6shvmNOarBBUcMI1H1evD18+BAHyoZXu+I4PB9xsgU6lY8PW2SFXR",
-	"2CX+6+AAzDtGhM02GuznI7yYWsojWi7YgD3bdcMuM8ZyPIZ4c7Ohc5dlFrkTl5XIqO4TRLZBoA5HOkYg",
-	"+U9hu2x/k+mQEdpuh11QoKiAOuyKBisQjrFPzJyWLJEfuk8U3lRgN2QZuOfh0tLhLiOOuty15EVessg9",
-	"6E0LPMsllFttgSsUbVwRSkKBNu6W2p5eRKeJc8EBamINaKrGGDdX4AqMY4o1FuK4dfHbxvPOiSHYdg+w",
-	"c1uykBU2YxbXH1H9xJq2sQSb1BM30zjuGpK9q4Q52rghImnflqHV6FSqnOvnQB1pRqLmJQ/ksomkFsQt",
-	"BqB7WHOY0eSNGPEWMbLD9hZ7U3dX/AR699TFvU5Nd1w8jOZkUc0W8fAe+8QTFAwcoVNsd5qVKy5oAYly",
-	"5ZTkdPEwHtkCVvVuH/958vbNLhOZzI0QgNnVMXLuq6vmcdVRr0mO5ev9PC9tElFr+hDySw6PCcVXCJ/X",
-	"+nassTqTKXYeiAB5RmbgMctHpzxViZTNOooRrEcXSxnQhPYUk2oyLvze2ydXbDrqrwmSvDzF+eD6aZ1H",
-	"6keV5K8juVAjriMWzxJuIg2O4yxmSdH9sXaxJsZJl1okROOfNC1STu4u+hIkODioC8S2IjsWtaYJBxOx",
-	"s4R7Uk8CRxBb7GdLKhbsmCp1Ict8/F05M9+ZQa7tp11tAL3FrumesAb7BrR7zsowm3IgCliwi3T7b9hF",
-	"3TbkZrQyGP46RNTtKTQ7TCxnX4SiPRwa6SEYrXh09HpMrGLcolTHF0fCMW141gAFBwN33sYwGzTer3lK",
-	"rEJTD97dEdVGabay3N4KzAOP5cfpQPhhwn9tWCRnznftmyU7UhTWkuN/c0E5D8eyslmIdDBji0BsImmf",
-	"9yu2sGkHL53JSjt62D8+jPulY3EIKdQBDW+4Xaq/nEwHghESuTyQrxEev5Vi0UDgdujDuAF6B8JgXAWm",
-	"bEZzjdxTsiPXTFA+JVToZSnXPJuSBTNNTwnT2V5UjWlFYcSEth3zDo6W/Huwrg8n060DNwIa2fLQCyWJ",
-	"sgpz1BC3pOq1LGPGrtJoU3NrfVVEaV4UxIbWg+FlZQ4e38eKbggtS4Q1jrgUgtC++CHbGPI2XNkX/6fZ",
-	"uk4L7mvI6P41lEn93ZHzoY37GF+38uqAAbigc61GpFZev2HVelaGZl3rCMAwnFELctrod1BQhbGJ9Vp1",
-	"F2DqCSUly9LxRft1JM24CKNGeE33xkCQJVFGyILUr1//qhDG/Iw0mb0JdGX4fMaAA2lRxARgMvs6urB4",
-	"g0teKl1iqw/FC9eis6gqdrG273vDoQs42MYzFAQpdG+tboxaWsP7sMXctZdYEkjp6aT/H5lLbqCoUrGx",
-	"UZaNk5mhYeV9WUw+fp7+1uo3Z5OP3bhH1yehZEVFRQvy1vSM92qADI0cxWhPG1BUzbd4sxt4MRx3GHJe",
-	"lXxIHW4tru3yKmub9PxjhHp9vtogTnQK4So1NIxW/E5waA+GnUQQHyKOemwvPkeBrrmoeyFzT5v+UkU1",
-	"V3NuxBbet/w5iOejLJPmqCzd3/PLdWljaeHmxLIz4JJzWlRUsz0CdoOfnUP6Z3MM2xT6KfAqugoBYJCG",
-	"IAjYVKXcZVEZEeV8gli4AHoFCALzKabJ/2y//7njJwxSal2QWGsq5uf66mLzYP1igiHXnO7Ru4ibYHSF",
-	"0MGBUHc+wdY3bNcK8qxhtRTTU3JAFxVC9ZbWw+zWNPeHR6XXlSZcKM0owGzhcrklZJdcWzKOroNFG+j3",
-	"MtTjvKCqOfsQ+ADyIiLWnTlAJ0wJF+es1KrVpF2SHfBymqnXj3LJFHnz9hRNBg+HPQs1kcZZRpY5F1TL",
-	"8lCY61MMrSt4CVbVvOXkQB/W1DIa6P9KKg1GqIul0YiysHFF6gTP7nlvu96q1EsWGXv06iLLyFiPZak9",
-	"4OyyM9iCK80QblnE8+40LRNISe9ePPvTn/70txolye103YP9OuolSALiND5Hjc+DgGQadXgu/D8rcSbk",
-	"hYjjUDe0mXr1p7iz0wBY0M/TruQArQ0lDMTuPTVkIgXcj+6+qoiE9m/12QBBe/UB1qmmx91aIgw1pKk3",
-	"RhldOUgZczhTCXsdvkRqtCkXptUbcDgGbC3AsQNU2muhjwWNPbEQt73K0ZbYZDcMIRaihw3vTIqcO1tz",
-	"I0BggF0WS3soCuhGsaw0RyaYyDzErYwKwDioGPbQM+8g+Wh0IBGip4CCJdjFQCpbfzrZ6IShIMWrJ3kI",
-	"J3UgszGwP5jGaSfhMCbH23Fhl8CB66IH6oxNH/byz/3XR2ReSqFXAFuUvgX3wgDIDOqPxbNR++yYMEWw",
-	"/jkAmC3XBRGA+kFhaqJvzm06uSi5Zm9FsUFMlc/TyYwqZq9JkRR+ACf5iYtcXgwnXo0LvE6hBlVKyxWA",
-	"BNFKy12MbIeoj1KuMLiAzyOgWKE2s670M6n0MSufvI5jD/RmOK7o5VvQdMemmqWTNpP4XKhKX2eU4QXQ",
-	"6yHOMjuZTtBa6/+xay7Xl5PpBE229vdSVkj9DoX8V8qjrgRVrY36oU6XXKTSP6cTbZ8+n8+t1ucrUMF1",
-	"f8VyXq2MmsMXy8l0cgn/H9SQXFhEDS2IK55mrRo6PeCsDnf8UBN9C7+OKkbevztqwanDFcnr6fvHhzHy",
-	"o5VeAuHEYPFoCVjKZwyjsPFf5otkSz6GzeHFC6y40zJJNWFPV4j77hbffjKjCigDeo3uMrwBxoKkI/A4",
-	"dDDC+8nx+9beD/vNh1vrVa+8/BgAsx0RuuRsek0I/S5DnPH1aaH+wUo+38RqEbbCjM74mpwenZDMUCA4",
-	"ZVnbPzsuki2g2zT9n8iq2PpMsXhbY/2lLpQT3NjurN3Bi6LeBGb6hyO2885Pia3yMofPctCUxzv8m5qa",
-	"9em2E/RSLGhzhoJggV4//BVgiNP8itYA/8K0H4r7tnGIg3EEqzFwp/CNJo+GIdoUI7DEfC9RRLGk8+Jg",
-	"/2XM9lBicH4z3ym4wtfMhl7GFI6MFVLHtKSrGEamBQesSySAtRQcyuBFsuXf+BxL42LYGcsJ9VUUPYbj",
-	"1jK8dasNHgawc4bu7VXaTHTmIGgjnS1KWUXiyo7kgme0IPDYusRKhsAYB/svLdBxuaCC/0qtvLFdqjgk",
-	"+YwVPTYQfI5sj2Fc/FfnDW4U21NbuY9W9HIfTE3vKucpXZfMxlYjUGJrb58fv3v+bP/0+cFTcrrkyiZp",
-	"ceURNLEykFmbHbOZWO4Jq/IoRPzPpMAwngwlfikxjtGBmeG7XYPddHK5W4/P7LHCu1qqTUforZYJ+ETM",
-	"o70NXRXOEp0+2d1md2vTxrCNojzh9rHmiQBBLqMCUsOoUpiC5osXWTAil/7GlauJO3qHYcr9rlN4xbcO",
-	"Id9K8YWAseyRwxabTn2WD56QmB42Y5lcWZM/NpiEs4eCuzbDsE/4vfMv2voKeVX0IKTbNwwfMO/eUVao",
-	"iQXab/3KqqWsCsP6w2vcW2zEDSsWZEgX43jKP8fKwchCwPB75JYFwMWYIiDQt3V5BRWH65IeAPprpT5I",
-	"PzMqLs5pwfOgcoed02TUTS1xrrmocTU+S9UlpPnAfKVlialRrXMtBpEIt7g6+WIOlnsJq+3TjuooBtMX",
-	"EBq7XBc847rY1GIovxJSbnvYQQpxo99h3d91lVjag1SwOD7Ak62lPfT5l+qsrGEgzPa2wjH/hbWMgkbM",
-	"t6d8BQGWChzWkCJ6Qbl23mnwtKBOXrulB61bt6vCMHHek3MdzV3F2Fw3KZu12ZrVeCFzRzrUkoq8YOXb",
-	"QXykV/5F8xWU3zAXMy7FAd30RkbmdOPqRVEuiPlWljAJW7+qu9WN9ms1K96+FVU9feyR15WuaFFsCLvM",
-	"ikoBvgDXS9KZyF50PF9I0yzkIopAe9BAnW2I10IuPKBuJArgm+Yaaq5+PU40W4/Cj/Ad2+R0AogrgL6A",
-	"fE5S0qv3Vt+J7UNd0Sbaca2Q36PWFHap31Ux0BarZZUW2hyrjRZUGB3VCHLNV2yPWFRFgHfOK0akYLty",
-	"PlekZCvDSudccSNRK6E5uD0gD3xvdDIQHDUHbB5Z3rdlDs7p+jQKA0xzVvJzp2Ka1cBrAi7QZs1y8v6Q",
-	"lEzkyFyG1UB3cofTKFX02I4uCoBhnp34ymdxjIQY17xjShZm6HCkYgvIpPBPV7onmBNoP4rOUVVExclM",
-	"zyxxPccYVuG9uzutg6i0eHWjPIwgdiFrq/rkrPN0zThBORgfqeACg77d6u7TrU7F5asfL4jSOuLWSNPx",
-	"JNlf15Ktv85r5hbXu+c517J8xaMh6PhwFwImPBBFZflebYReMkAOWLNy15eVxOUjSx4LzeZiyUquWf4M",
-	"fAFmiU8361iuBL6AMadm4OYstt/idhp1wR7OsFqdVAJ32G6x5Yfx4ZnlGc6WTk0tsfIDtUB8nPuIwMKc",
-	"LkZc+XxMaw834XPCBFR9gxM2r0oXXFvHUI2X6WYycYwZswDN6iRJBA/z+3EUc2B/pmRh2D4ooNJsj0hB",
-	"cg41LSJWYM2UPth/abWggRV8V4mTarWimON637UnVSmAjBoBH8QVqd8eNGv4LQW4+klrGcOOPcH18MCb",
-	"wVSTgDL8zEu2gLiIsGws3f11f/e/02VjocuXJc8PNVvFSvrxnBjCxixcripaoDB2OoVHU7KXxC6mgfu9",
-	"SxONAtc2kh5V2NmG2C0cJaOa6VvjUD7edItQj/Kguukktm/U1t3IrhklBs7yw9FJTuCkLTa7TgfwRcWh",
-	"1rlRw6izsT5AAn7ggvftxQzLidPV2gjqyXePv/v+8ZPHTz49+e7x48ePJ9OJkM1gGWhk8vHz1eYXWAPH",
-	"oaa3BVLSitg9QfxNEbUne1f0yvNeh64LGYN4NNLWHrbOdhC9Zso8dsLXLMFFzs95XllYPRWixETsX0Pc",
-	"EeMLKfZnNrhpzOdSPL/kW7z9gvKiKtn4D06qUa509wGW8MAdRxbYhkYO8wh51CcvNu5X+vPHVofDbN5q",
-	"IZoK3Xu181fO8LXkLS+gixu+55VS3tYqm6aba1x3NrzCja/j5RHLjIXn6cCA/avm4zXLXpRyFVdJa2UB",
-	"70+w7JgjvYRcrAqRvnEIDZ1rShSAY1qFCDarkDRv6Oemd3dDox7FyScroZgCg2FgmoVQYGULrOwN6yyt",
-	"tW7tNJhLJ05SjSgP0ZatUBXOrU0duayqmf8V244fpAmAyF4L3+BhZi017r7WRBpdSQAfzQK2bR539jS7",
-	"2lmGgbhRE05hy0RiKK0FmgV9yGtXqoG4Ng3Ws1bA3HUUDCsNeeDq4JFZpYmQviPIN8/oWlels764R7gX",
-	"HmuUrdZ604jIcciyK7qpX8BJq+5hORZwxK5SCDYi64W7Iq5qvcKoZNopUkXOAsRVXqo98iPbKGIuKDZD",
-	"EK18O++Pj5+/+/Rs/+S5uXScs1IjAWV0xYpn5tY92zjv8xTSOfGqfsY2gKLgLFwPnV21YtgNML3dANen",
-	"W0TyHNbU7gT4EOM716BSCHQEG9jktw+TM7b5MHlKPkygzw+TzxZ7tNAo2CaPzP3wkZaP8Nc9fanr0jaV",
-	"MBrq999NPscwY5tIAj5Oy+3XxyRfO/0sif0TkSBpz+6+h+Uadl+DKwcWvjaNhP7r5lESxIPTSst3TJcb",
-	"uyhp95nyN12uVGXt0hDbWTJEcqJFyShGmsL1tWcEgcPDj+AIMCmTMQdWrS/YOSugX6PeZsR0vkE8y5rg",
-	"2h3/nYiqKGp7ZrwReyNohBQkStuG7uYA83ek4jCZc8HVMpkcSWLZkaHgc9/farQbOEu3sv+IbSq4pn0S",
-	"7yph5h6CRdcuiHAZmtEIk3gdherqy3xBFVrm43moliFOeUyhi7Qv5/EuXEN4dCd0vt5s2sGZjEqn7TeQ",
-	"1yAyowFk2uAxX8SYvj0Z65IvFqw8HYEXehq8aiO+hjAN8R3Uf60fI2/q2zsPwFP+IHCae2UoGil/nUAz",
-	"VC0TwWboWblewFmAE24tTWHqdADFEyZSBwJyGjsROwdX+lBWx3TRg8H6QpYXtMzR8WHxBmsLEqS5p5NW",
-	"cW795YxxDcHEr5eM1ygizSjwsVWNm3bpjgRml/pZVaoYmMXbNTU3jAweW9qiubP3o+GaLuAWCkV3/OBH",
-	"bnFSM8LiIS8Yy3vShGFUGBcCXGsrcJS2kG4PwFcMeiJchSjS/jbFef0UDCWBPXkQKM+Pug9fqttuhI4W",
-	"S33BzH/txtklaixPbcmm1grbqm2XNL17xwmiUnC9lJURS5oJlYjdszN6DUghqkc39XcaogRfr5luK6gz",
-	"tpEitzh4KbBwh0mi0rj5vvk6MQyqio31/cJSwnzimzuUlIaSNQzIwQH8HZbWhXG4gjh4FSg2ZMXRARe4",
-	"WKJRO3alt2drQLJrLY6NWPArdHNnicdxvc3o5WCtnNuiSY41uUQ5TmYHrGCa/WBeegFmHcd4LfDcVtW4",
-	"Lh76QN0tBAjHZoaHMiYTMIfXCdTqWhfMJ96rEME+BgRsxhIL8nVe/AYAPrwcdLezopfkyePHoxFFfco9",
-	"xM0d4icO7sL92WKx6aQC85d9DBnvkQVVo1ayPmK6FZ51vLQQ2OfnVVFs7LRzXIftvN9ATnHXibVv4yvB",
-	"6oJaAEQSAIOPXeY4KacLUA9GQyurWAye93Yl/ZzrLhIbdATxs8kCKF7t8pEloHftAC6+kWoF1Q+vCYl/",
-	"IDNnffMjiUbzOcjp4ZhA/yZcGhjbZiwOmT89lg4D+O4Si9yZXq864Ze6JxWxJ5D+ZCnLoJEwkB7MvOPx",
-	"O7rhxzIHo2LssntElSb4gs2kB1OBvVv54bRDCHqDJ9L18EcdhsDZ8gJ8WFz5McTPQC+098ZizOuCjag3",
-	"66RuulCHXxsInNnp6HoPp4TtLfbMdXkm5Zl6lLN1ITe7iwpKdEEFM4hqhOpnILgeEYyxhlj6vb0IXFIz",
-	"2Th0L+zv/jfd/fXx7t/2Pu1+/PedR60fHv6fuMtBZj1Xuaoo6mnCCGuo8BvAphlD0jYCYQvUswbdXlAf",
-	"yDiafG+dTa8SkeV77InISlXMTrJjhYCc11ncwsgP287oFb6fYqAf9OBAZlvfu+u4znt0+XbzuOnLd6fd",
-	"G7h8J7Gwbp1H7/xWnjq778Fl/RbuzH5z0hfnr02L2P7qjGufliUn/VLjypyPxeC34v2hGbj2YkVLGsxt",
-	"awxgIZMvx97D3HZT3PM7UYY716vITner/2FZPq82DNf9u7nb3g3ehjCfEkICnuIkV/XPgaHoaSMyyLTM",
-	"RA5VZOD10epREkIvTUu6VR7f3tfq6oAfb0gNMzsaJz6I0drbopJBT7m/53H48JdMsJJn1sDjK8jb71LY",
-	"+n2kBB09s4GiyQJ0+/Uh37YtdYaetA2h2GIhAvqICgcAxdZnDKpnkEBbz5AHc6Bo65yCGrAuZSWAzZvL",
-	"csbznAnAzss/1UkrQupPc1lhxQdhbp20+OQ+rwSt9FKW/Fe4BJgvF1SzC7BEIqrcJ0Me/oMVvfxUVuJT",
-	"yWiGYRem/Rqv20a+fGKXXMHJZ9rfa3UDvwGy3ydrAHc/htMwTCYrHWWA5+dM6CO5GKrGaPi5pIXplxgK",
-	"cwn+WKevp0qj1my11jH/9T4+IocHDvYDsyTOUxVKcrpIu5w8jN+odhIRk3U57dFN6S0zdE9WtLDLVldw",
-	"XNON0RZjzLRtBcfzRBmzs2jF6ld8sbShS7iT5jVXtTKni08QQVkUq0+VYb9oqEBvmSvYFqqUzDhYY53T",
-	"Oj1QV/MxdjhdtzxkyTJZ5qPaxnLo+LorwbMuZV5loE6NzKECGfsPVqq4Goe5juf43Nk5sH8mzlkh1ywO",
-	"hB/iOLc1ZqyTYUn46Og1ga3rIWKMvE4XLDjxZGrnX8Oh1iSYbviElec82i4+wPPVtp33E0cKqN8mgyQJ",
-	"DU03dL0ueJYsSxqtHO4nj+3AsVHzx15ZiT30WDzcph7pe8VKs0VbcEZMiWjSV4N3GsTua2Db0vfNjYme",
-	"qfZc2LaaWnBMNI+GK5UTjTUxSj9uHmu3HdXSKMZtq6Pgx4GHJVUn73plTcMu5WpNNZ/xgusNkfO5Yjo6",
-	"gKFqpzF6eBWC9QwheOG2WXyfZjh+V0EYk1flcunZiKwq9+58XE6Ve12Ny6jC12MlCF8xWujlCHaxQerO",
-	"WLWE72wlIibyteQRc0BK8L09Z6VRKGwrqpknyspzFiq3+BY6x92/P25XodnHnUPbJFmIea2jMawn+Bk+",
-	"DoC5osfceergdINonZx+vgP1C1yw4LkXnHa44cxjXNCX4B/bcMRiYYCDAJAGaPwRARhB1gIq2M4Q1AZH",
-	"hmYG4LABKv/GwGUsNbs0IYuDgyhnTccZ9BtTceOhUG0EhySUiablgunT6On9Q8ULzV3qtYXvQYUDc8jq",
-	"PqDYFyDEjU1vDjpurmqMdI4SMfTBxdrGH2sJisqmHZq+gzrnU3LGNv+BgVKyhLQcKYrNwz3ympULp0jY",
-	"tgB3BTGiXCCZuXSkUcnqQGrys6YL9fPfA86yZU8UeXV6eky+f/yY8DmZSb0Ee79ikEk3PrrFnPKdSnWq",
-	"L9TAYrZUM/+zC4Uzl15fVU8lC+eNt2smS+gNxVT4nqJU4KZs1XQ1JoKlcIWVwtousZisq4WZ+Aa3Who7",
-	"gZ+4XpqVGQ42CcfdH3fiF+lUymIEPeC6QEXRZOCJebrlDE3vh2IuB2eGbadmAoy/nVpgNhwSDwonFLqq",
-	"850jsQwlAWG4XT3eK6IN+e97oD9Ml2BeOC6ZYnoEiWDlnDW+HpEO+PtY8gj6HiEPsO3eeYyeQQPNVaWQ",
-	"3qHNw6TtnxVbTtWVKxoZVma7SM34RFZjJqzMazcn5KC10U4tqKQxcrp+oMNS7ZQumhMfnbVUfi1ios7I",
-	"ukUhAZ30iwiZ0eIAga2ugnbl4FSuBnp1sYTgpVKaK6VbTUy72hbUcBiRJ2x3lN7au2yLKPBvFP3FZ5CN",
-	"j4UzTdUBdKmQk17EqZKBtglRFQUXTNWBJvGoEvVcab6KFo4NgaxMWwS2EMAXBWHus1ir5u3B/GYcnqsV",
-	"GL3iggnoyLw3bAfC5qwq30XMSZl1+kK62qXAB0rYVMpVqb6pyjVPEkbTdOWoB6pRiWaLet6xwjE9i7KN",
-	"3ZM2S3Fhsa2OML5c85KpmPMBaocReKF2uqMdYnTIcbz82H/+dJoaXXThx5QMimjA2GI9Q9tYbHlfM13y",
-	"7Fhao0lLT09bnt4LfhlfFy70X76PW5DMNTleZ69VW689o6AfbCQ6lYh2lHCEjanaWOMSxOMg77xe47cy",
-	"i7/7MothMMqoWovh5afrT6ZlbhYjb9y40LSUvJvfNOl+o9E/cCnQN9E4oBosEIzvDbzADn5hRDqbrmlx",
-	"KK6JrvSjx1ACIlXOYulvXa6nmJncj0Lb8Js+1RPOLwwJgTkuqSIzxgRZV2rJcjKj2RmodCXbDfX4CJgO",
-	"dLt1igSuLFXEfR8tdmqf/bDpqRIKGCfSt9ODQjqd5HKEWq58cqaD+wLUMFyNtS3SiLjhsQVh8ci852FY",
-	"G+EBUqvPaIykwfSC9vSubR9gj9nkH2h29iqFJ/tsWUohC1vRxbwOhnQHTFvHE9mFHlfRoNlr0vfuRnfz",
-	"7GRhABnm5PhpTQkX/4uoZlQlSuhYBoyzQ82EJcOGrsoN7vt4dBI+G8MN7t1ebsCXoJo8VjBJug+xxIll",
-	"AftRvMUxuF6IfWVD/xRZ0Zx1KCoS1NSLR9S7stfGImqiIm+BR1R/6DGJlM5ZWfbj3SpNRU7L3AbDust8",
-	"apXC6eSy0iMbtyB527TO1uMDJmb9QDUBemUsMmtL4XJSzSxCeKzIA47lHUjvWMK8G1Np32gOLgiiwReO",
-	"ZcGzzQ0MrRN5ABO2m+hJpQeoKAFsFPBheOilFKKTRPTIm2oF0eQ2bKQdJ+0gViGHwbzDnn4Qj5+SD5M3",
-	"LmuX5R8mH8QT89s7jF02f39n/kYAAfPnn8yfAKCMf39v/rYQCebvP8PfZ3y9xud/MX8f01JzWpDgvf9r",
-	"fv+JchgcRFJYlcg8/CuMwYpF88Pf8Addbuyo6kCYx9Mn0++mf5p+P/3z9C/T/zv96/RvH6NVoMz7u+cU",
-	"RK8yO/hG6hMvbN75aO0X7oi3s5xM3fzMv3Bmk6mbU/3ITsa0VR8MbsyABd4WMIOAC7iVjZQga68V7QLx",
-	"+lMtOOvIc6+uUD8X0JgYQvIrP5s1zqbYfAqfX/gpBWdd6acUkTptINOuauVxW+1VMUBWtXNzSJpXiD8P",
-	"wqgD4PAEaJ7Hh93imGLB8O3X4zOx65j3kRCIVwGPTMEm/ufJ2ze7ipXchnT2wSdeFwjQnp9dHLC6VoQD",
-	"fas3NRCQ4c7E5OBxw3PYskCgoDuOZq04KQgxn/b+HS2zxi4TLdQhoz2fr0t2Hv8ccmZlpQabAGeCaSOy",
-	"lbrlTFjDa8lW3kHw8Ih2SvvioBui0ey0seSNkQcLGSxKfEdtBbWuOR2KtDWEhM+49LXPmI4U4Lce9FHF",
-	"204yWtBRJikUub9tURiubjtSA9x5PH6L2aKwcOAom3fDfRJtzBmgRjUW99kcN8rANRPn1lK54LdatOzF",
-	"a/lZnI9o/r+jsmD/5rRQLOa5i4f516O0QZjulLTdTAO1wHOga3XQgJXM8gv32pxWYmPrBbQnGdmdMXvS",
-	"NdJ1sOix93ZdXhhc7D7fvUMKFhgQMPTbJ87YcFenpzmNv31Gb3XjqzsLc3RGn6izWJpb7SBjOXo5zSXb",
-	"2sr8NdN0moyovY4h47BlDfQXtdbCRhPE0ubAY/+1f6k+PjxvPxkU3XUXURq2ZGIuhuNdyWZmRo2H4VFi",
-	"tUZr2mrZJfHKOGcstzvQrgp3Y3akQMHR0u0Hocp3DiUTFPNg/8OGpaalwk+rkSgcIMUPLO+gU5rODQ/3",
-	"rG7aup0mpNOg3obn5ZqmMGqi7nm3ySgJZPNuF3X6Zdz2BBDww+XIHAi2WWuBYNuYltI17AXnglmbeHrp",
-	"qRU6LEdmgo1MRaMrZwxITVJVs916olN0kQGqUWHL1dsXat73CbdqG2hkP6GY66BezhhL/1e8Xuk+Qpc/",
-	"soFNWBkYmdPi/MH1kyvNs+5VbEUvn9XFk7cseYxGJFtYC1AHHmCp5Qe21vIUBSbH4qc14D5pdmu/zekC",
-	"gXe6n9Pigm4UeUJ2Xhy+eNuEye6TmWNCtbAm605YJBozZDHZ2NZyheoV+LCuhpGGoh80x1oWqM1dVhrU",
-	"VU/jvsZgas46kDSs1Ri09tW6u52ZrITNom1uxsMbA6m2nQ4uRXqYg0sQ1xtPLYwAbtduTZV8TtjluuAZ",
-	"1wABCnkY0wbl8bk1OrqtD9RObAavvfj+aDepVWAbS9KklXo/k8yfXwfpvElwaaxzv/dxmmo1gyJmiyTQ",
-	"u4I2b/HXcOouDD659Fum3NLCl5sPEOEpFJcPx9Rcelv6Pp1TEW3VMQ0VeWTWozYFj5aoDd9Xuhn8XvlN",
-	"bS2tr+jvWkuvcrKyzknnKCM0K6VSwaJE8ks0LZ7RNc24jrfZWNWWILRljoZlkOkF+TMVLtqR8lzUYx7b",
-	"+ohgVEtg2zT9LnWItEZeC2l7+ML9u7+DSvOC/wq6zTErMyZ0HIFmozRb7V4YrT74hOyEAySPSGM7yf9x",
-	"aNX+hjkvJFSH8dYWh0aditeJGcP+y61dY3WauzxtUVZqpjE6R5fCdtcy6/01PN5/ayi3dylb2ZmsINs7",
-	"gZEXn9Hjv85NpP+q4F3zPfeEEVp7fEdXUgPqVxv9ur057r3xOS9126MzX8Ju+oebHipd8x96wlpppZen",
-	"LWQvIQUDjCUFgW4YfPxxCDn3Ssi3sQyFZyUDjxEtekAxs/olSFcILiQ7QU07weq02oaSPwyLmQwtVGd8",
-	"fVqof7CSzzfxOD3E/4iOHgrtWZQzcx2ymmugmtqaeYZUm0ioCVTdMQvfE5QaEEhADn4Ocboz3wKw83AN",
-	"hBJefrSS5yyAW22A+HWEh2AXDr14VN2CtqJuP4+Pfc0oZPDFRm2ehWUspY2scdFqbo8gAQlgHjQvulsU",
-	"dW3/ZL95j9/4sdhgiBG4H9bCBYOcsSU957LrYZnR7EzOwWkjBbPm7hgUU1mxKakUUx5fz9ap4KiBfLf3",
-	"OMYvCRwBdom7w2lB7BCC9mJne+sE/4hB9rb47zZVgtkl13F4Op9Cdsk1RF/Yog62XFhkLduJY50LagD+",
-	"KDQrz6MAM03sDcMF5qwkM6YvGBOuWxfAFbXkFfHSil0jjo1Ela7VrgrQ9F4d2mGfsCzdvJtbOIudjK5V",
-	"Y58XpbzQy4fRnnA0w4ei58aEhqKYPrbJROO1LJqvuPAZUQSD6aNCJpVU9YZd1N9rSRSrEWUg86bh2/vr",
-	"oOkg6CsulVDcBgGlLQ/6uhqfUxwkAEXoFrDdb7AtuF38sNHpW415iyDWJxdkBq+OSi8yH75XLE+0/h4Q",
-	"0K/aOCAC3tA6rNjKbtvNtTa8ruvlRkGAMX6w5fzxo6HlvU4fUY6uxDNbFHj4wMuk0KUsCnPLAK8hyCWa",
-	"YUA5eH4gwqpVN7J1E+FQAB1cwc9zJ1SbbuxOlTCI9ap9tizHq06zy05kTqAC2l7h2rFdrxY0x+JkOgxL",
-	"mnCstG867clGRvIxvi3mdnxKUTa03ad1cjKdyUoTSjRVZ4ElYcbqAuAYvNa+Dd5NmBUT+uCaX245UKhU",
-	"f4Uu6++27PBqcdywX8k47vR9uQ4Qq/uNEdCJrYibdhNHid591szfbKDDwi0TiX/FRdjiEwD1xQt5ALN3",
-	"uS4RCwdC2UDTHhUQ0SnJC5W+rJPBAf6ZkUnBdo1+66oAq9HBEcHYuuKvlJCibF+wlh3DYmBkCfrqXjYW",
-	"ctf8uGvuprvSmoh2AeqOlbY6Wwqj1m+BaWGPwOVA2iAiWkixUDxnwcCwOL8bD3gE0Q5AoBKOHbm5A1zQ",
-	"Mm/CJu6F99zS79Aoa5UbZ9mDpOpeqRFUbbxugK/QrY0iY0rlK6k0ltiES7uqm1akDuaNxKpgx4ejcIUd",
-	"sF5n3NsXlI4xft3uqAyO1GLaNdyxNun/IEtZ5IoUMjsDV4XthovFw2B/8W2I7PL/rMSZkBcjIrqCZZzi",
-	"BjVj9UdIIosotp23Jyj3XxSRfemqF/6dAW9PyRZcIXxJvNlxuRAdJhjMiajHF12tVn2JLmY3IrXANFyl",
-	"DUBKrfM6G/U3OgtUcBHL2ndgqxYXJIlZYp6jgzm2vsIF7Fo2tSODCuSVyNOJUEfRUUHuAQ7J6Z/sUg8H",
-	"38IcG2MNuxlY9z6P5LNIESOco6t4s12pp1soP9JbPaoNXeUL1/QVehqu+XIYpBhvU1Z5HDhRb1WkgB8C",
-	"kmOKXJg/fAGDWy1jHMa8hEV8xyIUmWXorx10wvRBAHY2xtBrtCOowuKsiZBD3olSqrHTYjAehweuKert",
-	"koOzcm2OmshIR5di2t/x+mc0CAr3OT6sagu3IRccLG5o2KIZRgQq08gWSEH7DbPYkAmrDygIW7plnKAG",
-	"SlxXVMuqIDuITblmpTIaL9ebhwNIMEnorNe0PMvlhcBFd6Ukd1BZ0zbEiLtIta0dW9t5mZL+mug6mYOm",
-	"g6c6gLL0mgojPd+aTxByiswLedGrKNNKL1OORPPxYQrURumyQqPN8FxtQ1PfW2LK10m49CFPt5xziUEG",
-	"N5dy2ZNheYv5lT5UYkx65Ue/O9fOp6zhQq6ZUvmLm8DojMqY0/XEJm8nNREwsdRiwtbdYKVhKGXUZFfq",
-	"wscbk1GwKINouvY9a1f9PJ1ktCjiwRMi0BwO9l8GMarmwKVh3nYiPXNFRd5z1XFvhC0rc5wJXPmxutEz",
-	"bCcJcpGzNesdiI8OsT6/VaW0TxAlMzaXzhUPb2bUGna3Qo/sBY9vUXpI4qA8Bs46kksWL4DEy3jpNCMv",
-	"6ip4Qfh9EAX0QLntiNuiELM9ZY5/7jDdu6a5EF2gc7zaxsbj3ruO6npN7SO8I//7g4U9Gr0tJPPAcPiD",
-	"KXmw1Hpt/p/L7IyV5l92fR48HBcxFasW5SOyFJRf61SNwr3YI8+oIDOGSP5c+AwWUrI5K5nImCIFP2Pk",
-	"337j+R5CGHwGsHqQtkgm9WLb8t6mQbQqBbnVhrH5vA5uj1aVorx4KxJV8HzGh1HFRU6YeZsIqb0nQhEp",
-	"avSdqoyjeCZQJhPltVgDLqp5AiQAvrqN/8MtK0g6MwGNjO5Zwn4ZTYIsaVHEDq2ua8q9G0SLyHnofB8V",
-	"ir1vBIn5EJ7bnCjYblDUsQtPOhRjZjNC8TNzy/XUA6RSU1UYwSzwg8k0iAgZLeM6b3zEOAIfXjsqSqG7",
-	"XGqP2IsZ4Yo8eYx5GbpBtQM5dB32TOXZHzfTzqhSHsulPu/MkkPlDWvKjxFIyXyIiopSiX0WHDkzRlZM",
-	"1wcO6zlvRoe8dPIzWpFEwzEX9l1wRAFGXfcSCr9HpL+64DpbPsqoYsR8jLpTK0LSfNyk/PYt1SccRwyD",
-	"xD4mO+ySZhAt9qBkT0u2YJcPHg5D3GLpkGghs1orkDB6hhVJ/DAHWl5xcYivPhlCprczrEcTu8n0tBfA",
-	"q7YPTO8kMlqWeYtqXzbtwb/9dnK6f/r+5PPwSrWGjP1N3e7FxovjiHkLzL/9vTnEZ3MJmUZ+4VtNYOUG",
-	"+lIU2ukFwH1TvUSrLF1rRBNrwjxtg+c02GL6lLDlPnvjp+pDAeowNc63OqRqjxzOiWLaJsfZhommZ0wR",
-	"I2tYDpJdntfZl7aapHu3oY3tDYTxx4yB8dt1yegqDAluIyp9DBye3g3ZvTF54KbuQrWwIxrXjmTsQNoK",
-	"6iIPXHYn5iFmoGNLWRBzI2rlMMLvLjVsb6iQ6XWhXo5T0C7bnEApN310E93io8E6ZcgGZbSJPU9bY8qK",
-	"ChOz+MolJ3lX3Ne8VQPokCQJiResTi9K5G2SwpA7eNTor43rdyVMvwE8ojpTIwrNNuD8RdtU2u7pCnxb",
-	"kNJ08mS6yrb9cmyF7b7S2icbke1Xepm6g7/kuo0u32/eVmr5I9u44Pk4huHJySuyLvm50RnO2GYLsPtj",
-	"a2d3d1J4jexclFwzrJIWiU6Bp29FsfFxKO1S8g7eXqnlNbBe7FpK8//Ueprnu2ojsoFlZMJcohIw8enA",
-	"b9N1LHY66oaWAiyx1TrWS6d0KI4n/CoYSGo5nsnViut0vKV5OoScD/XQn6/sERJNIJLlm6QbIzGufkh/",
-	"Q/Yjdsn0PShsmhyGIwYaGfllSE+fp5NZSUW2TJQIWK1G1E9t7wvgV/WQ25rqeH/rSi2f931ZMgCAspHJ",
-	"/WzlhvCxd8veQ+LRGG8wpiiRbzt5JzuZ3LKCZ3qEzxvLd8MmmS8wMU07dMmuKStV/Kw+MyPwMiup2T6I",
-	"i54XnvmlT7zwOtlHBAYAFImhA/iZFAIhmU+Z6l+tqrBODvcF0VjAK1IWbOsVCkokDxwH7s3UjA5YwTT7",
-	"gepsOYZXc3idKFZgYi3aQtqTmstoTuML87NrAs2YWCEVymatZB7ajEtPYiqeiwl7FtGZDzVbkcMDVQ/3",
-	"Rmw20wlaz+1jq58kVT97YjoQenethwFFEb2jJJnet+d90PcWQR2kqfcg3gx3bqmxxmOyQPkBj3oTENKM",
-	"KJKlqAJWj67ykqolCiZAmzfzjl9VHEkdWIkVuxH95K4/XsRd0IaMG5m+zNW6oJv4JfcAH+7OS85EXmww",
-	"GC0FZDXnBYvr6e9YQSHQdu6NVDYUXTOhUlWn/b53IhpndhNCj8tOs21Z6br5aHyLix0fOhANbfxo3oWS",
-	"pkqfwLb1bom52JvdaG7zuA2pu3hF1TJqktdYLH8kMYHYSrRlo0dQsmVBy1EHG4fShL0zh02AYFVeKu3J",
-	"kVBF7OejFwIF7TYr7b7A+YBnflxXDi/3ZJyJYCOy2kzQ1gK6OJHSR8XjuwSVMSjPXZ8eD+MIdk0Nos1W",
-	"YXNAEKPbfJ06EeyDKw9Zbb2GKS3Hy5OmlArsKMDCfTL9gM/nafXHPPUpzMgDVORuyrbWv4sTh4twTPDf",
-	"kdg7XXZkXpLbn6VCApsMD8PqCVS/MmlvT8rhCYmZd5BlUxrRsaBcqN42U7O1Ag2KvNgBBveO7XmjpS01",
-	"p27p5c54wnNBY8v7uOHHaLaUizEJKdzZsTCs2uN32KRgQAwpCoTUAMg5mcUDzDYiO65mBVfL/WJUyPUa",
-	"3x7S3Edp1bYt8EnZ9K8pJK74c0KWpBK6pNmZ6wkwVuyHLN/bKnRqpJI9Li4nWLpt1i2eJBBcdPrydfHi",
-	"45pi58zi6PbfcG582ngrHXNrpUN3h3rkXeG//xIIpTPD8Rte13kelxzh70P9xJPUhNKTgOAMSNfw724z",
-	"kZ57+nRiNcsRfXsddIvi32H11ivg/igfuVu3lBKBqRBrf82zaXKWhbyn2M0qWFovNSb1ZW3iNeSkLMQR",
-	"AKRmNNjG/G6GcLD/EuKlGM2WSOIJ96TvOwHGj8OJPwyIKpJR5jc9gpznJx953N6f6yxebPvSYuHtOSuN",
-	"dPcG2sSa1VbS5vc/wO9W++i7m7vdG3eW291uWlLjwYl+5FyR2j8StympQXMFEI89z0fLp1TWlrlfPR9T",
-	"xhB0LbiNYQg7TKjvntt7vfNXWyIziM3b/h49RuOU87qnvraSEsQLj3rAO1Y0PYJTInETC23gHeQu+4y8",
-	"f3cUxw0fBX8K406An9ZE5hpzpOLpPMmNY8BQK1UHCpk1mdF8wWKidc1EjraBiEBwBTRjUvWULux+rEuW",
-	"YSm3WET2gX9OaMEpjgsc8GqP7PvAblLATxB5ul4Xm6BaEYQX7CDtPSVnbPMfWABAluYP9BXvkdesXLiK",
-	"dbYti4dHglBmtWbZHnld6YoWAFqaFZUyF0X47mf88Oe/48Kxskb8U+TV6ekx+f4xBJfOpF6CwqqY3k5V",
-	"RbeAw1qs3QVp1MUv6QA4xdAwL39oUdiY3z7Cd6rWbz04nuNaCkJsOveNgzD3xJAIqEPmUs1FxVSQw8AF",
-	"QEgsSkio/fxxi9inTgkWuyK2GGTpnV6GfPxAzCGLHYcJcN3VlbI48Dk+MQx/CFZqpwFBpd5zyguwxlo+",
-	"OTp6Hckm7ckoOUikkGCXiRSS4RRi83kygAkClbZL6IDApRMgB5v1MnNpKaarByqoAxIt0jEqSvEUQfxO",
-	"o6kgr+RFQxSZ9cckWt0A23SAFNMaJwEyo0UF6PAXbLaU8gxE/gyv91Bqz4heqrNltY7L2UoIVlwFf0LD",
-	"lyDGuEcv6QtPSSRuQDNAzl45MiyVSKwJtKXR1ZdxoOnay1zBhTzrrydim7GVYBRep20MjWDaBhrFb9JR",
-	"yEdcekTudAHRmCf1AKLI5/D8AZxop5QXKqMFexg3c9cF4LvGxebAS4Lp0AFladd4lEJwnjaZNlYOBZbh",
-	"/bujumjRbBOsWKjYVSW/GbgYOyfr3d5Ce0xByDgDan1dbMzA280QoczecAQCTNd/2AqXjUcpHSetsuEQ",
-	"Y4LkveCXp+HNupOudBmsVCOqi13S1bpgk6dP/vJ/v/vzn548+dvfRmHvYRzN/vHhj2yTNFjhS2T/+NDo",
-	"TKS07211YrxhF2Hi4aRR3+7Pjx+PPy/YhXNlBi04WPY+73spi0F4s/eKle/Me5+nkwtZngGe477Xhvo+",
-	"/an1etRQZtd7wYR2sWejQ5j2DwmiDPSHMHVhIFK6TxtOIlLOcEi8L4NhDVx/nW34RFYD41KyKjDlz3wB",
-	"hfgK0FfcKR8JE5XFuKQjWPrT+vXPcLYiAMqob39yb7tYq4Ftfg3G9y222eJb1g6ekbgRhjGa35KdlcWS",
-	"eDhokct6HBE4m5Ew23YWHl9763m8qIqmjyuI/HeIcuSf+6+PyLyUQq8gu+kG5gcMszVTUuSeobDCNf+R",
-	"RULmYkHJsx6UfIsD9RMXubywAUc9xTIGsUDEutLPpNLHrHzyOl79s6fWBUhgLOp8Ks+YUMMjQlGzFcw9",
-	"JkBdZ5ShAuVB4YRelnINhQXkmgnK/T92M5mzy8l0smCmWfu7zU60frvJdPIr5VGNSlXrtSy1Ol1ycda0",
-	"44bVSe3T5/O5LHU4skJegM8u59VqMp0s+WI5mU4u4f/jAApdcGxdlMGT8/Y1GVwA/s1WbIA3ACUmAAlK",
-	"v/U+AADaGvHm6gUU0ouLkEBbSAk403Ys7of9+eG9AQUS48Pmcf6gJ41GjYLpYq0Wi1ne8gurA6d590EA",
-	"WPXcY587/KmourGttpfGmDIHq3tKdlYNtIOHTTX0L98PaqG3p1X6V5P8PpYMRg0IYYCjw1Gxy+p7xM3u",
-	"R3U6Tt5195vZR/6+uzOreKG5MJdqyfMsRP60j4wE53k2XDqm1aNFN3O4v/Xda/R9lKfBVxFGvDcoaBRb",
-	"tHgBIubra+wN8EVY4qWFcEOVdhJu+7VJM5zp/oEKUd1ug4s6uGZBf7BI3V5CcglX5mOCCbYzuVVDDFJZ",
-	"vhrauijQXXqQliAi3GqWAYJBy5VF8MB0u7X5AfL+1R5CAT4lc6O22+e1wg5zWlFBF8zcBqb23+VTsHc/",
-	"e/f+AGL3WhWgaZVzTQrpOpySnJ2zwqxF6sOpDSeR9o0ADUUUmyk55+zCfF0yimUsQ4BgMwO0tlIELPPd",
-	"ofIH7U6mE2wkKkfMeql2sa7hPS8sSJRZJxXd8PGBKrj1A2AQ2GSMFn5CE7ORs/GqRC0J7GsSUWKt076c",
-	"DRM5QH6HVkijCn6yC49/UJF/Wq6oURTN//BhbG3tyJ4B741NIkKpbe6MJSnZggkINsJ6cXa4O0inTCHx",
-	"wqgeRio+RlNQ4ZrqJ475p1AeVS2NniZFxqYW80exrCpZsfn/RXUzZ9ofEmH4mkV97exr7SBoK92dHXZN",
-	"dM8UO5sm0kpucWZ3cJJCugs51jHrnuGOekbMxhNb/0nsAwvcel8Awjuj29iG7fs/bBLC7vCAXCylazbs",
-	"LpHnP4wwAMB2S67aDKKubGxzDXFFPJZ594wHrhq3A69e7z/zNNWrsERgsur1qX0AFc9TsRjv1RbbCyEZ",
-	"dr222GUg0uOSzfllDGylVJr8lWRLWtIMgA+859HQNiDc2mm2C50kit6NnUkQSHXVOnl1BYxwktPAt+C5",
-	"z5LANvpKQA8OZGxUfq0veOjnbJoAsAA8IuCYFhDLalSAvQ/icB7+8NoWIHSlHkB6w5yMtDaNTQnXzlit",
-	"EEqt5JltqfGZG4xFG2OXXGG5HBwaVoxw4KvBGD6IlR0FVOkvz1mORN44qKYEyu0Rf1q5GvJQvM4P6lnB",
-	"DaeZg6AqchhgZ76Y52q3y/Tr24TwkQ/Cxo/Y71zr9YoiQqgicgZv7n0QvbK4eQyPPnlbw95Cpjxvfdmm",
-	"aD+4AVpMHlbo1G0SXfTg6q5LsZAl18tVTEBcstwSsHsLCOH8yZVEtmeFQG/ijcLHPQ6TG1z8WuhEILxs",
-	"EVu+EFQbPl4yai7WGCeVhn3B1+KH4CtsIownWDJcDd9P1H/LspJpL396VpYK2xx8AYtaV17tnk/d1R0j",
-	"xcMevCS3quRVRXngCW5PdoAVnnfpoRtrAkM+pwXPPbVZOrIiEl5ouOpqbK0SI/isTOnTxU0rJzD+sfq4",
-	"l+htfbzBwS2VHJeoq2YaqYXdJxTzcOfuh1oeDLlnn/tvkGEZF9tyV7z5B2Pvje0pDNwgffs90xhvGu65",
-	"Ot5i/J+HyuU5W62lhtr8Z2wDSUc+0MWcs9QH5oFlArT5wwNCi5LRfIMahpp+EAAoZsfuoz6/f/w34sAv",
-	"oGlzeXLNTwklgl2Q9+8PDwxDerliznIAN13TjasNOT7mbb+ccV3ScoOwXbaNNtyomRRV5KfnP7x6+/bH",
-	"T8f7/zx6u38QjYFL7/Eg40NU+TY7PHyl8jeCeJmRqwCwDdec66H0U7lYFKMUZQ1v+uVwwXAQ+X+FwDpp",
-	"W8AC1rVjJH3mbQG085Msz2JG+FgBxpybxZ8Bkt4FftfJ3ajU5lgW8dpUb2psXnwlVI9qKHGqzuLwVUtG",
-	"C70clwOO83oVfrH9pdfNMXLHnbGiJ0R1yHc5+ZFtdlHtWlNe2swEiEanyuhNNUJfexD11hkd5RWjpZ4x",
-	"qsfF3FnWAu1m6T4lJcsYh4uQ4WI0YySnXtZlO3ug8GETk8U6MbAw7GbU8RVWDI2lqklNiyTxYf1a0SXB",
-	"tjIZmX0qeQou65YWWv1PG6zQWrbu3rWIO82pr1o80NHGC71sBT/ibAgW9JKitfEiCw3kOIoNlKgobamL",
-	"Srhfo2oitD7WII7TgZUu7I29K1VUHN2oh9zwOWECPGNwaFjsGsz/dmGM4xMw3EiSXcaHPU4NQ/IaVL98",
-	"q3b6Kapouce6lRuwVrVz6UA43J5RLf/DqBVkUVKhHerp2qL9gkOIC8LOWbkh3j2Gn0FyMrFjVXXBUv+a",
-	"axP1qrpVdKtA43uR23rRZxLlivggiIwKNx3QkF2/8bBtHEzP0tRlIOy4oWICWm7cdLfZXGj0pWlqcJMp",
-	"pOnbEfZuMLYXwUwv7HJbCRZUffHrEgFLv2KE7PZBA22SdgOCIfTOuC1TWrefetNH33/quAnb6hgetJ30",
-	"DjWuzta8ByjC5BSEkbU6IiS8IV/DJS5Cl5YM7g60KOQFAhz0Rp14UPvJ//xrf/e/6e6vj3f/9mn347//",
-	"2yQhrJsL0C37HzpnRkJUXa8Q2Rbk1DLmXNW+nkjwQbNUVXK9gVQiHzrnQ+NmjJasfOG6lWv6C6DXw3BB",
-	"6sAL9SiWWq8b8W2ufiu+7aLnwpc/Q7jmXLooMYrZ47hak39KTckruqI5NUdzWdjv1NNHjxZcL6vZXiZX",
-	"jzZSa7pc5V3Nc//4sFPo3hDgSgquJZyZB3RR2bTGPSgxmjFLKnYQL4+Pdv+097hvADldVFkhqxz+9WhW",
-	"yNmjFeXi0dHhs+dvTp7v4dg010YQTUyXk+nEQbQ8nTzZe7z3GIJC10zQNZ88nfwJfkJMS9iZRxCN/khh",
-	"YQL4aRGzExk5ooh7zav3zjsAkQtw51/TBRdw59nz4QBcwhUTGoEw7xPXXTNbLWGiqF95VIdpgp1i4O1j",
-	"uhj3Hivx1Y+G0JGrYSm+e/y4FWhI1+achik9+l+FzIqMNsSGncnXAvRzh8KOcRVZ7mMe/A59nk6+f/zk",
-	"xoZlM0i7Q3gjGyDWzPKBxVa57d7fC+Zs7cy+E2SDowaJiRSqpiUNidL/msADrCAfLRKOsRHKmpQazSBO",
-	"PoNSaAD1ViqP7tIlaGwo3NVrUfRHlLNM6R9kvrmxRcYEmiXVzvDyuSnRbSh9i/JvjsS6q9RH+vYVH7EA",
-	"9P749inuUIAjwN90viif/fnxn26/Z9gR0JTqC/x9YnKkmyZ/Rrj887R9ij36zf7rMP+cPNHeWbOzY3wX",
-	"uVOH41m2xytYFiRFRiTBS6ZvTAwMH1hhV4f57Z5bYV/o9RjDvXntH/lyXPT94+9vv2c3Y8NHWEf8HrHQ",
-	"S6avyT+PMioyzEJKHKbwXLkSeBYFAowLgAvZ6D5yhMLnv2veaQIK9B15sBaFI95vbPPFTh7YiGtzzhJj",
-	"SOJ8cwJKJvXoDFo2grCG2GZJv/iBc1801e/uml8dfDDNMrb+w+qoX05MfNOOrfxoCI/rCSvkqbxHXlWz",
-	"FUe7fvmgrsBl5Rb2vi7laq270gqZKf+dCqz3ipXHMPOm0BiSW3euZ3gX4jfB9WUE1/dP7mCtkRQJu1wb",
-	"4oN60zb+igp1we6ZGLOioZZgKENSAmzNd8/YRg3e6mlROAgetUfeOTcrJK9532nEOg3YPhBscGu8il00",
-	"HHORZXM+cjeJL80yd3DiRnbpTin1edLQHBBTSJiOGNOWZgivRRgoQ5cQPFJsECaTN8pYRg3LQCqT2zm6",
-	"wi6+rGHYDiHNC8/+yKbgL8l63z/+2x3Mmq5YK0T4i7O9NT1bpo/zfHgePfrtjG2stdkWOoscTefyDKzJ",
-	"rt2RBxPWhfPCYDu1GT+LG7q+j6QtW9g6nET+Ryb97+9m1ndv/IoRPNJYP8FP+1UuRzqzDTk8GEnaL5m+",
-	"Bbq+aWWt72yqGeZeODy+McwdMcxLpoe4ZU11rAYD4g/V/MLFXI5klxCA9Locc/MaZQwe9a4NIYP8ioPM",
-	"/d590yl/93LiD6vFNqGIe7TYKsfqIf0mFY8zxIQuOVNkZQScyxCY80KzkmQl16zkdI+8RoAgMEABBZir",
-	"b8LsYpo+kotrBwS2E9RhSLONHbuh/4UsNw5iHKGaaDEFo/aU5HTxEAr0T55OfqkYAMbbcE33qQtUpVEA",
-	"wPQAKgRNSbRunkIOXW/bA0txJBcnmpb6lK9GmciP5OK5yMe+7bboiK+4Htn82/lcMX3LGpojnTHGtA4J",
-	"/wHE8AtZznieM0F263STVZs379zYNirAs7NdgQgDmeXkl14+ypZULNjuOoBIjdvk9otCXmB4Z2NHkEe1",
-	"JNiSeYGXRF4I4tuMeOHFgh3Xj2/FUNfo5AspVidYuqY/ZhlHaNcvJ7bczbwqii+mZzlRf8Homd/Hh1+O",
-	"7Q3HXZRSLHxAoSeu+xT/ghwQRvdD+RdP5zUX6mXIhIVccNHDesFSKItvi2kDHjKWitx3NPXYAJT850+n",
-	"FndKClJXRGzpEtD97bAhtP2FuM/23WOFaKLm1Jx3Z5Tu+C4rGWQY0kLdA4q2qUiTp//6GNJ3SIcWEFbk",
-	"RM405aKmtB46x6yrXnWZd7L/g4SZYhM5e2LWMVuL5T0+vjUCa+CvxjwwYZ5PiLr6LRvF2oKcrIxua4qM",
-	"FNPVuie01uap1JkoqKzBLtjkbCyPVRBoao+8BaA1X7IM8m+FRMRUvJbukXcRoQpgfqsVy7lhCRDj3ava",
-	"CQz3Hma4wMDurWyGPaMhPvZXoBz96S5CZHS19jYT8yX4nMhOQK0P7+8hYn2EjgFpuM1xns/pYresRG/0",
-	"SsnZOehGRQBfZevh2ULevq4LmlyMFJhtiNeefPW5rp0FYXy2t7JgaJlpYczN/4BqdspX7EUpV9u8fypH",
-	"vW2hiGwVotFfmNGPNl7UnzyrSmVLbN5ogKLPyk7brfyu291NGI/so+3NUmH7B/svHXrOTiZXK7qrmBmt",
-	"ZvnD+sSAtwHhBqp3Q43MJT1nZP/oyEEmsNw21FP81fDGFUq/RibvkWMGpn+Fyrl1idrVQBHbPbLtiiZm",
-	"AzKjby63aUmzwuGYLljvcRYcXXUA012dYK9pYZRPBgl5SoIFi9tTrU49DyuV3uX58ZIJVvKsVbI2FVLm",
-	"qCU4LPwJMSZ52VVJxUKZxQY5k9aQfK4MswdoJjv/3H999NAdE6VWNfT/3gfxQZwuAezSIvPlkikIYLXa",
-	"FLTNdn2Oiml/zgtmObkyC8py8rPp82fAB6alstCAFmWT5R+E4ite0BIrT/9sTkX1yD3+eQpvA+RQhYCc",
-	"DvDKq6fFxkgH+AKRfJsH3XP8AMnZnEEna5Z9Ka31LmAZDw88HoMlib+bBbJYzw45UQrWAE5E1MQaha1X",
-	"x8W3kmUm/VBACdFw37DFSQ1hrFkGo4GM+0SNElj6GHKx35IIKiOQv7myn7x90wO+q7hYFEy7SptWGAAk",
-	"UUdDnhM4IAyhn9t83xJLW88tvKWFtmREmcnCjLnyaqwFKSM7Aazlw3iJR0OW3WLRHZ7lwhbwS85P2wLx",
-	"fVsIReQ7BcnNECIgLjd+gUqzwfVQJ+NjbynVlQcTqAXfF4gmdTtrjqzm+XQnDur9LYgXlskxTYh/fO8O",
-	"U4cR4KvbsOBghPOQi4ILFELxgza8mT1i4peKVWzYJnPd49d2pAhHKL97fw6vWam40jWqJoy//yTGOX47",
-	"iW3VhfogvuBFQWbsaz+NkSRqoQLbnW9xMntma83gnJUlz1lAZ3YaHl/U9nHTxz2ypT3vDV9FpKMs3UTD",
-	"Qx7SRAue6W+n/Zc87R3thfZVJ2z/cGf+MAHXp/xK5uzhFACy7Xp/yXC2UYe/PV+ud+L/ZmTg5y9tkv1h",
-	"Y6sVfe2GWQsy+Qc0436zzf0ObXO2xGaNB+w0plFS5dFvTpb2ZoYds3JFBYYFlGwlzxv3i5JlskTlHZQ2",
-	"pWTG4TYLtw+q6R45cCU4fc3Q6VDcLWb8IGHdMhjDwf7L7cTCMymykmk2Om0teuo3ctge38lpC4PIqDCX",
-	"tRlrjuD7uxvBlwFVGsVhNtGMhgp8zPgdPYxfMJ0tmbLJVswdrJFYm4BjbUd75L1i5EFhbu/6AaEYVuF1",
-	"HS1JaY96eLCSyoL761q7sHe72tNlFCzApY7F7iAtH9SF1e4Ti91E5lxMzw9K0A0faKlCUM2mxqj9PSff",
-	"3fDdu/vNdk6N7bCOnA/xYv+59oiWms9ppocxOSDi2r4N9iqiS+aqItdDSPDRvu/nHnLSiPwJO/x3zChH",
-	"/PyWFUbX3WnJ+jVGtx+wFU4CxsKT7oCN/GDQpCrLzX1mKdQVHVV2yPganPQolxfCFTOLstSBfcHoiXiF",
-	"b3GWtUWnuMp932Str5uzjqlebstUMtNM7ypdMrpq0o2vizDjgkKMRjsWI02+sAOuR1f7E0bzDH/cPeBq",
-	"LRV35R5a7WhNs+XKaB6mpcH4ns93zp8wwfusZFryBmQ6P+gbZNB1yc45uxg88ux7ZMU0NTc1dKqwS+3I",
-	"w5WbifDw8FF4bEfxh2Pbq52FdrlGHYdu4+7LiXjfOc6u7Q0zXM68gyh+DLK2Adi7UTE4sVE+2voAuoaQ",
-	"wEv5FV3Tvl2AtrM7OFJpOimvRJcs53rXiIbNmEABwS6aDgoqiGnB2g9yNocYcilsxXxbVR88NZVeV9rG",
-	"3CpW2OrjZ3y9hiscW6uu6eF5zrW5723uOUnfkB//Tb8T3JloiHROZWfGsXsA+0jKChJeeivkbhMogNsf",
-	"DRd47mr9GYIIQgYCOomEDbTrHmPEgCGFE0MGEcBe8zPRkqxoeeZppkNimOYM552slKU4ck5LTmcFA/Ia",
-	"X/Ix7uV+XhN7n7N7b7Dy2FfjwbaRDXmsKHBu018vfFlGjLG5oMrJJi6UZjSHuk8+/rAOf92LRxjgDntq",
-	"2GLXMCJu6y/TtaD9UdsYVKunMXa9k9CgX9BKZEuWNzj3HtraqoZ8x1Fe75gJLxzx4+YfNnBLpU8XuqCG",
-	"sDB6DLndHUvmWlIGZjpXStGcMCA67OETrUVgdb9vx84NHju/W0EaX7Z3TMniPHCoRFcrulKpusaWIczq",
-	"2NLGcCNYVQpcc3N+yXIyY3NZMitYuFhsd9pxwQq+4LOCJY7gY8dndXKjwlPZDKX2E/rD2QXS2Vk3Nrkx",
-	"tlYpWkZVonCo6c+t+QBNuDenrr1Yudb2GpSVgNjgfiXETNfFPNozwGg4Zvp27lvqGa2zrn0trKWXqtVm",
-	"LYO13rK/eEeebAOBi53y8Coqy9yWxK/NCiKHMKG9sTWRzVSjRbaxxnhkcG+k2J0VMjsz2oN7LclXVz/z",
-	"Lc20TvomXbgV7PKMZ+BgKlsrBnZZWd6Z2v21lNQkY6M8rqolFHKRNJA4hzksi6fHQi7Get2O5OLrNC+e",
-	"Ul6Mee8Vo6Pac1hmI4DPMDrt4+1iDtw/J/iRoap7bqb0bnAjN1occW1PeCEX23jugCeF5mWHNcGB18uf",
-	"Tf/dPWXSYQ7Q7FI/WheUtwhg0NXmae136GX7GvjIO9hug4+s0XPUoWbf3TOTrymCckxjKwq8wTozaiYL",
-	"qwta9Jwwj7TOwCJhxIr7lgJgzsnbNwSVEnKxZCWDmiAAUlC/CxcYyPoX5wxUXTDfvj8+fv7u07P9k+cA",
-	"MkhXrHhGFSOzjbtuQ8xmViktV4gMPa9DzR667K2K1f1ldK0rKPKDHSOxqp5otLd2bX+H0WjDljk3+SF1",
-	"snb6OfJqUERNRY424N7mfLx75HDeIKz68jerdL1nQrrvA5w7QdhqrTe+ZaS1vW8RpJEzvLsPnaiX7YVP",
-	"ycwo86royQE9AnMBoWReMrVs+XbIkistYRZeLKLRvWQVlsfViphXWB7EwkeL5tmRfDOo9RrUhLlQ37D7",
-	"5tYdN5ViFlTvgC5e8CIy9Z8gZRPSE2uqRCoLoASdCgShnHAIlXzB3YrBkRi4EyB8GWnP0Skt0HaoBF2r",
-	"pdQx78LnyI38j+djGfR3bG24cLuaN93EXwwQrp2jeAcnzisnLjvHzp2kSD6zWbtkxzJ1M+nx4X08/7ws",
-	"cCePTVKyghBx5q9y9F0hoGFGFVS1C71K/uCLnGl/GP/QzR07hwdOcrtFt8kqGxsDEJj444cnqKQOl2KK",
-	"NQghix5uR84wj2pvPuw9GkjIHuVBGmm5un+q56Z7YbwStzkP3+AVFw/nhruvJoeGf7fODLSOpAZOQuJG",
-	"eG1Mjq8iOSnuTz21iwgp+kPIC+NdqPcsMg+IVZaoQX8F97que/v6kaTgfnr0mxOVnx/RtZGHPZe8fXwB",
-	"MiyMlHTxpFyQnygHdRFTqKY++x88UUbIIjDzugoVqqYTtIZopoowcc5LKcAW6QOvTEeqmilzHgnry+xw",
-	"sR2k5WTN1l+ns+bEnWC3Vqcd18n0E8BE32olsrDHdMC5eU4sLX4hUGgYAkeAqQ5xE+BXlHpI0oqsuFL2",
-	"en3XIgw48R7LMLvrhJILv4zAlDcgscb6eb13VxAucn7O84paOzgXY3y+hiC+Wr9vLUq+Ah/xmOlAato3",
-	"b/K91ljqgAqPOhDjt5sRAlf0MTc9y1vKhqa/+Q8jIMZz3zdP9tfuyb47Hl4xpeiCjXNwHx29JhkEruJX",
-	"1my/sWOFR6bp2kWJDkQI14OXhBS7/rU+37DhjNdubL+He8QtnZfPllS7deotmhRsm/rSmaRfkRJdO3gb",
-	"C2iZ88b06XWllrszmp2lbQDHlTJ8aF4aYQYYe/fn4n/RbZ28+tdgraZLgPoFbGO1R07drxDIDsU3qLA3",
-	"SFoACClfVCVNpGpUavkDzc6+GQwGKNgt1B1aDJpdDpgMDPGyHCnz/lkNps5G0LYdTL388ZjWSMJN+v0m",
-	"FSNx45VaOkkUGhdALnoRAdEmXC/JnLEchNuNyMqSgXU7KSjfwfMr20opwYwTO5f/xUyziPfQPPkmuwbo",
-	"DJfpDiVX2OGA3MLNvZemzm9CJ6aKQajrbZgz7aIbkUJ1tozEG0ARYmX1oBqsrnk9M8Ku13Txfp1THXhI",
-	"ThwW8x9VdLQco34b+qjIzNsuXDdtEH6+/VCALxEQddcAKl+BSHhNBRbDq4Cx7JH/IIj6qeHOryImZE9B",
-	"3BeyzJiNnNNybfQNh2lv2R9D6KnIWGGezhHrspSyRnF1yonS5j62ZiJ33xfsnBWEVlquqOZZnRzZlCin",
-	"rFxxQfXvFjLofhGcX+56a69rkatmu1vUYtV85YsidfGGwSxRFERVM4R7qIQiO1xkRZXjDWiNtac8f6iH",
-	"kYNsj0C475qWTOiTaub2Ekrv+MgtlydgZgAdQSQQ1UFb9TDIjhnbqio0t6QtmLI5p+ph1BDoO76vB2Qi",
-	"OByXrTH5wwO3pAOrZ0RBbPVaUdNOhhCHJZ2TnuVNVfjs7O+tlvtsHfXV7J2l+HEp526UCMQ8mAvu2v8q",
-	"gqLIPc9yecl0U6RgSB+KIiN/ri35Hv2majL8POiM2Apz3Y48FWfQoqyvQ9jUYbjB7Bq48bhEYKl27L+m",
-	"ellzv2rwfVM5vjs58AcGaj8Jdu6rRWtvMtdNCoGbAnPfWg7cd3D3bSVBAxb8ZmXBN6j5b1DzyGR3IAZu",
-	"Dom+TxK4Njri4OuWBnkChfxLyYNvAPnf4LqHAfLvRKzcEn5+yICDWPodaXO/4fRHCp11HIj9a5I539D9",
-	"vx50/1uUFtfMdxh58/gGcHeN5IVtr0XBdt0vA8m39Ii7To+4VblxCykS21xf7q9M2fbm8gUZ9ltGxe8x",
-	"o+L22L4XTyG0WkbwFCrnzWsOddc6X3oViPuKn3DF4xmgEO65AyMO5pDGybBbeXOQDieWNpq9feHbxElN",
-	"sPfdsemG+q4S5CRcwxsXCrcH+1BHHgbsdA+wIGrBdH8jpMcIpgDH1WfCaOmwEu7anvENoeIbQsU4t/JX",
-	"i1JB2sLs1oXxTSFajLX1fNV5618RWMV9sgzdl6Thb1akWwXZuGOxdZsYHFuZmu63SLuKuQkW4ouLgm/Y",
-	"IN9Ez0hskLsUPbcCHdKZxrWwRBqi6Z7DiYyQT79PbeSrhDD5ym5ZgzAmdy8+bhjyJGEC+4pwUH7nxrK1",
-	"w4z43ZnLvuGz/CHwWb4ykZ/EaGkLyVsBbdniILgpPJdtnCBbgbz8zgWzXf/fm1T+hjxzv5FnvjoNOoI+",
-	"c/da800j1fQZ+BCtpiH97jVgzUgLn8UK8SLQrtKXt/Z/g9D5BqFzfyF0tpN1Y7BMmiWDLKqAU9fInBea",
-	"QWHc2QaLLVKRk4LOWNGNNzniSh/sv9xeMh3TxShBc8zKsa9uJ+x+kuWZWtOMRUTYC1gCwPBwi5CA8hi0",
-	"pQ+1jetKdjK5WtFdxcwINcsf1uZXeBOUb7iF7R8d1QWB7ed75HWlkZrYZVZUip8z3NWfDaX8/HcUuqw8",
-	"Z6UHQHl1enpMvn/8mPA5mUm9hILCiukUaomngIHJrkuWmSk4Qd1iUP+c0IJTNIX9jG3//HeySs3DvrFH",
-	"xq5fYhbAOVtuGCtyc34pWWoy2zz9IHbJz6a5n5+SE/MbLdZLOmOaZzD02aauU7qTUcV2uVBMKK75OXuI",
-	"X7NL/a4SrgFDY+xSB5Ug4cjkK7aHE4U1YLQsOCvxVfeCMtdyRksy56UCtZKqzMJZyTJnZdCCrOou/HcF",
-	"VXrvg0gsl5lzY7m8EHWkz0S1MqLI/mlnFpxj6ZWFucMgyU44amJew78eJsYFXyUGRlUWjAv/Mi3GxnTj",
-	"oBoqUimYK21UoqjY1UvGSyfpjaB1WY5m4mMAeg72X0Ll2A4wz3QC50/PgPA5YSKTldCsbJZEd0d3MI5O",
-	"Zd12j2u64II6L2SvIa1+M4InYljUjr7R6LXARe5jOn9RBJGf9iANDndzsE9HleJEJ52v9utoKzgqLIM2",
-	"z29s5A27wNDoq98ubkyRFraI5QDN24tKIgy8UTA6UtjPiHPgQ1rwX5mro9iqkOkj4Z2vAlRaOLpmbC5L",
-	"RjKzeta30B9VDrO6mm7/5AYWs7k6b6CC9tyVrS42OBFELbvOVO7jDcRRwP2TAMh7dSnd4HDoSgGn3j+y",
-	"atiwlm9kSyX4LxVzShJkuNCslKpzB4g58feL4mD/5ZFT+27T8hAo47caf8eVhvn0Joh/zedHfU9L0Y9i",
-	"tET7VZR+jllpRCTgplZFsQs4C/iNIx3T6RD5nMAXV7oZtiinszk4FtAHiRVRcR3xlzvNTmqzITZFUDzC",
-	"LQdHbnFmYV0asjqlrT3v1dLsdm6jpJVMVYWO9ISbRuxzsqIah2v6cWs7DjQSGnoH7RxqthpEjXRD8lrf",
-	"70jRs6saV+48W+q26eYK1+jwgAD7wVVOgr30UXCKivnv5CA4pYvf7zFgzRwpanOabNr5+w/7hkKY5VjC",
-	"rDMprFmpuNIIj7whGTpC9j6ID8LZsCgp7J3T9my+R1a3AL1e2c6oMLr2mpZGOy825l+K5dMPAi+lVq6u",
-	"6IbQQhk1PiuqnNnXLDUjBOes4oW2thPT166WBSup0KSQNOdigXaPJrW7iR/sv7x2Vu+NX4oStxwwNWlp",
-	"2DoIlzKrKVccPPj28tc5G+LXp25a683VRR/lyri6/WXE1dHjmA5bSDrUutVBC19HCs6XFSN8Xm8SV9gR",
-	"2RHS9vOwXuKZlAWjXTMJtr7VifmPejp44t4/eebYz4odlU4Mbgi03+a8YOCpxfUumGZR5XZFzWiLDSnZ",
-	"yqb4Ng9AxCqEvdkoo7t00i+g8evaS0a5WF/YScUOw+8TbBsENhBch/xOIbXvcyg/LAehgrBLe2BZg0fb",
-	"4jYOBLsX/bpzoY8V3Lwz6Os+Srrpe86CaVhbdwxDGITll2uK65xrWb7iQo8xi/9/7H3rchs31uCroLRb",
-	"FXs+kpITJ1/iqvxgLFvRrBV7LWWd2VHWAbsPSYy6AQ6Apsxx6d23cAD0hewbKYpsy/yTyBIaOADODef6",
-	"Kjd6L9Zww5Ow9t2qc36kRJRosMbaGdVTb49LLbiCk5CpmzKhHRm9zLVAW7N0d+8oEgGNTmsdFTgk53ek",
-	"0upjaasEe5mtnoJv3HJl51OufFy5U1hPATF0rmbAQyiRuB+moKcg0wNmimSjGwVt4bjz6+QP8zG+XLPk",
-	"BRHPJEyBo0MWTzBjfa2k8nFjqxXPXX1+knWY2RYd3jztRDNTLbkrXtmvdsIviceWleqvoVd7ZMwRbc2R",
-	"reFTLDKNZcqdSBaeUl0iEM4kCwlWaTWiYM5UQiP2H48obVc3s7QyHPmzyYH0GAkwiwnKkQct117qqC8X",
-	"/djCg1DT5AOf50ESUZlFOVdgXDVl7kH72UL/rHtR8TY6bTwmDD8DXdHfIqdHrwa9VeE58H8nkNSYtTKn",
-	"eZqE4Z97S89AykNCw1ARht5iZwNPbNp+So8r+P3KQpDi+M7FzjbMTSGd/FZvcUoDnMQcpGRhan4yp5Oe",
-	"lZEEE397y/qa50QIXBS9HVeeTpE46puAnZ+mIKSZKGyMr1Lv2XceV8Eh9exPDK6itf3uT1SwvY+1VrW1",
-	"o+7cDZcI6He5pEdBZlQpj0oe+xgnf798+1uNWpsi9NKG03N3s9nuYxZFi47kFUUZ66RrXynAsYMxjdSK",
-	"m+F8TND7gMWygWtiCQzTOsdelaaRBBou0i6MQvrbf+JjDZ+f/IRZYxELdJlpyxN3/YGj/+Hubh9mxRRT",
-	"2+FnKd8+D7+gsAnHSPJ5u0J67zIC89Nu7En1CJbiMolFCE+7J+CcPFgWOK01Nwne9l8h0HJpJ2g3yNIv",
-	"Gt5I73Hmfdgxt+IRgdt01tUAJ7h1dUWMiMrJg+bgpty0h1SMx2wMtoST2dvOT9sRZG2J4XzllfJStLSd",
-	"6WI3BYUf0GKxXT+cs82yzLO5liG22dC4kYPzUdv8WnjfekezpIQILkTIxux+VJA27N8LIWwlIbAS53y4",
-	"58Z4t3vH+oPS59IWHx9NWWTOIpHbyRlNpd6WIQMnU4TpfHphMe0vR2ErNg1bcOdLVRW3YM3wts2DOeO+",
-	"5oz7GB8Qi+ttDweLw8Hi8AAWh2U7AyYpuiNOB2NQiepungkPHfPf3BqBFNhXCx5sQTL1SsVSDwt92IBm",
-	"a3o3B4KBO08MJ2QxiEQ/7eF+ZK6lyziJopx089EnNj7UXCIXoS/N4cJT//a383gmpKZckxFM6ZwJqV78",
-	"7W/XvE/OrRHFrWeQAj4FAGlmmO+yYS6Y8QTSLWDJOxwyosHNRJrnry1V9/zkxyyA1cWtWtj/8sj0F1GC",
-	"BBEz14+hsLHgTAtpEC6gPIDIRqMkfJADEmEBik9PSr5xlVK+8Q6VJ9MkprzPeF9PoR8JMctqeXHc0tOe",
-	"q1AczgTjOj1XFscQMqohWlh14duTE9t+0wjFRErHlnWiymJpM8Xh0qDMQXk4KA9fnfLQO3IspOShTD+x",
-	"OImJgkDwEM/DkC5emgExY2YFLujlvIEstlMcvfjxh+cnJ72jmHH772cp1IxrmIBcURE8WH/uSbvZmSs8",
-	"K8NmTzBEQWL5ZZgrK2W42NMOqj0/bg2YK3vllTC5v6dnUouIVqatJwYPetx9XElOeUM7woIHUym4SFS0",
-	"QImccg53P60j85QWsz6NomqN7gpkzLhV6qLIy/0oO9Y06syLm6zqQFkM0KUWM5tR5mK3vlAzd/uMzZ1Y",
-	"wy7zof/mVmeYHRil18S40kaHVAcfkEvN1EYVLjuhNePrXExwzaNIcC1FhNWs01BkX4tHEjUVSRQ6TTFH",
-	"ToV4VxoEQuJLRgt8M+FTCsxngqeT1ZjSEUjFBL/UVMOXaVXPDnoZ+fEP/smjBVGgy12/VXHefu5d+H0P",
-	"tHclJhOXrKpSxMTrg3Y0dwujqRA3dfle712Ol0EBN7xYFL5cYA3IKcwhMnjXIzHldGJ+EJLQMGacCB4t",
-	"BtVpYR8cXF3LDnNw+aSw/bRv+E2sXITruptGDO8SU3/n8GlmKwKDG1OSMOYhdupwDj3dX2qSxvJNh6tR",
-	"sBjviq908yS3muigIkagE4i2vZeS20724Fu5rQ9l53dA3VLUPQPdFm/blBfLT1XCL98v22H9eC1ugPeu",
-	"+e2UBVPzIjPM06g7t4aPBjAgl1pIIEwTBUEiIVoMrnkz+y0xNlqAu0IXz7ZNF3Z7dRUrUvJw9sZVBr+D",
-	"h7cHoviY7iyZON9IK0qpUUKOpzENjlO2UP0OsMp4URz8ejF8SWiip2iTMIgOfCxkANgIB3/nS21Ioalm",
-	"fGKLYSgIJGisWpf74sJ8YCgtZlp7b4W31S/PfM2ZIjMJWBvWcTNDsh8pDz+aTfXI7ZRFQMzPH5F4aXRL",
-	"F1hCRmH9pUBvTLH+vDKiNWfR6YcJfKLxLLJUnp6J+cecRjZO39ykuYOjF7kB6EMuHOxHMcJDr/q2OBoL",
-	"mhauGJ8ndoaSye3F3GNuNwFS1Fp8ytxgerG5DhDbNilvR5VA2rMVuPfUFGLZDiwkYe5XCFyXlZ3vtyjm",
-	"GiUKnobrnqGS2UxIc2eCW4i4pf7uCBZ/agU2v6FgCZmio6hGrJzaASqTJcC1j7dcdpRnupnl9X1k60Ya",
-	"bPbwtWvvmYd3g5O4i+r04/pAtNXvfXt92yBZ4PUU+4pXE6x/ZKVVajw8y5ScuuqvOc5jFUICn2igo4V7",
-	"YDVoh0wTd/yKaHHNnUpHNtLo7K4O6txBnXsAJmxAuUQMb/MKRoKwVHjQ7A5CYntCwvK4bcgICZ6BV8uJ",
-	"MzfCG+LyfL5KTtjIDBGFfuAIAhGDuuYe/3JxfUXjnVmiTIps+MJ/n+6wKBMsGT8yJXED/uSOOkOEPbGq",
-	"MkrMuMOBN30pvCmjt6J1UXl6W5NNbcKhUBtpwZvsuPVYk3UnkEpvwhbZ1JVZ6pFxqGYfAu66gR8dfG3N",
-	"VKcd9qxJbxqDIppfjUJ6U0PRpOP9BFiD25euRFg2Mu3YGI09utS23504pW6zs86bpy0+HIiwBRG6eCIP",
-	"cfryWwooWiZEEdT20kHpE4ogiTEjhxohpyUAUVomgU4k+uvGEdVY4X5AhlGUN+dASBIF0ubyjKS4VTAo",
-	"b6JpANlZb4Xeg/TnrMjA8Bar9JQwzBJoiIVqJFT11jTjy1o15uL31urVOFxp0xgyNYvoorZVo1n0rxfk",
-	"l4XNHzP/JE9CJhWZK6wcomzyFF9uA+laPQMNpmQiRTLD6WLNYjdfRBU6YbPKCOZvm7RixINZacXofosr",
-	"rtmPcUBeGzJfzOAFoSr4eSyi0GAxNpm0f8RpX2Crxp853ILS7q8d79hYm3IiAkOLdQqSr3iQ8oQu8UAE",
-	"LoMsF0ZpuEvbWB8/gVG/USTa8ONbyTSQGciYKeWa3pVG4Ihgx01itq8lpDtZS0O4T6e+GJQyfLSsI22L",
-	"FCd3ZT72Z7/FpXaR3+M3vMeknXahRZ6aVqnR6x/HNiK3P6LaNmar8PHiKEXiJNJsFkFeLeHhMT4JJARa",
-	"SAa+aThmO5GUSgfk0powFJEQJBLLefuvXLsIHPab0H2MGyeYtoIV4LULK6MqH9YeOqgwXTuEeCY08GCx",
-	"Nu9w4dMi+AVP4UtnIKcisDvC7ezpnbEMRLVUwwHuLvN5kjFd5BssaUYjMqYsSiSopx2M1C6hjSJd1FGh",
-	"COpyCTz50c3lY4rjO1X0KxgnNp0oXLCKqJo6UuagMGVdBKqqBbeZoLbDYgNqYmeMu5adbTzUO+9o4xfe",
-	"TyZNO6yvFjENSQmphPAzmEeRudfylIMD4tYj7lY5dx27zpFDWkLxQA62mHwNLcy8glUek53vC+Wn+UYR",
-	"t6W1ub3LuzwQzQrRbF/dSs/6ATWtB3mvudDjAwHnCyW2eTKJoLE0ti1wrY59n79UyOVUwgXRwpk9UO7Z",
-	"ZqQ093ekHKbIhM2B9zBbPFMuEx4ClqkyDySzTkioFrG1AK7NMVxF7r1yjJcuUaT8sB45G0kv4EtjI4VL",
-	"snSxB55SgKLAYHZiE7qicgJO1HXXLGRxrJy+ahheQ9t+22QcMl+VV1vSQAzkX7aN+6CqXf+ufVCl7efT",
-	"TvN7b+nfwIvSLveVenqxof7OjLIXRsDwiT3JrLBUl6jAnUyNr8JgPlZu60di0uyjDYBrSSP2H7MgFnyL",
-	"xISYXzJQJDaav4+HGLNIgySBkceS0QG5sOEQTdEQb5jSr8zUb8T9++Av+y0RpNHCwX7DeEiewGAy6JGQ",
-	"Tj5i6b4oij8mRlpUCVfz1VEdQTSua72b6boDmfDBGJtz4fIDXH4gIRAytAXoyuBwjseN4PDVFCum9uUY",
-	"7zG7TLht9lAxvy2ruOECVGuIZ7p6ATdg8xUUoPZYvYIbsPkKiQJZPb356+ZzxyKEqO6CcUDT7A2k9kZM",
-	"LjWV+oq1a/n3Rkxe8bDtaM8C3rCY6XU+eEcnjCM7uWgpLd+IydvxWMFay7xMpDL89iGlX8oG27jKV9jx",
-	"7gQhjcZCxhCSAA8ln4IxS28jX/oeIXu2i9gqXQwTsit/9/ArvxZyxMIQOOkT6d+n8bIElCKCzkU21Mr4",
-	"nAaBf/M6xBRopKeN+oMdVtLrFMuH0UlCMDlLlhnGf8VvL7Hy2NEDUp1dp47k2jTEWM+JPYXgxm3dn5Hy",
-	"G/XnrRZKQ+zOO2IBcAXHNNBsXhs4/upT4JqXUeK+Ijew8AWR2IRDSP7+4coHsA5rdLOhW+2Nnede2tnW",
-	"itXdwKKsZUq2U9S0jFLye/+PP/74Y+k/Txu7wZgF9tAM5tOMyUVpKcsxUJ1IO6x9e6ZZRPmGFhB371g6",
-	"LsX8nUe/mLs0SJsBY5XmnTH1c66S8ZgFDG39qYmxU4YPT6JFam9iIyE0MxJf5g+78edvAfuQF5KmsXxx",
-	"nHCmfcWDYW1NP7ptvtIRy6HnQtnx7i5m7Ddh7whSPBAy/TEXaT5nlACfkzmVB0IqeP39nRVKO0UpipYS",
-	"VAxashbB7u+kiEFPIVF9AzPVbBQBcV+jbHYtKbDws1GMUppRZcrRhVu3Efk1fNLHs4iypSN0KfrmFMmv",
-	"r968IyGdJB+NomaXHyUsCvOK2zX/H+TqH+9e5QZOaDKBa57+4vMcpLnXn6+Png2ePR+cXB/1cJ6PIdXw",
-	"8/XRtyffPu+fPOufPLt69u2Lk5MXJyf/9/qoNxEf819+++z66I48u8YlM9iSmWYxfPRl/c3DkijGA/CK",
-	"FPYiKIK59E0e4KU/fffDycnyis5GpD6mtbk/+pLGvyXxyGj24/K63eZnVQSlZrI8WDXDvq+Ez/bt/aiF",
-	"phG5wv/yFEAPDmHcNvitgKswSSlIhRE/VkLTAMZo4XTdCjjs567I9zII+MfP9vufr4+cdmLw5dvnP33X",
-	"MBrJHnHr+4aRdIQZqWbsf5fss2aPq7tS5Ufqf/185VrTAtbpzX8oLW/NlEe54pqr3+eXXv3rs+uS7imr",
-	"/DNjYSnfYpwY/uJ7i3SuGvIZaLIKdo6XZ0zX8XMxA05nbOChbKw+y83W7eP57Qz48N155ixy5f9GC5fa",
-	"Zfiqr4VextTf2sX/rrDJwL20GhqGzPareZfTb6xrp1GNuZrCymb2a8PpUiyULjudCvUAOWazdmCbmWMS",
-	"F/aD8pRplGzXkx35oRqQ3xUQN+3xZ05juEPtwc7gu3OZ74qDjm3E+Thr8j6iwU0kJjZ9jvFJuWfmf9sN",
-	"7FNBr7tHC959bTcd4FRoiaOFVmv2/ixCuIEoOJnSRSbmkCyPce7WWyJeDJqaR10v69hgsKW3in8llYrx",
-	"76WRrXg52/Xo4ZQFR4cLfXF+DveXbjiz7f7X6wy5g8ASd4bd7WFgmKxH+LzleKmk+78dejWTwXFqNKsl",
-	"BsGxu9wtlaEt3jijEzBqnSOBmn48OKJnszwhzFozWvqaAg2JFmZiWzqAsshV7JjRfyfg/SgSVBKDInSs",
-	"nbKHyawqoJz7RdAwv6hh1ue414eNN8GFzIqtvXXpF96P9ghIO3Stlt7RCWwkgnZqTQ38wXeQ2h2BZTpK",
-	"WzKXiLZ9LsIWWpYdjAnnrgnQSOips41h0rnr6i0klNJXRiTd1YiyddrmXOePZff+0io9qABVdvuFC2+T",
-	"eo232bee2DA/a0Wede6e9uTtas6gzhZ6wETqdkhWh2Dvs8P+ajKof6MxrITJlvaNxuj4Ij5WIPkyozv+",
-	"LNPzPw/v2qVXtqcD+81W6MA10r0Xa3xe7pnyiFVIXdwB43pJuVGd06ReIzv6SiQygDAtXLejQkKpBl+W",
-	"vkjbIVfbXMbcZGS0sIFsKy++ziDNyR5Z3a6zB6vwwChWrZGgKYlvDf5hv9knKjxUJtyGcnefyFjIhNsd",
-	"e8T6S4uusMfOyH6XiUe3IvePNSjdDwTnEFgIqjsNK40ZtzhyzrTLz1vi6TG9QaOzj0gLMErNa1ArVQwh",
-	"/yB6mYHxWDh/1QbrSC8bRcztuByVvYuCKwtLTh3PX1cN+tn8KCys2ZwrggaG/ogqzGtgk6m+BfNf2420",
-	"kLST5U9FYkQj/9cZnUBlGtXw7DVgz+d9ZVINPZg2A8gZmraRUlUV2n86PEN/ue1bT54EIo5pX4EBWEP4",
-	"NCvliyP1lGoypXMgwzdvctZJ+3lVCTnXFP9+yQH2jnLGvVbjnfnwQTPKhmd2LYM8taSbQ98SjN35yzVd",
-	"Pn3AdqzLt4XPIF7e+2kTKle4R74sL6aNwXoMhSjOZjNwnENwyJLPzF1hz250eFeyDzq5cOuuy0EKBXR7",
-	"93Ng7YWHEHfi7ViJH4wukNspcN/rm0yp2j1nWau+6mZsCBFjB7wot1q903qFF+GdpDSwXyvaocl6xvqW",
-	"L6aBEbYpi1ytRuXiiu6rS4ngMetSX6yu4rPf19JVKtBin0yik+RalhVfRaXb1lHS4obVBLmpdvKIy1o9",
-	"Aq7RbdUiZR1fm36x33pdm3Ct1pqGnLMA1HEghAwZp1rIRiZmw95LsnStI37ClMawptycaRyz2qgFyhno",
-	"l9lkaY5vN0MqViB9BPGmZ6AL1+nwpiELOcUuCdamr46nTGGNpcZMcBzHAhrZ/EEjSezcJJ1sU1R67yb4",
-	"1cGy1ZDTU9dKkoix2wRazyVoyWCe1nX57iTukWfTKokY+oaU5a0ank2PdtuTYfnIvmxM9hhEsJKOv6ZG",
-	"LE5TYbbGIXPJOfflj5d+qo5zxyU4HwlvzC5yTc6oE84h2hyhMD4Y50hXfnJFWaQCGsHTTZHpCmfsOCbl",
-	"gXwkaLR0k004pDXjE3VMJw70xuwzn6U8PCf4UbH7ca5UalZ/phQ/huZj25C6s+iRg7EWO1bP4SuoP1Ry",
-	"0V3LmytB0MIrxiJ/m2gkg/n3wng70VaR/qFijgpYv5ego5Z0Z8ENS695r/aEA93vvfR4a9JfFYPH5lyP",
-	"Z1LMWYi02aRYJaP01/0RDW5SlDQTET+RV8DSVC6I7C44lq9pxUXeMGUF5zDR03cphF2Vn+XgtskQKZyc",
-	"OlBVJ8r2lSB1XrO0OmQLijr+7H88D++OIzGxlWKqMgp8VSgkGi3Mo/etQSgSSAjNTdFolaj8Ci0J65Qp",
-	"F6C2gq9vEL6tGniGBb6QVWgtph9mh3TPJMSSfAa/ORKmG/fIfiCzPVaESm+jVIJEDhWXKa4qJw2L5ypC",
-	"zbs9oZEjG5yFjCNxe2+ywQUeIcVs0VZVfUK1cjC7I6wxte88tgNT2GOJfYMAa/GDTSTwse8WWR3U/9KN",
-	"qOMoifLV+WcUnfYSbFcM8vv7N2g2TPRUSPYfa4EM2uu+fvXHwW4eIFG39nz29JJvAqqJA6YtTA/s72tl",
-	"fx6F7ssB3X76tjGBYXJJaf8dbV8abrhrdOAVJW8DbKsfgT618yABXLieCJ0z/GVwIoh7YhYrUNQ0zizc",
-	"jm2s8JXS6U7Cly5su48u9hq8BJ0Sq2URvvVIC6YQQ+xCSZrqGeTCnS9eXbx9/49BHGIFl7YWBkjl4IVd",
-	"9AGsdkuXhuuQIAKKkQKpCzNaHMTa3sWauRWPUQ5vPV6UeqhqbdBuntjduGsZR3loSzy6QrG2sp8dtKan",
-	"9uFwdnseIwtjs6fWnZKYg5wzuD3QQnd8tfUkUKqx5V20Kwx6tedzSyfttvD9QZ20HuHX1dZKxYSr21Ai",
-	"Jg6vrq/WjdpaPlXqVi4X1/X7u2urajkejSXylsrznQ7P1te5TodnWyDpFUMRFuAQY5+vWm4nynodbpie",
-	"MjyzmcDt1D5MEjyofl1X/U6HZ/0Up++tAC5pfhuTjNf3HjW9bE/lTI+p1liRI0i38oEGO6Byrk2Bjfrn",
-	"tqgwp+M9IkL8AnThHKke9OEDv1jRh9dkGWVasQgh6s8kKNDNsYVTKsNAhBA6S7f7zhtybBOFNYIH0Y77",
-	"zi3e5bDBPKBtAgYLx3Mgl07ECxbvpJ093nzSTBY0ivINBtPIfPv5usG0F3bRzpNDe0I4UECXImZjj19t",
-	"4/ayWvIcbpewe6PsEztht33QFkYEb4Pkk+2hegGCanqzHtFOFJn/Or3eP+3K671cX7dDhiRX2H9trzcy",
-	"pOPP+P+29fz5NhhR3gt+b0a08qi191UVrud2u/1gertsoS3AIRDl6wtE8V0Qaqmxue49t4yG8ck2CC5v",
-	"IfliCO6hDEWbKhcn+1EuCpX0D8rFgZPtJYd2Db1CiWT9x7tdBT8lT2YgleA0YpqBerruQ/4S13/Ycobv",
-	"6KTdOJDp0OUQ41xBwSe+9rHhmT2SG/m0psrgfnKZzDnjEbexQ1hcOJghumOGUI46NrNCZHPkqXTxdH0L",
-	"hEGh7hogDHR7sjzYpatJy/z9YHF41BYHvOIvxOCgLB231QuOP5v/tbc2ZIusb1u4N4tpUWwXN9OyfSBe",
-	"a8FAsMu2Hmbx89ODOv/wlNt9u0QF0bbtyZjTArAl45pRZXuly5PdCupCU8YDuR/IfefJFJW0voYJcm0p",
-	"nDM47praH8psuPaTYMec5mAnPHCavZsJ278GtBAtrIQ+uM0G0RZrXtsVcZ4aa+AVrtP52ngIZhuLmj23",
-	"g0WtOxY17TCsFulHVEHf2rtbFVg247HRV8HdR/4xvHiTE8GhL8qN54OFdUZiXq56/0IVdKDS8kwayDSz",
-	"X+PRqtWj8NiOIsZu3Q4lzLYFXK7pyTTEqsQUnnY3oFJSjNxWMwhKWhX7My+eN7NHTmy19JJeCXk14J92",
-	"7p7f1Z/pcDH6FwS6DM1+WVnza6LtCvztmiK9ihgb54bU0vX/sdgOioxgLCQQReeMTzYheLvmNml+M726",
-	"SO4PTnvlJLddTX1jDnZLJUdMac+ulvZ4L8bSjVyWA6/rLq9zqnxbdldQcG59gz91/Dn92fZdXlf3ST/v",
-	"q0DMIGypDFHEaqJFcZJSZShtR1jHIUuCmQo72zgj7kNhlrs1ezs/Yg0sPd696GIfalbfOZ+8zTXMfOw8",
-	"8pyrZDxmAcMmoCBjphQTXO3MupLdfGeNuXWksbE2uh6nbVZPbyXT0J4RW1C+eF78sJrx1nniQUduw/AP",
-	"2vJBEnTW2L6+MEBdfcGD4yACypNZdX1v32iDRhHB4+cTAlxLBoqMpYiJmQbb2MBqpdsFD166BbpjZBwL",
-	"ORFaA6/mQOkQYrgOOT9Va2m0MShFJyWRTJf25ogf0MSgM1CzSdtwMnfoWaXsFbbVpbJHBlKPWohN9qTz",
-	"7Ql5UEDa9o9H35nwjGk79zJxrDY8NUjb7RaEGYi1LdYL8ss3Cu4uKhi1tvKallChhTLb8sadN3+Ll/4A",
-	"lbhT6Cy0+4o72ADtqtSmjknQtniXsiAbt9kf+aCZeuGJ7XRshRgILXuzwlMi8hAJM6GYFnLRI5HA5uRM",
-	"3fSwVm8mX416nwbdBSKOmR6Uylwb3PcLwtZRdM6B+IDYXBT8Pta2Uuy7AXsW+h7M9UT+OSKV38FeXikv",
-	"KTdasoWBPJklamrwmI4iCHsk4VraPoh4pr0VU+LTnWn15qw6HZabtt5qpQ45XuRUqPVU+U15UQ3fuXBw",
-	"dMLFWEmVL5F/eqJMe3ngWTrWv0KlK0R44E+t+dNFAeG6w6e6GJSfo89WDAD/fvzZ/O88vDvO8mga+IB7",
-	"3pZTP3kyYZrImPyX0zXIfxHD0p+uxRbslgxzMEx3u4UGroxW5c+HMGz7OWYgyROsED2jeoo18USiCXzS",
-	"wFU+g7ZoPbZnt6uqBCsGiaCELl+bX3tpCnPghI3tVqdUuTuIRcjGbh2VUe5ICPOuriX7rTDAnT4/LK+o",
-	"e3ugYN8rb0EIAstgRlBM7zpoNmDeL55i12BobDxuZe0xA8kI9C0AdxRi+JPjbXOQaD3GXtIFOEqNQOYs",
-	"T83Kj45pPaB9wB9abelrc0vV9qivnFDOQFs8doWiNyEXFVAZVisAp3aAFyLBlPIJ6gOGVOYgdeqtdSTj",
-	"gzxWlITSntlUhh4VXtqpDyS0PRnnjpS4Wz4QUFm/bCrDJeTemJrGQjq508LGh2Y64Fou3IJOj8+ZP3pE",
-	"SDQzRizQGUADYmgGQqRCq9alpkIqDc39C7e8avF7jQA+XhV7z+pk5gvsgkKZeQQPhG4RfzOqNjRbTdND",
-	"LWIW0ChaEAkGfbPHMg2kUF5yGiJQC6Uh7pWZzxrexhdi/oAvY+/5fPQvZHOVq+9jz2G38ypGdOmtGtk4",
-	"3J7bcykN1uJwu3L0WjicIlo02tqy+f/8Ql7f5qS68fZGSPbIJrdZG8i7Xg1GNzlfUa8IQSPj7BK/Nvxu",
-	"M249S0YRUzWOVku29gkzS9QUcuVJMgboHjXNr5h3dr2D2XI9DHXHtsfwhPegkkg3MimHT915Ox14xRKv",
-	"cKhUQsU1XMNda59G0VqsYjU8g5yfqgE5t/rEeagIU0TETGvzfvPY4zyp/q0m5LKPuzw6w21tGEVdDc7I",
-	"IOw0KXsc6X6Uo4c09eufDs9ULSLXYfBrsNVh/fNCZUYOKeK8iDtjuk7M2bveBh7uGQ+weHD3kSCKijdV",
-	"pohUoYR5QiaqlRdEzEEazpQGtdlvCeNBlIToVba/EGPkYA4ZS50gl3bZLiOIBbG2IFLuEL6wOFjlz78K",
-	"LzQo3Q8E5xDY6au4xhUoI/WyPA4mOLIQ//Gc6YVXkXOluFvwEDP1ywyEjodNOzAN0A3qkRtJzBkTiXyo",
-	"S4hidkCCHJiiLUdJFMgWNdlJ5AKPDJfAb9Yovv47rvGA14kLmJXa1EmyO378GVOvhRyxMARO+kR+CTWT",
-	"PGblENX+u20NcjOa0CAQCddr1R03+HP0kFXDzQJ7qhpul64mC/N3XzW8E81syRMYTAY9cgv0hsyoUrdC",
-	"hj3C/CgRwdMD+e6s5HhqG+gjfaG5uvsVyFN+UMJMUrF3/Nn8r23J8RbshRTj3hcikQqicVUtcsd21lOR",
-	"fkeQWxYWR+KujoL7mgkIfe+F6zJXtTPDH95Ml8PzKoinuRK4b3+N5DJamEcEk2vVAt8yXZzsVpYWCnsf",
-	"RNRXTUvmAe/pANvglSi2DcW28ftvFGHclvJYt7ff/Ynpoepmr60U75iQO1Hn4+spot1dbnLQs6uSpVtr",
-	"2MfY5b/vn3N1MZQKdI7t+S+IFk6rn9MogZYsEGczF/rOL9wxTogAeuD25WJrjufxEBK8xu6aCQ6GgYPW",
-	"5ah+mYFUsKlbGE2FuFnXBO4/80Gg3nk2IKe+mmuPxJTTiflBSHdBgkeLchv5Bw9HV10mDsC2Vvb0XLto",
-	"Zr7NDtvjRPqrIlocfx6zyFarrfGnSTaZgDQ4cjo8I/AJggTdMHNG/VoDcjW1BRTjRGkypRgA5/6Yc7Jd",
-	"c8pDAhxLJQzIsOikC2EGPFRE2CQc/7lhRNiI98U1HwGVGFt6AxbfeqTwq1mUKPLrxfAloqX5wWLlNb/m",
-	"VxgvYNnrSIQLwiz9QOi9gWYD1PqWP7z65de3b//Xx3fDf7x5OzwlwOdMCh4D19d8TiUzW8DIGf9huktF",
-	"/vLHMhbylsrw4xRoCFL91UsjI665B8X9DZMfHDhLMPz6anj66v1lHgaSgnDNXwtJFB2DXvTws7/MsQrJ",
-	"/oOn+pdbwOyWwxwkcUBBOLBnYoCXCTcDgP87gcQlZ5i5gIczwbgm0jEKFscQMqohWmDwnhl1zUM66Zsp",
-	"zk8H16ulhxwGORpbmwecDs9eOzQ9WrNO5UpM4i95ZBkLWUCyHCo68fuNGx/SSfLxdvpxMBh8kzV9DRG3",
-	"yO0UKhDWRSKAcgsWFxn4UEd7QVmwY+H+ahser+wPMd7DodiEU51I8Glt9o1NvlFT+u33P/x8nZycfBdM",
-	"4RP+AN9ssjFccOnwEC8MjIEmwDGm3eBs5X7/6J/SSdK/9OAWtgyfaDyLcNcW6pNn3373/Psf/vvHn+go",
-	"CGG87r8R/7QGaUD5f27Sf570f6L98bD/+s/PPzy/+58lAesPpCM7qshpx3cPL+dqs1YdO9CWarviNzMc",
-	"e2f67++cOhqEkPTTIhVCpo46JOhe7jfHftAyNlsycuSA5JKjCcN0aaDZHPaiYXvC9tWLzH6M0ptJ7J0p",
-	"3Abtcvx4ryYKTwJewOWCZkM6eZ/w87B71gsIEsn04ujFP/8sBM9YMq7W3WrURCFvGuJnMMSsYL4ldCQS",
-	"bTBKSzZKDJBuHh/Ck+lbgRAyZJxqITd6WriOBjaqoqMPCwtf08NimGOyRKYDe0ff74IlvMzugSiQcxYA",
-	"STidUxYZtrBT5D4DDpIFFrNzR7H61ilBsUIUmNIQ5zDZdunIIXPJMzUb1mV8QhBbP1WzPXXzGvHJmj/4",
-	"lBtlv8zHSJWFOKWn0sVMgyUQ9xQplVu/GmmyMuAuZmq/HqEdSP3fyjwR5UE3tzkkK8XRIqfJ9QNaCcIp",
-	"i5epweG6LhBrZsXdt+dOSRhOhjS7rgL1W6YkltdgaryyXrkwyLdK6vR1nOyLPxTiQPZ41Us9YpYCEZZF",
-	"iI9GqO3H0rULf6gIhc0k0t4wrtDoe7cY1xlZ5JtOt5BFxSehgZddiRvg5n1osHBEFQuGiZ66XxhcM7q/",
-	"x/ml1zmdJGT47pzYIUe9o0RGRy+OPttN3b04Pv48FUrfHdMZO54/O+odeQs14s00dS04/fMIC4vgr5eP",
-	"4VehNMEIAWGt627NuzxdZRNNtZ4d9Y6AJ7E5BfdP8z97Dn+mJ7S8qbeeC9iqSfjqxLb/PLT6j/mHeTeH",
-	"MGac+dqTjgOEZtJVC+zSpC4Ny8w0ZUoLybCkKNUUl4nEBNPTTodnaEBPX+jFhczfyha7xEcOEUsbEZyZ",
-	"ldxW0n2hp8JcZHqJbgX3Vlqd/50UMegpJKpvEJlqNoqAxGZPwcpaOHUGSjZ9Nqb5vAqXkJs8M12ghyI3",
-	"u/v36sxL3qXcIT2JxITxnjl9keies85bq0MMXD/NZqeGQlbnRvdw9kFh8pfvfz/tZaEdpdP6NJ0VmN+d",
-	"kxtYVE2dGUPyIM5Y/wYWZdM5c2/qxrFn7Cy7HrvTo81Jt8y/uXqqIdPm5FoAZoaWTPESuJY0QuNqOgmN",
-	"sLbN6tTOGFS0BeVWwa/KIPW5hVMpuHOmLFOKywhNyYAHZTs+J9irfqlRVvP2sSH66nynIkjwZksvOUf3",
-	"IijbltUPCBchrIUmNmWub75TR3d/3v3/AAAA//+Q3ZCavz4EAA==",
+	"H4sIAAAAAAAC/+z9a3fcNpI/jr8V/Pu359jebV2cZGZnPGceKJYv2rFjrSVvdnbsdSAS3Y01G+gAoOSe",
+	"fP3e/6cKF4IkeGld246eJFaTxLWqUKjLp36bZHK5koIJoydPfpusqKJLZpjCvw6Oj/7G1kc5/DtnOlN8",
+	"ZbgUkyeTUvBfS0Z4zoThM84UkTNiFowcHB+RT2w9mU44vLeiZjGZTgRdssmTySdsbDpR7NeSK5ZPnhhV",
+	"sulEZwu2pNDLkotXTMzNYvLk8XRi1iv4TBvFxXzy5ct0cjBnwpwwrbkUqXG96xoXhQ+Jtl+mR6dDs1ca",
+	"oTJ8RjNzDG23xveWFdTwc0aoe43MeMEIDIRccLPgAkd7ePBiR5WieivnimVGqvUueV1qQ4Q0RBuqDH5F",
+	"Huw9IFKRB+/f4/8zKQzlgjzY3X2w6yf7a8nUupqtm3v3RGlRvJlNnvyjOeUVNYYpaPF///G/e+/fv3//",
+	"Yfdf/2XSWovpbxMhjSUq/wW8PfmSfPTwf//fP+Dx3odH79/vvn+/+9D9+f/+5VHHJ//7j4Od/6E7//zw",
+	"ZPLly4d48d+yrFSan7P2Dvy8YGbBFDGSKP9WsSbs84qKnAimDcvb686Z7ljH0MgkXryczWhZmMmTGS00",
+	"C2tzJmXBqLCEUubcvJLzV3zJTXucr+lnviyXRJTLM0vGTBgYhx25KZUgD1035PH+/qOO8RXYfHJsj/f3",
+	"p5Ol7Qf/gj+5cH+GQXNh2JwpHPThwYvnvGA/YdvNIQPlQq+e5w4PXiB5p9lt5tvpI8J/UWw2eTL5//Yq",
+	"MbVnn+q9eCxubOlxNcaUHo644lDiYbwtxVMpMsUMSwmpo8NoNMDnfyFLz9RnDB/oFcs4LciDghqmzQNC",
+	"C051euQ5nb8th8RWxc1DAnMmVTw2kB/sM12uCujru/3vfth/vP/44+Pv9vf39yd1cUB3/nmw8z/7O3/+",
+	"uPPh33pFAhNAY/+Y2OlNPjj+tWs3as1Q3vnVMZLMmcHHS6kNcDaIe/fqFZZtYNNtA/HITxhV2eJmxt/g",
+	"7GgClx7wK67N01JpqdpDfrOiQBoZPkayKCTNuZjjOAX7bMiKzpGzZJEz5YfbJSltQ7XRto/Palij5aLv",
+	"9qYE4x/GycW3pRglfropclAEJZaLGnbKl+y5kst2z1ZDyKlhhi8ZbuGMF4bB59WycUGOTt6QP/1x/zG8",
+	"sqROqYBv/ikF61i/mZJL6H40+b0T/DOMVRu6XNVGfyrbY2civ6mRG3nFcT87ZwKO7s05xzIKg+9JIef+",
+	"TL8Kx/jBXIce8YfLcMsfNmUWP+JjOueCwjhfyzzBONVzspQ52yXvNCO/2EX5JZxTdjFnjOWEixkX3LAd",
+	"v9yzQl78hcglNyBuS/hczmaaGfs5bDY1/IwX3KzJKnTXrTPXBpxcjYntYDINZ1z4wW3nh9S14SWjiVPv",
+	"p7BpBRe1LQPuw+mfsTkXAmnLiphY42qMfwGdNC4zfRs1mqQaoxtNQzXlc5hwXsn5M5EDL7YH9RzFQyDu",
+	"MzaTCnQprlEckIcNSdFF6Mx1EA/TfoFHrmE7xj5u7+ErOX9j97pnIyPu05/4ijwEQqwIq2tYgYgSJBcv",
+	"3H7Xwp3AWTBq6egM/tp85XTo4RJr17Vwr7hgntBgyfBEU8yxNzDBw8c7Z1SzfIOVGyCzYzpPrBLqOhXJ",
+	"c8OWuIszZrJFJUK5Jo8fdcuPDqnxeJD2j5lKj6s5pBVTVi+Lh/T9/pQs6WccXbdKtHJ9DIv5UZfF/yxZ",
+	"ya5Jw/wV2sqvRcUMwxot3hqd36Wi+ZYtpWE/Jc/LWNFU+B4R9pxKGy5CS8mxTQqZ0SLJrNUgUle10wUj",
+	"nfbBxLjqSrCK295MGbZ3r3HEZveP5eRsjeNaKXbOZamJxkaIYnolhWZXITQ7ng4qq06EqseyMEni+s5x",
+	"76XUs+/qJDZIYXbUr6kZP/QlvEy04KsVS8/gD1eYwB82HL8siy6y1LIsyNFhh/3XfngV4++JoabUIFuS",
+	"lzFT6sZFcJe8ZStG7dU/GN9hBe2aLsvC8FXBiP2aaWuOWRUoAOzwOs5iU+raeuLpMHTXsROYfAmTo0rR",
+	"tZsbWw1fcLVhq47l9d9vyNRGMbocsOMisWmTyxIVfW1ypuAwmetOXQVbHXsFdIOA8ZxSXlxKUYcL7bCK",
+	"bqD5DXSVd5qpjRw0pWYqvUGlbeoq9P+zVJ/0imYpu7t/RDQr0Ka+S57Dic+1IWB6d7Lk4PhIT/GqRoti",
+	"SpwUmMK2UnIRGoEx75I3S24My8mSUaHhA9tmLrNyyYQhhiqwq1VtenmUaM3eE6vW3Ktdd8HwcfNC4xfo",
+	"jz9Mezwn1nPRbSr98sU3G3ni2qvq3G1w80U9m6Nza6XkiinDGX6bKUYNyw8SMumpYvZ+bYJ9YzpKY5/6",
+	"Vn9cd7Rq79vKStvW17UPWvf+Uq0kblb1a6INPsb/l/rwE1sfKzbjn1NXIaUN+RPJFlTRzDClUS317WW0",
+	"azAF1eadTq/yKwokXpoFNJKNXHBRFgU9K4KUb/UokrL4ZbmkYgcuSPAxcSbF1sdKFmzQ7qWZegvvfZlO",
+	"ylVOTc/s7PNLkFFgo4MsY3rwfPq58fqXL7G4+seE555D3RzbPcT7P42YI55kTN6VuUae/R/LDIzasuNb",
+	"ryN2smWkRdZ5kq644+e+2dpuWpN0H3cPDDWQ7tHBUzgO3Ch1x+j0aK3BjzOhNbTHrdMDnzNhDkqzOFby",
+	"nOdMOU2kLVykECyDP5xOhAwqBSO6PAsv7pzR7BM4cqFZ5D2ycg23Z5tlshTGnqJtOUfFWzZTTC+ix8GP",
+	"O51kdjwsTz9mn1dcMW0ZZxxL8PRAPL+3T90uBqiG1rniP1K9OJYFzxJi/IWioiwomkmXcEKv8MVgeD2j",
+	"ekGMlEVrRd3R+SNb0HOeuov5J+RiwQQRkqiyYFblZZrQ0KNmczjHI2MqLQp5MYEDRKwTllT7YGzHrY4I",
+	"h3NfcJbHXepPH53WdFbI7FOyW5jABgzj1/5taeVri2+6tws/efJbi4j9WTp6pZiAIyJxiEIPxD1FJqts",
+	"SU8InEZ29Zy69GgyTdB9B7VGSlCrVzZnn4l77GgBGJhyoQ1hFG6YC1YUCeLoZwnf49QvUic3PF1Q85b9",
+	"WrLU/c09QCsk6PIUlARDlkxrsFEZWcUZtRmCzp9KYdhnk5BoECah2IwpJjKmnWMCX55MNyCnw4MXro82",
+	"QU0nbpgJjUkz9UATLlalIa7TXjV/OgFnTOIO9OrVa/TTwA0blqPUSfGm6Yyl/T3PkOQIXYGopgVI7OXK",
+	"2BXJqZgzBQYat/06SXa6OzTsDf6DFiQrOBNmx50HuQ8Kg1E/fPfu6JCc//DIa33LlTQgFzKnKO+SN6JY",
+	"w9SC5QgfsR3fChP5SnJh/kL4XEgVvQfU4p/uJlemw3JxIstoTZ385ToM/KE8Z0rxnIVLy6NBpvD00M0L",
+	"Usz4vEfFOSL4HtDqjM9Llb58uAHBhhf9cR3uTUtCk03kVTAFQPzhkTvzufYirINQCjwau6xF1biyUikm",
+	"TLEm/hs0I41R0eFwrE7XQQ4+rV4HzZidVcEkg9/+7N+2+9ZzhkRyol8UxZJoh15Q5aMovVarU3IOQrLS",
+	"DVeRjlL5i8mApAkhLm2eWLEMLmRElYJUm2XDu/pJ3w/yQ88KvWZLqXoUfDDfaT+EJb5slyoh/P3vqdMw",
+	"p3NvUxsbX9aYyk9B18NOuifFCjanhp0IutILmdh8/wTWkpLcvQ9q9Q7u+wNNCj5j2Tpz9kiWmKpuKLqy",
+	"PCuiY8D6VHq0XB3Ufq/HqBLd2zhHiEKr67PVl4bqTyP1Y3w19NW5ZENEgC/53QcZfM7ZRYonsCH/Z/Iq",
+	"BvwBLKGJgWNiQc+ZbxjYRo/VBAKhtDWAeSHPaGHnlLxW4UEnZ8S+SF4/e/3m7d93l3lKFtuxHfKEiv1W",
+	"yrAoIWyZOFNje3O6lr5DXTkIChcXhIpWXPd41tuAVmsWtHGXOM8+R3n9VtB6sblNXXwB2qfInMMtjIEL",
+	"88cfJm3LcEMTSp5MT2lRbHhlOXWfpQYOTb61PqzNG7UfJpvFvytx4G5iVGuuDbV3Q6XQF1fyj07Dn+Jr",
+	"H60CmZQWJT8IN6bBEb47ci/Dh54uR8zrExPv8PUvdkDHdjyjuqxeT4qwOFkBJ1cjkZhkO8WbS6Pokmuo",
+	"r7puyJIZmlND+43LI1mjOvM62eY0Lcw72WNFVTMxpPWO4aZI91qzbo6bRBn8LiPOm7SRcWhjDhk4g7qP",
+	"H/cayfE9TbjIihKjJ5yI1OhXcfprx4ntF3xDnm1pE91Xzg1b9pI/0aCuCHawGU/c1WcnOP8NvrUfdFyc",
+	"dMWDk0YXQ/saBtI4gd0+rZTM4EUxd7YXORs+6BZUHzMBm1/JmO5LUrghXVBuoKOZ99agGSB5YQqX/sTR",
+	"1H/SGFo8lSmLyik8IjTLymVZAFMQOJPhYH93cjiZJk7mRJhbdUqDoR86TdhhG3sYC0//VTzSoR38mZtF",
+	"2MUqs2JDwpo2bXiXou/01BJT+DBNinn3gQ2c5ka35EXHSqDu3COc+LIKW+j2g1Tqfr8YHVLVo7M2QWaf",
+	"mCB4buN4uDY809Yx/+rV64TSCEOHj/FLXfPC76d0LatnjH0b6Wzcy1+65+sUscRsZUEyWhREWYNlZYFK",
+	"TXZWivF6kO/1uf+o+0D2atuY25hVX8JIPgxN+nk05ob71j2xC1AdjISiowJ/bvt/1ByjBfQV3C7OnFE1",
+	"1TuJIzFLZDy8djoWSmNKFJtzbZgKXqwOV0vNkZ7wS591iO2NJmabqUuQ3il2OpXs5RJ3Y8XUklvpE7mW",
+	"ggXPSaa2lQkcT6M9LJVBDfq0G57n3NqCj2vttg+9RlwCUzs4cGtY3Mu5hv+TJV215WQv67rrTsLHAL9b",
+	"0eRIln1mWWk2vdxy/QwvRclZ+cvfGP01eneEkSlclNpuhiNi72ZgxT5jsPdwvLO8Na2VS5y+pEjBp90D",
+	"rN3C6kP8T5CWMMIQsuXNndA6qEmoInX79MHh9lwxdupsqylnMfouktObuS+PC5qxBSYKJd/DkMATNEWn",
+	"O5E4oQ3V7mphrJ8kpX/bY65Dz3NnYOO2PmeCKYwidlP/6L07yVv5r24Lkj04Tc0ZnQZ8fn6oUZvTxg7V",
+	"l3IEybzpiFc64WJeMGLX3Z42SCl2EBuL7I4TtUuSp45U++6IKXUrcM4v6Kkd2DbcQjomllGRsaLoCoXw",
+	"BB732UFGR11GMOuAsRuxoWmti0I6V6npUmnHqbmIkh1hMR0u2JmPYqw5xeyB3n+mDTq3Vt2dDbi6lvTz",
+	"O830mEQH36A4lzZOzaaTOE1yMhh73V5Ix+1dS/i0vU5kASFslRcYnRASmMrFFtcXzt5YOw3r7PPK+uzw",
+	"PTLjrMidpb0S89iVDYsdbadddZwizn5Bcq5XBV2zPEQGVL20Gq8IsyVbyjPNcCbRBJyXAEEDzpgnjXyj",
+	"8St2wUWeyrwNjnJYb1wrG85sc69w1TD+I54UWZV6gd4LGwJOIP7KYZVUDsXwlBvNihlG4QpSrmw0NsnZ",
+	"iomciWy9O9JVYHuHyPTBsI0zmVv90g4ZjnMa7B/dlDVGYewIa538ja13zmlRRpH9GBnu9muXnC6YZuSC",
+	"FwVsIz2nvLAREMDQ51xJgUFJ51Rx+B1vMhDlBlPCGzpb6d2Rqme8VF0y3z9xKYCjV6rbTXvqYECslzZN",
+	"+rpcDnn1fRMXFE6kHSZcRhbsZ6wgJ/z8nVkLpxX55hWpj/EeB9SFOKPBziJ5mDgwGchp8vpRwxLj3iBG",
+	"MZsbRdyl2OtRwZE2mSJwSlJ7CtBDkMvELv7GRZ46tPAhhllYfhB1MKKo1yVVn3J5gSvrwpGWNj/wjAuq",
+	"1mNG0U1tYST+3gvWaumFDxcFF8w71d3R0MJFcgPuvBg1gjpsmyvXcdy2nyrIJJhr6EInI8jd0vaq1Ynd",
+	"gNMY8A6SVHDIjD2qXh+9foYEEEItm/MdGQx+EH/WGQu+2hSpKtWI5v8cHAC8AyLsbG3Qfj7Ci2mkfEXV",
+	"nA3Ys3037HPGWG6PIV7fbOzcZ5kl7sSqFBk1fYLINYjU4UkHBFL4FLfL9TeZDhmh3Xa4BUWKiqjDrWi0",
+	"AvEY+8TMqWId+aEHRNubCu6GVJF7nosUdxnFEty14EWuWOIe9FMDPMsnlDttgWsr2rgmtOp6dIxjc3oJ",
+	"nSbNBYdWE6tBU9XGuL4EV9g4plRjMY5bG79tPO+cAME2e8Cd25CFnLAZs7jhiOon1m4bS7RJPXEzteOu",
+	"JtnbSpinjWsiksa8bKvJqZQ5N8+QOroZicJLAchlnUgtSFsMUPdw5rAFtWIkWMTIQ7Y73536u+JH1Lun",
+	"Pu51Ct1x8SiZk0UNm6fDe9yTQFA4cAud4rozTC25oAUmyqkpyen8UTqyBa3q7T7+4+TNTztMZDJnuZtd",
+	"FSPnv7psHlcV9drJsXx1kOfKJRE1po8hv+TomFD7CuGzSt9ONVZlMqXOAxEhz8gMPWb56JSnsiNls4pi",
+	"hDfIxUJGNGECxXQ1mRZ+79yTSzad9NdESV6B4kJw/bTKIw2j6uSvV3KuR1xHHJ4l3kRqHMdZypJi+mPt",
+	"Uk2Mky6VSEjGPxladDm52+hLmODgoS4sthV56FBr6nAwCTtLvCfVJOwIUov9Y8mL/GeqhPOPN85tKXZm",
+	"FIbJtS4Zyb0ierGA4+YMPra3QBte29S2U7rGicGb7IXtk8BLUwKCBm75iqEa83FJP2PE1jn76EA9EoGG",
+	"HYGAjURH35F/f4iSMwtA0RcG/3RBxZwdU60vpMrHGxgy+A7GsnKftpfMuth90z2xIO4NbPecqTgFdSB0",
+	"WrCL7vZ/YhdV25jQ0kj7+NPg+jWmUO+wYzn7wjrdiVrLqbEhnpBXMiLAM22G8y8kScvFtA2wfTRw76KN",
+	"U2jT/cJT4rTAavD+Yq3X2rClE5GNaEZ0836YDsRsdjj9Qa7kzDv8Q7PkoYS0FVS1wm9ulfWjsfIPFqI7",
+	"ArRBIC77ts9lmFrYbq84PZOl8fQAqatJZ34qeKMLqsHgG36Xqi8n04EIjo4EKExyiXWWUrNk9HQzXmTc",
+	"AIPXZTAYxea5JhO0/FPyUK6YoHxKqDALJVc8m5I5g6anhJlsN6n7NUJXUiedG/NDO1ryb9G6PppMN452",
+	"iWhkQ00hliTa3TKS1ssF1a+lSlkIFaigM2ey1kQbsNS6fAS0Vi3htA59LOmaUKUsFnTCDxPFQ6Y1k9qQ",
+	"N+HKvqBJw1ZVLnVfQ3BhqvBfqu9eecfjuI/t605eHTJEZPT+6ITUyqs33F2IqdgWbmQUuzRqQU5r/Q4K",
+	"qmi9o7VqL8A0EEqXLOsOyjqowo/GhWXVYpLa1yxiWdLKCFmQ6vWr36/iQKmRdsafogsGfn7GkANpUaQE",
+	"YGfKenJh7bW38ybus4FD/GK8Fq1FTazngX8/WFtdkxu506LIjvZV34/RSOetGKOf2vY6lgTzoFqYCa/A",
+	"MhApqlSsXWhq3LRi1hr1ThWTD1+m9YeoF39oB4v6PgklSypKWpA30LM1RiDOaufFYEBRhW+P8hEvxuOO",
+	"4/RLxYfU4cbiui4vs7ad4RI2rL86X13kq/Wk2VWqaRj1tYoP7cFYnQRMRiK6wbaXnqOw/sykTybzT+tO",
+	"Zk0N1zMOYsteUsM5aM9HqTpteFl3f88+r5QLQMabE8s+IZeA25QatkvQ2PKL9+L/Asewwx2YIq9a/yqi",
+	"MtIYOcI2VWp/w9Ygorwj1VZ7wF4RtwE+tdgCv7jvf2k5Vys6ZD6yrjEV+Lm6urjk4bCYaP2G0z15F/ET",
+	"TK4QPnH4gEQ2G3ZrhcnpuFqamSk5pPMSCY4q55b3a5qHw6M0EFHAhTaMIjaZXS6/hOwzN46Mk+vgIBr6",
+	"XTPVOMHGWZt9jBaBySQJk9gM8SamEJHClNGNJt2SPETXMEy9epRLpslPb06tneXRsDsmfNrBMlLlXFAj",
+	"1ZHQhooUxFn0EuHuLS8H+gC6FsnsiJdSG7TcXSyYgllHjWtSZcW2z3vX9Ub1cbLE2FONr6RKjPVYKhNQ",
+	"ehetwRZcG2YxqkU6WdFQ1QEv9fb50++///7PFbSU3+mqB/d1ari6E0Wo9rnV+AJyCpqkJrCS4Z+l+CTk",
+	"RUwcXdpMtfpTu7PTCI0xzNOt5ACtDWVZpO49Fc4kRbCU9r7qhIQOb/UZTosijkrvanrcrSXBUEOaem2U",
+	"yZXDPDsPztVhr7MvkQqiy743EKU5BqEuAv9DKN8rQbZFjT12uMC9ytGGgG7XjLsWQ64N70wXObe25lrQ",
+	"0xDwLZUrUhTYjWaZgiMTTWQBF1gmBWAaic320DPvKGNrdPSVhZxBBUuwi4H8v/4cvNFZVqzKi+vJuLKT",
+	"OpTZGKykzO6pnYQH5hxvx8VdQq+3eyVKcw2xQn8/eP0KAhOFWSLWU/ctuBc7QWZYtC2dwttnx8QpovXP",
+	"o+ZsuC4WNqkfSaci+vrcppMLxQ0DXCILRPNlOjmjmrlrUgL3ABFdfuYilxfD2WrjotW7oJZKbeQSkZVo",
+	"aeSOTQfAUBkllzYig88SSGJR+2JVGsjKPGbq8es0YENvWuiSfn6Dmu7Y/LzuTNdOUDOrSl9llPEFMOgh",
+	"3jI7mU6stTb8Ywcu158n04k12brflSwt9Xvo9n9SnnQl6HIF6oc+XXDRlTM7nRj39Nls5rQ+PzJ73V+y",
+	"nJfLyXSy4PPFZDr5jP8f1JB8LEmFx2hXvJu1Krz5iLNa3PFjRfQN0D+qGXn39lUDgx6vSEFPPzg+SpEf",
+	"QEci4SSaZVQhADWkl86k/xd80dlSCPzzIPvClilqmKTqWLFL66v0i+8+OaMaKQN7Te4yvoHGgk5H4HHs",
+	"YMT3O8cfWns3HGww3FqvehXkxwAC8Ih4L2/Tq9cdaDPEJ746LfR/McVn61QBx4Z3Gyq3nL46IRlQIDpl",
+	"WdM/Oy78L6LbbvoHkLKNzxQHUjbWX+rjX9GN7V4jD+1F0awjM/2jEdt566fERsmsw2c5asrjHf51Tc35",
+	"dJtZjV0s6BKtVpUnvdcPfwns5m5+tdaA8MK0H7/8psGbo3FEqzFwpwiNdh4NQ7QpRgCwhV6SMGydzguI",
+	"l0nYHpTNaKgniUVX+IrZrJexC3zHCaljqugyBSxqH0d1JdBaig5l9CK5mnl8ZusJ21g9lhMaSk8G4MuN",
+	"ZXjjVhs9jLD6gO7dVRomeuZxexOdzZUsE8F4YJbPIEsMHjuXmGIWTeTw4IVDh1ZzKvg/qZM3rkudxnE/",
+	"Y0WPDcQ+t2xvY9/4P703uFahUG/kPlrSzwdoanpbek+pj5Ly6JKNvX12/PbZ04PTZ4dPyCm47WxmG9cB",
+	"dtSWU4K1eQibaWtk2VJG2pZJyKSwYTyZlfhK2uBPjwBn320b7KaTzzvV+GCPtb2rdbXpCb3RMuHCPdpd",
+	"02XhLdHdJ7vf7HZB3xQgFF327GPFExHsXkYF5tNRrW3eXqj45BCcfM4g167f8TuMU+53neIroXXYS6o1",
+	"nwscyy45arDp1M/fnZA2p+6MZXLJdNRgZw2AUlRpmX3C72140RWlyMuiB1bevQF8wIJ7RzuhJubWfhtW",
+	"Vi9kWQDrD69xb4UWP6zE0hs6H8dT4bktt2xZCBl+l9ywALgYUzkF+3Yur6hMc1UHBX70OLoo/WBUXJzT",
+	"gufkot6O3p2Muql1nGs+1F6PT+31WXwhm0EbqWywZuNcS+FK4i2uyliZoeVe4mr7BnUVxQB9IaFBsSSe",
+	"ccDvDWIovxS8cHPYUd51rd9h3d931bG0h10R9vaBPdka2kOffykszwj00Oa24jF/x1pGQRPmWyg0CV1o",
+	"dFhjXi3kqHrvNHpaQtCyc0sPWrduVoVh4rwnUT2Z8Gtjc/2kXKprY1bjhcwt6VALKvKCqTeDoFIvw4vw",
+	"FdYsMUxAD4d03RsZmdO1L7JFuSDwrVQ4CVf0q73VtfYrNSvdvhNVPX1AcrspaVGsIeuwKDWCMnCzIK2J",
+	"7CbHc0eaZiHnSdjewxpUb028QqqERyFORAHca66x5hrWA1LtR4FuhI5dRj9BmBqErCDUU2KShHpv9a3Y",
+	"PqsruuxEbrTl90kyd+CzeVumkG6clqUcHrwt0VpQAToqCHLDoSybg6JETOy8ZEQKtgNleIliS2Clc645",
+	"SNRSGI5uD0ye3x2dQYVHzSGbJZb3jcrROV2dRnGAac4gOtepmLAa+JpTG9YrlpN3R0QxkTPlWQ11J384",
+	"jVJFj93oUtyH/Z2EcnFpYIkU17xlWhYwdDxSbQuWSfGfvt5RNCfUfjSdWVXRKk4wPVjiao4pgMetuzut",
+	"oqi0dEmoPI4g9iFry+rkrJKbYZyoHIyPVHCt39/qtupWp9PyNYwXHkcRt4RHw7vaoAxbfZ3XzA2ud89y",
+	"bqR6yZMh6PbhDgZMBPSO0vG9XguzYAi3sGJqJ9TitMtHFjwVms3FgiluWP4UfQGwxOArSzE7voDbiyJb",
+	"k/Ct3U5QF9zhjKvVSiXwh+0GW36UHh4sz+Aqd06tY+UHCqiEOPcRgYU5nY+48oWY1h5uss8JE1gqD0/Y",
+	"vFQ+uLaKoRov02EyaWAeWIB6SZdO2BP4/TgJ1HBwpmUBbB9Vnam3R6QgOdefUu3C3UdDHRinBQ2s4NtS",
+	"nJTLJbWJwduuPelSI87WCMwlrkn19qBZI2wpYvxPGssYdxwIrocHfhpMNYkoI8xcQRW5Sb3WLt3558HO",
+	"/3TX2sUuXyieHxm2TNVB5DkBwrZZuFyXtLDC2OsUAYLKXRLbQBD+91SedFQV3EXSWxUW0oPsFo6SUfX0",
+	"rXHQKD+1K3eP8qD66XRs36itu5ZdAyUGz/Kj0UlO6KQt1jteBwiV2LFAPKhh1NtYH1gCfuCD993FzNZg",
+	"p5DMMXky+W7/ux/2H+8//vj4u/39/f3JdCJkPVgGG4FUm0vNL7IGjoOabwqkTiti+wQJN0WrPbm7YlCe",
+	"d9vw4dhSnDWykTrtLEVPF5SLXqS90RyQov0GGcqcBYZzA3DzxdvDTMIu49MZVoO2TDnz7+rIjmxzTgqu",
+	"zRRCSS3T+jYfSnEkOAaWPvts/39Soucb/vmc8qJUDP55cCYVPv6Z2tfeMqPWjyxF2jF44CSXCuy6eKAJ",
+	"hGygAWU3dZMrZAq2FA5Dpwt5007SCgAL1SexuMj5Oc9LWtTpxTF40zx5ma1zizP2c7vS4992mzD+A9jQ",
+	"8W/jPo5/3VHH+A+AXsa+bSvkWNlwlG8qTY7yhCCpdDTbeNj0Lx8aHQ4fCI0WkknzvUaAYJyIX+u0B0Qk",
+	"es0WASXlTa0yNF1f46qz4RWufZ2uPqoyFmteAwMOr8LHK5Y9V3KZvrxUaiXXfggum36BErS0QPp2CDXt",
+	"fEo0Ys861Rk3q5A0r93koHd/l6cBJC2ktdkDDU3LkREfg8a1q1+0O6zdNta6sdNW1nqhOaL6SvMUxqKL",
+	"fm2qGHddnoVfbdtplasDf7XXFjyo9jibnr/Z14F8lxKxfbOIbeuKkW3lklqdDdlOGvsKV4XVBl07HGc8",
+	"pIMermuAhtNoPcMrwXCBJriaPPBlJslZCSdv6AiRCTK6MqXydjr/yO5FgPJly5VZ12K3PHAzYESEF+yk",
+	"dVutGgtN41YphqWR1cJdEra4WmGr+bgpUk0+RYDGXOldAuX8CVxl7UvOHvzw3fHxs7cfnx6cPANdCTI3",
+	"LQFldMmKp1QjQI1zK08x8dcadSAbh88qW+gjb4Evme3GLKoN8H36RSTPcE3dTqC3Ob1zNSrFkFi0lk5+",
+	"ew9pPO8nT8j7Cfb5fvLFQfsWxgq2yR5YEvaM3LO/7prPpqocVQozeTL54bvJlxQkcx1zIkT0+f360MnX",
+	"XpPvRIlKSJDuGICDgHo3HOiATj9c+MqIFkc61I+SKHMAwnhR+XGL0u1o1cEmgthj1oOBUcCKWaA0WihG",
+	"83UwdPSMIHKNhRG84ktuuqNT3AWwYOeswH7hIpQR6Hxt4WIrgmt2/BcCFaAry3e6EXd3rAWfdFSOjsYf",
+	"Q2qPVBwmMy64XnSm0ZJUHm0s+Pz3NxoXSRzS23hLodikQHK39+ptKWDuMRZ75ayKl6EetzJJlykpL7/M",
+	"kAhvW0i17RkC4ltGtS9n6S58Q/bo7tD5evOuB2cyKvG635VSwQ2NhhpqwgzdidtlczI2is/nTJ2OgOM9",
+	"jV51sYFDkKH2Hav/Oo9XXte3Hz7AmIoHUXhFUIaSORVXCUm0qmVHWKL1wV0tNDGC4Xc2yTjJPgJtilPu",
+	"IwE5TZ2IrYOr+1DWx3TeA3H8XKoLqnLrInNwnpWtES1G3enNdm791cLtGqIzyCwYr/Bm6vkCY4uG1z0Y",
+	"LQnMPpunpdIp2JM3Kwo3jAwfO9qiufcMWRcHBEvKGcGaVmHwI7e4UzOytXmeM5b3JJTjqGwEEXKtK3Cj",
+	"XJ3qHii4FEhJvArJQhab1L4OUwBKQs/DIKRiGHUfElm73QQdzRfmgsF/SRYvUW15Kp9HGvi124MXXGwW",
+	"v4SbhSxBLBkmdEeUp5vRa8SU0T26abjTEC34asVMU0E9Y2spcoeY2IXF79FrdHdZitB8lUIIqzI6SgCX",
+	"EueT3tyh9EUrWePQLTuAv+DS+oAft2POEgL+eG5dtZEzLhnf5VZ6c7ZGzMPG4nAdDfBaz5IAk3yTce7R",
+	"WnkHV50cK3JJcpzMoB62YT/CS8/RrOMZr84yrFGUsV1uoH+g+JZHoBoeypic0RxfJ1gKbwVRzi5eQ8cF",
+	"IlI42zCWVDi4+75eXwJfjrp7uKSfyeP9/dHYswGcASMsj+wnHhjF/9lgsemkRPOXe4zYCIkF1aNWsjpi",
+	"2gXUTbpyF1rzZyWECLu37DpsFieB5JT24niPFb4SrS6qBUgkEe7+2GVOk3J3fffBuHntFIvB896tZJhz",
+	"Pzz3ocxeYaR1Z32hoHaFGCTUux5i2QmQagU1j65YceJQZt76FkaSjPv0iO7D0aPhTWhHMbbJWHzhi+6x",
+	"tBggdNexyK3p9aoTYal7klZ7Ui5OFlJFjUQPrZl3PNJL6+elzNGomLrsvqLaEPuCw1xAU4G7W4XhNINN",
+	"esNsDDcF6yxkOngYImfLC/RhcR3GkD4D/dMRZ50r4WAKNqKcs5e63XVwwtpgiNXDlq73yKH+q1KcSflJ",
+	"7+VsVcj1zrzECnhYIBDjX7G4IHxO9oiNxsesi93dBLBWPS09di8c7PwP3fnn/s6fd8G/8HCv8cOjf027",
+	"HGTWTd2IQBSmiSOsQOWvAcVoDEm7WJUN8PFqdAvmINfEaPK9cTa9TOxe6LEndq+D/bvZsVzlV13cAuSH",
+	"a2f0Cm+nGOiHxziU2cb37rBS23T5llnjknxNl+9Wu9dw+e5ETbtxHr31W3nX2b0Fl/UbuDOHzem+OH9t",
+	"WsTmV2e79t2y5KRfalya89/ihxvx/tAMfHup8jY15rZ87Ure3B17D3PbdXHPN6IMt65XiZ1uF9e0wZtB",
+	"bRguq3l9t71rvA3ZzFuYnX6C/yPL6ufIUPSkFhkELTORY70hfH20etQJtthNS8b51nzktbuvhZ1IouRd",
+	"hgBhR9PEB0/GEl6cwpciuGdpoPkXTDDFM2fg8V4kF/jSWYWhj5Swo6cuULSzvuNBdcg3bUutoXfahqzY",
+	"YjFW/nXUaqtm0IHLn1kezJGinXMKWgnJTRHA4kyqM57nTCDKYv6xSm8S0nycydLWBhGGKUGLj/7zUgDY",
+	"IXiMWe6+nFPDLtASafEHPwJ5hA+g/J0qxUfFaGbDLqD9CtndRb58ZJ+5xpMP2t9tdIO/IQbkR2cA9z/G",
+	"0wAmk6VJMsCzcyagEuNQsVPgZwWpLywnQGEeCsKWwewpgmoMW65Myn99YB9BttesKiBhW0yiDeR03u1y",
+	"CoCPo9rpiJisqtWPbspsmMt9sgQkdWyxKpC6omvQFlPMtGmB1POOgnefkgXhX/L5woUu2Z2E13xR2JzO",
+	"P2IEZVEsP5bAfslQgd6CaLgtVGuZcbTGeqd190B9SdXU4XTV6quKZVLlo9qGm71/3RdrWimZlxlTo/uz",
+	"Mva/mNJpNQ4fk3P73Ns5bP9MnLNCrli6ZEKM+N1o0z7yJAxl33DreojYRl53l7Y4CWTq5l8B51Yk2N3w",
+	"CVPnPNmufWDPV9d23k8cXSUdXF5KJ6FZ0w1drQqedVb9Xa/6Jm/bgZci/tiFUBfrsXi0SblfgOiELdqA",
+	"M1JKRJ2+arxTI/ZQYt648vK1jUmeqe5c2LTuXnRM1I+GS1XrTTUxSj+uH2s3HdVSq3Xv6ujYjyMPS1dF",
+	"xatVDY67lMsVNfyMFxxCoWczzUxyAEPFhFP08DKGdRrCerPbFlLm4nD8toIwJsXLoy5k15Mr2AXi0JQp",
+	"N5AWyDEpcObz/7TPCaQ2DZBhruAFpgKqViKgr0W5YSIgG5EW55dkNi4pzr/Oxfim1ZiEOP+yHpcO51+/",
+	"oGPHkaoz+pLRwixGSDr7SbAzLvA7V26MiXwlecKS03VmvTlnCnRB14quJ4Mzdc7ie4l9y8Y1+H9/2Kx2",
+	"fUgZwLZJZ4n6lUmGH5/Yz+zjCH0vqaGcd+k8fhANpSfMt//UC3Ge5+HMc8ONZ54SYH0oHk9+6wJcYgh2",
+	"grgl1m4nIsSRrIFGspkNr4mAjs0MYN5jPYxrQ5By1OwzvBzYlYUyrPs8sd+UaElHsTVhWjrxigxVc2ZO",
+	"k4oXlK033OMrOIwuK45t+l/VB1b0QxjIsRgGUcf1VU2RzquO9IfIJuJCx41EHXPdzCp4aK8LT+BA+KuN",
+	"cZMK/sAY4Ue75DVTc68DurYQXMkCwbmmMDeqG3qwioEnvxg617/8JeIsV9tIk5enp8fkh/19SJI6k2aB",
+	"rhrNMAlyfGASKGitcpS6L0rEATOVZ+FnH8UI9opQOlN3Vsccf7x31skcCocJPSWpwE/Z3bD0mOCjws27",
+	"VsApMcdLRgiFBjdaGjeBn7lZwMoMxwnF4+4PGQqLBOWQR9CDXRcsG9wZMwRPN5wh9H4kZnJwZrbtrpkg",
+	"42+mFsCGY85I4YVCaz63D7c0lL9lIyWr8V4SUix834PvA12iZehYMc3MCBKx5bFW9vWEdLC/jyWPqO8R",
+	"8sC23TuP0TOoQTbrrnIO2OZRp9uGFRtO1dckGxkR6LromjHUohkj5eG16xNystxg1rZczsjphoEOS7VT",
+	"Oq9PfHTCmfpaxESVTHeDQgI76RcRkJ52aNHrLgNp5zGTLodsd7HAuDMlM6ZDAWmbMbcpcukw7Fbc7ii9",
+	"tXfZ5kl07yTEU0j+Gx/GCE35h93RQr2wcoqhtokBMQUXTFcxQumAIP1MG76kpr9ZbIvgFiLCqiDMf5Zq",
+	"Fd4eTE23w/MFQZNXXLTevYL3hk14tjmnyrdxl7oscn3ReM16/wN1qkrtS9FfV3mqxx327u7ycA90rdzU",
+	"BkX7U9WhehZlE5M1rdfbsxX1WsL484orplN+IywQSPCFKl7C2iFGR4unawz+x8+nXaNLLvyYumAJDdi2",
+	"WM3QNZZa3tfMKJ4dS2c0aejp3Zand4J/Tq8LF+aPP6QtSHBNThfTbBTQbM4o6sc2kpxKQjvq8GGOKc1a",
+	"QUqkQ1hvvSjrfS3Vb76WahxHNKqganz5aYcCUJXDYuS1G5c1LXXeza+bdO9p9Hdc7/enZAhXBTlJRRN1",
+	"sgVSmpDO0DUtjsQVgbH+FuCvkEi1t1iGW5fvKWUmD6MwLnKqT/XE88tG8+AcF1STM8YEWZV6Ae5Emn1C",
+	"lU6xnViPbxO37Xbj7Ba7suDGdN8nKxq7Zz+ue0oBIzyNDO30QA1PJ7kcoZbrkFfrkdoQ8M2uxspVYoUe",
+	"0l4plg6qfBZHJIJhPKxCSEZNZDD14i31rm0f1hJs8o80+/SyCzT66UJJIQtXtgleR0O6R5+uQsHcQo8r",
+	"W1LvtTNswo/u+tnJITgym04VpjUlXPyfBaSjuqNOlmPANDtUTKiYbeiy3OC/TweW2WdjuMG/28sN9iUo",
+	"VuXKFHW6D20dI8cC7qN0i2Mg2fAt4qI2NVnSnLUoqs1X/VBSvSt7ZRipOvT5BlBS1YcBTkqbnCnVj5qs",
+	"DRU5VbmLY/aX+a5ViqeTy9KMbNzhG27SOluND64468cYioBHU0F1GwqXk/LMlQFISBQ3lrcovVnePSbl",
+	"3qgPLop/si8cy4Jn62sYWivyACfsNjGQSg/GVAcmVcSH8aHXpRCddESP/FQuMRHANt4KcffouJh+Au+w",
+	"J+/FPqBb/uQTrln+fvJePIbf3tqwc/j7O/jbYj/An9/DnwjDbf/+Af526Bbw9x/w7098tbLP/wh/H1Nl",
+	"OC1I9N6/w+8AWQ2Dw0gKpxLBwz/hGJxYhB/+bH8wau1GVQXC7E8fT7+bfj/9YfqH6R+n/z790/TPH5Kl",
+	"3uD9nXOKolfDDv4kzUkQNm9DoP1zf8S7WU6mfn6TqZ/ZZOrnVD1yk5lMw8gn0zDmyYfa9gV51I+VYbey",
+	"ls3l7LXCZkG4NcBUgUpwVkkDQV2hYS6oMTFbd0OH2azsbIr1x/j5RZhSdNapMKWE1Gli0LZVqwC5666K",
+	"ESium5sHQb1E6kAUAR/Bz3fgHQZo3w2OKRYN3309Pom+SlcYiV55GdzPLsRLCPTZ0UxxF43bh3x5VQxH",
+	"d362IdyqgjAer6/a1EhAxjuTkoPHNc9hwwJhBd1xMuHIPbThuu7+naylyD53tFBF+/Z8DknN6c/hCZel",
+	"HmwCnQnQRmIrTcOZsMLXOlt5i3HfI9pR7sVBN0St2WltyWsjjxYyWpT0jroyiW1zOlZirAmJkCwbChwy",
+	"a/lIetBHVWg8yWhBR5mkrMj9bYPqj1XbiUL/3uPxW8oWZauDjrJ519wnyca42KCxtM/muFbrsZ7zuJLa",
+	"B79VomU3XbDTQbQkoRs8lUX7N6OFZinPXTpDoxolPq9OSdfNNFILAgf6VgcNWJ0JmvFew2kl1q7UQ3OS",
+	"id0ZsydtI12rjIDtvVl8GweXus+375CCRQYEG7Ufcp5cuKvX07zG3zyjN7rxVZ3F6VWjT9SzVIZi5SBj",
+	"ufVywiXb2crCNRM67YyovYoh46hhDQwXtcbCJnP7us2Bx+Hr8FJ1fATefjwouqsukjTsyAQuhuNdyTAz",
+	"UONxeJQ4rdGZthp2SXtlnDGWux1oln68NjtSpOAY6fcDjEa+c8yk0CzUaRg2LNUtFWFatRzvCOR/YHkH",
+	"ndJ0Zljv6nZbt7sJ6TQqlRJ4uaIpGzVR9bxTZ5QOUPp2F1XmbNr2hOj9wzUHPX45rLWwOOlIUgnDXnQu",
+	"wNqkM4NPndBhuWUm3MiuaHTtjQFdk9Tl2U410al1kSEgVWE5w79Q8X7IldaboFqHCaVcB9Vyplj6P9NF",
+	"iQ8s6vyeC2yy5b8tczqIRrx+cm141r6KLennp1WF9A3rmlsjkqueh4ARD2w99Qd2THpqBSa3FY6jTKp6",
+	"t+7bnM4tZlL7c1pc0LUmj8nD50fP39QRzvtk5phQLeyLPIwrwdvkZpsnbv9tC4/Yh1Uhk+4qAoPmWMcC",
+	"lbnLSYOqtHHa1xhNzVsHOg1rFXywe7Xq7uGZLIVLgK5vxqNrwxd3nQ4uRfcwB5cgrTeeOgQIu107FVXy",
+	"GYTzFDzjplj7PIxpjfL4zBkd/dZHaqdtxl577fuj3aROga0tSZ1WwsNu5s+vAlJfJ7humPqw92maajRj",
+	"RcwG+bu3hUrf4K/hrGscfOfSb5gtDdG/VobFYP40Q3DNaEz1pbdfdK99ulXPNFTkiVmP2hScYtqGH4oU",
+	"DX6vw6Y2ltZNq2qte5U7iyKdtI4yQjMltY4WJZFfAgWc6Ipm3KTbrK1qQxC6ClXDMgh6sfzZFS7akvJc",
+	"VGMe2/qIYFRHYJs0/bbrEGmMvBLS7vAV88EOSsML/k/UbY6ZypgwafCgtTZsuXMBWn30CXkYD5Dskdp2",
+	"kn/1QOPhhjkrJDWTyNrigcS74nVSxrD/9GtXW536Lk8blNU10xSdW5fCZtcy5/0FHu+/NajNXcpOdnaW",
+	"ie6dwMiLz+jxX+Um0n9VCK75nnvCCK09vaNLaRCwrQlc3twc/974nJeq7dGZL3E3/cPtHipd8R97wloh",
+	"dPm0AcompGAIj6Ux0M0GH38YAj2+FGhxKkPhqWLoMaJFD55pVr2E6QrRheRhVI5QsCqttqbkDyOadoYW",
+	"goPutND/xRSfrdNxeha6JTl6xSqAOrgOOc01Uk3tVJBU6yC2HYDIYxa+Jyg1IpCIHMIc0nQH3yIm93D5",
+	"CoUv7y3lOYuQcmv4iy3hIdiFB54eVXKiMT3/eXrsK0Yxgy81angWVyCVLrLGR6v5PcIEJDgshOFFe4uS",
+	"ru2f3Tfv7DdhLC4YYgRki7Nw4SDP2IKec9n2sIBFQ87QaSMFc+buFIqWKtkUbLE6QCO6EiPcaiDf7e6n",
+	"+KUDR4B9trsDIQVuCFF7qbO9cYJ/sEH2l6hRD9ApaWTBkEL2mRuMvnBQMa7SW2Itm4ljrQtqpVDDI3We",
+	"xAaqY28AF8BZSc6YuWBM+G59AFfSklekq2K2jTguElX6VtsqQN17deSGfcKy7ub93OJZPMzoStf2ea7k",
+	"hVk8SvZkRzN8KAZu7NBQNDPHLplovJZF8yUXISOK2GD6pJDpSqr6iV1U3xtJNKsQZTDzpubb+9Og6SDq",
+	"Ky2VrLiNAkrrg81W5fic4igBKEG3CMt/jW3h7eLHtem+1cBbxMK0ckHO8NVR6UXw4TsNEZvJ1t8heP1l",
+	"G0cwx2tahyVbum27vtaG13W1WGsMMLYfbDh/+9HQ8l6ljyRHl+Kpq+c8fOBlUhgliwJuGeg1hKYVzWxA",
+	"OXp+MMKqUfKzcRPhWLseXcHPci9U627sVoE3+CLy2bLcXnXqXbYicyIV0PWK147NenWgOQ7i1MOP0g7H",
+	"SvOm05xsYiQf0tsCt+NTamVD031aJSfTM1kaQomh+lNkSThjVe12lrf34LbCrJgwh1f8csOBKikv1WX1",
+	"3YYdXi6OG/erM467+75cBYhV/aYI6MQVM+52EyeJ3n9Gshbzh2r/inniX3IRt/h4OhHS2At5NXj2eaUs",
+	"Fg6GsqGmPSogolVNGYu0OSeDx2qEkUnBdkC/9QWc9ejgiGhsbfGnpCDVC86yAyyGRpaor/ZlYy534Mcd",
+	"uJvuSGci2kGoO6ZcYb0ueOGwBdDCLsHLgXRBRLSQYq55zqKBTa2K6b/imhhrByBYxMiNHO4A4LuoI17u",
+	"xvdcFXZolLXKj1P1gOD6VyrwWxevG+ErtMvayJRS+VJqY6uj4qVdV01rUgXzJmJVbMdHoyChPbBea9yb",
+	"1wJPMX7V7qgMjq7FdGv40Nmk/0oWssg1KSQEP4jcd8PF/FG0v/btCSxJ+GcpPgl5MSKiK1rGqd2geqz+",
+	"CEnkEMU28/ZUAI/gS2jvS1u9CO8MeHsUm3Nt4UvSzY7LhWgxwWBORDW+5Go1SoO04dYtUgtOwxdJQZDb",
+	"Kq+zVjqltUAFF6msfY+T63BBOjFL4Ll1MKfWV/iAXcembmRYPL4UeXci1KvkqDD3wA7J65/ssxkOvsU5",
+	"1sYadzOw7n0eyaeJ+lN2jr5Y0WZVum6gckxv4a/GOvnu+2t0DZfrOYpSjDepiD0OnKi3oFXEDxHJgR8X",
+	"/vBUd7MVqOOYl7j+8liEIliG/rJPJ8wcRmBnYwy9mlnjU7AmwoftKKUKOy0F43F06JuiwS45OCvf5qiJ",
+	"jHR0aWbCHa9/RoOgcF/Swyo3cBtywdHiZg1bNLMRgRoa2QAp6KBmFhsyYfUBBdmWbhgnqIYS1xbVsizI",
+	"Q4tNuWJKg8bLzfrRABJMJ3TWa6o+5YDRjYvuXiMPrbJmXIgR95FqGzu2NvMydfprkusEB00LT3UAZek1",
+	"FSA938AnFnKKzAA+vU9RBu9QlyMRPj7qArXRRpXWaDM8V9fQNPTWMeWrJFyGkKcbzrm0QQbXl3LZk2F5",
+	"g/mVIVRiTHrlh7A7V86nrOBCrphS+aufwOiMypTT9cQlb3dqImhiqcSEK5nCFDCUJjSY8qp4YzIKFmUQ",
+	"Tde95+yq4EejRZEOnhCR5gAW0ypG1ZZEiPK2O9Izl1TkPVcd/0bcsobjTNiVH6sbPbXtdIJc5GzFegcS",
+	"okOcz29ZahMSRMkZm0nvisc3M+oMuxuhR/aCxzcoPXpolcfIWUdyydK1q7hKV70DeVEVMIzC76MooAfa",
+	"b0faFsUQs73LHP/MPU+Y5mJ0gdbx6hobj3vvO6pKbTWP8Jb87w8W9jPzNYAeAIc/mJIHC2NW8P9cZp+Y",
+	"gn+59XnwaFzEFM97IrI0Vs5rFfyye7FLnlIBGS2I5M9FyGAhis2YYiJjmhT8EyP/8hvPdy2EwRfgIorS",
+	"1pJJtdiuMjs0aK1KUW41MDafVcHtqd1fUl68ER0FDEPGhySaiZwweJsIaYInQhMpKvSdUqVRPDtQJjsq",
+	"o7EaXFT9BOgA+Go3/l9+WVHSwQSMZfTAEu7LZBIk1PpIHVpt15R/N4oWkbPY+T4qFPsABAl8iM9dThRu",
+	"Nxehi0A61MbMZoTaz+CWG6gHSaWiqjiCWdgPJtMoImS0jGu98cHGEYTw2lFRCu3l0rvEXcwI1+Txvs3L",
+	"MDWqHciha7FnV579cT3tjGodsFyq8w6WHCtvOFN+ikAUCyEqOkkl7ll05JwxsmSmOnBYz3kzOuSllZ/R",
+	"iCQajrlw78K3FqOufQnF3xPSX19wky32MqoZgY+t7lSnd2y0TvnNW2pIOE4YBol7TB6yzzTDaLEHij1R",
+	"bM4+P3g0DHFrS4cka9BVWoHE0TNbkSQMc6DlJRdH9tXHQ8j0bobVaFI3mZ72InjV5oEZnERGEgZvURMq",
+	"3j34l99OTg9O3518GV6pxpBtf1O/e6nx2nGkvAXw73BvjvHZfEImyC/7Vh1YuYa+lIR2eo5w3xSSVEEf",
+	"oStj0cTqME+b4DkNtth9SrhKrb3xU9WhAG/Xz7cqpGqXHM2IZsYlx7mGiaGfmCYga1iOkl2eV9mXrhCo",
+	"f7emje0OhPGnjIHp27VidBmHBDcRlT5EDs/ghmzfmAJwU3uhGtgRtWtHZ+xAtxXURx747E6bh5gtqCtc",
+	"AjeiRg4j/u5Tw3aHatBeFerluAvaZZMTqMtNn9xEv/jWYN1lyEZltI49TxtjyorSJmbxpU9OCq64r3mr",
+	"BtAhSSckXrQ6vSiRN0kKQ+7gUaO/Mq7fpTD9BvCIqkyNJDTbgPPX2qa67Z6+NrsDKe1OnuwukO6+HFsc",
+	"va8q+slaZGCB7bqDv+CmiS7fb97WevE3tvbB82kMw5OTl2Sl+DnoDJ/YegOw+2NnZ/d3UnyNPLxQ3DBb",
+	"JW3Sjk7Bp29EsQ5xKKaRcGJ7m8Lgr4D14tZSwv+71hOe7+i1yAaWkQm4RHXAxHcHfkPXqdjppBtaCrTE",
+	"lqtUL62qr3Y88VfRQLqWA4xoEBvfFW8JTwdWwpayf7Z0R0gygUiqnzrdGB3j6of0B7IfsUvQ96CwqXOY",
+	"HTHSyMgvY3oCeBtFRbboKBGwXI6ocNrcly/TsL1JcltRk+4PACye9X2pGAJAucjkfrbyQ/jQu2XvMPFo",
+	"jDfYpiiR+528lZ3s3LKCZ2aEz9tWXieZ+8ImphmPLtk2ZXUVP6vOzNYzm8x4gOKi54WnYek7Xnjd2UcC",
+	"BgAViaED+KkUwkIynzLdv1pl4Zwc/gtibAGvRFmwjVcoKqc8cBz4N7tmdMgKZtiPYEkZw6s5vk40K3DH",
+	"rTGyNamZTOY0PoeffRP4pauQimWzljKPbcYqkJhO52LiniV0ZrCRkKNDXQ33Wmw204m1nrvHTj/pVP3c",
+	"iemeh2s9DiiJ6J0kye59e9YHfe8Q1FGaBg/i9XDnhhprOiYLnliPeh0QEkaUyFLUEasnV3lB9cIKJkSb",
+	"h3mnryqepA6dxErdiH721x//MsYIRjJuZPoy16uCrtOX3EP7cGemOBN5scaZdwJZgQ0srae/ZQXFQNtZ",
+	"MFK5UHTDhO6qOh32vRXReOY2Ifa4PKy3Daak0HwyvsXHjg8diEAbf4N3saSpNie4bb1bAhd72I36No/b",
+	"kKqLl1QvkiZ5tEeOJiYUWx1tuegRfIVkUcuTpNDB0oS9M8dNwGBVrrQJ5AiuePf56IWwgnaTlfZf2PkU",
+	"69Fdebzck3EmgrXIKjNBUwto40TKEBVv3yVWGcPy3NXp8SiNYFfXIJpsFTeHBDG6zdddJ4J7cOkh643X",
+	"sEvLCfKkLqUiOwqycJ9MP+SzWbf6A09DCrPlATAFuim7Wv8+ThwvwinBf0tiD2JdGjKvk9ufdoUE1hke",
+	"h9UTqH5p0t6clOMT0mbeYZaNAtExp1zo3ja7Zmsf2CIvboDRvWNz3mhoS/WpO3q5NZ4IXFDb8j5u+Fsy",
+	"W8rHmMQU7u1YNqw64He4pGBEDCnQdClL+F8us3SA2Vpkx+VZwfXioBgVcr2ybw9p7qO0atcW+qRc+tcU",
+	"E1fCOSEVKYVRNPvke0KMFfchy3c3Cp0aqWSPi8uJlm6TdUsnCUQXnb58XXvx8U0BfrCVV/03nGuftr2V",
+	"jrm10qG7QzXytvA/eIGE0prh+A2v6jyPS44I96F+4unUhLongcEZmK4R3t1kIj339OnEaZYj+g466Pie",
+	"a9VbL4H7o0PkbtVSlwjsCrEO1zyXJudYKHiK/ayipQ1SY1Jd1iZBQ+6UhXYECKmZDLaB32EIhwcvMF6K",
+	"UUiLqobXSd9dYPx2OOmHEVG1n1ab3n5WTT7xuLk/V1m81PZ1i4U35wwisioDbceaVVbS+vc/4u9O++i7",
+	"m/vdG3eWu92uW1LTwYlh5FyTyj+StinpQXMFEo87z0fLp66sLbhfPRtTxhB1LXjbFTHECfXdc3uvd+Fq",
+	"S2SGsXmb36PHaJxyVvXU11anBAnCoxrwQyea9vCU6LiJxTbwFnKXe0bevX2Vxg0fBX+K4+4AP62IzDfm",
+	"SSXQeSc3jgFDLXUVKARrckbzOUuJ1hUTubUNJASCO2iTUvWUzt1+rBTLbCm3VET2YXhOaMGpHRc64PUu",
+	"OQiB3aTAnzDydLUq1lG1IgwveGhp7wl4mf9qCwBIBX9YX/Euec3U3Fesc205PDwShTLrFcsg3NmUYKYg",
+	"7HNWlBouivjdL/bDX/5iF46pCvFPk5enp8fkh30MLj2TZoEKq2ZmM1XVugU81mLlLuhGXbxLB8CpDQ0L",
+	"8ocWhYv57SN8r2r91oPjOa6lKMSmdd84jHNPgERQHcqkMFyUTEc5DFwghMRcYULtlw8bxD61SrC4FXHF",
+	"IFVwegH5hIHAIWs7jhPg2qsrZXEYcnxSGP4YrNRMA8JKveeUF2iNdXzy6tXrRDZpT0bJYUcKie2yI4Vk",
+	"OIUYPu8MYMJApc0SOjBw6QTJwWW9nPm0FOjqgY7qgCSLdIyKUjy1IH6nyVSQl/KiJopg/XFDqKmBbXpA",
+	"immFk4CZ0ZAcOZlOLtjZQspPE6w7Ya/3WGpvMp1k4F0rV2k5WwrBisvgTxj8EsUYD+glfeEpHYkb2AyS",
+	"c1COgKU6EmsibWl09WU70O7ay1zjhTzrryfimnGVYLS9TrsYGsGMCzRK36STkI926S1ypw+ItnlSDzCK",
+	"fIbPH+CJdkp5oTNasEdpM3dVAL5tXKwPXBGbDh1RlvGNJynEztMl06bKoeAyvHv7qipadLaOVixW7ErF",
+	"rwcuxs3Jebc30B67IGS8AbW6LtZmEOxmFqHM3XCEBZiu/sAHirm/+nWcbpXNDjElSN4J/vk0vlm30pU+",
+	"RytVi+pinykkFE6ePP7jv3/3h+8fP/7zn0dh79k4moPjo7+xdafByr5EDo6PQGciyr230YkBOJbxL7X6",
+	"dn/Y3x9/XrAL78qMWvCw7H3edyWLQXgzKL/9Ft77Mp1cSPUJ8RwPgjbU9+nPjdeThjK33nMmjI89Gx3C",
+	"dHBELMpAfwhTGwaiS/dpwkkkyhkOifdFNKyB66+3DQNeQv+4wDxtU/7gCyzEV6C+4k/5RJioLMYlHeHS",
+	"n1avf8Gz1QKgjPr2Z/+2j7Ua2ObXaHzfYJsdvmXl4BmJGwGMUf+WPFw6LIlHgxa5rMcRYWczEmbbzSLg",
+	"a288j+dlUfdxRZH/HlGO/P3g9SswWgizxOyma5gfMszGTEkt9wyFFa7431giZC4VlHzWg5LvcKB+5iKX",
+	"Fy7gqKdYxiAWiFiV5qnU5pipx6/T1T97al2gBLZFnU8hcloPj8iKmo1g7m0C1FVGGStQARROmIWSK55N",
+	"phO5YoLy8I+dTObs82Q6mTNo1v3ushOd324ynfyT8qRGpcvVSiqjTxdcfKrbcSNpaNzTZ7OZVCYeWSEv",
+	"YEIs5+VyMp0s+HwxmU4+4//HART64NiqKEMg581rMvgA/Out2IBvIEpMBBLU/da7CABoY8SbyxdQ6F5c",
+	"Cwm0gZTAM+2hw/1wPz/aGlAgMT5s3s4f9aTRqFE4XVurxWGW16fN9aHXvPsgAJx6HrDPPf5UUt3YVNvr",
+	"xpiCg9U/JQ+XNbSDR3U19I8/DGqhN6dVhlc7+X0sGYwakIUBTg5Hpy6r7yxudj+q03HnXfegnn0U7rsP",
+	"z0peGC7gUi15nsXIn+7RZDqBJ8OlYxo9WuoKuL/V3Wv0fZR3g68iAfcHBY1iiwYvYMR8dY29Br6IS7w0",
+	"EG6oNl7Cbb423QwH3T/QMarbTXBRC9cs6g8Xqd1LTC7xynzoYILNTG7lEIOUjq+Gti4JdNc9SFkkBghP",
+	"CCwDBoOqpR2iS7dbwQ+Y9693LRTgEzIDtd09rxR2nNOSCjpncBuYun+rJ2jvfvr23SHG7jUqQNMy5wbA",
+	"4FyDU5JDsrlcdX84deEk0r1RtQgunik55+wCvlaM2jKWMUAwzMBaW6kFLAvdWeUP251MJ7aRpByB9dLN",
+	"Yl3De144kChYJ53c8PGBKnbrB8AgbJMpWvjZmphBzqarEjUkcKhJRImzTodyNkzkCPkdrTGqgh/dwts/",
+	"qMg/LpY0m0wn8D/7MLW2bmRPkffGJhFZqQ13RkUUmzPBlP27Gu5DS6dMW+LFUT1KVHxMpqDiNTVM3Oaf",
+	"wgyIXoCeJkXGpg7zR7OsVKxY//+SuplrY1CE2dcc6mtrXysHQVPpbu2wb6J9prjZ1JFWcvs6eWgnKaS/",
+	"kLO8vVg0op4RswnE1n8Sh8ACv94XiPDO6Ca2Yff+j+sOYXd0SC4W0jcbd9eR5z+MMACCCOEDGgyiL21s",
+	"8w1xTQKWefuMR64atwMvXx88DTTVq7AkYLKq9al8ACXPu2Ix3ukNthc+8Ou1wS4jkR4rNuOfEzyL2Q1/",
+	"ItmCKpoh8EHwPAJtw7z8NJuFTjqK3o2dSRRIddk6eVUFjHiS08i3ELjPkcAm+kpEDx5kbFR+rRcW1Zyh",
+	"CQQLsEcEHtMCY1mXTJjd9+JoFv/w2hUg9KUeUHrjnEBaQ2NTwo03VmOQB6xS5lqqfeYH49DG2Geubbkc",
+	"OzRbMcKDr0ZjeC+WbhRYpV+ds9wSee2gmhIst0fCaeVryGPxujCopwUHToODoCxyHGBrvjhav13Qb2gT",
+	"w0feCxc/4r7zrVcrahFCNZFn+Obue9Eri+vH8OiTtzHsDWTKs8aXTYoOgxugxc7Dyjp160SXPLja61LM",
+	"peJmsUwJiM8sdwTs30JCOH98KZEdWCHSm3it8HGPw+QaF78SOgkIL1fEls8FNcDHC0bhYm3jpLphX+xr",
+	"6UPwpW0ijidYMLsaoZ9Um5plipkgf3pWlgrXHH6Bixq+SpxP7dUdI8XjHoIkd6rkZUV5teOtyQ6wwrM2",
+	"PbRjTXDI57TgeaA2R0dOROILNVddha2lbASfkyl9uji0coLjH6uPB4ne1MdrHNxQye0StdVMkFq2+w7F",
+	"PN657VDLoyH37HP/DTIu4+Jabou38GDsvbE5hYEbZGi/ZxrjTcM9V8cbjP8LULk8Z8uVNFib/xNbY9JR",
+	"CHSBc5aGwDy0TKA2f3RIaKEYzddWw9DT9wLEhR97iPr8Yf/PxINfYNNCmtD8lFAi2AV59+7oEBgyyBU4",
+	"yxHcdEXXvjbk+Ji3A3XGjYJQXox+c2004UZhUlSTn5/9+PLNm799PD74+6s3B4fJGLjuPR5kfIwq32SH",
+	"h69U4UaQLjNyGQC24ZpzPZR+KufzYpSibPDNsBw+GA4j/y8RWCddC7aAdeUY6T7zNgDaAXspU+MKMOYc",
+	"Fv8MkfQu7Het3I1Sr49lka5N9VOFzWtfidWjCkqc6k9p+KoFo4VZjMsBt/N6GX+x+aXXzzFxx4UA8G52",
+	"HfJdTv7G1jtW7VpRrlxmArRJqAa9qULoaw6i2jrQUV4yqswZo2ZczJ1jLfiSLPynRLGMcbwIARdbM0bn",
+	"1FVVtrMHCh83sbNYpw0sjLsZdXzFFUNTqWrS0KKT+Gz9WtEmwaYymZh9V/IUXtYdLTT6n9ZYobFs7b1r",
+	"EHc3p75s8EBLGy/MohH8aGdDbEEvKRobL7LYQG5HscYSFcqVuiiF/zWpJmLrYw3idjq40oW7sbelik6j",
+	"G/WQm31OmEDPGB4aDrvG5n/7MMbxCRh+JJ1dpoc9Tg3D14fVr9Cqm34XVTTcY+3KDfhCcOlgONwuqJZ/",
+	"BbWCzBUVxqOerhzaLzqEuIAkY7UmwT1mP8PkZOLGqquCpeE136bVq6pWrVsFG99N3NaLPpMo1yQEQWRU",
+	"+Omghuz7TYdt28H0LE1VBsKNGysmWMuNn+4mm4uNvoCmBjeZYpq+G2HvBtv22qwFG4XfOwkWVX0J65IA",
+	"S79khOzmQQNNkvYDwiH0zrgpUxq3n2rTR99/qrgJ1+oYHnSd9A41rc6GxxZFmJyiMHJWR4GQ8EC+wCU+",
+	"QpcqhncHWhTywgIc9EadBFD7yf/+42Dnf+jOP/d3/vxx58O//cukQ1jXF6C1rDXnzEiIqqsVItuAnBrG",
+	"nMva1zsSfKxZqlTcrDGVKITOhdC4M0YVU899t3JFf0X0ehwuSh18oRrFwphVLb7N12+1b/voufhlGAYX",
+	"M+mjxKjNHrerNfm7NJS8pEuaUziaVeG+00/29ubcLMqz3Uwu99bSGLpY5q3tmUBsf7PQPRDgUgpuJJ6Z",
+	"h3ReurTGXSwxmjFHKm4QL45f7Xy/u983gJzOy6yQZY7/2jsr5NneknKx9+ro6bOfTp7t2rEZbkAQTaBL",
+	"cLs7iJYnk8e7+7v7ky82IpOu+OTJ5Hv8yWJa4s7sYTT6nraFCfCnecpOBHJEE/9aUO+9dwAjF/DOv6Jz",
+	"LvDOsxvCAbjEKyY2gmHeJ767erZah4miemWvCtNEO8XA28d0Pu49puyrH4DQLVfjUny3v98INISMWWeb",
+	"3vs/LUWgRTrEhq3JVwL0S4vCju0qAsyXL4zlvoL9/GH/8bUNy2WQtocAhfUiYzxzfOCwVW6693eCeVs7",
+	"c+9E2eBWg7SJFLqiJYOJ0v+Y4ANbQT5ZJNzGRmhnUqo1g3ysGZZCQ6g3pQO6S5ugbUPxrl6Joj9YOcu0",
+	"+VHm62tbZJtAs6DGG16+1CW6C6VvUP71kVh7lfpI370SIhaQ3vdvnuKOBDoCwk3nTvnsD/vf33zPuCOo",
+	"KVUX+G1icks3df5McPmXafMU2/vN/eso/9J5or11ZmfP+D5ypwrHc2xvr2BZlBSZkAQvmLk2MTB8YMVd",
+	"HeVOcNzQuRX3Zb0eY7g3r/wjd8dFP+z/cPM9+xkDH9k64lvEQi+YuSL/7GVUZDYLqeMwxefal8BzKBBU",
+	"uJCreveJIxQ//6Z5pw4o0Hfk4VoULL9nmzs+eXAjrsw5C2q6+eYElUwa0BmMrAVhDbHNgt75gbMtmup3",
+	"t82vHj6YZhlb/W511LsTE/fasZMfNeFxNWFleSrvkVfl2ZJbuz7kG3kedHLL9r5ScrkybWllmSn/RgUW",
+	"GPmPceZ1oTEkt25dz/DP7gXXHQmuHx7fwlpbUgSPIhAf1pt28VdU6Au2ZWLMiYZKglkZ0iXAVnznE1vr",
+	"wVs9+IQcBI/eJW+9mxWT14LvNGGdRmwfDDa4MV61XdQcc4ll8z5yP4m7ZplbOHETu3SrlPqs09AcEVNM",
+	"mJ4Yuy3NGF5rYaCALoEdIbwWYTJ5rYxl0rCMpDK5maMr7uJuDcNuCN288PT3bAq+S9b7Yf/PtzBrumSN",
+	"EOE7Z3tnenZMn+b5+Dza++0TWztrsyt0ljiaziWUCKfCtzvyYLJ14YIw2Exttp+lDV0/tAfpRuaqteW/",
+	"Z9L/4XZmffvGrxTBWxrrJ/hpv8rlSedsTY4OR5I2uE2un66vW1nrO5sqhtkKh8c9w9wSw4BnZYBbVtSk",
+	"ajBY/KGKX7iYyZHsEgOQXpVjrl+jTMGj3rYhZJBf7SDzsHf3OuU3Lyd+t1psHYq4R4sFBKFhk0rAGWLC",
+	"KM40WYKA8xkCM14YpkimuGGK013y2gIEoQEKKQCuvh1mF2j6lZxfOSCwmaCOQzpbu7ED/c+lWnuIcQvV",
+	"RIspGrWnJKfzR1igf/Jk8mvJEDDehWv6TyfTaKNaYbLdAygtaEpH6/D0KB9qe2ApXsn5iaHKACj2ZNz7",
+	"z0Q+9m2/Ra/4kpuRzb+ZzTQzN6yhedIZY0xrkfDvQAw/l+qM5zkTZKdKN1k2efPWjW2jAjxb2xWJMHgW",
+	"5JdZgLddzNnOKoJITdvkDiAtwIZ31nbE8qiRxLYEL3BFIA8+tJnwwos5O64e34ihrtbJHSlWJ7Z0TX/M",
+	"sh2hW7+cuHI3gE1wZ3qWF/UXjH4K+/jo7tgeOO5CSTEPAYWBuLYp/sVyQBzd/0BXI4250CxiJizknIse",
+	"1ouWQhMapQ0EyFgq8tDRNGADUPIfP5863CkpSFURsaFLYPc3w4bY9h1xn+u7xwpRR82pOO/WKN3zXaYY",
+	"ZhjSQm8BRbtUpMmTf3yI6TumQ0uEQHbyzFAuKkrrofMlG1SXeSv7P0qYATSs1tmTso65Wizv7OMbI7Aa",
+	"/mrKAxPn+UQzu89G8bYgLyuT29pFRpqZctUTWuvyVKpMFKus4S645GxbHqsg2NQueYNAa6FkGebfColf",
+	"aHst3SWeQmOhimB+yyXLObAEivH2Ve0Eh7uFGS44sK2VzbhnNMbH/gqUo+9vI0TGlKtgM4Ev0edEHkbU",
+	"+mh7DxHnI/QMSONtTvN8TudQ1q43ekVxdo66URHBV7l6eK6Qd6jrYk0uIAXO1iRoT6H6XNvOYmF8Nrey",
+	"2NAyaGHMzf+QGgZmhedKLjd5/1SOettBEbkqRKO/gNGPNl5UnzwtlZZqzDebBSiGrOxuu1XYdbe7HcYj",
+	"92hzs1TcPkBcOfSch5lcLumOZjBaw/JH1YmBbyPCDVbvxhqZC3rOyMGrVx4ygeWuoZ7ir8Ablyj9mph8",
+	"QI4ZmP4lKudWJWqXA0Vsd8mmK9oxG5QZfXO5SUuaEw6QCd17nEVHVxXAdFsn2GtagPLJMCFPS7RgcXeq",
+	"VanncaXS2zw/XjDBFM8aJWu7Qso8tUSHRTghxiQvu++JLZRZrC1n0gqSz5dhDgDN5CGUAnvkjwlldAX9",
+	"v/tevBenC64D5hzWpsUAVqdNYdtsJ+SoQPtQe8xxcgkLynLyC/T5C+IDU6UdNKBD2WT5e6H5khdU2crT",
+	"v8CpqPf841+m+Da0B1PjugK8CuopBNDNbIMWybd+0D2zH1hyhjPoZMWyu9JabwOW8egw4DE4kvgLLJDD",
+	"evbIiVKwGnCiRU2sUNh6dVz7VmeZyTAUeAy7WnogUySMFctwNEC0XTVKcOlTyMVhSxKojEj+XFjwxm7w",
+	"XUhKLJjxlTadMEBIopaGPCN4QAChn7t8X4XEzmeW5Ty0JSMaJosz5jqosQ6kjDyMYC0fpUs8Alm2i0W3",
+	"eJYLV8Cvc36Gzge3EIvItwqSwxASIC7XfoHqZoOroU6mx95QqssAJlAJvjuIJvU7C0dW/Xy6FQf1wQbE",
+	"i8vkmSbGP966w9RjBITqNiw6GPE85KLgwgqh9EEb38z2mPi1ZCUbtslc9fh1HWnCLZTf1p/DK6Y016ZC",
+	"1cTx95/Edo73J7GrulAdxBe8KKAu3ld+GluSqIQKbne+wckcmK0xg3OmFM9ZRGduGgFf1PVx3ce9ZUt3",
+	"3gNfJaSjVH6i8SFPModdfX/a3+Vp72kvtq96Yfu7O/OHCbg65ZcyZ4+m8Myv912Gs406/N35crUT/zeQ",
+	"gV/u2iT749pVK/raDbMOZPJ3aMa9t819g7Y5y94RHrDXmEZJlb3fvCztzQw7ZmpJhQ0LUGwpz2v3C8Uy",
+	"qazyjkqb1jLjeJuFpzk1dJcc+hKcoWbodCju1mb8WMK6YTCGw4MXm4mFp1Jkihk2Om0teerXctj2b+W0",
+	"xUFkVAiJZa9rI/jh9kZwN6BKozjMJZrRWIFPGb+Th/FzZrIF0y7ZivmDNRFrE3Gs62iXvNOMPCioYdo8",
+	"INSGVQRdx0ii3FGPD5ZSO3B/U2kX7m5XebpAwUJc6lTsjqXlw6qw2jax2HVkzqX0/KgE3fCB1lUIqt7U",
+	"GLW/5+S7Hb57u91s59XYFuvI2RAv9p9re1QZPqOZGcbkANbxb6O9ihjFfFXkaggdfHQQ+tlCThp+1w//",
+	"LQPlCGrR3mxahOvuVLF+jdHvB26Fl4Cp8KRbYKMwGGtShUydLWYpqyt6qmyR8RU4aS+XF8IXM0uy1KF7",
+	"AfREe4VvcJazRXdxlf++zlpfN2cdU7PYlKlkZpjZ0UYxuqzTTaiLcMYFxRiNZixGN/niDvgefe1PHM1T",
+	"++POIdcrqbn9sBUlbwzNFksmbEuD8T1fbp0/cYLbrGQ68kZkujDoa2RQMBdzdjF45Ln3yJIZCjc161Rh",
+	"n40nDzeqFA8PH4XHbhS/O7a93FnolmvUceg3bltOxG3nOLe218xwOQsOovQxyJoG4OBGtcGJtfLRzgfQ",
+	"NoREXsqv6Jp2fwHazO7gSaXupLwUXbKcmx3FjFqPCRQAp2/NQUEFgRbsIKBsFcaQS+Eq5ruq+uipKc2q",
+	"NC7mVrPCVR//xFcr+L9hK902PTzLuYH73nrLSfqa/Pg/9TvBvYmGSO9U9mYctwe4j2BL3R2qkLtJoIDd",
+	"/mS4wDNf6w8IIgoZiOgkETbQrHtsIwaAFE6ADBKAvfAzMZIsqfoUaKZFYjbNGc87WWpHceScKg4hP0he",
+	"40s+pr3czypi73N27w5WHvtqPNgusiFPFQXOXfrrRSjLaGNsoGa+/YpwoQ2jOdZ9CvGHVfjrbjrCwO5w",
+	"oIYNds1GxG38ZXct6HDU1gbV6GmMXe8kNugXtBTZguU1zt1CW1tZk+92lFc7ZuILR/q4+S8XuKW7Txc6",
+	"p0BYhFbc7o8luJaoyEznFhRPGBQd7vBJ1iJwut/9sXONx843K0jTy/aWaVmcRw6V5GolV6qrrrFjCFgd",
+	"+4q9ESxLja65Gf8MRbTZTCrmBAsX881OOy5Ywef8rGAdR/Cx57MquVHbUxmGUvkJw+HsA+ncrGubXBtb",
+	"fV0Vo7qjcCj059d8gCb8m1PfXqpca3MNVCkE7V6Bk2q6PubRnQGg4cD03dw31DMaZ13zWlhJL12pzUZG",
+	"a71hf+mOAtlGAtd2yuOrqFS5K4lfmRVEjmFCu2NrIsNUk0W2bY3xxOB+kmLnrJDZJ9Ae/GudfHX5M9/R",
+	"TOOkr9OFX8E2zwQGjqaysWLglpXlraltr6WkIhkX5XFZLaGQ804DiXeY47IEegREpZFet1dy/nWaF08p",
+	"L8a895LRUe15LLPhN1102oebxRzYPif4K6CqLTdTBjc4yI0GR1zZE17I+SaeO+RJYbhqsSY68Hr5s+6/",
+	"21ImHeYA8ITsrQrKGwQw6GoLtPYNetm+Bj4KDrab4CNn9Bx1qLl3d2HyFUVQbtPYisLeYN1bJJOF0wUd",
+	"ek6cR1plYJE4YsV/SxEwB9JZrFIC9hvFsCYIghRU7+IFBrP+xTlDVRfNt++Oj5+9/fj04OQZggzSJSue",
+	"Us0gUNxtLsZsZqU2cmmRoWdVqNkjn71Vsqq/jK5MiUV+bMeWWHVPNNobt7bfYDTasGXOT35Inaycfp68",
+	"ahRRUZF7bO9t3se7S45mNcKqLn9npan2TEj/fYRzJwhbrsw6tGxpbfc+gjRxhrf3oRX1srnwUQxGmZdF",
+	"Tw7oKzQXEEpmiulFw7dDFlwbibMIYtEa3RUrbXlcowm8wvIoFj5ZNM+N5N6g1mtQA+/IdbtvbtxxU2rm",
+	"QPUO6fw5LxJT/xlTNjE9saJKS2URlKBXgTCUE36Xis+5XzE8EiN3ArzgaM/TKS2s7VALutILaVLehS+J",
+	"G/nvz8cy6O/Y2HDhdzWvu4nvDBCumaN4CyfOSy8uW8fOraRIPnVZu+ShY+p60uOjbTz/gizwJ49LUnKC",
+	"0OLMX+bou0RAwxnVcACL2KsUDr7Emfa78Q9d37FzdOglt190l6yydjEAkYk/fXiiSupxKaa2BiFm0cOn",
+	"wTBv1d582Hs0kJA9yoM00nK1farnun1hvBS3eQ/f4BXXHs41d19FDjX/bpUZ6BxJNZyEjhvhlTE5vork",
+	"pLQ/9dQtIjwdRF4Y70Ldssg8JFaJ6IRfxb2u7d6+eiQpup/2fvOi8sseXYE87LnkHdgXMMMCpKSPJ+WC",
+	"/Ew5qos2hWoasv+LtROyHIGZwSKzigBTYidoBdFMNWHinCsp0BYZAq+gI12eaTiPhPNltrjYDdJxsmGr",
+	"r9NZc+JPsBur027XCfqJYKJvtBJZ3GN3wDk8J44W7wgUGofALcBUi7gJ8quVepakNVlyrd31+rZFGHLi",
+	"Fsswt+uEkouwjGx1PRJrrJ83eHcF4SLn5zwvqbODczHG5wsE8dX6fStR8hX4iMdMB1PT7r3JW62xVAEV",
+	"AXUgxW/XIwQu6WOue5Y3lA11f/PvRkCM5757T/bX7sm+PR5eMq3pnI1zcL969RoKqhnivnJm+7UbKz6C",
+	"pisXpXUgYrgeviSk2Amv9fmGgTNe+7F9C/eIGzovny6o8evUWzQp2jZ915mkX5ESXTl4awvomPPa9OlV",
+	"qRc7ZzT71G0DOC418CG8NMIMMPbuz8X/Wbd159W/AmuFLhHqF7GN9S459b9iIDsW36DC3SBpgSCkfF4q",
+	"2pGqUerFjzT7dG8wGKBgv1C3aDGodzlgMgDiZbmlzO2zGky9jaBpO5gG+RMwrS0J1+n3Xiom4sZLvfCS",
+	"KDYuoFwMIgKjTcD4P2Msh7evR1YqhtbtTkH5Fp9f2lZKic04cXP5P5tplvAewpN72TVAZ3aZblFyxR0O",
+	"yC27uVtp6rwXOilVDLbrRsyZbtFBpFCTLRLxBliEWDs9qAKrq1/PQNj1mi7erXJqIg/Jicdi/r2KjoZj",
+	"NGxDHxXBvN3CtdMG8eebDwW4i4Co2wZQ+QpEwmsqbDG8EhnLHfkPoqifCu78MmJC9hTEfS5VxlzknJEr",
+	"0Dc8pr1jfxtCT0XGCng6s1iXSsoKxdUrJ9rAfWzFRO6/LwBFmdDSyCU1PKuSI+sS5ZSpJRfUfLOQQdtF",
+	"cGG5q629qkWuPNvZoBar4ctQFKmNN4xmCUg0Kc8s3EMpNHnIRVaUub0BrWztqcAf+lHiINslGO67oooJ",
+	"c1Ke+b0ESq0it3yeAMwAO8JIIGqitqphkIcwtmVZGO5IWzDtck71o6QhMHS8rQdkR3C4Xbba5I8O/ZIO",
+	"rB7X6dVrRE17GUI8lnROepa3q8Jna39vtNxn46gvz946ih+Xcu5HaYGYB3PBfftfRVAU2fIslxfM1EWK",
+	"Demzogjkz5Ul395vuiLDL4POiI0w193Iu+IMGpT1dQibKgw3ml0NN94uEVqqPfuvqFlU3K9rfF9Xjm9P",
+	"DvyOgdpPop37atHa68x1nULgusDcN5YD2w7uvqkkqMGCX68suIeav4eat0x2C2Lg+pDo+ySBb6MlDr5u",
+	"aZB3oJDflTy4B8i/h+seBsi/FbFyQ/j5MQMOYum3pM12w+mPFDqrNBD71yRz7tH9vx50/xuUFlfMdxh5",
+	"87gHuLtC8sKm16Jou7bLQHKfHnHb6RE3KjduIEVik+vL9sqUTW8ud8iw9xkV32JGxc2xfS+eQmy1TOAp",
+	"lN6bVx/qjnO+9CoQ24qfcMnjGea/7Q6MNJhDN06G28rrg3Q4cbRR7+2ObxMnFcFuu2PTDxVCnk7iNbx2",
+	"oXBzsA9V5GHETluABVEJpu2NkB4jmCIc15AJY6THSrhte8Y9QsU9QsU4t/JXi1JBmsLsxoXxdSFajLX1",
+	"fNV5618RWMU2WYa2JWn43op0oyAbtyy2bhKDYyNT03aLtMuYm3Ah7lwU3GOD3Iuekdggtyl6bgQ6pDWN",
+	"K2GJ1ETTlsOJjJBP36Y28lVCmHxlt6xBGJPbFx/XDHnSYQL7inBQvnFj2cpjRnxz5rJ7fJbfBT7LVyby",
+	"OzFamkLyRkBbNjgIrgvPZRMnyEYgL9+4YHbr/61J5Xvkme1GnvnqNOgE+szta83XjVTTZ+CzaDU16bfV",
+	"gDUjLXwOKySIQLdKd2/tv4fQuYfQ2V4Inc1k3Rgsk3rJIIcq4NU1MMgaBqwERWmBFTHfpqBnrGjHm0BS",
+	"IkBdbCyZjul8lKA5Zmrsq5sJu5+l+qRXNGMJEfYclwDWSftF6IDyGLSlD7Vt1xXqBC+XdEczGKFh+aPK",
+	"/IpvovKNt7CDV6+qgsDu813yujSWmtjnrCgh39fu6i9AKb/8xQpdps6ZCgAoL09Pj8kP+/tQrPJMmgUW",
+	"FNbMdKGWBAoYmOxKsYwaL6taDBqeE1pwak1hv9i2f/kLWXbNw72xS8auX8cskHM23DBW5HB+aakMOVs/",
+	"eS92yC/Q3C9PyAn8RovVgp4xwzMc+tm6qlP6MKOa7XChmdDc8HP2yH7NPpu3pfANAI1BPltVCRKPTL5k",
+	"u3aiuAaMqoIzZV/1L2i4ljOqyIwrjWol1ZmDs5IqZypqQZZVF+G7gmqz+150LBfMubZcQYh60meiXIIo",
+	"cn+6mUXnWPfK4txxkORhPGqSM//Xo45x4VcdA6M6i8Zl/4IWU2O6dlANnagUzLUBlSgpds2CceUlPQha",
+	"n+UIEx8D0HN48AIrx7aAeaYTPH96BmSfEyYyWQrDVL0kuj+6o3G0Kus2e1zRORfUeyF7DWnVmwk8EWBR",
+	"N/pao1cCF9nGdP6iiCI/3UEaHe5wsE9HleK0TrpQ7dfTVnRUOAatn9+2kZ/YhQ2Nvvzt4toUaeGKWA7Q",
+	"vLuodISB1wpGJwr7gThHPqQF/yfzdRQbFTL98gVfBaq0eHSdsZlUjGSKBd9Cf1Q5zupyuv3ja1jM+urA",
+	"2vk7mmAXxdpOxKKWXWUq23gD8RSwfRLA8l5VSjc6HNpSwKv3e04NG9byQbaUgv9aMq8kYYYLzZTUrTtA",
+	"yol/UBSHBy9eebXvJi0PkTJ+o/F3XBucT2+C+Nd8flT3tC760YyqbNFJP8dMgYhE3NSyKHYQZ8F+40kH",
+	"Oh0inxP84lI3wwbltDbHjgX1QeJEVFpH/PVWs5OabGibIlY84i3HjtzhzOK61GR1l7b2rFdLc9u5iZKm",
+	"mC4Lk+jJbhpxz8kSDJ2+H7+240AjsaG32M6RYctB1Eg/pKD1fUOKnlvVtHIX2NI0TTeXuEbHB8QuueRJ",
+	"sNt9FJxaxfwbOQhO6fzbPQacmaOL2rwm2+38/S/3hrYwy6mEWW9SWDGluTYWHnlNMusI2X0v3gtvw6Kk",
+	"cHdO1zN8b1ndAfQGZTujAnTtFVWGW+cxVZrl0/fCXkqdXF3SNaGFlsSCLDP3mqNmC8F5VvLCONsJ9LVj",
+	"ZMEUFYZAQCcXc2v3qFO7n/jhwYsrZ/Ve+6Wo45YDj9HDohm5qK2mXHL04LvLX+tsSF+f2mmt11cXfZQr",
+	"4/L2lxFXx4BjOmwhaVHrRgctfp0oOK9KBkbXsElc247IQyFdP4+qJT6TsmC0bSaxrW90Yv5XNR174m6f",
+	"PPPs58SO7k4Mrgm038DqgZ5au94FMyyp3C4pjLZYE8WWLsW3fgBarELcm7U2bNlOv8DGr2ovGeVife4m",
+	"lToMf+hg2yiwgdh1yG8VUnubQ/lxOQgVhH12B5bdxpbFbRwIdi/6detCnyq4eWvQ132UdN33nDkzuLb+",
+	"GMYwCMcvVxTXOTdSveTCjDGLP4vevhNrOMgkxL5rO+fPtCxKw6yxdkXB/zrzZkj7oxQk5/pT6tAuqGHa",
+	"lUDbELp7OilkRovDXkcFvhL5Hamy+lgolWA3c9RV8JXrLrU+aeXj1K3CZgoI8LleMZGzxIn784KZBVNh",
+	"gbkm1dvtg3Y6uaAKwvUSa/STFDszamhB/DtA5zao7GIBGwdaZ+7JZoOV+hG++9k2OnhhrpFAPPd4g7/F",
+	"23SVUCGXK8UWTKCTGHe1EsejNIW9wfIvXuL7nCnrxMNvgsncqQtcj5T4uGUvbYNfk9xPlQ/okSF2ybgT",
+	"JD1LtoGfsy7ImtJkrnh+SE3ikHqheE4QORaOp3OuS3D7eEIZ2zu0MsqY5dcmGtK3yIBVnFLEHjStUfVx",
+	"XxSROcKr0VN4BE0GWVlQVUVed1BcN2fegUZ2DTW9rsTF11H941ui8BfMdNTciHT7diBeF50z8WvJyh5T",
+	"W+XID4kh/grauJpSkROa55pw9GA7u3xpoQQCP7bo+5kdQaDxWz92rsMEltP5T/1WsBB0Jc+ZUjwPJjFY",
+	"nbBWOXGCJ6VDekmEgyuKN7PO1akzR39hsqPDMISQHcNneFP20QbOCywFC9EGc6BV9AB8+YBKv/f79qrb",
+	"9q0vbocTB/RxlIgpyYpq7UnJUx8X5D9O3vzUo2oHgm5MOKy7a81WRLMkWnduN1u02O3Goxc4cTCjhW65",
+	"Po5mBD0iCODNhCGWwTDVdObVe1ooRvN1qAwpld/9hz7+8Yf9P2MmW8Ez8yh5C/A+mb4FR5/Ily93YeoM",
+	"lDqOPpNy+yj/ikI5nCCJc4ml8h5vHMyfb8fG1U9ggZbJUubs0fYdcO48aB44ozU3xbw/ouNAi1Jh4CtS",
+	"pYQM3JHeYst3YVu9Fi8Nuwittg0H7MIuBh5R0XkwHHAVNXufHvItG6gt41Q2wKPDcQzZC3sco8Gk4XHp",
+	"ONPF7YAc36DF4np9g/Y5aEvekbeRcXjY+DnC5rn1dkq3LN+wHXKEl3I6WZUJxnwtc4jMvhJn2lTRu2LO",
+	"a0mc7OQDHxY7ihe2IwDhRmVGY4rfHk9ZYg57PvLsM1SZ6zKuYGOacBOnYdbTIyMOa9lZ8JOvVn29BguL",
+	"t7fem1iuamK5ikEEqbjfHnJvBbm3gtyAFaRp+4CR+SUOL2Pwjd7efByRO+F/eQsJcuCOXovsGk6mafJY",
+	"miIgig38tu4AWBAMcHoIkpAvmSzNoynOR0WlbyA0rGomROnYOFrYRCFzD2Hiwnj/9V+PliupDBWGnLEF",
+	"PedS6Sf/+q+Qy3xkDTuuPyAK9jljLGTQ+WoksMFclCxMQcy9HgRoV3MFV3IL6ffD/p/CHrlxOS35F09M",
+	"vxAtSVZw2H4MGV5KwY1UQHAZFRkr8H3AMY0GiWNhFK/DlDxwiDIP3GTJw0W5pGKHix2zYDuFlKsK80zg",
+	"lB7ZOTGRryQXJqwrXy5ZzqlhxdqqC9/t7+PCw9tZqZQTy6bUqZjjSnE4AZK5Vx7ulYffnfIwnTgRkrgo",
+	"0898WS6JZpkUOa4HsC5uGgyxEmY1KejPeRjZ0jYxefKnP/6wvz+dLLmwfz8Oo+bCsDlTLRXBD+vDHWk3",
+	"t+aer+Dq7ArmeJBYeZlH8FsgxR5todrzp2sbzKnd8s4xuedhTXoJ0Z5pmx2D93rcVdxbTnmDuYEKtlBS",
+	"yFIXazyRg+Rw+zM6WlAbudqhRdGt0Z0yteTCKnVF4c/9olrWEAnnj5sKnSEVl3Ri5Mpm3rl4sq/U9D4+",
+	"s/VWrGEncYoE7OoKsyiLsE1caENFxvS9X8qlsBq5Sq/QhjF/Lk6551IkhVGyQNTvELLtMYsU0QtZFrnT",
+	"FCN2qsXg0iyTyno2JN6Z8CrF4DMpQmM9pnQcpOZSAFQf+zqt6tVCN4kfH/grj5FEM5N2R3clnvm2b8MX",
+	"fc97p3I+d0m9OhAm0Y4yR/DcBTtbSPmpLy/urcuFAxJwr9fB89MH1i45hAAvoLspWVJB5/APqQjNl1wQ",
+	"KYr1bnf63M9uXNuWRefG5ZPn7qbMxU+ytRGuOrEXc7dKqe8E+7yyzmPm3kkk1vkRO3U4Ik/3pCe5Li7O",
+	"3E2C9RhcvKVLwZwmutsRt7AVhHZ9NyU3nerC19qtn1Prd0+6SdKFYO+RdDsGhi1uKiEv3zbtsP59Iz8x",
+	"MX0vLhY8W8CNDIQnqDsXIEcztktOjFQMLL2aZaVixXr3vRgWvwljox3wtvDF4+vmCzu9PmSPwB7O3tgW",
+	"8Ldw8faDqF+mt5ZNnG9kFKf0KCF7iyXN9oJY6L4HWGW8fhy8fH3wlNDSLNAmAYTOxEyqjGHBIPzNQ5Io",
+	"aSiYVixoiGaZYgbR/aIvXsMHwGlLboz3VnhbfbPl94JrslIMMXSdNAOW/UhF/hEmNXVRVfDvj8i8tLig",
+	"a4Ta0YhTlZlLc6xfr4ppYS22+mLCPlMwryCXhzWBP85pYXMHYCdhDyZPohfQh1xb2I/yDBe969v625Pp",
+	"pLHFeD2xLSQatxtzhbZdA8hRG8kp2MGwsVGljOs2KV+PKoG8Z5HK76h4RtMOLBXh7icc3DYrO3/Yf3zz",
+	"Q6ltlasyosvVSirYMynsiITl/u05WPyq1cT8JQ+WnGtAwOo+Vg7tC7o6S5gwbsItR3mlm1lZv4NiHU6D",
+	"y118bd93LMO3Q5K4jdrqy/U903bf9+32XQfLMtHPsc9EN8P6S1ZA8/HjaXJycNW/F9iOVQgJ+0wzcM/Y",
+	"C9aAdsgNccuviZHvhVPpyKU0umdiC0TBvTr3bapzMJQTpPAxt2BkCCa6xPG9Znd/SFzukLAy7jrOCMW8",
+	"AO8+J164N7whLpbzXeeEjcyQRe5fPGOZXDL9Xnj6i+L66sY76CJ1ilzyhv82zLB+Jlg2/saUxEvIJ7fU",
+	"FSHckahKcWIlHe5l09cimyp+IxdtOttcTF1GQqE2MkI22fc2E034zZR0ehOuUUydQlffmIQa9iHgrAfk",
+	"0b2vbZjrjKOeDfnNYFDE8K1RKm9qqJt0vJ8Ascp9DjSO5VKmHRujcYcuteuv4hy4G2a29eZpSw/3TDiC",
+	"Ce2OhhGHm18joKjJiDLrrTmEp08us3IJgyQUDjmjGCPaqDIzpUJ/3aygBisB7JKDoojNOSwnpWbK5vKc",
+	"KXmh2W662CgM5NZqUExvpI5pRwaGt1iFVcIwS0ZzBM9RrKsGKbyfKmkZxe9tVNPyoFXOMud6VdB1b0lL",
+	"6PSXJ+THtc0fgz/Jw5wrTc41oplomzwlmuUyXUlsRrMFgfD3FTa3NHzp2iuoRidshYwAzy5TshIXplWy",
+	"0v2KPW5Yt3KXPAc2X6/YE0J19teZLHKgYizGaR9is0+wpOVfBbtg2rinW17ZsjflRGbAi30Kkkc8CDJh",
+	"m2QgDq4aWRRGKbPxsT6+AVC/8Ui04ccXihtGVkwtudauOGAyAkdmt1xM5/q1hDCTjTSEq1Q0XDKtQY6m",
+	"KveOSHFyW+Zjf+4W8Oo28nv8hO8waWdcaJHnpjY3ev1jz0bk7pxRYwvYdfh48S1NlmVh+KpgsVoi8j28",
+	"EiiWGak488XVMduJBC7dJSfWhKGJYlmpEGLcf+XKauBrP0mzg3HjBNNWECnfuLAyquOw9tyNCtO1c7Zc",
+	"ScNEtt5YdrjwaZn9iKvwtQuQQ5nZGeF07uie0RxE96mGL7i9jPMkoRpVVIjKcKijT3lRKqYfbWGkdoI3",
+	"6nzRx4Uy68sl8OxHL38+Bhq/VUW/Q3BicY7aBuuC6oVjZcE0pqzLTHeVKocGeitRDpAmVhD5MrICkB/1",
+	"rVf+8R3fTSbNOKrvPmIGkhLCCeFbgEsR7Gs65eCecPsJ91old5+4jtghwDres4MFuO/hhZVXsNIx2XH9",
+	"LN/MA03clDaW9i7v8p5pWkxz/epWWOsb1LRu5L7mQo/vGTgGShxzZZLZIFy3Bd3We74eYjjkIpVwTYx0",
+	"Zg8892zRVho9h5/B1Tjn50xMMVu8Ui5LkTOEqaKKEegnJ9TIpbUAbiwxHEr4nUqMpy5RJL1Y37gYCRvw",
+	"tYmR2iZZvrgDmVIbRU3A3IpN6JSqOXNH3faahSyNpfmrR+C5evhdripbjJ1VviqvtoRADJRfttx9W/LY",
+	"z2/dB5WaQ1WRPyE+fu2VHbfsKrDj7dPT3YxsTWJ9a0bZ13DAiLldyQpYapu4wK1Mj68CKB+R23YKOR/2",
+	"0WZMGAXl76BD+IwUck7gR840WYLm7+MhZrwwTJFMccMUp7vktQ2HGIqGAO/KM2j6lZxfmU+afksc0tna",
+	"jf0TFzl5yHbnu1PAp/qI0H1FsfxYwmnRdbjCV5M+hhjs13o3Q7+7gDM5w4Jh2P0udr+rWCZVzvKucTjH",
+	"46XG4dEUO5r2cIxXaF2Vwhag6GjfwipesgNqDFuuTHcH7oXL96AZao/dPbgXLt9DqZnqbh6eXr7tpcxZ",
+	"0bfB+MJQ6wOs9krOTwxVBuDsJuPefybysW97EfCKL7nZ5INjOucCxcnrkaflKzl/M5tptlE3T0ulQd7e",
+	"5Onn+9JjXOUtcXx7ByEtZlItWQ7579qi6Po401XYjRj6Hkf2+DZiq0w9TMj2/P3N9/xcqjOe50yQHaL8",
+	"/XTZPAGVLNjWRTb0nvGRBoHPvA6xYLQwi0H9wb6WqL+K8GF0XhJMzlIpw/hL/PYEkccmN8h1tp8+lhtT",
+	"EGMzJ/aCZZ/c1P0aaT9Rv956rQ1buvUueMaEZns0M/y8N3D82efMFVSjxH1FPrG1B0Tic8Fy8h8/n/oA",
+	"1oMe3ezA9fbKtnMl7ezawOo+sXWqZEo1U9S0QCl5t/Pf//3f/934z6PBajDQwR0Ug/m84mqdhLKcMWpK",
+	"ZV8bXzJqVVBxSQuI23dg14rybz36BfYSiLYajFWab02oHwldzmY842jrDybGrTJ8eBatc/uQGMnZsCDx",
+	"MH+FhLLs0S5gbfRa0jTCFy9LwY1HPDjoxfSj1y1XtsRy6KVQtby3FzP2k7R7xAIdSBX+GUWan3NKmDgn",
+	"51TdM1LN6+/3rAbtVAQSTTLUkhnFRwS7Hyu5ZGbBSr0DY6aGQ7ar+xrPZleSAoGfQTEKPKNTytFr1+8g",
+	"8Rv22eytCsobS+hS9GEVyctnr47BMFF+BEXNdo/1/mLF7b34/8jp34+fRS/OaTln70X44bdzpmBf//p+",
+	"8nj38Q+7++8nU2znY04N++v7yXf73/2ws/94Z//x6ePvnuzvP9nf/5/3k+lcfoy//O7x+8kX8vg9dlmN",
+	"rVwZvmQfPaw/XCyJ5iJjXpHCWgT1YTa+iQfcePT9H/f3mz06G5H+GLC5P3pI45/K5RlTcA9L4nbDv3V9",
+	"KD2NxcPqee0PneOztYQ/GmloQU7xvyIM0A+HcGGLDneMq9ZIcki1N/7UOZqBYZytna7bMQ77uQP5fi8S",
+	"D3+z3//1/cRpJ0Av3/3w5+8H3ka2R9r6w8Cb9AwzUuHdf0/Ms2eO7Vnp9JL6n39obWsAsA47/3MS3ppr",
+	"T3L1Ptvfx123nz5+n6ie0paflQgLcosLAvLF1xbZOjRkCF1pDzuS5ZXQdfJcrpigK77rRzmIPitg6vby",
+	"/GbFxMHxUeUscvB/Z2uX2gVy1WOhp4T6G9v5f0DfV9RqaJ5zW6/mONJvrGtnUI2BXOLmZO7WhrNNsVAm",
+	"tTod6gFKzGHtAF+zSVxYD8pzJijZrk48ykO9S95pRlyze78JumRfUHuwLfjqXPBd/aU9G3E+qwrPQ/0Q",
+	"MPRg+hwX87Rn5j/tBO5SQe/bRzu8q9putkBSoSWO1kqt2f2zBOFexIOTa1MXYvbFGsW5XR9JeEtmKFzq",
+	"poHwkFqmbfpLIBXj82RkK27O9Xr0sMmao8OFvuBPTybuyXY4s+38N6sMeQuBJW4Nt7eGAQhZ91fNctyA",
+	"dP/VkdcwG+wFo1kvM0iB1eUuqMoteOOKzhmodY4Feurx4BtTm+XJcl9hhNnfwcKbEyOhYfwVhLRD7FjR",
+	"X0vm/SiK6XLJNKEz45Q9TGbVGRXCd4KG+XWPsD7Cud5svAl2BD2O9taFL7wf7Rtg7dyVWoJM7UsdQbdq",
+	"Tc38wm8htzsGq3SUsWyukGx3hMxHaFn2ZUw4d0WAzqRZONsYJp27qt5SsSR/VUyyvRpR1c/YnOt4WW7f",
+	"X9qlB9VGVe1+bcPHpF7jbu5YT2wet9qRZx3t0x15u4YzqKuObjCRehyR9RHY22qxfzcZ1BBG1QqTTdaN",
+	"xuj4Oj12EHlT0O39psL6H+VfxqVXjucD+8218IErpHsl0fhD2jPlCauWungLguspFUKaKqkXzo4dLUuV",
+	"sTwA190SkFDQ4FPpi3QccY3NZYwaA4PW0WGLbl4wszVEs3+Hou62swe76AAUq9FEMJTEt4H8sN/cJSnc",
+	"VCbcJc/duyTGWibc7YlHxF9ab4t43Jqz32Xi0Ws59/cM02Ynk0KwzI6gu9KwNpr4N8+5cfl5DZm+pJ/Q",
+	"6Owj0jKMUvMaVAvFkMUXoqfVML4Vyd81wT7Wq94isDsuR+XOj4JTO5ZIHY+3q4f8bH4UAmsO54qggWHn",
+	"jGrMa+Dzhblg8F9bjbSWtFPlTxXyjBb+KRjcOtOoDl48Z1jz+a4yqQ78MG0GkDM0XUdKVVdoP/iTgTNt",
+	"3XryMJPLJd3RDAZsWP6ogvLFN82CGrKg54wcvHoVWSft510QcvbpFZMD7B5Fxr1R7zvz4Y1mlB28sH0B",
+	"8fSybkS+CYq99Ztr6D5cYLesyrcdHxBe7P3EX9vSI4blxbQxtplAIVrw1Yo5ySEFq5LPYK+wZjc6vDvF",
+	"B52/dv1uKkFqALrTqzmw7kSGELfi40SJfxldIBcLJnytb7Kg+vYly0b4qpcTQ0gYtyCLot76ndYtWYR7",
+	"Enjgbq1o90XWK9HX3JgBQSizzaReXY2K4oquqkvJ7FvWpb5aXcVnv2+kq3SQxV0Kia1k11RWfBeXXreO",
+	"EsANuxnystrJNwxr9Q1Ije1WLYLo+L3pF3eL13UZqTVa01DnPGN6L5NS5VxQI9WgELNh74ksXeuIn3Nt",
+	"MKwpajPEMetLlUB5wczTqrGQ47udIRWtkX4D8abgHIq309HNQBZyoC7FrE1f7y24RoylwUxwfA8g3Gz+",
+	"IJwktm0SGrssKb11Dbx0Y7nWkNNDV0oSQmXcZImRRDGjODsPuC7f7y+n5PGi60TMXSsdpRoeLya3W5Oh",
+	"uWRfNyV7CiKIpOO3aZCKQyrMtUnIKDnnqvLxxDe15dKxMc5vRDZWG7mhZDSlEKy4PEFhfDC2EXp+eArx",
+	"BBkt2KPLEtMptrjllBQP8hsho8ZODtGQMVzM9R6du6EPZp/5LOWDI4If1asfR1CpFf5Mkj4O4GNbkHpr",
+	"ySMaYy91tNfhd4A/lNjobcubSxBo7RZjiX9MNBJQ/pUo3jZ0rUR/UzFHNaq/k6CjkXxnh5snt/lO7Qn3",
+	"fH/n0OOjWb99DO7Buu6tlDznOfLmkGJVnoWfdyDHNZAkNER8Q14BC6lcrLCzEAhfM0qKQK4CMsdBaRbH",
+	"YYTben6mhzsmQ6S2cvqeq7YCti9B1LFmiY/HcNTeb/6fEFNYyLlFiunKKPCoUMg0RsKl9w0QFMkUy5mw",
+	"tayaTOV7GMlYh1y7ALUWvb7C8V2rgeegJhcqhNZ6+mG1SFdMQkzkM/jJkTxMnOX3bHbniFBhN5InSOFI",
+	"sclxXTlpCJ6rCYV7e0kLxzbYCpkV8uLKbIMdfIMcc422qu4V6j0Hqz1CjKm7zmO7Fwp3CLEPBLCRPLjM",
+	"Cbznq0V2B/U/dW/0SZRSe3T+FUWnvWK2KgZ59/YVmg1Ls5CK/9NaILPxuq/v/dsQNzeQqNu7Pnd0kx8a",
+	"1JAEDCVM78Xf71X8eRK6qgR089mxhQlAyJXJ+jvG3jTc667QgVeUvA1wrH7EzKFtBxngtauJsHWGv2qc",
+	"OMQ7EhatUfQUzqztjma/Yz69lfAl3JDtrDV4wkxgVisifOmREUJhyZYulGQIzyAKd3797PWbt3/fXeaI",
+	"4DLWwsDCOfjadnoDVrvGpmE/JCsYxUiB4MIs1vfH2p0fa7ArnqIc3Xq6SHqoem3Qrp2l23E7McQWKpxF",
+	"FdP1ENnPvrShp/bmaPb6PEZ2jMOeWrdK8hx85Ozinhe2x1fbzwJJjS120bYEdLvm80gn7XXR+406aT3B",
+	"b6qtJY8Jh9uQOCbub12/Wzfq6POpU7dyubiu3t+XsaqW/dhC5DXg+Q4PXmyucx0evLgGlm4ZimBOcLq6",
+	"fNW0naiqdXjJ9JSDF/j9l3FqHyYJ3qt+2676AbZroOkrK4ANze/SLOP1vW+aX65P5QzL1GusiBjS9XzP",
+	"g1ugcm7MgYP653VxYaTjfUOM+BXowhGr3uvD9/KipQ9vKDJSWjEYJ3dWimlmhmMLF1Tl4CPNnaXbfecN",
+	"ObaIwgbBg2jHPXadb3PYYDzQMQGDteW5Z5etiBes78k4ezx8MswWkAMXFRgMkfn2802DaV/bTreeHcYz",
+	"wj0HbFPE7NLT19i4vQpLHhC769R9qewT2+B2+6DtGHF4l0g+uT5Sr42gm99e283YBpD536fX+8+35fVu",
+	"4utukSHJAftv7PWGV/Xeb/j/sXj+4joEUewFv7Igal1q7X51heu52V5/ML3ttlYW4D4Q5fcXiOKrIPRy",
+	"4zDuvbCCBsJor4HhYgvJV8NwN2UouqxysX83ykUNSf9eubiXZHeSQ7uBXqFlufnl3faCn5KHK6a0FLTg",
+	"hjP9aNOL/An2f7NwhlB6b9R7TIVXmyHGEaDgQ499DDJzSqI3H/WgDN5NLhOsMy7xGDuEpYV7M8T2mCG0",
+	"447LWSGqNmIuXT/a3AIBJLS9BggY3R1ZHmzX3awFz+8tDt+0xQG3+CsxOGjLx2P1gr3f4H/jrQ1VJ5vb",
+	"Fq4sYkaA7eJkRpYPxG2tGQhukXNRZh8d3qvzN8+522+X6GDasTUZq0ZsScYNo8rulC/3b/egrhVlvGf3",
+	"e3a/9WSKTl7fwAS58SkcGRxvm9tvymy48ZXgliXNvZ3wXtLcuZlw/G3ASDnCSuiD22wQbR3z2vaI7fRY",
+	"A0+xn63HxsNhjrGo2XW7t6htj0XNOArrJXqoe7Jj7d2jAJbhfSz0VXP3kb8fvH4VHcG5B+XG9UFgnTN5",
+	"nla9f6SabQHS8krByAy3X+PS6vZSeGrHI8ZO3b5KuC0L2MT05IYtdcIUHqobUKUoRm7rFcsSpYr9mtfX",
+	"m9slJxYtPVErIVYD/mHbnvpZfQivy7P/Y5lJkdmPrT5/T7zdQb/bpki3CePSuSG9fP1fltqhYCabScWI",
+	"pudczC/D8LbP6+T5y+nVdXa/cd5Ls9z1auqXlmAXVAmklPHiqjHHKwmW7chluZd12yvrnCo/VtzVFJwL",
+	"X+BP7/0W/m3rLm+q+4TPd3QmVywfqQxRpGpiZL2RpDIUyhH2SchEMFNtZpfOiPu51sqXL9PNpfG3qYGF",
+	"5b0TXeznnt5vXU5eRAUzv3UZeSR0OZvxjGMRUKaWXGsuhb4160q181trzO1jjUtro5tJ2mH19EJxw8YL",
+	"YjuUr14W36xmfO0y8V5HHiPw77Xl+5Nga43tmx8GqKuvRbaXFYyKctWN7+0LbYC1HZdfzAkTRnGmyUzJ",
+	"JYFmsIwNayPdrkX21HWwPUbGmVRzaQwT3RIovEJA6pCjQ72RRrtkWtN5IpLpxO4c8S8MCehqqFWjYySZ",
+	"W/QKKbsltrYJ9ghG6kkLqcmudFyeUGQ1oh1/efSVCV9wY9tuMke74CkQ7XaXIKyG2FtivXZ++ULB20sK",
+	"L1jPNjVIYYQyO3LHnTf/Gjf9BpC4w+jsaO8q7uASZNelNm3ZCTqW7oIIsnGbO2c+aKb/8AR6dAgxLLfi",
+	"zR6eComHKLaSmhup1lNSSCxOzvWnKWL1VucrqPch6C6TyyU3u8kz1wb3/Yhj21JyjoZ4g9RcP/h9rG3n",
+	"se9euOND3w9zsyP/CInKz+BObilPqRDSuDGQh6tSL4CO6VnB8ikphVG2DiKu6bRlSnx0a1o9rNVWh+WG",
+	"0luj1CEni5wKtZkqf1lZ1CN3XrtxbIWLsZMrn6L89EwZanngWjrR3+LSFhPey6fR8ul1jeC2R05tY1B+",
+	"xJ+jBAA+3/sN/gelu6o8mgE54K63ae4nD+fcELUk/+Z0DfJvBET6o43Egp0SCAcQutcLNADm0LA+hGPZ",
+	"zxlnijxEhGgwDyMmniwNYZ8NEzrOoK1bj+3a3RYqQcsgkSX48jn87E9Tds4E4TM71QXVbg+WMucz14+u",
+	"OPdMSrhX97L9tQjAW71+WFnRd/fAg/1OZQuOILMC5ozV07vuNRtGaMWxGwg0PpuNsvbAi+SMmQvGhOMQ",
+	"kE9Otp0zhdZjrCVdG0fSCARreQg9f3NC6wbtA37ReqGvYZe67VG/c0YBMxjSsQOKvgy76IyqvFsBOLQv",
+	"+EMkW1AxZ9qxyjlTJnhrHcv4II+WkpCsmU1V7knhqW36noWu74xzS0rcLt8zUKpeNlV5g7gvzU3gg2Gm",
+	"m5nqleipYegeW7sOnR4fmT+mRCo0MxY8M9WAdgnwDOSxiNypdcFUSBXw3P/hlNsWv+c4wG9Xxb5jdbLy",
+	"BW6DQhlGc8/ojvAvx9XAs908fWDkkme0KNZEMSDf6rJMMyW1PzmBCfRaG7acpsxnA3fj1/L8Bm/G3vP5",
+	"zd+QYSvb92MvYa/nVgx9tG/E04lgF0d2XZLBWoCR1Fx6Ix1NESMHbW1V+x++kts3rNR23L1xJHcoJq8T",
+	"G8i7XoGih5yv8A7JmUHBuU3yGuTd5aT1qjwruO5xtFq2tVcYMFKyCJ6kEoDuUjN8izm2/d2bLTejULds",
+	"dxie8JbpsjCDQsrR0/bcne5lRUNWOFJKcHGP1HDbukOLYiNR0Q7PAO/aLjmy+sRRrgnXRC65MXB/89Tj",
+	"PKn+riZV08edjs5wUzsoim0NzqhGuNWs7Glk+6Mc/UiDXx8Kb/cSch8FP2cWHdZfL3Rl5FByGR9xENbU",
+	"c8zZvb4OOrxjOiiKr4IIiqK+UylFpIsktKGm1KO8IFDDHCRTCGqz3xIusqLM0atsfwA/SFF4Ykw6QU5s",
+	"t9tMIHaIvYBI0SJ8ZXGw2q9/F10Ypg1k0wqW2ea7pMYp00bHeRxcChQh/uNzbtZeRY6guEfIEGj6aTWE",
+	"LQ+bdsOEQQ+oR+5NAmtMFMqhbSIUmAHJomHKsRKl1EwNyxJKChd4BFICv9kAfP0d9nGD24kdQE9jcJLs",
+	"jL/9jKnnUp3xPGeC7BD1NWAmecqKCNX+PRaDHN6GHFNZCrMR7jjQz+QmUcOhgztCDbddd7MFPPeo4VtR",
+	"zJY8ZLvz3Sm5YPQTWVGtL6TKp4T7t2TBHt2z761BjgfbwA7yF5qrtx+BPMiDhDAJx97eb/C/sZDjI8QL",
+	"qce9r2WpNCtmXVjkTuxspiK9wyGPBBZH5u6Ogvs9MxD63mvbBVt1a4Y/3JltDs/rYJ5hJHBf/hrZ5WwN",
+	"lwiuNsICv2a+2L/ds7QG7H1/RP2ueQku8J4Pjg6T7DQEto3fP9CECwvlsWltv6sz003hZm+sFN8yI28F",
+	"zsfvB0R7e6XJvZ7dlSw9WsPeU0wzs+Ovc30xlJqZSOz5L4iRTqs/p0XJRopAbA029Nh3vGWSEAfoB3dX",
+	"LrbheB4/QoLbuL1mgnvDwL3W5bi+KUA6xNQFO1tI+WlTE7j/zAeBeufZLjn0aK5TsqSCzuEfUrkNkqJY",
+	"p23kP/txbKvLxA1wrJU9rOs2mpkvqsX2NBF+qpPF3m8QiYVotT3+NMXnc6aARgAhkX1mWQmPyDmnvq9d",
+	"AsFe8HhZakMWFAPg3MPIyfZegCOOCYRK2CUHdSddzlZM5JpIm4TjPwdBhIV4n7wXZ4wqjC39xCy9TUnt",
+	"p1VRavLy9cFTJEv4h6XK9+K9OF2wIF7PZL4m3PIPy703ECZArW/552c/vnzz5m8fjw/+/urNwSFh4pwr",
+	"KZZMmPfinCoOU8DIGf9hmKUmv/hlmUl1QVX+ccFozpT+ZRoiI94LPxT3DJMf3HAaY3j57ODw2duTeAwk",
+	"DOG9eC4V0XTGzHqKn/0CyyoV/yeu6i+uA5itgKQn4gbF8l27JjB4VQp4gYlfS1a65Axoi4l8JbkwRDlB",
+	"wZdLlnNqWLHG4D14673I6XwHmjg63H3fhh5yFOR4bGMZcHjw4rkj08mX6dViEn+MiQWCnmMii0jRHb8P",
+	"3Ps5nZcfLxYfd3d3H1RFX3OkLXKxYB0E6yIRmHYd1jvZ9aGOdoOqYMfa/vUWPG7NDynej0PzuaCmVMyn",
+	"tdk7NnmgF/S7P/zxr+/L/f3vswX7jP9gDy4zMeywsXhIFzDGzBAmMKYdaLZzvv+9c0jn5c6JH25tyuwz",
+	"hYAXmLUd9f7j777/4Q9//Pc//ZmeZTmbbfo30p8xTMFQ/tc1+o/9nT/TndnBzvMPv/3xhy//kghYvyEd",
+	"2XFFpB1/uflzrjdr1YkDY7l2W/xmILFvTf99J6jjQZaTnQBSIVVw1CFDT6Nf9vxLTWq2bOTYAdkl4gkQ",
+	"uhRiUtidaNiesT16EZFW6a1O7FtTuIHsInl8pyYKzwL+gIuCZnM6f1uKo3z7rBcsKxU368mTf3yoBc9Y",
+	"Nu7W3XrURKk+DcTPYIhZzXwL5R1KyOwGij8rYZCuHR/CU+lbmZQq54IaqS51tXAVDWxUxZZeLOz4hi4W",
+	"B5GQJSq8OJ384TZEwtNqH4hm6pxnjJSCnlNegFi4VeJ+wQRTPLOUHS1F+66TILFaFJg2bBlRsq3SERFz",
+	"4ppavbbN9IRDHH1Vrea0nduIV9Z44YM0qn6MY6RSIU5hVbYx06AxxDuKlIr67yaa8JKPmbpbj9AtnPo/",
+	"pTwR6aCbi4jIkjRalzRRPaBWEE4qXqaHhvuqQGyYFXfVmjuJMJyKaG4bBeqnSklMYzANbtk0fRjEpZK2",
+	"ejv270o+1OJA7nCrGzViGoEIzSPERyP01mPZtg2/qQiFy51Id0ZxtULft0txW3MW+aLTI86i+pUQxstP",
+	"wWYB90OgwjOqeQaWRvcD0Bro/p7mG7dzOi/JwfERsa9MppNSFZMnk9/spL482dv7bSG1+bJHV3zv/PFk",
+	"OvEWaqSbRXAtOP1zgsAi+HNzGV5KbQhGCEhrXXd9fon5qmpoYcxqMp0wUS5hFdyf8D+7Dh/CCjUn9cZL",
+	"AYuahLdOLPsPyUJw6sMfcG/O2YwL7rEnnQTIodEv04FGXRoWtLTg2kjFEVKUGordFHKO6WmHBy/QgB5u",
+	"6PWO4FmqsxO85BDZmIgUHHpyUwnzQk8FbGTYRNeDuyu12z9WcsnMgpV6BwiZGn5WMLKEOWWtvrDpaihV",
+	"89U7w+tV24So8cp0gR6KqHX3d7vlhncpWqSHhZxzAfiuc1maqbPOY9dolXtUtQ52wETb6B6uPqg1/vTt",
+	"u8NpFdqRbNan6bTGfHxEPrF1V9OVMSQe4orvfGLrVHPO3BvcOHaNnWXXU3dY2uh0q/yb7VXNuYGVGzEw",
+	"eDXRxFMmjKIFGldDI7RAbJt203YpVN0WFPWCX6VG6nMLF0oK50xpcorLCA1sILLUjI8I1qpvFMoanj58",
+	"lGjvUGYl7mxykyO+l1lqWlY/IALcMJuQiU2Z24Hv9OTLhy///wEA8wHwFQFFBAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
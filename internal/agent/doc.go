@@ -104,6 +104,13 @@ type DocSearchResult struct {
 	NextMatchesCursor string        `json:"nextMatchesCursor,omitempty"`
 }
 
+// RenameOpts configures a doc rename.
+type RenameOpts struct {
+	// Overwrite replaces an existing doc or directory at the new ID instead
+	// of failing with ErrDocAlreadyExists.
+	Overwrite bool
+}
+
 // DeleteError represents a single item failure in a batch delete operation.
 type DeleteError struct {
 	ID    string
@@ -114,12 +121,19 @@ type DeleteError struct {
 type DocStore interface {
 	List(ctx context.Context, opts ListDocsOptions) (*exec.PaginatedResult[*DocTreeNode], error)
 	ListFlat(ctx context.Context, opts ListDocsOptions) (*exec.PaginatedResult[DocMetadata], error)
+	// ListAfter returns up to limit docs ordered by ID, continuing after the
+	// position recorded in cursor. An empty cursor starts from the first doc.
+	ListAfter(ctx context.Context, cursor string, limit int) (*exec.CursorResult[DocMetadata], error)
 	Get(ctx context.Context, id string) (*Doc, error)
 	Create(ctx context.Context, id, content string) error
 	Update(ctx context.Context, id, content string) error
 	Delete(ctx context.Context, id string) error
 	DeleteBatch(ctx context.Context, ids []string) (deleted []string, failed []DeleteError, err error)
-	Rename(ctx context.Context, oldID, newID string) error
+	Rename(ctx context.Context, oldID, newID string, opts ...RenameOpts) error
+	// Move relocates every doc under the oldPrefix directory to the same
+	// relative path under newPrefix. No doc is moved when any target ID is
+	// invalid or already taken.
+	Move(ctx context.Context, oldPrefix, newPrefix string) error
 	Search(ctx context.Context, query string) ([]*DocSearchResult, error)
 	SearchCursor(ctx context.Context, opts SearchDocsOptions) (*exec.CursorResult[DocSearchResult], error)
 	SearchMatches(ctx context.Context, id string, opts SearchDocMatchesOptions) (*exec.CursorResult[*exec.Match], error)
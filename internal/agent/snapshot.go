@@ -328,17 +328,20 @@ func (r *snapshotRequirements) walk(ctx context.Context, dag *core.DAG, resolve
 		}
 	}
 
-	handlers := []*core.Step{
-		dag.HandlerOn.Init,
-		dag.HandlerOn.Failure,
-		dag.HandlerOn.Success,
-		dag.HandlerOn.Abort,
-		dag.HandlerOn.Exit,
-		dag.HandlerOn.Wait,
-	}
-	for _, handler := range handlers {
-		if err := r.collectStep(ctx, dag, handler, resolve); err != nil {
-			return err
+	handlerTypes := []core.HandlerType{
+		core.HandlerOnInit,
+		core.HandlerOnFailure,
+		core.HandlerOnSuccess,
+		core.HandlerOnAbort,
+		core.HandlerOnExit,
+		core.HandlerOnWait,
+		core.HandlerOnRetry,
+	}
+	for _, handlerType := range handlerTypes {
+		for _, handler := range dag.HandlerOn.Steps(handlerType) {
+			if err := r.collectStep(ctx, dag, handler, resolve); err != nil {
+				return err
+			}
 		}
 	}
 
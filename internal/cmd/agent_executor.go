@@ -30,27 +30,44 @@ func ExecuteAgent(ctx *Context, agentInstance *agent.Agent, dag *core.DAG, dagRu
 	// Set up signal handling
 	listenSignals(ctx, agentInstance)
 
+	successOnPartial, err := successOnPartialParam(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Run the DAG
-	if err := agentInstance.Run(ctx); err != nil {
+	runErr := agentInstance.Run(ctx)
+	if runErr != nil {
 		logger.Error(ctx, "Failed to execute dag-run",
 			tag.DAG(dag.Name),
 			tag.RunID(dagRunID),
-			tag.Error(err),
+			tag.Error(runErr),
 		)
 		if ctx.Proc != nil {
 			_ = ctx.Proc.Stop(ctx)
 		}
+	}
+
+	// Commands that accept --success-on derive the exit code from the final
+	// status of the dag-run; others fail only when the run returned an error.
+	exitErr := runErr
+	if hasSuccessOnFlag(ctx) {
+		exitErr = dagRunExitError(agentInstance.Status(ctx).Status, successOnPartial, runErr)
+	}
+	if exitErr != nil {
+		code := ExitCode(exitErr)
 		if ctx.Quiet {
-			os.Exit(1)
-		} else {
-			agentInstance.PrintSummary(ctx)
-			// If progress display was enabled, exit directly without returning error
-			// to avoid printing "exit status 1" which ruins the UI
-			if enableProgress {
-				os.Exit(1)
-			}
-			return fmt.Errorf("failed to execute the dag-run %s (dag-run ID: %s): %w",
-				dag.Name, dagRunID, err)
+			os.Exit(code)
+		}
+		agentInstance.PrintSummary(ctx)
+		// If progress display was enabled, exit directly without returning error
+		// to avoid printing "exit status 1" which ruins the UI
+		if enableProgress {
+			os.Exit(code)
+		}
+		return &ExitCodeError{
+			Code: code,
+			Err:  fmt.Errorf("failed to execute the dag-run %s (dag-run ID: %s): %w", dag.Name, dagRunID, exitErr),
 		}
 	}
 
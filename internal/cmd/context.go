@@ -32,6 +32,7 @@ import (
 	"github.com/dagucloud/dagu/internal/cmn/telemetry"
 	"github.com/dagucloud/dagu/internal/core"
 	"github.com/dagucloud/dagu/internal/core/exec"
+	"github.com/dagucloud/dagu/internal/core/spec"
 	"github.com/dagucloud/dagu/internal/license"
 	"github.com/dagucloud/dagu/internal/persis/fileagentconfig"
 	"github.com/dagucloud/dagu/internal/persis/fileagentmodel"
@@ -666,7 +667,8 @@ func (c *Context) NewScheduler() (*scheduler.Scheduler, error) {
 	}
 
 	coordinatorCli := c.NewCoordinatorClient()
-	m := scheduler.NewEntryReader(c.Config.Paths.DAGsDir, dr)
+	m := scheduler.NewEntryReader(c.Config.Paths.DAGsDir, dr,
+		spec.WithRemoteSchemaCacheTTL(c.Config.Core.ParamsSchemaCacheTTL))
 	watermarkDir := filepath.Join(c.Config.Paths.DataDir, "scheduler")
 	wmStore := filewatermark.New(watermarkDir)
 
@@ -762,6 +764,7 @@ func (c *Context) dagStore(cfg dagStoreConfig) (exec.DAGStore, error) {
 		filedag.WithFileCache(cfg.Cache),
 		filedag.WithSkipExamples(c.Config.Core.SkipExamples),
 		filedag.WithSkipDirectoryCreation(cfg.SkipDirectoryCreation),
+		filedag.WithRemoteSchemaCacheTTL(c.Config.Core.ParamsSchemaCacheTTL),
 	)
 
 	// Initialize the store (creates directory and example DAGs if needed, unless SkipDirectoryCreation is true)
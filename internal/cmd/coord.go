@@ -129,7 +129,8 @@ func runCoordinator(ctx *Context, _ []string) error {
 // advertise address (using cfg.Coordinator.Advertise, auto-detected hostname, or a
 // configured host fallback); a warning is logged when the fallback address may be
 // unsuitable for discovery. If peer TLS certificate and key files are provided in
-// cfg.Core.Peer, it loads TLS credentials for the gRPC server. It binds a TCP listener
+// cfg.Core.Peer, it loads TLS credentials for the gRPC server; without them the
+// server only starts when cfg.Core.Peer.Insecure is set. It binds a TCP listener
 // to cfg.Coordinator.Host:cfg.Coordinator.Port and returns an initialized coordinator.Service.
 // It returns an error if any part of setup (TLS loading, listener binding, etc.) fails.
 func newCoordinator(
@@ -188,16 +189,11 @@ func newCoordinator(
 	)
 
 	// Configure TLS using global peer config
-	if cfg.Core.Peer.CertFile != "" && cfg.Core.Peer.KeyFile != "" {
-		// Load server certificates
-		creds, err := loadCoordinatorTLSCredentials(&config.TLSConfig{
-			CertFile: cfg.Core.Peer.CertFile,
-			KeyFile:  cfg.Core.Peer.KeyFile,
-			CAFile:   cfg.Core.Peer.ClientCaFile,
-		})
-		if err != nil {
-			return nil, nil, fmt.Errorf("failed to load TLS credentials: %w", err)
-		}
+	creds, err := coordinatorServerCredentials(cfg.Core.Peer)
+	if err != nil {
+		return nil, nil, err
+	}
+	if creds != nil {
 		serverOpts = append(serverOpts, grpc.Creds(creds))
 	}
 
@@ -218,6 +214,13 @@ func newCoordinator(
 		return nil, nil, fmt.Errorf("failed to create listener on %s: %w", addr, err)
 	}
 
+	// Runs orphaned by evicted workers are only requeued when enabled, since
+	// re-running a partially executed DAG is not safe for every workflow.
+	var evictionQueueStore exec.QueueStore
+	if cfg.Coordinator.RequeueEvictedRuns {
+		evictionQueueStore = ctx.QueueStore
+	}
+
 	// Create handler with DAGRunStore for status persistence and LogDir for log streaming
 	handler := coordinator.NewHandler(coordinator.HandlerConfig{
 		DAGRunStore:               dagRunStore,
@@ -228,6 +231,10 @@ func newCoordinator(
 		WorkerHeartbeatStore:      workerHeartbeatStore,
 		DAGRunLeaseStore:          dagRunLeaseStore,
 		ActiveDistributedRunStore: activeDistributedRunStore,
+		QueueStore:                evictionQueueStore,
+		StaleHeartbeatThreshold:   cfg.Coordinator.HeartbeatTimeout,
+		StaleLeaseThreshold:       cfg.Coordinator.LeaseTimeout,
+		ParamsSchemaCacheTTL:      &cfg.Core.ParamsSchemaCacheTTL,
 		EventService:              ctx.EventService,
 		EventSourceInstance:       ctx.EventSourceInstance,
 	})
@@ -236,6 +243,36 @@ func newCoordinator(
 	return coordinator.NewService(grpcServer, handler, listener, healthServer, httpHealthServer, registry, cfg, instanceID, advertiseAddr), handler, nil
 }
 
+// coordinatorServerCredentials returns the transport credentials for the
+// coordinator gRPC server. It returns nil credentials when plaintext (h2c) is
+// allowed, which requires peer.insecure to be set explicitly; a coordinator
+// that is asked for TLS without a certificate refuses to start rather than
+// silently accepting unencrypted connections.
+func coordinatorServerCredentials(peer config.Peer) (credentials.TransportCredentials, error) {
+	if peer.CertFile == "" && peer.KeyFile == "" {
+		if peer.ClientCaFile != "" {
+			return nil, fmt.Errorf("peer client CA file requires a peer certificate and key")
+		}
+		if !peer.Insecure {
+			return nil, fmt.Errorf("TLS is required (peer.insecure=false) but no peer certificate and key are configured")
+		}
+		return nil, nil
+	}
+	if peer.CertFile == "" || peer.KeyFile == "" {
+		return nil, fmt.Errorf("peer certificate and key must be provided together")
+	}
+
+	creds, err := loadCoordinatorTLSCredentials(&config.TLSConfig{
+		CertFile: peer.CertFile,
+		KeyFile:  peer.KeyFile,
+		CAFile:   peer.ClientCaFile,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS credentials: %w", err)
+	}
+	return creds, nil
+}
+
 // loadCoordinatorTLSCredentials loads TLS credentials for the coordinator server.
 // It supports both standard TLS and mutual TLS (mTLS) configurations.
 func loadCoordinatorTLSCredentials(tlsConfig *config.TLSConfig) (credentials.TransportCredentials, error) {
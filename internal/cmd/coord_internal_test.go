@@ -0,0 +1,182 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package cmd
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dagucloud/dagu/internal/cmn/config"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestCoordinatorServerCredentials(t *testing.T) {
+	t.Parallel()
+
+	t.Run("InsecureAllowsPlaintext", func(t *testing.T) {
+		t.Parallel()
+		creds, err := coordinatorServerCredentials(config.Peer{Insecure: true})
+		require.NoError(t, err)
+		require.Nil(t, creds)
+	})
+
+	t.Run("SecureWithoutCertificateFails", func(t *testing.T) {
+		t.Parallel()
+		_, err := coordinatorServerCredentials(config.Peer{Insecure: false})
+		require.ErrorContains(t, err, "peer.insecure=false")
+	})
+
+	t.Run("ClientCAWithoutCertificateFails", func(t *testing.T) {
+		t.Parallel()
+		_, err := coordinatorServerCredentials(config.Peer{Insecure: true, ClientCaFile: "ca.pem"})
+		require.Error(t, err)
+	})
+
+	t.Run("IncompleteKeyPairFails", func(t *testing.T) {
+		t.Parallel()
+		_, err := coordinatorServerCredentials(config.Peer{CertFile: "cert.pem"})
+		require.ErrorContains(t, err, "provided together")
+	})
+}
+
+func TestCoordinatorMutualTLS(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	trustedCA := newTestCA(t, "Trusted CA")
+	untrustedCA := newTestCA(t, "Untrusted CA")
+
+	caFile := writePEM(t, dir, "ca.pem", "CERTIFICATE", trustedCA.cert.Raw)
+	serverCert, serverKey := trustedCA.issue(t, "localhost", x509.ExtKeyUsageServerAuth)
+	serverCertFile := writePEM(t, dir, "server.pem", "CERTIFICATE", serverCert)
+	serverKeyFile := writePEM(t, dir, "server-key.pem", "EC PRIVATE KEY", serverKey)
+
+	creds, err := coordinatorServerCredentials(config.Peer{
+		CertFile:     serverCertFile,
+		KeyFile:      serverKeyFile,
+		ClientCaFile: caFile,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, creds)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer(grpc.Creds(creds))
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(trustedCA.cert)
+
+	check := func(t *testing.T, clientCerts []tls.Certificate) error {
+		t.Helper()
+		conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			MinVersion:   tls.VersionTLS12,
+			RootCAs:      roots,
+			ServerName:   "localhost",
+			Certificates: clientCerts,
+		})))
+		require.NoError(t, err)
+		defer func() { _ = conn.Close() }()
+
+		ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+		defer cancel()
+		_, err = grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+		return err
+	}
+
+	t.Run("TrustedClientConnects", func(t *testing.T) {
+		certDER, keyDER := trustedCA.issue(t, "worker", x509.ExtKeyUsageClientAuth)
+		require.NoError(t, check(t, []tls.Certificate{tlsCertificate(t, certDER, keyDER)}))
+	})
+
+	t.Run("UntrustedClientRejected", func(t *testing.T) {
+		certDER, keyDER := untrustedCA.issue(t, "worker", x509.ExtKeyUsageClientAuth)
+		require.Error(t, check(t, []tls.Certificate{tlsCertificate(t, certDER, keyDER)}))
+	})
+
+	t.Run("ClientWithoutCertificateRejected", func(t *testing.T) {
+		require.Error(t, check(t, nil))
+	})
+}
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key}
+}
+
+// issue returns a DER certificate and DER EC private key signed by the CA.
+func (ca *testCA) issue(t *testing.T, commonName string, usage x509.ExtKeyUsage) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return der, keyDER
+}
+
+func tlsCertificate(t *testing.T, certDER, keyDER []byte) tls.Certificate {
+	t.Helper()
+	cert, err := tls.X509KeyPair(
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	)
+	require.NoError(t, err)
+	return cert
+}
+
+func writePEM(t *testing.T, dir, name, blockType string, der []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+	return path
+}
@@ -20,16 +20,23 @@ func Dequeue() *cobra.Command {
 			Short: "Dequeue a DAG-run from the specified queue",
 			Long: `Dequeue a DAG-run from the queue.
 
+Without --dag-run, the first DAG-run in the queue is dequeued. Use --tag to
+dequeue the DAG-runs in the queue whose DAG carries the given tag. With --all,
+the argument is a DAG name and every queued DAG-run of that DAG is dequeued,
+whichever queue it waits in. Both print the number of removed items.
+
 Example:
 	dagu dequeue default --dag-run=dag_name:my_dag_run_id
 	dagu dequeue default
+	dagu dequeue default --tag daily
+	dagu dequeue my_dag --all
 `,
 			Args: cobra.ExactArgs(1),
 		}, dequeueFlags, runDequeue,
 	)
 }
 
-var dequeueFlags = []commandLineFlag{paramsFlag, dagRunFlagDequeue}
+var dequeueFlags = []commandLineFlag{paramsFlag, dagRunFlagDequeue, dequeueAllFlag, dequeueTagFlag}
 
 func runDequeue(ctx *Context, args []string) error {
 	if ctx.IsRemote() {
@@ -39,6 +46,31 @@ func runDequeue(ctx *Context, args []string) error {
 
 	// Get dag-run reference from the context
 	dagRunRef, _ := ctx.StringParam("dag-run")
+	all, _ := ctx.Command.Flags().GetBool("all")
+	tagFilter, _ := ctx.StringParam("tag")
+	if all || tagFilter != "" {
+		if dagRunRef != "" {
+			return fmt.Errorf("--dag-run cannot be combined with --all or --tag")
+		}
+		if all && tagFilter != "" {
+			return fmt.Errorf("--all cannot be combined with --tag")
+		}
+		if all {
+			dagName := args[0]
+			removed, err := dequeueAllForDAG(ctx, dagName)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Dequeued %d dag-run(s) of DAG %s\n", removed, dagName)
+			return nil
+		}
+		removed, err := dequeueMatching(ctx, requestedQueueName, tagFilter)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Dequeued %d dag-run(s) from queue %s\n", removed, requestedQueueName)
+		return nil
+	}
 	if dagRunRef == "" {
 		return dequeueFirst(ctx, requestedQueueName)
 	}
@@ -107,6 +139,124 @@ func dequeueFirst(ctx *Context, queueName string) error {
 	}
 }
 
+// dequeueAllForDAG dequeues every queued DAG run of the named DAG from all
+// queues and returns the number of removed items.
+func dequeueAllForDAG(ctx *Context, dagName string) (int, error) {
+	if !ctx.Config.Queues.Enabled {
+		return 0, fmt.Errorf("queues are disabled in configuration")
+	}
+
+	queueNames, err := ctx.QueueStore.QueueList(ctx.Context)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list queues: %w", err)
+	}
+
+	var removed int
+	for _, queueName := range queueNames {
+		items, err := ctx.QueueStore.ListByDAGName(ctx.Context, queueName, dagName)
+		if err != nil {
+			return removed, fmt.Errorf("failed to list queue %s: %w", queueName, err)
+		}
+		n, err := dequeueItems(ctx, queueName, items, "")
+		removed += n
+		if err != nil {
+			return removed, err
+		}
+	}
+	return removed, nil
+}
+
+// dequeueMatching dequeues the DAG runs in the named queue whose DAG has
+// tagFilter and returns the number of removed items.
+func dequeueMatching(ctx *Context, queueName, tagFilter string) (int, error) {
+	if !ctx.Config.Queues.Enabled {
+		return 0, fmt.Errorf("queues are disabled in configuration")
+	}
+
+	items, err := ctx.QueueStore.List(ctx.Context, queueName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list queue %s: %w", queueName, err)
+	}
+	return dequeueItems(ctx, queueName, items, tagFilter)
+}
+
+// dequeueItems aborts and removes the given queue items, skipping those whose
+// DAG lacks tagFilter when it is not empty, and returns the number removed.
+//
+// Unreadable or stale queue items are discarded along the way, but only count
+// towards the result when no tag filter is given.
+func dequeueItems(ctx *Context, queueName string, items []exec.QueuedItemData, tagFilter string) (int, error) {
+	var removed int
+	for _, item := range items {
+		data, err := item.Data()
+		if err != nil {
+			if tagFilter != "" {
+				continue
+			}
+			if _, err := ctx.QueueStore.DeleteByItemIDs(ctx.Context, queueName, []string{item.ID()}); err != nil {
+				return removed, fmt.Errorf("failed to discard unreadable queue item: %w", err)
+			}
+			removed++
+			continue
+		}
+
+		if tagFilter != "" {
+			matched, err := queuedDAGRunHasTag(ctx, *data, tagFilter)
+			if err != nil {
+				logger.Warn(ctx.Context, "Skipping queued dag-run with unreadable DAG",
+					tag.DAG(data.Name),
+					tag.RunID(data.ID),
+					tag.Error(err),
+				)
+				continue
+			}
+			if !matched {
+				continue
+			}
+		}
+
+		err = withQueueProcLock(ctx, queueName, func() error {
+			if err := exec.AbortQueuedDAGRun(ctx.Context, ctx.DAGRunStore, *data); err != nil {
+				return err
+			}
+			if _, err := ctx.QueueStore.DeleteByItemIDs(ctx.Context, queueName, []string{item.ID()}); err != nil {
+				return fmt.Errorf("failed to delete dequeued queue item: %w", err)
+			}
+			return nil
+		})
+		if err != nil {
+			if !isQueueAbortSkippable(err) {
+				return removed, mapAbortQueuedDAGRunError(*data, err)
+			}
+			if _, err := ctx.QueueStore.DeleteByItemIDs(ctx.Context, queueName, []string{item.ID()}); err != nil {
+				return removed, fmt.Errorf("failed to discard stale queue item: %w", err)
+			}
+		}
+		removed++
+
+		logger.Info(ctx.Context, "Dequeued dag-run",
+			tag.DAG(data.Name),
+			tag.RunID(data.ID),
+			tag.Queue(queueName),
+		)
+	}
+
+	return removed, nil
+}
+
+// queuedDAGRunHasTag reports whether the DAG of a queued run has the given tag.
+func queuedDAGRunHasTag(ctx *Context, dagRun exec.DAGRunRef, tagFilter string) (bool, error) {
+	attempt, err := ctx.DAGRunStore.FindAttempt(ctx, dagRun)
+	if err != nil {
+		return false, err
+	}
+	dag, err := attempt.ReadDAG(ctx)
+	if err != nil {
+		return false, fmt.Errorf("error reading DAG: %w", err)
+	}
+	return dag.HasLabel(tagFilter), nil
+}
+
 // dequeueQueuedDAGRun aborts a queued dag-run and removes its queue entries.
 func dequeueQueuedDAGRun(ctx *Context, requestedQueueName string, dagRun exec.DAGRunRef) error {
 	// Check if queues are enabled
@@ -217,3 +217,81 @@ steps:
 	require.NoError(t, err)
 	assert.Equal(t, 0, length)
 }
+
+func TestDequeueCommand_AllAndTag(t *testing.T) {
+	// Note: not parallel - manipulates os.Stdout
+	th := test.SetupCommand(t)
+
+	daily := th.DAG(t, `queue: shared-queue
+labels: [daily]
+steps:
+  - name: "1"
+    command: "true"
+`)
+	weekly := th.DAG(t, `queue: shared-queue
+labels: [weekly]
+steps:
+  - name: "1"
+    command: "true"
+`)
+
+	for _, run := range []struct {
+		dag   test.DAG
+		runID string
+	}{
+		{daily, "daily-1"},
+		{weekly, "weekly-1"},
+		{daily, "daily-2"},
+	} {
+		th.RunCommand(t, cmd.Enqueue(), test.CmdTest{
+			Name: "Enqueue",
+			Args: []string{"enqueue", "--run-id", run.runID, run.dag.Location},
+		})
+	}
+	th.RunCommand(t, cmd.Enqueue(), test.CmdTest{
+		Name: "EnqueueOtherQueue",
+		Args: []string{"enqueue", "--run-id", "weekly-2", "--queue", "other-queue", weekly.Location},
+	})
+
+	length, err := th.QueueStore.Len(th.Context, "shared-queue")
+	require.NoError(t, err)
+	require.Equal(t, 3, length)
+
+	out := captureStdout(t, func() error {
+		return th.RunCommandWithError(t, cmd.Dequeue(), test.CmdTest{
+			Args: []string{"dequeue", "shared-queue", "--tag", "daily"},
+		})
+	})
+	assert.Contains(t, out, "Dequeued 2 dag-run(s) from queue shared-queue")
+
+	items, err := th.QueueStore.List(th.Context, "shared-queue")
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	data, err := items[0].Data()
+	require.NoError(t, err)
+	assert.Equal(t, exec.NewDAGRunRef(weekly.Name, "weekly-1"), *data)
+
+	for _, runID := range []string{"daily-1", "daily-2"} {
+		_, err = th.DAGRunStore.FindAttempt(th.Context, exec.NewDAGRunRef(daily.Name, runID))
+		assert.ErrorIs(t, err, exec.ErrDAGRunIDNotFound)
+	}
+
+	out = captureStdout(t, func() error {
+		return th.RunCommandWithError(t, cmd.Dequeue(), test.CmdTest{
+			Args: []string{"dequeue", weekly.Name, "--all"},
+		})
+	})
+	assert.Contains(t, out, "Dequeued 2 dag-run(s) of DAG "+weekly.Name)
+
+	for _, queueName := range []string{"shared-queue", "other-queue"} {
+		length, err = th.QueueStore.Len(th.Context, queueName)
+		require.NoError(t, err)
+		assert.Equal(t, 0, length, queueName)
+	}
+
+	err = th.RunCommandWithError(t, cmd.Dequeue(), test.CmdTest{
+		Args: []string{"dequeue", "shared-queue", "--all", "--dag-run", weekly.Name + ":weekly-1"},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--dag-run cannot be combined with --all or --tag")
+}
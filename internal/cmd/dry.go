@@ -16,7 +16,13 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var dryFlags = []commandLineFlag{paramsFlag, nameFlag}
+var dryWatchFlag = commandLineFlag{
+	name:   "watch",
+	usage:  "Re-run the dry-run whenever the DAG file, its dotenv files or its params schema file change",
+	isBool: true,
+}
+
+var dryFlags = []commandLineFlag{paramsFlag, nameFlag, dryWatchFlag}
 
 // Dry returns the cobra command for dry-run simulation.
 func Dry() *cobra.Command {
@@ -32,8 +38,13 @@ without producing any side effects or running actual commands.
 Parameters after the "--" separator are passed as execution parameters (either positional or key=value pairs),
 allowing you to test different parameter configurations.
 
+With --watch, the command keeps running and repeats the dry-run each time
+the DAG file or one of its dependencies is saved, clearing the screen
+between runs.
+
 Example:
   dagu dry my_dag.yaml -- P1=foo P2=bar
+  dagu dry --watch my_dag.yaml
 `,
 			Args: cobra.MinimumNArgs(1),
 		}, dryFlags,
@@ -43,23 +54,34 @@ Example:
 
 // runDry executes a dry-run simulation of the specified DAG.
 func runDry(ctx *Context, args []string) error {
+	if watch, _ := ctx.Command.Flags().GetBool("watch"); watch {
+		return runDryWatch(ctx, args)
+	}
+	_, err := executeDryRun(ctx, args, true)
+	return err
+}
+
+// executeDryRun loads, validates and simulates the DAG once. The loaded DAG
+// is returned even when validation or the simulation fails so that watch
+// mode can keep tracking its dependencies.
+func executeDryRun(ctx *Context, args []string, forwardSignals bool) (*core.DAG, error) {
 	dag, err := loadDAGForDryRun(ctx, args)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := dag.Validate(); err != nil {
-		return fmt.Errorf("validation failed for %s: %w", args[0], err)
+		return dag, fmt.Errorf("validation failed for %s: %w", args[0], err)
 	}
 
 	dagRunID, err := genRunID()
 	if err != nil {
-		return fmt.Errorf("failed to generate dag-run ID: %w", err)
+		return dag, fmt.Errorf("failed to generate dag-run ID: %w", err)
 	}
 
 	logFile, err := ctx.OpenLogFile(dag, dagRunID)
 	if err != nil {
-		return fmt.Errorf("failed to initialize log file for dag-run %s: %w", dag.Name, err)
+		return dag, fmt.Errorf("failed to initialize log file for dag-run %s: %w", dag.Name, err)
 	}
 	defer func() { _ = logFile.Close() }()
 
@@ -69,7 +91,7 @@ func runDry(ctx *Context, args []string) error {
 		SearchPaths: []string{filepath.Dir(dag.Location)},
 	})
 	if err != nil {
-		return err
+		return dag, err
 	}
 
 	as := ctx.agentStores()
@@ -97,15 +119,17 @@ func runDry(ctx *Context, args []string) error {
 		},
 	)
 
-	listenSignals(ctx, ag)
+	if forwardSignals {
+		listenSignals(ctx, ag)
+	}
 
 	if err := ag.Run(ctx); err != nil {
-		return fmt.Errorf("failed to execute dag-run %s (dag-run ID: %s): %w", dag.Name, dagRunID, err)
+		return dag, fmt.Errorf("failed to execute dag-run %s (dag-run ID: %s): %w", dag.Name, dagRunID, err)
 	}
 
 	ag.PrintSummary(ctx)
 
-	return nil
+	return dag, nil
 }
 
 // loadDAGForDryRun loads the DAG with parameters from flags or command-line arguments.
@@ -114,6 +138,7 @@ func loadDAGForDryRun(ctx *Context, args []string) (*core.DAG, error) {
 		spec.WithBaseConfig(ctx.Config.Paths.BaseConfig),
 		spec.WithWorkspaceBaseConfigDir(workspace.BaseConfigDir(ctx.Config.Paths.DAGsDir)),
 		spec.WithDAGsDir(ctx.Config.Paths.DAGsDir),
+		spec.WithRemoteSchemaCacheTTL(ctx.Config.Core.ParamsSchemaCacheTTL),
 	}
 
 	nameOverride, err := ctx.StringParam("name")
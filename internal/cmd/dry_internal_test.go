@@ -0,0 +1,91 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dagucloud/dagu/internal/core"
+	"github.com/dagucloud/dagu/internal/service/scheduler/filenotify"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchDryRun(t *testing.T) {
+	dryWatchDebounce = 50 * time.Millisecond
+	t.Cleanup(func() { dryWatchDebounce = 300 * time.Millisecond })
+
+	dir := t.TempDir()
+	dagFile := filepath.Join(dir, "dag.yaml")
+	require.NoError(t, os.WriteFile(dagFile, []byte("steps:\n  - echo hello\n"), 0o600))
+
+	watcher, err := filenotify.NewEventWatcher()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = watcher.Close() })
+
+	var runs atomic.Int32
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- watchDryRun(ctx, watcher, func() dryWatchTargets {
+			runs.Add(1)
+			return newDryWatchTargets(dagFile, nil)
+		})
+	}()
+	require.Eventually(t, func() bool { return runs.Load() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	t.Run("RapidSavesRunOnce", func(t *testing.T) {
+		for range 3 {
+			require.NoError(t, os.WriteFile(dagFile, []byte("steps:\n  - echo changed\n"), 0o600))
+		}
+		require.Eventually(t, func() bool { return runs.Load() == 2 }, 5*time.Second, 10*time.Millisecond)
+		time.Sleep(4 * dryWatchDebounce)
+		assert.Equal(t, int32(2), runs.Load())
+	})
+
+	t.Run("UnrelatedFileIgnored", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("x"), 0o600))
+		time.Sleep(4 * dryWatchDebounce)
+		assert.Equal(t, int32(2), runs.Load())
+	})
+
+	t.Run("DotenvChangeReruns", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, ".env"), []byte("FOO=bar\n"), 0o600))
+		require.Eventually(t, func() bool { return runs.Load() == 3 }, 5*time.Second, 10*time.Millisecond)
+	})
+
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestDryWatchTargets(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	workDir := filepath.Join(dir, "work")
+	dagFile := filepath.Join(dir, "dag.yaml")
+	schemaDir := filepath.Join(dir, "schemas")
+	require.NoError(t, os.MkdirAll(schemaDir, 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(schemaDir, "params.json"), []byte(`{"type": "object"}`), 0o600))
+	targets := newDryWatchTargets(dagFile, &core.DAG{
+		Location:   dagFile,
+		WorkingDir: workDir,
+		Dotenv:     []string{"config/*.env", "${HOME}/.env"},
+		YamlData:   []byte("params:\n  schema: schemas/params.json\n"),
+	})
+
+	assert.Equal(t, []string{dir, schemaDir, workDir, filepath.Join(dir, "config"), filepath.Join(workDir, "config")}, targets.dirs)
+	assert.True(t, targets.matches(dagFile))
+	assert.True(t, targets.matches(filepath.Join(schemaDir, "params.json")))
+	assert.False(t, targets.matches(filepath.Join(dir, "other.json")))
+	assert.True(t, targets.matches(filepath.Join(workDir, ".env")))
+	assert.True(t, targets.matches(filepath.Join(workDir, "config", "prod.env")))
+	assert.False(t, targets.matches(filepath.Join(dir, "other.yaml")))
+	assert.False(t, targets.matches(filepath.Join(dir, "config", "nested", "prod.env")))
+}
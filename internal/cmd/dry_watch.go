@@ -0,0 +1,194 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/dagucloud/dagu/internal/cmn/logger"
+	"github.com/dagucloud/dagu/internal/cmn/logger/tag"
+	"github.com/dagucloud/dagu/internal/core"
+	"github.com/dagucloud/dagu/internal/core/spec"
+	"github.com/dagucloud/dagu/internal/service/scheduler/filenotify"
+	"github.com/fsnotify/fsnotify"
+	"golang.org/x/term"
+)
+
+// dryWatchDebounce is how long watch mode waits after the last change before
+// re-running, so an editor writing a file in several steps triggers one run.
+var dryWatchDebounce = 300 * time.Millisecond
+
+// newDryWatcher creates the file watcher used by watch mode.
+var newDryWatcher = filenotify.NewEventWatcher
+
+// clearScreen moves the cursor home and clears the terminal.
+const clearScreen = "\033[H\033[2J"
+
+// runDryWatch repeats the dry-run whenever the DAG or one of its dependencies
+// changes, until the command is interrupted.
+func runDryWatch(ctx *Context, args []string) error {
+	watcher, err := newDryWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create file watcher: %w", err)
+	}
+	defer func() { _ = watcher.Close() }()
+
+	watchCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	dagFile, err := filepath.Abs(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s: %w", args[0], err)
+	}
+	targets := newDryWatchTargets(dagFile, nil)
+
+	// Only clear the screen of a terminal, so redirected output keeps the
+	// results of every run.
+	clearOutput := term.IsTerminal(int(os.Stdout.Fd()))
+	run := func() dryWatchTargets {
+		if clearOutput {
+			fmt.Print(clearScreen)
+		}
+		dag, err := executeDryRun(ctx.WithContext(watchCtx), args, false)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
+		if dag != nil && dag.Location != "" {
+			targets = newDryWatchTargets(dag.Location, dag)
+		}
+		fmt.Printf("\nWatching %s for changes. Press Ctrl+C to stop.\n", targets.dagFile)
+		return targets
+	}
+
+	return watchDryRun(watchCtx, watcher, run)
+}
+
+// watchDryRun calls run once, then again after each burst of changes to the
+// files it reports, until ctx is cancelled.
+func watchDryRun(ctx context.Context, watcher filenotify.FileWatcher, run func() dryWatchTargets) error {
+	watched := make(map[string]bool)
+	watch := func(targets dryWatchTargets) {
+		for _, dir := range targets.dirs {
+			if watched[dir] {
+				continue
+			}
+			if err := watcher.Add(dir); err != nil {
+				logger.Debug(ctx, "Failed to watch directory", tag.Dir(dir), tag.Error(err))
+				continue
+			}
+			watched[dir] = true
+		}
+	}
+
+	targets := run()
+	watch(targets)
+
+	var rerun <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case event, ok := <-watcher.Events():
+			if !ok {
+				return nil
+			}
+			if event.Op == fsnotify.Chmod || !targets.matches(event.Name) {
+				continue
+			}
+			rerun = time.After(dryWatchDebounce)
+
+		case err, ok := <-watcher.Errors():
+			if !ok {
+				return nil
+			}
+			logger.Warn(ctx, "File watcher error", tag.Error(err))
+
+		case <-rerun:
+			rerun = nil
+			targets = run()
+			watch(targets)
+		}
+	}
+}
+
+// dryWatchTargets describes the files a dry-run depends on: the DAG file,
+// its dotenv files and the JSON schema file of its params.
+// Directories are watched rather than files so that editors which save by
+// renaming a temporary file are still noticed.
+type dryWatchTargets struct {
+	dagFile  string
+	patterns []string
+	dirs     []string
+}
+
+// newDryWatchTargets returns the watch targets for the DAG at dagFile. dag
+// may be nil when the DAG could not be loaded.
+func newDryWatchTargets(dagFile string, dag *core.DAG) dryWatchTargets {
+	dagDir := filepath.Dir(dagFile)
+	baseDirs := []string{dagDir}
+	dotenv := []string{".env"}
+	var schemaFile string
+	if dag != nil {
+		if dag.WorkingDir != "" && dag.WorkingDir != dagDir {
+			baseDirs = append(baseDirs, dag.WorkingDir)
+		}
+		dotenv = append(dotenv, dag.Dotenv...)
+		schemaFile = spec.ParamsSchemaFile(dag)
+	}
+
+	t := dryWatchTargets{dagFile: dagFile}
+	addPattern := func(pattern string) {
+		t.patterns = append(t.patterns, pattern)
+		if dir := filepath.Dir(pattern); !strings.ContainsAny(dir, "*?[") {
+			t.addDir(dir)
+		}
+	}
+	addPattern(dagFile)
+	if schemaFile != "" {
+		addPattern(schemaFile)
+	}
+	for _, file := range dotenv {
+		file = strings.TrimSpace(file)
+		if file == "" || strings.Contains(file, "$") {
+			continue
+		}
+		if filepath.IsAbs(file) {
+			addPattern(filepath.Clean(file))
+			continue
+		}
+		for _, base := range baseDirs {
+			addPattern(filepath.Join(base, file))
+		}
+	}
+	return t
+}
+
+func (t *dryWatchTargets) addDir(dir string) {
+	if !slices.Contains(t.dirs, dir) {
+		t.dirs = append(t.dirs, dir)
+	}
+}
+
+// matches reports whether a change to path affects the dry-run.
+func (t dryWatchTargets) matches(path string) bool {
+	path = filepath.Clean(path)
+	for _, pattern := range t.patterns {
+		if pattern == path {
+			return true
+		}
+		if ok, _ := filepath.Match(pattern, path); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -29,13 +29,14 @@ func Enqueue() *cobra.Command {
 Examples:
 	dagu enqueue --run-id=run_id my_dag -- P1=foo P2=bar
 	dagu enqueue --name my_custom_name my_dag.yaml -- P1=foo P2=bar
+	dagu enqueue --priority high my_dag  # Dequeued before low-priority runs in the same queue
 `,
 			Args: cobra.MinimumNArgs(1),
 		}, enqueueFlags, runEnqueue,
 	)
 }
 
-var enqueueFlags = []commandLineFlag{paramsFlag, nameFlag, dagRunIDFlag, queueFlag, labelsFlag, tagsFlag, defaultWorkingDirFlag, triggerTypeFlag, scheduleTimeFlag}
+var enqueueFlags = []commandLineFlag{paramsFlag, paramFileFlag, nameFlag, dagRunIDFlag, queueFlag, priorityFlag, labelsFlag, tagsFlag, defaultWorkingDirFlag, triggerTypeFlag, scheduleTimeFlag}
 
 func runEnqueue(ctx *Context, args []string) error {
 	if ctx.IsRemote() {
@@ -60,6 +61,11 @@ func runEnqueue(ctx *Context, args []string) error {
 		return fmt.Errorf("failed to get queue override: %w", err)
 	}
 
+	priorityOverride, err := ctx.StringParam("priority")
+	if err != nil {
+		return fmt.Errorf("failed to get priority: %w", err)
+	}
+
 	dag, _, err := loadDAGWithParams(ctx, args, false)
 	if err != nil {
 		return err
@@ -69,6 +75,14 @@ func runEnqueue(ctx *Context, args []string) error {
 		dag.Queue = queueOverride
 	}
 
+	if priorityOverride != "" {
+		priority, err := core.ParseQueuePriority(priorityOverride)
+		if err != nil {
+			return fmt.Errorf("invalid --priority: %w", err)
+		}
+		dag.QueuePriority = priority
+	}
+
 	if err := parseAndAppendLabels(ctx, dag); err != nil {
 		return err
 	}
@@ -150,7 +164,7 @@ func enqueueDAGRun(ctx *Context, dag *core.DAG, dagRunID string, triggerType cor
 			tag.Error(closeErr))
 	}
 
-	if err := ctx.QueueStore.Enqueue(ctx.Context, dag.ProcGroup(), exec.QueuePriorityLow, dagRun); err != nil {
+	if err := ctx.QueueStore.Enqueue(ctx.Context, dag.ProcGroup(), exec.QueuePriorityForDAG(dag), dagRun); err != nil {
 		if closeErr != nil {
 			return errors.Join(
 				fmt.Errorf("failed to close run: %w", closeErr),
@@ -71,3 +71,41 @@ func TestEnqueueCommand_RequiresDAGDefinition(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "requires at least 1 arg")
 }
+
+func TestEnqueueCommand_Priority(t *testing.T) {
+	t.Parallel()
+
+	th := test.SetupCommand(t)
+
+	dag := th.DAG(t, `queue: batch
+steps:
+  - name: "1"
+    command: "true"
+`)
+	urgent := th.DAG(t, `queue: batch
+queue_priority: high
+steps:
+  - name: "1"
+    command: "true"
+`)
+
+	th.RunCommand(t, cmd.Enqueue(), test.CmdTest{Args: []string{"enqueue", "--run-id", "low-run", dag.Location}})
+	th.RunCommand(t, cmd.Enqueue(), test.CmdTest{Args: []string{"enqueue", "--run-id", "flag-run", "--priority", "high", dag.Location}})
+	th.RunCommand(t, cmd.Enqueue(), test.CmdTest{Args: []string{"enqueue", "--run-id", "field-run", urgent.Location}})
+
+	var order []string
+	for range 3 {
+		item, err := th.QueueStore.DequeueByName(th.Context, "batch")
+		require.NoError(t, err)
+		data, err := item.Data()
+		require.NoError(t, err)
+		order = append(order, data.ID)
+	}
+	require.Equal(t, []string{"flag-run", "field-run", "low-run"}, order)
+
+	err := th.RunCommandWithError(t, cmd.Enqueue(), test.CmdTest{
+		Args: []string{"enqueue", "--priority", "urgent", dag.Location},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid --priority")
+}
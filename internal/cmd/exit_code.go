@@ -0,0 +1,110 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dagucloud/dagu/internal/core"
+)
+
+// Process exit codes for commands that accept --success-on (currently start),
+// derived from the final DAG-run status:
+//
+//	0  succeeded (also partially succeeded with --success-on partial)
+//	1  failed or rejected, or the command itself failed
+//	2  partially succeeded
+//
+// An aborted run, e.g. stopped by `dagu stop` or replaced by `dagu restart`,
+// is not a failure by itself.
+const (
+	exitCodeFailure          = 1
+	exitCodePartialSucceeded = 2
+)
+
+// ExitCodeError is an error that carries the exit code the process should
+// terminate with.
+type ExitCodeError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitCodeError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitCodeError) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode returns the process exit code for an error returned by a command.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *ExitCodeError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+	return exitCodeFailure
+}
+
+// successOnFlag lets callers treat a partially succeeded DAG-run as success.
+var successOnFlag = commandLineFlag{
+	name:  "success-on",
+	usage: "Exit with code 0 for additional final statuses (supported: partial)",
+}
+
+// hasSuccessOnFlag reports whether the command defines --success-on, and so
+// exits based on the final DAG-run status.
+func hasSuccessOnFlag(ctx *Context) bool {
+	return ctx.Command.Flags().Lookup(successOnFlag.name) != nil
+}
+
+// successOnPartialParam reports whether --success-on partial was given. It is
+// false for commands that do not define the flag.
+func successOnPartialParam(ctx *Context) (bool, error) {
+	if !hasSuccessOnFlag(ctx) {
+		return false, nil
+	}
+	value, err := ctx.StringParam(successOnFlag.name)
+	if err != nil {
+		return false, fmt.Errorf("failed to get success-on: %w", err)
+	}
+	switch value {
+	case "":
+		return false, nil
+	case "partial":
+		return true, nil
+	default:
+		return false, fmt.Errorf("invalid --success-on value %q: must be 'partial'", value)
+	}
+}
+
+// dagRunExitError maps the final status of a DAG-run, together with the error
+// returned by the run, to the error of the command. It returns nil when the
+// run counts as successful. Aborted runs and runs that are still active (e.g.
+// waiting for approval) are not failures by themselves.
+func dagRunExitError(status core.Status, successOnPartial bool, runErr error) error {
+	switch {
+	case status == core.PartiallySucceeded && successOnPartial:
+		return nil
+	case status == core.PartiallySucceeded:
+		if runErr == nil {
+			runErr = fmt.Errorf("DAG run finished with status: %s", status)
+		}
+		return &ExitCodeError{Code: exitCodePartialSucceeded, Err: runErr}
+	case status == core.Succeeded || status == core.Aborted || status.IsActive():
+		if runErr == nil {
+			return nil
+		}
+		return &ExitCodeError{Code: exitCodeFailure, Err: runErr}
+	default:
+		if runErr == nil {
+			runErr = fmt.Errorf("DAG run finished with status: %s", status)
+		}
+		return &ExitCodeError{Code: exitCodeFailure, Err: runErr}
+	}
+}
@@ -84,6 +84,11 @@ var (
 		usage:     "Parameters to pass to the dag-run (overrides DAG defaults; supports positional values and key=value pairs, e.g., P1=foo P2=bar)",
 	}
 
+	paramFileFlag = commandLineFlag{
+		name:  "param-file",
+		usage: "Path to a YAML or JSON file mapping parameter names to values (--params and values after -- take precedence)",
+	}
+
 	nameFlag = commandLineFlag{
 		name:      "name",
 		shorthand: "N",
@@ -96,6 +101,11 @@ var (
 		usage:     "Override the DAG-level queue definition",
 	}
 
+	priorityFlag = commandLineFlag{
+		name:  "priority",
+		usage: "Queue priority for the dag-run: high or low (overrides the DAG-level queue_priority)",
+	}
+
 	labelsFlag = commandLineFlag{
 		name:  "labels",
 		usage: "Additional labels (comma-separated key=value or key-only, e.g., workspace=foo,env=prod)",
@@ -151,15 +161,53 @@ var (
 		usage:     "Sub dag-run ID for checking the status of a nested dag-run (requires --run-id)",
 	}
 
+	statusSinceFlag = commandLineFlag{
+		name:  "since",
+		usage: "List DAG-runs started at or after this date/time in the server timezone (format: 2006-01-02 or 2006-01-02T15:04:05)",
+	}
+
+	statusUntilFlag = commandLineFlag{
+		name:  "until",
+		usage: "List DAG-runs started at or before this date/time in the server timezone; a date alone includes the whole day (format: 2006-01-02 or 2006-01-02T15:04:05)",
+	}
+
+	statusJSONFlag = commandLineFlag{
+		name:   "json",
+		usage:  "Print the status as a JSON object for scripting",
+		isBool: true,
+	}
+
+	statusTailFlag = commandLineFlag{
+		name:   "tail",
+		usage:  "Follow the step logs of the DAG-run until it finishes",
+		isBool: true,
+	}
+
 	dagRunFlagDequeue = commandLineFlag{
 		name:      "dag-run",
 		shorthand: "d",
 		usage:     "<DAG-name>:<run-id> to dequeue a dag-run",
 	}
 
+	dequeueAllFlag = commandLineFlag{
+		name:   "all",
+		usage:  "Dequeue all queued dag-runs of the DAG named by the argument",
+		isBool: true,
+	}
+
+	dequeueTagFlag = commandLineFlag{
+		name:  "tag",
+		usage: "Dequeue all dag-runs in the queue whose DAG has the given tag (e.g., daily or env=prod)",
+	}
+
 	stepNameForRetry = commandLineFlag{
 		name:  "step",
-		usage: "Retry only the specified step (optional)",
+		usage: "Retry only the specified step, by name or ID (optional)",
+	}
+	downstreamForRetry = commandLineFlag{
+		name:   "downstream",
+		usage:  "With --step, also retry every step that depends on the step",
+		isBool: true,
 	}
 )
 
@@ -237,6 +285,12 @@ var (
 		bindViper: true,
 	}
 
+	workerTagsFlag = commandLineFlag{
+		name:      "worker.tags",
+		usage:     "Only run DAGs that have one of these tags (format: tag1,tag2)",
+		bindViper: true,
+	}
+
 	workerHealthPortFlag = commandLineFlag{
 		name:         "worker.health-port",
 		defaultValue: "8092",
@@ -313,6 +367,32 @@ var (
 	}
 )
 
+// Validate flags
+var (
+	emitSchemaFlag = commandLineFlag{
+		name:   "emit-schema",
+		usage:  "Print the JSON Schema for DAG definitions instead of validating a file",
+		isBool: true,
+	}
+
+	strictFlag = commandLineFlag{
+		name:   "strict",
+		usage:  "Treat build warnings (e.g. deprecated fields) as validation errors",
+		isBool: true,
+	}
+
+	checkPathsFlag = commandLineFlag{
+		name:   "check-paths",
+		usage:  "Fail validation when an absolute working_dir does not exist on this host",
+		isBool: true,
+	}
+
+	graphFlag = commandLineFlag{
+		name:  "graph",
+		usage: "Print the step graph of a valid DAG in the given format (dot or mermaid)",
+	}
+)
+
 // Tunnel flags
 var (
 	tunnelFlag = commandLineFlag{
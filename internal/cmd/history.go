@@ -224,7 +224,7 @@ func buildDateRangeOptions(ctx *Context) ([]exec.ListDAGRunStatusesOption, error
 	var err error
 
 	if fromDate != "" {
-		fromTime, err = parseAbsoluteDateTime(fromDate)
+		fromTime, err = parseAbsoluteDateTime(fromDate, time.UTC)
 		if err != nil {
 			return nil, fmt.Errorf("invalid --from date '%s': %w. Expected format: 2006-01-02 or 2006-01-02T15:04:05Z", fromDate, err)
 		}
@@ -236,7 +236,7 @@ func buildDateRangeOptions(ctx *Context) ([]exec.ListDAGRunStatusesOption, error
 	}
 
 	if toDate != "" {
-		toTime, err = parseAbsoluteDateTime(toDate)
+		toTime, err = parseAbsoluteDateTime(toDate, time.UTC)
 		if err != nil {
 			return nil, fmt.Errorf("invalid --to date '%s': %w. Expected format: 2006-01-02 or 2006-01-02T15:04:05Z", toDate, err)
 		}
@@ -362,18 +362,19 @@ func parseRelativeDuration(s string) (time.Duration, error) {
 	return 0, errors.New(expectedFormat)
 }
 
-// parseAbsoluteDateTime parses absolute date/time strings in UTC.
-// Supported formats: "2006-01-02" (midnight UTC) and "2006-01-02T15:04:05Z" (RFC3339).
-func parseAbsoluteDateTime(s string) (time.Time, error) {
+// parseAbsoluteDateTime parses absolute date/time strings and returns them in UTC.
+// Supported formats: "2006-01-02" (midnight), "2006-01-02T15:04:05", and
+// RFC3339. Values without an explicit offset are interpreted in loc.
+func parseAbsoluteDateTime(s string, loc *time.Location) (time.Time, error) {
 	// Define supported formats in order of preference
 	formats := []string{
 		time.RFC3339,
 		"2006-01-02T15:04:05",
-		"2006-01-02",
+		time.DateOnly,
 	}
 
 	for _, format := range formats {
-		if t, err := time.Parse(format, s); err == nil {
+		if t, err := time.ParseInLocation(format, s, loc); err == nil {
 			// Ensure UTC timezone for consistency
 			return t.In(time.UTC), nil
 		}
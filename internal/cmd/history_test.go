@@ -163,7 +163,7 @@ func TestParseAbsoluteDateTime(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := parseAbsoluteDateTime(tt.input)
+			got, err := parseAbsoluteDateTime(tt.input, time.UTC)
 			if tt.wantErr {
 				assert.Error(t, err)
 				return
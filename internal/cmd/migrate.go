@@ -21,10 +21,12 @@ func Migrate() *cobra.Command {
 		Long: `Migrate various types of legacy data to new formats.
 
 Available subcommands:
-  history - Migrate DAG run history from v1.16 format to v1.17+ format`,
+  history - Migrate DAG run history from v1.16 format to v1.17+ format
+  dags    - Rewrite deprecated keys in DAG definitions (run: -> call:, cancel: -> abort:)`,
 	}
 
 	cmd.AddCommand(MigrateHistoryCommand())
+	cmd.AddCommand(MigrateDAGsCommand())
 	return cmd
 }
 
@@ -0,0 +1,253 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/dagucloud/dagu/internal/cmn/logger"
+	"github.com/dagucloud/dagu/internal/cmn/logger/tag"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// MigrateDAGsCommand creates a command to rewrite deprecated keys in DAG files
+func MigrateDAGsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dags [DAG file...]",
+		Short: "Rewrite deprecated keys in DAG definitions",
+		Long: `Rewrite deprecated keys in DAG definitions to their current form.
+
+This command will:
+- Rename the step-level run: key to call: in steps and handler_on handlers
+- Rename the removed handler_on cancel: handler to abort:
+- Edit files in place, changing only the renamed keys
+- Leave files without deprecated keys untouched, so it is safe to run repeatedly
+
+Without arguments, every YAML file in the DAGs directory is migrated.
+
+Example:
+  dagu migrate dags
+  dagu migrate dags my_dag.yaml`,
+	}
+
+	return NewCommand(cmd, nil, runDAGMigration)
+}
+
+func runDAGMigration(ctx *Context, args []string) error {
+	files := args
+	if len(files) == 0 {
+		for _, pattern := range []string{"*.yaml", "*.yml"} {
+			matches, err := filepath.Glob(filepath.Join(ctx.Config.Paths.DAGsDir, pattern))
+			if err != nil {
+				return fmt.Errorf("failed to list DAG files: %w", err)
+			}
+			files = append(files, matches...)
+		}
+		slices.Sort(files)
+	}
+
+	var migrated, failed int
+	for _, file := range files {
+		changed, err := migrateDAGFile(file)
+		if err != nil {
+			failed++
+			logger.Error(ctx.Context, "Failed to migrate DAG file", tag.File(file), tag.Error(err))
+			continue
+		}
+		if changed {
+			migrated++
+			logger.Info(ctx.Context, "Migrated DAG file", tag.File(file))
+		}
+	}
+
+	logger.Info(ctx.Context, "DAG migration completed",
+		slog.Int("total", len(files)),
+		slog.Int("migrated", migrated),
+		slog.Int("failed", failed),
+	)
+	if failed > 0 {
+		return fmt.Errorf("failed to migrate %d DAG file(s)", failed)
+	}
+	return nil
+}
+
+// migrateDAGFile rewrites deprecated keys in a DAG file and reports whether
+// the file was changed.
+func migrateDAGFile(file string) (bool, error) {
+	info, err := os.Stat(file)
+	if err != nil {
+		return false, err
+	}
+	data, err := os.ReadFile(file) //nolint:gosec
+	if err != nil {
+		return false, err
+	}
+	migrated, err := migrateDAGKeys(data)
+	if err != nil {
+		return false, err
+	}
+	if bytes.Equal(migrated, data) {
+		return false, nil
+	}
+	if err := os.WriteFile(file, migrated, info.Mode().Perm()); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// keyRename is a deprecated key found in a DAG file and its replacement.
+type keyRename struct {
+	key *yaml.Node
+	to  string
+}
+
+// migrateDAGKeys renames the deprecated step-level run key to call and the
+// handler_on cancel key to abort. The keys are located with the YAML parser
+// but replaced in the original text, so comments, quoting and indentation
+// are preserved. Steps that already define call and handler_on mappings that
+// already define abort are left for validation to report.
+func migrateDAGKeys(data []byte) ([]byte, error) {
+	var renames []keyRename
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var doc yaml.Node
+		if err := dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to parse YAML: %w", err)
+		}
+		if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+			continue
+		}
+		root := doc.Content[0]
+		for i := 0; i+1 < len(root.Content); i += 2 {
+			switch root.Content[i].Value {
+			case "steps":
+				renames = appendCallRenames(renames, deprecatedRunKeysInSteps(root.Content[i+1]))
+			case "handler_on":
+				handlers := root.Content[i+1]
+				if handlers.Kind != yaml.MappingNode {
+					continue
+				}
+				for j := 1; j < len(handlers.Content); j += 2 {
+					renames = appendCallRenames(renames, deprecatedRunKeysInHandler(handlers.Content[j]))
+				}
+				if key := deprecatedCancelKey(handlers); key != nil {
+					renames = append(renames, keyRename{key: key, to: "abort"})
+				}
+			}
+		}
+	}
+	if len(renames) == 0 {
+		return data, nil
+	}
+
+	lines := bytes.SplitAfter(data, []byte("\n"))
+	for _, rename := range renames {
+		key := rename.key
+		if key.Line < 1 || key.Line > len(lines) {
+			continue
+		}
+		line := lines[key.Line-1]
+		offset := byteOffsetOfColumn(line, key.Column)
+		if offset < 0 || !bytes.HasPrefix(line[offset:], []byte(key.Value)) {
+			continue
+		}
+		lines[key.Line-1] = slices.Concat(line[:offset], []byte(rename.to), line[offset+len(key.Value):])
+	}
+	return bytes.Join(lines, nil), nil
+}
+
+// appendCallRenames records run keys to be renamed to call.
+func appendCallRenames(renames []keyRename, keys []*yaml.Node) []keyRename {
+	for _, key := range keys {
+		renames = append(renames, keyRename{key: key, to: "call"})
+	}
+	return renames
+}
+
+// deprecatedCancelKey returns the cancel key of a handler_on mapping that has
+// no abort key.
+func deprecatedCancelKey(handlers *yaml.Node) *yaml.Node {
+	var cancel *yaml.Node
+	for i := 0; i+1 < len(handlers.Content); i += 2 {
+		switch handlers.Content[i].Value {
+		case "cancel":
+			cancel = handlers.Content[i]
+		case "abort":
+			return nil
+		}
+	}
+	return cancel
+}
+
+// deprecatedRunKeysInSteps returns the run keys of steps written as a list
+// or as a map of step names to steps.
+func deprecatedRunKeysInSteps(steps *yaml.Node) []*yaml.Node {
+	if steps.Kind != yaml.MappingNode {
+		return deprecatedRunKeysInHandler(steps)
+	}
+	var keys []*yaml.Node
+	for i := 1; i < len(steps.Content); i += 2 {
+		keys = append(keys, deprecatedRunKeysInHandler(steps.Content[i])...)
+	}
+	return keys
+}
+
+// deprecatedRunKeysInHandler returns the run keys of a single step or a list
+// of steps, as used by handlers and list-form steps.
+func deprecatedRunKeysInHandler(handler *yaml.Node) []*yaml.Node {
+	switch handler.Kind {
+	case yaml.MappingNode:
+		if key := deprecatedRunKey(handler); key != nil {
+			return []*yaml.Node{key}
+		}
+	case yaml.SequenceNode:
+		var keys []*yaml.Node
+		for _, item := range handler.Content {
+			keys = append(keys, deprecatedRunKeysInHandler(item)...)
+		}
+		return keys
+	}
+	return nil
+}
+
+// deprecatedRunKey returns the run key of a step mapping that has no call key.
+func deprecatedRunKey(step *yaml.Node) *yaml.Node {
+	var run *yaml.Node
+	for i := 0; i+1 < len(step.Content); i += 2 {
+		switch step.Content[i].Value {
+		case "run":
+			if step.Content[i+1].Kind == yaml.ScalarNode {
+				run = step.Content[i]
+			}
+		case "call":
+			return nil
+		}
+	}
+	return run
+}
+
+// byteOffsetOfColumn converts a 1-based rune column into a byte offset.
+func byteOffsetOfColumn(line []byte, column int) int {
+	offset := 0
+	for col := 1; col < column; col++ {
+		if offset >= len(line) {
+			return -1
+		}
+		_, size := utf8.DecodeRune(line[offset:])
+		offset += size
+	}
+	return offset
+}
@@ -8,12 +8,14 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/dagucloud/dagu/internal/cmn/config"
 	"github.com/dagucloud/dagu/internal/core"
 	"github.com/dagucloud/dagu/internal/core/exec"
+	"github.com/dagucloud/dagu/internal/core/spec"
 	"github.com/dagucloud/dagu/internal/persis/filedagrun"
 	legacymodel "github.com/dagucloud/dagu/internal/persis/legacy/model"
 	"github.com/spf13/cobra"
@@ -161,7 +163,83 @@ func TestCmdMigrate(t *testing.T) {
 	assert.Equal(t, "migrate", cmd.Use)
 	assert.True(t, cmd.HasSubCommands())
 
-	// Check for history subcommand
-	historyCmd := cmd.Commands()[0]
-	assert.Equal(t, "history", historyCmd.Use)
+	// Check for history and dags subcommands
+	var names []string
+	for _, sub := range cmd.Commands() {
+		names = append(names, sub.Name())
+	}
+	assert.ElementsMatch(t, []string{"history", "dags"}, names)
+}
+
+func TestMigrateDAGsCommand(t *testing.T) {
+	dagsDir := t.TempDir()
+	ctx := &Context{
+		Context: context.Background(),
+		Command: &cobra.Command{},
+		Config:  &config.Config{Paths: config.PathsConfig{DAGsDir: dagsDir}},
+	}
+
+	legacy := `# parent workflow
+type: graph
+steps:
+  - name: child
+    run: sub_dag # call the child DAG
+    params: "FOO=bar"
+  - name: after
+    command: echo done
+    depends: child
+handler_on:
+  failure:
+    run: notify
+  cancel:
+    command: echo cancelled
+---
+name: sub_dag
+steps:
+  - echo child
+---
+name: notify
+steps:
+  - echo notify
+`
+	legacyPath := filepath.Join(dagsDir, "legacy.yaml")
+	require.NoError(t, os.WriteFile(legacyPath, []byte(legacy), 0600))
+
+	current := "steps:\n  - name: run\n    command: echo run\n"
+	currentPath := filepath.Join(dagsDir, "current.yml")
+	require.NoError(t, os.WriteFile(currentPath, []byte(current), 0600))
+
+	t.Run("RewritesRunToCall", func(t *testing.T) {
+		require.NoError(t, runDAGMigration(ctx, nil))
+
+		data, err := os.ReadFile(legacyPath)
+		require.NoError(t, err)
+		want := strings.ReplaceAll(legacy, "run: ", "call: ")
+		want = strings.ReplaceAll(want, "  cancel:", "  abort:")
+		assert.Equal(t, want, string(data))
+
+		dag, err := spec.Load(context.Background(), legacyPath, spec.WithoutEval())
+		require.NoError(t, err)
+		assert.Empty(t, dag.BuildWarnings)
+		assert.Equal(t, "sub_dag", dag.Steps[0].SubDAG.Name)
+		assert.Equal(t, "notify", dag.HandlerOn.Failure[0].SubDAG.Name)
+		require.Len(t, dag.HandlerOn.Abort, 1)
+	})
+
+	t.Run("SkipsFilesWithoutDeprecatedKeys", func(t *testing.T) {
+		data, err := os.ReadFile(currentPath)
+		require.NoError(t, err)
+		assert.Equal(t, current, string(data))
+	})
+
+	t.Run("Idempotent", func(t *testing.T) {
+		before, err := os.ReadFile(legacyPath)
+		require.NoError(t, err)
+		changed, err := migrateDAGFile(legacyPath)
+		require.NoError(t, err)
+		assert.False(t, changed)
+		after, err := os.ReadFile(legacyPath)
+		require.NoError(t, err)
+		assert.Equal(t, before, after)
+	})
 }
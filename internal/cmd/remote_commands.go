@@ -131,7 +131,7 @@ func mapAPIStep(step api.Step) core.Step {
 }
 
 func validateRemoteStartLikeFlags(ctx *Context) error {
-	disallowed := []string{"parent", "root", "worker-id", "attempt-id", "schedule-time"}
+	disallowed := []string{"parent", "root", "worker-id", "attempt-id", "schedule-time", "param-file"}
 	for _, flag := range disallowed {
 		if ctx.Command.Flags().Changed(flag) {
 			return fmt.Errorf("--%s is only supported in the local context", flag)
@@ -245,6 +245,9 @@ func remoteRunEnqueue(ctx *Context, args []string) error {
 		}
 	}
 	queueOverride, _ := ctx.StringParam("queue")
+	if priority, _ := ctx.StringParam("priority"); priority != "" {
+		return fmt.Errorf("--priority is not supported for remote contexts")
+	}
 	labels, err := remoteLabelsFromFlag(ctx)
 	if err != nil {
 		return err
@@ -268,6 +271,11 @@ func remoteRunStatus(ctx *Context, args []string) error {
 	if subRunID != "" {
 		return fmt.Errorf("--sub-run-id is not supported for remote contexts")
 	}
+	since, _ := ctx.StringParam("since")
+	until, _ := ctx.StringParam("until")
+	if since != "" || until != "" {
+		return fmt.Errorf("--since and --until are not supported for remote contexts")
+	}
 	dag, err := remoteResolveDAG(ctx, args[0])
 	if err != nil {
 		return err
@@ -285,8 +293,7 @@ func remoteRunStatus(ctx *Context, args []string) error {
 	if err != nil {
 		return err
 	}
-	displayTreeStatus(coreDAG, status)
-	return nil
+	return printStatus(ctx, coreDAG, status)
 }
 
 func remoteRunHistory(ctx *Context, args []string) error {
@@ -405,6 +412,11 @@ func remoteRunRestart(ctx *Context, args []string) error {
 
 func remoteRunDequeue(ctx *Context, args []string) error {
 	queueName := args[0]
+	all, _ := ctx.Command.Flags().GetBool("all")
+	tagFilter, _ := ctx.StringParam("tag")
+	if all || tagFilter != "" {
+		return fmt.Errorf("--all and --tag are not supported for remote contexts")
+	}
 	dagRunRef, _ := ctx.StringParam("dag-run")
 	if dagRunRef != "" {
 		ref, err := exec.ParseDAGRunRef(dagRunRef)
@@ -466,7 +478,7 @@ func buildRemoteHistoryQuery(ctx *Context, args []string) (remoteHistoryQuery, i
 		query.From = &from
 	}
 	if fromDate != "" {
-		t, err := parseAbsoluteDateTime(fromDate)
+		t, err := parseAbsoluteDateTime(fromDate, time.UTC)
 		if err != nil {
 			return query, 0, err
 		}
@@ -474,7 +486,7 @@ func buildRemoteHistoryQuery(ctx *Context, args []string) (remoteHistoryQuery, i
 		query.From = &from
 	}
 	if toDate != "" {
-		t, err := parseAbsoluteDateTime(toDate)
+		t, err := parseAbsoluteDateTime(toDate, time.UTC)
 		if err != nil {
 			return query, 0, err
 		}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/dagucloud/dagu/internal/cmn/fileutil"
@@ -29,11 +30,14 @@ func Retry() *cobra.Command {
 
 Flags:
   --run-id string (required) Unique identifier of the DAG-run to retry.
-  --step string (optional) Retry only the specified step.
+  --step string (optional) Retry only the specified step, by name or ID.
+  --downstream (optional) With --step, also retry every step that depends on it.
 
 Examples:
   dagu retry --run-id=abc123 my_dag
   dagu retry --run-id=abc123 my_dag.yaml
+  dagu retry --run-id=abc123 --step=build my_dag
+  dagu retry --run-id=abc123 --step=build --downstream my_dag
 `,
 			Args: cobra.ExactArgs(1),
 		}, retryFlags, runRetry,
@@ -43,6 +47,7 @@ Examples:
 var retryFlags = []commandLineFlag{
 	dagRunIDFlagRetry,
 	stepNameForRetry,
+	downstreamForRetry,
 	rootDAGRunFlag,
 	defaultWorkingDirFlag,
 	retryWorkerIDFlag,
@@ -57,6 +62,7 @@ var retryWorkerIDFlag = commandLineFlag{
 func runRetry(ctx *Context, args []string) error {
 	if ctx.IsRemote() {
 		for _, flag := range []commandLineFlag{
+			downstreamForRetry,
 			rootDAGRunFlag,
 			defaultWorkingDirFlag,
 			retryWorkerIDFlag,
@@ -70,6 +76,10 @@ func runRetry(ctx *Context, args []string) error {
 	}
 	dagRunID, _ := ctx.StringParam("run-id")
 	stepName, _ := ctx.StringParam("step")
+	downstream, _ := ctx.Command.Flags().GetBool(downstreamForRetry.name)
+	if downstream && stepName == "" {
+		return fmt.Errorf("--%s requires --step", downstreamForRetry.name)
+	}
 	rootRefStr, _ := ctx.StringParam("root")
 	workerID := getWorkerID(ctx)
 	attemptID, err := requireWorkerAttemptID(ctx, workerID)
@@ -131,6 +141,13 @@ func runRetry(ctx *Context, args []string) error {
 		return fmt.Errorf("failed to restore DAG from status: %w", err)
 	}
 
+	if stepName != "" {
+		stepName, err = resolveRetryStep(dag, stepName)
+		if err != nil {
+			return err
+		}
+	}
+
 	if err := prepareQueuedCatchupRetry(ctx, attempt, dag, status); err != nil {
 		return err
 	}
@@ -182,13 +199,13 @@ func runRetry(ctx *Context, args []string) error {
 				return ctx.DAGRunStore.CreateAttempt(execCtx, dag, time.Now(), dagRunID, opts)
 			},
 			func(preparedAttempt exec.DAGRunAttempt) error {
-				return executeRetry(ctx, dag, status, rootRun, stepName, workerID, attemptID, preparedAttempt)
+				return executeRetry(ctx, dag, status, rootRun, retryStep{name: stepName, downstream: downstream}, workerID, attemptID, preparedAttempt)
 			},
 		)
 	}
 
 	if ctx.DAGRunStore == nil {
-		return executeRetry(ctx, dag, status, rootRun, stepName, workerID, attemptID, nil)
+		return executeRetry(ctx, dag, status, rootRun, retryStep{name: stepName, downstream: downstream}, workerID, attemptID, nil)
 	}
 
 	if err := validateWorkerAttemptBinding(dagRunID, attemptID, attempt, status); err != nil {
@@ -212,7 +229,7 @@ func runRetry(ctx *Context, args []string) error {
 			return attempt, nil
 		},
 		func(preparedAttempt exec.DAGRunAttempt) error {
-			return executeRetry(ctx, dag, status, rootRun, stepName, workerID, attemptID, preparedAttempt)
+			return executeRetry(ctx, dag, status, rootRun, retryStep{name: stepName, downstream: downstream}, workerID, attemptID, preparedAttempt)
 		},
 	)
 }
@@ -334,11 +351,36 @@ func prepareQueuedCatchupRetry(ctx *Context, attempt exec.DAGRunAttempt, dag *co
 	return nil
 }
 
+// resolveRetryStep resolves the --step value, given as a step name or ID, to
+// the name of a step in dag.
+func resolveRetryStep(dag *core.DAG, ref string) (string, error) {
+	if name, ok := dag.ResolveStepName(ref); ok {
+		return name, nil
+	}
+	valid := make([]string, 0, len(dag.Steps))
+	for _, step := range dag.Steps {
+		if step.ID != "" {
+			valid = append(valid, fmt.Sprintf("%s (id: %s)", step.Name, step.ID))
+			continue
+		}
+		valid = append(valid, step.Name)
+	}
+	return "", fmt.Errorf("step %q not found in DAG %q; valid steps: %s", ref, dag.Name, strings.Join(valid, ", "))
+}
+
+// retryStep selects the step a retry is limited to. An empty name retries
+// every failed step.
+type retryStep struct {
+	name string
+	// downstream also retries every step that depends on the named step.
+	downstream bool
+}
+
 // executeRetry runs a retry of a DAG run using the original run's log file.
 // Queued catchup runs reuse this path but preserve their catchup trigger type.
-func executeRetry(ctx *Context, dag *core.DAG, status *exec.DAGRunStatus, rootRun exec.DAGRunRef, stepName, workerID, attemptID string, preparedAttempt exec.DAGRunAttempt) error {
-	if stepName != "" {
-		ctx.Context = logger.WithValues(ctx.Context, tag.Step(stepName))
+func executeRetry(ctx *Context, dag *core.DAG, status *exec.DAGRunStatus, rootRun exec.DAGRunRef, step retryStep, workerID, attemptID string, preparedAttempt exec.DAGRunAttempt) error {
+	if step.name != "" {
+		ctx.Context = logger.WithValues(ctx.Context, tag.Step(step.name))
 	}
 	logger.Debug(ctx, "Executing dag-run retry")
 
@@ -386,7 +428,8 @@ func executeRetry(ctx *Context, dag *core.DAG, status *exec.DAGRunStatus, rootRu
 			RetryTarget:                status,
 			ParentDAGRun:               status.Parent,
 			ProgressDisplay:            shouldEnableProgress(ctx),
-			StepRetry:                  stepName,
+			StepRetry:                  step.name,
+			StepRetryDownstream:        step.downstream,
 			WorkerID:                   workerID,
 			AttemptID:                  attemptID,
 			PreparedAttempt:            preparedAttempt,
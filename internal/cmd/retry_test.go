@@ -23,6 +23,38 @@ import (
 )
 
 func TestRetryCommand(t *testing.T) {
+	t.Run("PartialSuccessKeepsExitCode", func(t *testing.T) {
+		t.Parallel()
+
+		th := test.SetupCommand(t)
+
+		dagFile := th.DAG(t, `steps:
+  - name: fail
+    command: exit 1
+    continue_on:
+      failure: true
+  - name: after
+    command: "true"
+`)
+
+		args := []string{"start", "--success-on", "partial", dagFile.Location}
+		th.RunCommand(t, cmd.Start(), test.CmdTest{Args: args})
+
+		dag, err := th.DAGStore.GetMetadata(context.Background(), dagFile.Location)
+		require.NoError(t, err)
+		dagRunStatus, err := th.DAGRunMgr.GetLatestStatus(context.Background(), dag)
+		require.NoError(t, err)
+		require.Equal(t, core.PartiallySucceeded, dagRunStatus.Status)
+
+		// Only start derives its exit code from the final status; retry keeps
+		// failing with the run error instead of exiting with 2.
+		args = []string{"retry", fmt.Sprintf("--run-id=%s", dagRunStatus.DAGRunID), dagFile.Location}
+		err = th.RunCommandWithError(t, cmd.Retry(), test.CmdTest{Args: args})
+		require.Error(t, err)
+		require.Equal(t, 1, cmd.ExitCode(err))
+		dagFile.AssertLatestStatus(t, core.PartiallySucceeded)
+	})
+
 	t.Run("RetryDAGWithFilePath", func(t *testing.T) {
 		t.Parallel()
 
@@ -93,6 +125,106 @@ steps:
 		})
 	})
 
+	t.Run("RetryStepByID", func(t *testing.T) {
+		t.Parallel()
+
+		th := test.SetupCommand(t)
+
+		outFile := filepath.Join(t.TempDir(), "runs.txt")
+		dagFile := th.DAG(t, fmt.Sprintf(`steps:
+  - name: first
+    id: first_step
+    command: sh -c "echo first >> %[1]s"
+  - name: second
+    id: second_step
+    command: sh -c "echo second >> %[1]s"
+`, outFile))
+
+		th.RunCommand(t, cmd.Start(), test.CmdTest{Args: []string{"start", dagFile.Location}})
+
+		dagRunStatus, err := th.DAGRunMgr.GetLatestStatus(th.Context, dagFile.DAG)
+		require.NoError(t, err)
+		require.Equal(t, core.Succeeded, dagRunStatus.Status)
+
+		th.RunCommand(t, cmd.Retry(), test.CmdTest{
+			Args: []string{"retry", "--run-id=" + dagRunStatus.DAGRunID, "--step=second_step", dagFile.Location},
+		})
+
+		out, err := os.ReadFile(outFile)
+		require.NoError(t, err)
+		require.Equal(t, "first\nsecond\nsecond\n", string(out))
+	})
+
+	t.Run("RetryStepWithDownstream", func(t *testing.T) {
+		t.Parallel()
+
+		th := test.SetupCommand(t)
+
+		outFile := filepath.Join(t.TempDir(), "runs.txt")
+		dagFile := th.DAG(t, fmt.Sprintf(`steps:
+  - name: first
+    command: sh -c "echo first >> %[1]s"
+  - name: second
+    command: sh -c "echo second >> %[1]s"
+  - name: third
+    command: sh -c "echo third >> %[1]s"
+`, outFile))
+
+		th.RunCommand(t, cmd.Start(), test.CmdTest{Args: []string{"start", dagFile.Location}})
+
+		dagRunStatus, err := th.DAGRunMgr.GetLatestStatus(th.Context, dagFile.DAG)
+		require.NoError(t, err)
+		require.Equal(t, core.Succeeded, dagRunStatus.Status)
+
+		th.RunCommand(t, cmd.Retry(), test.CmdTest{
+			Args: []string{"retry", "--run-id=" + dagRunStatus.DAGRunID, "--step=second", "--downstream", dagFile.Location},
+		})
+
+		out, err := os.ReadFile(outFile)
+		require.NoError(t, err)
+		require.Equal(t, "first\nsecond\nthird\nsecond\nthird\n", string(out))
+	})
+
+	t.Run("DownstreamRequiresStep", func(t *testing.T) {
+		t.Parallel()
+
+		th := test.SetupCommand(t)
+		dagFile := th.DAG(t, `steps:
+  - name: first
+    command: echo first
+`)
+
+		err := th.RunCommandWithError(t, cmd.Retry(), test.CmdTest{
+			Args: []string{"retry", "--run-id=any", "--downstream", dagFile.Location},
+		})
+		require.ErrorContains(t, err, "--downstream requires --step")
+	})
+
+	t.Run("RetryUnknownStepListsValidSteps", func(t *testing.T) {
+		t.Parallel()
+
+		th := test.SetupCommand(t)
+
+		dagFile := th.DAG(t, `steps:
+  - name: first
+    id: first_step
+    command: echo first
+  - name: second
+    command: echo second
+`)
+
+		th.RunCommand(t, cmd.Start(), test.CmdTest{Args: []string{"start", dagFile.Location}})
+
+		dagRunStatus, err := th.DAGRunMgr.GetLatestStatus(th.Context, dagFile.DAG)
+		require.NoError(t, err)
+
+		err = th.RunCommandWithError(t, cmd.Retry(), test.CmdTest{
+			Args: []string{"retry", "--run-id=" + dagRunStatus.DAGRunID, "--step=missing", dagFile.Location},
+		})
+		require.ErrorContains(t, err, `step "missing" not found`)
+		require.ErrorContains(t, err, "valid steps: first (id: first_step), second")
+	})
+
 	t.Run("QueuedCatchupRegeneratesLogAndPreservesTriggerType", func(t *testing.T) {
 		t.Parallel()
 
@@ -50,9 +50,14 @@ instance with a unique DAG-run ID.
 Parameters after the "--" separator are passed as execution parameters (either positional or key=value pairs).
 Flags can override default settings such as DAG-run ID, DAG name, or suppress output.
 
+The exit code reflects the final status of the DAG-run: 0 when it succeeded,
+1 when it failed or was rejected, and 2 when it partially succeeded.
+Use --success-on partial to exit with 0 on partial success.
+
 Examples:
   dagu start my_dag -- P1=foo P2=bar
   dagu start --name my_custom_name my_dag.yaml -- P1=foo P2=bar
+  dagu start --success-on partial my_dag  # Treat partial success as success in CI
 
 This command parses the DAG definition, resolves parameters, and initiates the DAG-run execution.
 `,
@@ -62,7 +67,7 @@ This command parses the DAG definition, resolves parameters, and initiates the D
 }
 
 // Command line flags for the start command
-var startFlags = []commandLineFlag{paramsFlag, nameFlag, dagRunIDFlag, fromRunIDFlag, parentDAGRunFlag, rootDAGRunFlag, labelsFlag, tagsFlag, defaultWorkingDirFlag, startWorkerIDFlag, attemptIDFlag, triggerTypeFlag, scheduleTimeFlag, sourceFileFlag}
+var startFlags = []commandLineFlag{paramsFlag, paramFileFlag, nameFlag, dagRunIDFlag, fromRunIDFlag, parentDAGRunFlag, rootDAGRunFlag, labelsFlag, tagsFlag, defaultWorkingDirFlag, startWorkerIDFlag, attemptIDFlag, triggerTypeFlag, scheduleTimeFlag, sourceFileFlag, successOnFlag}
 
 var fromRunIDFlag = commandLineFlag{
 	name:  "from-run-id",
@@ -98,6 +103,9 @@ func runStart(ctx *Context, args []string) error {
 	if ctx.IsRemote() {
 		return remoteRunStart(ctx, args)
 	}
+	if _, err := successOnPartialParam(ctx); err != nil {
+		return err
+	}
 	fromRunID, err := ctx.StringParam("from-run-id")
 	if err != nil {
 		return fmt.Errorf("failed to get from-run-id: %w", err)
@@ -137,7 +145,7 @@ func runStart(ctx *Context, args []string) error {
 		if len(args) == 0 {
 			return fmt.Errorf("DAG name or file must be provided when using --from-run-id")
 		}
-		if len(args) > 1 || ctx.Command.Flags().Changed("params") || ctx.Command.ArgsLenAtDash() != -1 {
+		if len(args) > 1 || ctx.Command.Flags().Changed("params") || ctx.Command.Flags().Changed("param-file") || ctx.Command.ArgsLenAtDash() != -1 {
 			return fmt.Errorf("parameters cannot be provided when using --from-run-id")
 		}
 
@@ -303,6 +311,7 @@ func loadDAGWithParams(ctx *Context, args []string, isSubDAGRun bool) (*core.DAG
 		spec.WithBaseConfig(ctx.Config.Paths.BaseConfig),
 		spec.WithWorkspaceBaseConfigDir(workspace.BaseConfigDir(ctx.Config.Paths.DAGsDir)),
 		spec.WithDAGsDir(ctx.Config.Paths.DAGsDir),
+		spec.WithRemoteSchemaCacheTTL(ctx.Config.Core.ParamsSchemaCacheTTL),
 	}
 
 	if isSubDAGRun {
@@ -334,14 +343,36 @@ func loadDAGWithParams(ctx *Context, args []string, isSubDAGRun bool) (*core.DAG
 
 	var params string
 
+	paramFile, err := ctx.StringParam("param-file")
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get param-file: %w", err)
+	}
+	var fileParams []string
+	if paramFile != "" {
+		fileParams, err = spec.ReadParamsFile(paramFile)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
 	if ctx.Command.ArgsLenAtDash() != -1 && len(args) > 0 {
-		loadOpts = append(loadOpts, spec.WithParams(args[ctx.Command.ArgsLenAtDash():]))
+		loadOpts = append(loadOpts, spec.WithParams(append(fileParams, args[ctx.Command.ArgsLenAtDash():]...)))
 	} else {
 		params, err = ctx.Command.Flags().GetString("params")
 		if err != nil {
 			return nil, "", fmt.Errorf("failed to get parameters: %w", err)
 		}
-		loadOpts = append(loadOpts, spec.WithParams(stringutil.RemoveQuotes(params)))
+		params = stringutil.RemoveQuotes(params)
+		if paramFile != "" {
+			// File values come first so that --params overrides them.
+			list := fileParams
+			if params != "" {
+				list = append(list, params)
+			}
+			loadOpts = append(loadOpts, spec.WithParams(list))
+		} else {
+			loadOpts = append(loadOpts, spec.WithParams(params))
+		}
 	}
 
 	dag, err := spec.Load(ctx, dagPath, loadOpts...)
@@ -472,7 +503,7 @@ func handleSubDAGRun(ctx *Context, dag *core.DAG, dagRunID string, params string
 			})
 		},
 		func(preparedAttempt exec.DAGRunAttempt) error {
-			return executeRetry(ctx, dag, status, root, "", workerID, attemptID, preparedAttempt)
+			return executeRetry(ctx, dag, status, root, retryStep{}, workerID, attemptID, preparedAttempt)
 		},
 	)
 }
@@ -567,7 +598,10 @@ func dispatchToCoordinatorAndWait(ctx *Context, d *core.DAG, dagRunID string, sc
 		slog.Any("worker-selector", d.WorkerSelector),
 	)
 
-	var taskOpts []executor.TaskOption
+	taskOpts := []executor.TaskOption{
+		executor.WithDAGTags(d.Labels),
+		executor.WithRequires(d.Requirements()),
+	}
 	if len(d.WorkerSelector) > 0 {
 		taskOpts = append(taskOpts, executor.WithWorkerSelector(d.WorkerSelector))
 	}
@@ -645,6 +679,11 @@ func handleDistributedCancellation(ctx context.Context, dag *core.DAG, dagRunID
 // waitForDAGCompletionWithProgress polls the coordinator until the DAG run completes.
 // Progress display is managed by the caller.
 func waitForDAGCompletionWithProgress(ctx *Context, d *core.DAG, dagRunID string, coordinatorCli coordinator.Client, progress *RemoteProgressDisplay) error {
+	successOnPartial, err := successOnPartialParam(ctx)
+	if err != nil {
+		return err
+	}
+
 	ticker := time.NewTicker(1 * time.Second)
 	defer ticker.Stop()
 
@@ -694,15 +733,16 @@ func waitForDAGCompletionWithProgress(ctx *Context, d *core.DAG, dagRunID string
 				continue
 			}
 			if !dagStatus.Status.IsActive() {
-				if dagStatus.Status.IsSuccess() {
-					logger.Info(ctx, "DAG completed successfully", tag.RunID(dagRunID))
-					return nil
-				}
+				var runErr error
 				// Include error details from response if available
 				if resp.Error != "" {
-					return fmt.Errorf("DAG run failed with status %s: %s", dagStatus.Status, resp.Error)
+					runErr = fmt.Errorf("DAG run failed with status %s: %s", dagStatus.Status, resp.Error)
+				}
+				if err := dagRunExitError(dagStatus.Status, successOnPartial, runErr); err != nil {
+					return err
 				}
-				return fmt.Errorf("DAG run failed with status: %s", dagStatus.Status)
+				logger.Info(ctx, "DAG completed successfully", tag.RunID(dagRunID))
+				return nil
 			}
 		}
 	}
@@ -77,6 +77,75 @@ steps:
 	}
 }
 
+func TestCmdStart_ExitCode(t *testing.T) {
+	t.Parallel()
+
+	const partialDAG = `steps:
+  - name: fail
+    command: exit 1
+    continue_on:
+      failure: true
+  - name: after
+    command: "true"
+`
+	tests := []struct {
+		name       string
+		dag        string
+		flags      []string
+		wantStatus core.Status
+		wantCode   int
+	}{
+		{
+			name:       "Success",
+			dag:        "steps:\n  - name: ok\n    command: \"true\"\n",
+			wantStatus: core.Succeeded,
+			wantCode:   0,
+		},
+		{
+			name:       "Failure",
+			dag:        "steps:\n  - name: fail\n    command: exit 1\n",
+			wantStatus: core.Failed,
+			wantCode:   1,
+		},
+		{
+			name:       "PartialSuccess",
+			dag:        partialDAG,
+			wantStatus: core.PartiallySucceeded,
+			wantCode:   2,
+		},
+		{
+			name:       "PartialSuccessAsSuccess",
+			dag:        partialDAG,
+			flags:      []string{"--success-on", "partial"},
+			wantStatus: core.PartiallySucceeded,
+			wantCode:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			th := test.SetupCommand(t)
+			dagFile := th.DAG(t, tt.dag)
+
+			args := append(append([]string{"start"}, tt.flags...), dagFile.Location)
+			err := th.RunCommandWithError(t, cmd.Start(), test.CmdTest{Args: args})
+
+			require.Equal(t, tt.wantCode, cmd.ExitCode(err), "error: %v", err)
+			dagFile.AssertLatestStatus(t, tt.wantStatus)
+		})
+	}
+
+	t.Run("InvalidSuccessOn", func(t *testing.T) {
+		t.Parallel()
+		th := test.SetupCommand(t)
+		dagFile := th.DAG(t, "steps:\n  - name: ok\n    command: \"true\"\n")
+
+		err := th.RunCommandWithError(t, cmd.Start(), test.CmdTest{Args: []string{"start", "--success-on", "failure", dagFile.Location}})
+		require.ErrorContains(t, err, "invalid --success-on value")
+	})
+}
+
 func TestStartCommand_BuiltExecutablePreservesExplicitEnv(t *testing.T) {
 	t.Parallel()
 
@@ -146,6 +215,43 @@ steps:
 	})
 }
 
+func TestCmdStart_ParamFile(t *testing.T) {
+	t.Parallel()
+
+	th := test.SetupCommand(t)
+	dagFile := th.CreateDAGFile(t, "test-param-file.yaml", `
+params:
+  - KEY1: default1
+  - KEY2: default2
+steps:
+  - name: step1
+    command: echo $KEY1 $KEY2
+`)
+	paramFile := filepath.Join(t.TempDir(), "params.yaml")
+	require.NoError(t, os.WriteFile(paramFile, []byte("KEY1: file1\nKEY2: file2\n"), 0o600))
+
+	err := th.RunCommandWithError(t, cmd.Start(), test.CmdTest{
+		Args: []string{"start", "--param-file", paramFile, "--params", "KEY2=cli", dagFile},
+	})
+	require.NoError(t, err)
+
+	dag, err := spec.Load(th.Context, dagFile)
+	require.NoError(t, err)
+
+	status, err := th.DAGRunMgr.GetLatestStatus(th.Context, dag)
+	require.NoError(t, err)
+	require.Equal(t, core.Succeeded, status.Status)
+	require.Contains(t, status.ParamsList, "KEY1=file1")
+	require.Contains(t, status.ParamsList, "KEY2=cli")
+
+	t.Run("MissingFile", func(t *testing.T) {
+		err := th.RunCommandWithError(t, cmd.Start(), test.CmdTest{
+			Args: []string{"start", "--param-file", filepath.Join(t.TempDir(), "missing.yaml"), dagFile},
+		})
+		require.ErrorContains(t, err, "failed to read params file")
+	})
+}
+
 func TestCmdStart_PositionalParamValidation(t *testing.T) {
 	t.Parallel()
 
@@ -4,8 +4,10 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/dagucloud/dagu/internal/core"
 	"github.com/dagucloud/dagu/internal/core/exec"
@@ -31,11 +33,24 @@ Flags:
   --sub-run-id string (optional) Unique identifier of a sub DAG-run.
                                  Requires --run-id to be provided.
                                  Use this to check the status of nested DAG executions.
+  --since string (optional)      List DAG-runs started at or after this date/time.
+  --until string (optional)      List DAG-runs started at or before this date/time.
+                                 A date without a time includes the whole day.
+                                 Dates are interpreted in the server timezone
+                                 (format: 2006-01-02 or 2006-01-02T15:04:05).
+  --json (optional)              Print the status as a JSON object instead of
+                                 the human-readable tree.
+  --tail (optional)              Follow the stdout logs of the DAG-run's steps
+                                 until it finishes. Exits with a non-zero code
+                                 unless the run succeeded.
 
 Example:
   dagu status --run-id=abc123 my_dag
   dagu status my_dag  # Shows status of the most recent DAG-run
   dagu status --run-id=abc123 --sub-run-id=def456 my_dag  # Shows status of a sub DAG-run
+  dagu status --since 2025-01-01 --until 2025-02-01 my_dag  # Lists DAG-runs started in the window
+  dagu status --json my_dag | jq -r .status  # Prints the status of the most recent DAG-run
+  dagu status --tail my_dag  # Follows the logs of the most recent DAG-run until it finishes
 `,
 			Args: cobra.ExactArgs(1),
 		}, statusFlags, runStatus,
@@ -45,10 +60,18 @@ Example:
 var statusFlags = []commandLineFlag{
 	dagRunIDFlagStatus,
 	subDAGRunIDFlagStatus,
+	statusSinceFlag,
+	statusUntilFlag,
+	statusJSONFlag,
+	statusTailFlag,
 }
 
 func runStatus(ctx *Context, args []string) error {
+	tail, _ := ctx.Command.Flags().GetBool("tail")
 	if ctx.IsRemote() {
+		if tail {
+			return fmt.Errorf("--tail is not supported with a remote context")
+		}
 		return remoteRunStatus(ctx, args)
 	}
 	dagRunID, err := ctx.StringParam("run-id")
@@ -65,11 +88,29 @@ func runStatus(ctx *Context, args []string) error {
 		return fmt.Errorf("--sub-run-id requires --run-id to be provided (root DAG run context is needed)")
 	}
 
+	since, _ := ctx.StringParam("since")
+	until, _ := ctx.StringParam("until")
+	if (since != "" || until != "") && dagRunID != "" {
+		return fmt.Errorf("cannot use --since or --until with --run-id")
+	}
+	if tail {
+		if since != "" || until != "" {
+			return fmt.Errorf("cannot use --tail with --since or --until")
+		}
+		if asJSON, _ := ctx.Command.Flags().GetBool("json"); asJSON {
+			return fmt.Errorf("cannot use --tail with --json")
+		}
+	}
+
 	name, err := extractDAGName(ctx, args[0])
 	if err != nil {
 		return fmt.Errorf("failed to extract DAG name: %w", err)
 	}
 
+	if since != "" || until != "" {
+		return runStatusWindow(ctx, name, since, until)
+	}
+
 	attempt, err := extractAttemptForStatus(ctx, name, dagRunID, subDAGRunID)
 	if err != nil {
 		return fmt.Errorf("failed to extract attempt: %w", err)
@@ -80,6 +121,10 @@ func runStatus(ctx *Context, args []string) error {
 		return fmt.Errorf("failed to read DAG from run data: %w", err)
 	}
 
+	if tail {
+		return runStatusTail(ctx, os.Stdout, dag, attempt, subDAGRunID != "")
+	}
+
 	dagStatus, err := attempt.ReadStatus(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to read status from attempt: %w", err)
@@ -102,11 +147,132 @@ func runStatus(ctx *Context, args []string) error {
 		}
 	}
 
-	displayTreeStatus(dag, dagStatus)
+	return printStatus(ctx, dag, dagStatus)
+}
 
+// printStatus renders the DAG-run status either as JSON (--json) or as the
+// human-readable tree.
+func printStatus(ctx *Context, dag *core.DAG, dagStatus *exec.DAGRunStatus) error {
+	if asJSON, _ := ctx.Command.Flags().GetBool("json"); asJSON {
+		return renderStatusJSON(dagStatus)
+	}
+	displayTreeStatus(dag, dagStatus)
 	return nil
 }
 
+// statusJSON is the stable JSON representation printed by `status --json`.
+type statusJSON struct {
+	Name       string           `json:"name"`
+	DAGRunID   string           `json:"dagRunId"`
+	AttemptID  string           `json:"attemptId,omitempty"`
+	Status     string           `json:"status"`
+	StartedAt  string           `json:"startedAt,omitempty"`
+	FinishedAt string           `json:"finishedAt,omitempty"`
+	Error      string           `json:"error,omitempty"`
+	Steps      []statusStepJSON `json:"steps"`
+}
+
+// statusStepJSON is the per-step entry of statusJSON.
+type statusStepJSON struct {
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	StartedAt  string `json:"startedAt,omitempty"`
+	FinishedAt string `json:"finishedAt,omitempty"`
+	RetryCount int    `json:"retryCount,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// renderStatusJSON writes the DAG-run status to stdout as JSON. Statuses are
+// encoded with their canonical String() form (e.g. "not_started").
+func renderStatusJSON(dagStatus *exec.DAGRunStatus) error {
+	out := statusJSON{
+		Name:       dagStatus.Name,
+		DAGRunID:   dagStatus.DAGRunID,
+		AttemptID:  dagStatus.AttemptID,
+		Status:     dagStatus.Status.String(),
+		StartedAt:  normalizeStatusTime(dagStatus.StartedAt),
+		FinishedAt: normalizeStatusTime(dagStatus.FinishedAt),
+		Error:      dagStatus.Error,
+		Steps:      make([]statusStepJSON, 0, len(dagStatus.Nodes)),
+	}
+	for _, node := range dagStatus.Nodes {
+		out.Steps = append(out.Steps, statusStepJSON{
+			Name:       node.Step.Name,
+			Status:     node.Status.String(),
+			StartedAt:  normalizeStatusTime(node.StartedAt),
+			FinishedAt: normalizeStatusTime(node.FinishedAt),
+			RetryCount: node.RetryCount,
+			Error:      node.Error,
+		})
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(out)
+}
+
+// normalizeStatusTime drops the "-" placeholder used for unset timestamps so
+// that JSON consumers see an absent field instead.
+func normalizeStatusTime(s string) string {
+	if s == "-" {
+		return ""
+	}
+	return s
+}
+
+// runStatusWindow lists the DAG-runs of the named DAG whose start time falls
+// between since and until, both interpreted in the server timezone.
+func runStatusWindow(ctx *Context, name, since, until string) error {
+	loc := ctx.Config.Core.Location
+	if loc == nil {
+		loc = time.Local
+	}
+
+	opts := []exec.ListDAGRunStatusesOption{exec.WithExactName(name)}
+
+	var sinceTime, untilTime time.Time
+	if since != "" {
+		t, err := parseAbsoluteDateTime(since, loc)
+		if err != nil {
+			return fmt.Errorf("invalid --since date '%s': %w. Expected format: 2006-01-02 or 2006-01-02T15:04:05", since, err)
+		}
+		sinceTime = t
+		opts = append(opts, exec.WithFrom(exec.NewUTC(t)))
+	}
+	if until != "" {
+		t, err := parseAbsoluteDateTime(until, loc)
+		if err != nil {
+			return fmt.Errorf("invalid --until date '%s': %w. Expected format: 2006-01-02 or 2006-01-02T15:04:05", until, err)
+		}
+		untilTime = t
+		// The upper bound is exclusive, so a date-only value must extend to
+		// the start of the next day to include the whole day.
+		if _, err := time.Parse(time.DateOnly, until); err == nil {
+			t = t.In(loc).AddDate(0, 0, 1)
+		}
+		opts = append(opts, exec.WithTo(exec.NewUTC(t)))
+	}
+	if since != "" && until != "" && sinceTime.After(untilTime) {
+		return fmt.Errorf("--since date (%s) must be before --until date (%s)", since, until)
+	}
+
+	statuses, err := ctx.DAGRunStore.ListStatuses(ctx, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to list DAG-runs: %w", err)
+	}
+
+	if asJSON, _ := ctx.Command.Flags().GetBool("json"); asJSON {
+		return renderHistoryJSON(statuses)
+	}
+
+	if len(statuses) == 0 {
+		fmt.Println("No DAG runs found matching the specified filters.")
+		return nil
+	}
+
+	return renderHistoryTable(statuses)
+}
+
 func displayTreeStatus(dag *core.DAG, dagStatus *exec.DAGRunStatus) {
 	config := output.DefaultConfig()
 	config.ColorEnabled = term.IsTerminal(int(os.Stdout.Fd()))
@@ -0,0 +1,131 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/dagucloud/dagu/internal/core"
+	"github.com/dagucloud/dagu/internal/core/exec"
+)
+
+// statusTailInterval is how often tail mode polls the DAG-run status and the
+// step logs for new output.
+var statusTailInterval = 500 * time.Millisecond
+
+// runStatusTail follows the stdout logs of the steps of a DAG-run until the
+// run finishes. It returns an error unless the run succeeded, so the exit code
+// of `status --tail` reflects the final status.
+func runStatusTail(ctx *Context, out io.Writer, dag *core.DAG, attempt exec.DAGRunAttempt, subRun bool) error {
+	tailCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	tailer := &stepLogTailer{out: out, offsets: make(map[string]int64)}
+	for {
+		// Read the status before the logs: once a finished status is seen,
+		// every log line written by the run is already on disk, so the drain
+		// below is complete even if the run ended before the first poll.
+		dagStatus, err := readTailStatus(ctx, dag, attempt, subRun)
+		if err != nil {
+			return err
+		}
+		if err := tailer.drain(dagStatus); err != nil {
+			return err
+		}
+		if !dagStatus.Status.IsActive() {
+			if dagStatus.Status.IsSuccess() {
+				return nil
+			}
+			return fmt.Errorf("DAG run finished with status: %s", dagStatus.Status)
+		}
+
+		select {
+		case <-tailCtx.Done():
+			return tailCtx.Err()
+		case <-time.After(statusTailInterval):
+		}
+	}
+}
+
+// readTailStatus reads the stored status of the attempt, preferring the
+// real-time status of a running root DAG-run.
+func readTailStatus(ctx *Context, dag *core.DAG, attempt exec.DAGRunAttempt, subRun bool) (*exec.DAGRunStatus, error) {
+	dagStatus, err := attempt.ReadStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read status from attempt: %w", err)
+	}
+	if dagStatus.Status != core.Running || subRun {
+		return dagStatus, nil
+	}
+	realtimeStatus, err := ctx.DAGRunMgr.GetCurrentStatus(ctx, dag, dagStatus.DAGRunID)
+	if err != nil || realtimeStatus.DAGRunID != dagStatus.DAGRunID {
+		// The run may have finished in between; the stored status is current.
+		return dagStatus, nil
+	}
+	return realtimeStatus, nil
+}
+
+// stepLogTailer copies output appended to step stdout logs since the last
+// drain, printing a header whenever the output switches to another step.
+type stepLogTailer struct {
+	out      io.Writer
+	offsets  map[string]int64
+	lastStep string
+}
+
+func (t *stepLogTailer) drain(dagStatus *exec.DAGRunStatus) error {
+	for _, node := range dagStatus.Nodes {
+		if node.Stdout == "" {
+			continue
+		}
+		if err := t.copyNew(node.Step.Name, node.Stdout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *stepLogTailer) copyNew(stepName, path string) error {
+	file, err := os.Open(path) // nolint:gosec
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open log of step %s: %w", stepName, err)
+	}
+	defer func() { _ = file.Close() }()
+
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat log of step %s: %w", stepName, err)
+	}
+	offset := t.offsets[path]
+	if info.Size() < offset {
+		// The log was rotated or truncated; start over from the beginning.
+		offset = 0
+	}
+	if info.Size() <= offset {
+		return nil
+	}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to seek log of step %s: %w", stepName, err)
+	}
+
+	if t.lastStep != stepName {
+		_, _ = fmt.Fprintf(t.out, "==> %s <==\n", stepName)
+		t.lastStep = stepName
+	}
+	n, err := io.CopyN(t.out, file, info.Size()-offset)
+	t.offsets[path] = offset + n
+	if err != nil {
+		return fmt.Errorf("failed to read log of step %s: %w", stepName, err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStepLogTailerCopyNew(t *testing.T) {
+	t.Run("AppendedOutput", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "step.out")
+		require.NoError(t, os.WriteFile(path, []byte("first\n"), 0600))
+
+		var out bytes.Buffer
+		tailer := &stepLogTailer{out: &out, offsets: make(map[string]int64)}
+		require.NoError(t, tailer.copyNew("step", path))
+
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+		require.NoError(t, err)
+		_, err = f.WriteString("second\n")
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		require.NoError(t, tailer.copyNew("step", path))
+
+		assert.Equal(t, "==> step <==\nfirst\nsecond\n", out.String())
+	})
+
+	t.Run("RotatedLog", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "step.out")
+		require.NoError(t, os.WriteFile(path, []byte("before rotation\n"), 0600))
+
+		var out bytes.Buffer
+		tailer := &stepLogTailer{out: &out, offsets: make(map[string]int64)}
+		require.NoError(t, tailer.copyNew("step", path))
+
+		// The new log file is shorter than what was already copied.
+		require.NoError(t, os.Rename(path, path+".1"))
+		require.NoError(t, os.WriteFile(path, []byte("after\n"), 0600))
+		require.NoError(t, tailer.copyNew("step", path))
+
+		assert.Equal(t, "==> step <==\nbefore rotation\nafter\n", out.String())
+	})
+}
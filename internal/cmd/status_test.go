@@ -4,8 +4,12 @@
 package cmd_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"runtime"
 	"strings"
 	"testing"
@@ -530,3 +534,210 @@ steps:
 		require.Contains(t, err.Error(), "failed to find sub dag-run")
 	})
 }
+
+func TestStatusCommandTimeWindow(t *testing.T) {
+	// Note: not parallel - manipulates os.Stdout
+
+	th := test.SetupCommand(t)
+	dagFile := th.DAG(t, `steps:
+  - name: "1"
+    command: "true"
+`)
+	dag, err := th.DAGStore.GetMetadata(th.Context, dagFile.Location)
+	require.NoError(t, err)
+
+	loc := th.Config.Core.Location
+	if loc == nil {
+		loc = time.Local
+	}
+
+	// Fabricate runs at known timestamps around the January 2025 window.
+	runs := map[string]time.Time{
+		"run-dec":   time.Date(2024, 12, 31, 12, 0, 0, 0, loc),
+		"run-jan":   time.Date(2025, 1, 15, 12, 0, 0, 0, loc),
+		"run-feb1":  time.Date(2025, 2, 1, 12, 0, 0, 0, loc),
+		"run-feb10": time.Date(2025, 2, 10, 12, 0, 0, 0, loc),
+	}
+	for runID, ts := range runs {
+		attempt, err := th.DAGRunStore.CreateAttempt(th.Context, dag, ts, runID, exec.NewDAGRunAttemptOptions{})
+		require.NoError(t, err)
+		require.NoError(t, attempt.Open(th.Context))
+		require.NoError(t, attempt.Write(th.Context, exec.DAGRunStatus{
+			Name:      dag.Name,
+			DAGRunID:  runID,
+			Status:    core.Succeeded,
+			StartedAt: ts.Format(time.RFC3339),
+			AttemptID: attempt.ID(),
+		}))
+		require.NoError(t, attempt.Close(th.Context))
+	}
+
+	t.Run("ListsRunsInWindow", func(t *testing.T) {
+		out := captureStdout(t, func() error {
+			return executeCommand(th.Context, cmd.Status(), []string{"--since", "2025-01-01", "--until", "2025-02-01", dagFile.Location})
+		})
+		require.Contains(t, out, "run-jan")
+		// A date-only --until includes runs from the whole day.
+		require.Contains(t, out, "run-feb1")
+		require.NotContains(t, out, "run-dec")
+		require.NotContains(t, out, "run-feb10")
+	})
+
+	t.Run("SinceOnly", func(t *testing.T) {
+		out := captureStdout(t, func() error {
+			return executeCommand(th.Context, cmd.Status(), []string{"--since", "2025-01-01", dagFile.Location})
+		})
+		require.Contains(t, out, "run-jan")
+		require.Contains(t, out, "run-feb10")
+		require.NotContains(t, out, "run-dec")
+	})
+
+	t.Run("InvalidDate", func(t *testing.T) {
+		err := executeCommand(th.Context, cmd.Status(), []string{"--since", "01/01/2025", dagFile.Location})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid --since date")
+	})
+
+	t.Run("SinceAfterUntil", func(t *testing.T) {
+		err := executeCommand(th.Context, cmd.Status(), []string{"--since", "2025-02-01", "--until", "2025-01-01", dagFile.Location})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "must be before --until")
+	})
+
+	t.Run("ConflictsWithRunID", func(t *testing.T) {
+		err := executeCommand(th.Context, cmd.Status(), []string{"--since", "2025-01-01", "--run-id", "run-jan", dagFile.Location})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "cannot use --since or --until with --run-id")
+	})
+}
+
+func TestStatusCommandJSON(t *testing.T) {
+	// Note: not parallel - manipulates os.Stdout
+
+	th := test.SetupCommand(t)
+	dagFile := th.DAG(t, `steps:
+  - name: "first"
+    command: "true"
+  - name: "second"
+    command: "true"
+`)
+	require.NoError(t, executeCommand(th.Context, cmd.Start(), []string{dagFile.Location}))
+	dagFile.AssertLatestStatus(t, core.Succeeded)
+
+	out := captureStdout(t, func() error {
+		return executeCommand(th.Context, cmd.Status(), []string{"--json", dagFile.Location})
+	})
+
+	var got struct {
+		Name       string `json:"name"`
+		DAGRunID   string `json:"dagRunId"`
+		Status     string `json:"status"`
+		StartedAt  string `json:"startedAt"`
+		FinishedAt string `json:"finishedAt"`
+		Steps      []struct {
+			Name       string `json:"name"`
+			Status     string `json:"status"`
+			StartedAt  string `json:"startedAt"`
+			FinishedAt string `json:"finishedAt"`
+		} `json:"steps"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &got), "output: %s", out)
+
+	require.Equal(t, dagFile.Name, got.Name)
+	require.NotEmpty(t, got.DAGRunID)
+	require.Equal(t, core.Succeeded.String(), got.Status)
+	require.Equal(t, "succeeded", got.Status)
+	require.NotEmpty(t, got.StartedAt)
+	require.NotEmpty(t, got.FinishedAt)
+	require.Len(t, got.Steps, 2)
+	for i, name := range []string{"first", "second"} {
+		require.Equal(t, name, got.Steps[i].Name)
+		require.Equal(t, "succeeded", got.Steps[i].Status)
+		require.NotEmpty(t, got.Steps[i].StartedAt)
+		require.NotEmpty(t, got.Steps[i].FinishedAt)
+	}
+}
+
+func TestStatusCommandTail(t *testing.T) {
+	// Note: not parallel - manipulates os.Stdout
+
+	t.Run("FollowsRunningDAGUntilSuccess", func(t *testing.T) {
+		th := test.SetupCommand(t)
+		release := newHoldFile(t)
+		dagFile := th.DAG(t, fmt.Sprintf(`steps:
+  - name: first
+    command: echo before-hold
+  - name: hold
+    command: %q
+  - name: last
+    command: echo after-hold
+`, holdUntilFileExistsCommand(release)))
+
+		startErr := make(chan error, 1)
+		go func() {
+			startErr <- th.ExecuteCommand(cmd.Start(), test.CmdTest{Args: []string{"start", dagFile.Location}})
+		}()
+		waitForDAGRunning(t, th, dagFile.Location)
+
+		go func() {
+			time.Sleep(200 * time.Millisecond)
+			_ = os.WriteFile(release, []byte("release"), 0o600)
+		}()
+
+		out := captureStdout(t, func() error {
+			return executeCommand(th.Context, cmd.Status(), []string{"--tail", dagFile.Location})
+		})
+		require.NoError(t, <-startErr)
+
+		require.Contains(t, out, "==> first <==")
+		require.Contains(t, out, "before-hold")
+		require.Contains(t, out, "==> last <==")
+		require.Contains(t, out, "after-hold")
+		dagFile.AssertLatestStatus(t, core.Succeeded)
+	})
+
+	t.Run("FinishedFailedRun", func(t *testing.T) {
+		th := test.SetupCommand(t)
+		dagFile := th.DAG(t, `steps:
+  - name: fail
+    command: echo failing-output && exit 1
+`)
+		_ = executeCommand(th.Context, cmd.Start(), []string{dagFile.Location})
+		dagFile.AssertLatestStatus(t, core.Failed)
+
+		err := executeCommand(th.Context, cmd.Status(), []string{"--tail", dagFile.Location})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "DAG run finished with status: failed")
+	})
+
+	t.Run("RejectsJSON", func(t *testing.T) {
+		th := test.SetupCommand(t)
+		dagFile := th.DAG(t, `steps:
+  - name: ok
+    command: "true"
+`)
+		err := executeCommand(th.Context, cmd.Status(), []string{"--tail", "--json", dagFile.Location})
+		require.ErrorContains(t, err, "cannot use --tail with --json")
+	})
+}
+
+// captureStdout runs fn while redirecting os.Stdout and returns what was written.
+func captureStdout(t *testing.T, fn func() error) string {
+	t.Helper()
+
+	oldStdout := os.Stdout
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	os.Stdout = w
+
+	runErr := fn()
+
+	require.NoError(t, w.Close())
+	os.Stdout = oldStdout
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, r)
+	require.NoError(t, err)
+	require.NoError(t, runErr)
+
+	return buf.String()
+}
@@ -6,12 +6,14 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"log/slog"
 	"strings"
 
 	"github.com/dagucloud/dagu/internal/cmn/logger"
 	"github.com/dagucloud/dagu/internal/cmn/logger/tag"
 	"github.com/dagucloud/dagu/internal/core"
 	"github.com/dagucloud/dagu/internal/core/spec"
+	"github.com/dagucloud/dagu/internal/runtime"
 	"github.com/dagucloud/dagu/internal/workspace"
 	"github.com/spf13/cobra"
 )
@@ -22,7 +24,12 @@ import (
 // - Load the YAML without evaluation
 // - Run DAG.Validate()
 //
-// The command prints validation results and any errors found.
+// The command prints validation results and any errors found. Build
+// warnings are printed separately and do not fail validation unless --strict
+// is set, and missing working directories only fail it with --check-paths.
+// With --graph it also prints the step graph of a valid DAG. With
+// --emit-schema it prints a DAG JSON Schema generated from the spec types
+// instead, for editor integration.
 // Unlike other commands, this does NOT use NewCommand wrapper to allow proper
 // error handling in tests without requiring subprocess patterns.
 func Validate() *cobra.Command {
@@ -33,9 +40,43 @@ func Validate() *cobra.Command {
 
 Prints a human-readable result instead of structured logs.
 Checks structural correctness and references (e.g., step dependencies)
-similar to the server-side spec validation.`,
-		Args: cobra.ExactArgs(1),
+similar to the server-side spec validation.
+
+Build warnings (for example deprecated fields) are reported with a stable
+code but do not fail validation. With --strict, warnings are treated as
+errors and the command exits non-zero. With --check-paths, an absolute
+working_dir that does not exist on this host fails validation.
+
+With --graph dot (or --graph mermaid), also prints the step graph of a valid
+DAG to stdout: dependencies as edges, handler steps dashed, and steps whose
+preconditions can never be met greyed out.
+
+With --emit-schema, prints a DAG JSON Schema generated from the DAG spec
+types instead, so it accepts exactly the keys this version of dagu accepts.
+Point your editor's YAML language server at it to get completion and inline
+validation.`,
+		Example: `  dagu validate my_dag.yaml
+  dagu validate --strict my_dag.yaml
+  dagu validate --graph dot my_dag.yaml | dot -Tsvg > my_dag.svg
+  dagu validate --emit-schema > dag.schema.json`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if emit, _ := cmd.Flags().GetBool("emit-schema"); emit {
+				schema, err := spec.DAGJSONSchema()
+				if err != nil {
+					return fmt.Errorf("failed to generate the DAG schema: %w", err)
+				}
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), string(schema))
+				return err
+			}
+			graph, _ := cmd.Flags().GetString("graph")
+			if graph != "" && graph != graphFormatDOT && graph != graphFormatMermaid {
+				return fmt.Errorf("invalid --graph format %q: must be %q or %q", graph, graphFormatDOT, graphFormatMermaid)
+			}
+			if len(args) != 1 {
+				return fmt.Errorf("requires a DAG definition (or --emit-schema)")
+			}
+
 			ctx, err := NewContext(cmd, nil)
 			if err != nil {
 				return fmt.Errorf("initialization error: %w", err)
@@ -45,7 +86,7 @@ similar to the server-side spec validation.`,
 	}
 
 	// Initialize flags required by NewContext
-	initFlags(cmd)
+	initFlags(cmd, emitSchemaFlag, strictFlag, checkPathsFlag, graphFlag)
 
 	return cmd
 }
@@ -56,10 +97,14 @@ func runValidate(ctx *Context, args []string) error {
 		spec.WithoutEval(),
 		spec.WithDAGsDir(ctx.Config.Paths.DAGsDir),
 		spec.WithWorkspaceBaseConfigDir(workspace.BaseConfigDir(ctx.Config.Paths.DAGsDir)),
+		spec.WithRemoteSchemaCacheTTL(ctx.Config.Core.ParamsSchemaCacheTTL),
 	}
 	if ctx.Config.Paths.BaseConfig != "" {
 		loadOpts = append(loadOpts, spec.WithBaseConfig(ctx.Config.Paths.BaseConfig))
 	}
+	if checkPaths, _ := ctx.Command.Flags().GetBool("check-paths"); checkPaths {
+		loadOpts = append(loadOpts, spec.WithCheckPaths())
+	}
 
 	dag, err := spec.Load(ctx, args[0], loadOpts...)
 
@@ -73,6 +118,25 @@ func runValidate(ctx *Context, args []string) error {
 		return errors.New(formatValidationErrors(args[0], vErr))
 	}
 
+	for _, w := range dag.BuildWarnings {
+		logger.Warn(ctx, "DAG spec warning",
+			tag.File(args[0]),
+			slog.String("code", string(w.Code)),
+			slog.String("warning", w.Message),
+		)
+	}
+	if strict, _ := ctx.Command.Flags().GetBool("strict"); strict && len(dag.BuildWarnings) > 0 {
+		var errs core.ErrorList
+		for _, w := range dag.BuildWarnings {
+			errs = append(errs, errors.New(w.String()))
+		}
+		return errors.New(formatValidationErrors(args[0], errs))
+	}
+
+	if err := printValidateGraph(ctx, dag); err != nil {
+		return err
+	}
+
 	// Success
 	logger.Info(ctx, "DAG spec is valid",
 		tag.File(args[0]),
@@ -81,6 +145,31 @@ func runValidate(ctx *Context, args []string) error {
 	return nil
 }
 
+// Graph formats accepted by validate --graph.
+const (
+	graphFormatDOT     = "dot"
+	graphFormatMermaid = "mermaid"
+)
+
+// printValidateGraph prints the step graph of the DAG when --graph is set.
+func printValidateGraph(ctx *Context, dag *core.DAG) error {
+	format, _ := ctx.Command.Flags().GetString("graph")
+	var graph string
+	switch format {
+	case "":
+		return nil
+	case graphFormatDOT:
+		var err error
+		if graph, err = runtime.DOTGraph(dag); err != nil {
+			return fmt.Errorf("failed to build the step graph: %w", err)
+		}
+	case graphFormatMermaid:
+		graph = dag.MermaidDiagram()
+	}
+	_, err := fmt.Fprint(ctx.Command.OutOrStdout(), graph)
+	return err
+}
+
 // formatValidationErrors builds a readable error output from a (possibly wrapped) error.
 func formatValidationErrors(file string, err error) string {
 	// Collect message strings
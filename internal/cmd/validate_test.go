@@ -4,12 +4,22 @@
 package cmd_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/dagucloud/dagu/internal/cmd"
 	"github.com/dagucloud/dagu/internal/test"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestValidateCommand(t *testing.T) {
@@ -59,6 +69,66 @@ steps:
 		})
 	})
 
+	t.Run("WarningsDoNotFailValidation", func(t *testing.T) {
+		dagFile := th.CreateDAGFile(t, "deprecated_max_active_runs.yaml", `
+max_active_runs: 3
+steps:
+  - echo ok
+`)
+
+		th.RunCommand(t, cmd.Validate(), test.CmdTest{
+			Args:        []string{"validate", dagFile},
+			ExpectedOut: []string{"DAG spec warning", "deprecated_max_active_runs", "DAG spec is valid"},
+		})
+	})
+
+	t.Run("StrictFailsOnWarnings", func(t *testing.T) {
+		dagFile := th.CreateDAGFile(t, "deprecated_max_active_runs_strict.yaml", `
+max_active_runs: 3
+steps:
+  - echo ok
+`)
+
+		err := th.RunCommandWithError(t, cmd.Validate(), test.CmdTest{
+			Args: []string{"validate", "--strict", dagFile},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Validation failed")
+		require.Contains(t, err.Error(), "deprecated_max_active_runs: max_active_runs=3 is deprecated")
+	})
+
+	t.Run("CheckPathsFailsOnMissingWorkingDir", func(t *testing.T) {
+		missing := filepath.Join(t.TempDir(), "missing")
+		dagFile := th.CreateDAGFile(t, "missing_working_dir.yaml", fmt.Sprintf(`
+working_dir: %s
+steps:
+  - echo ok
+`, missing))
+
+		th.RunCommand(t, cmd.Validate(), test.CmdTest{
+			Args:        []string{"validate", dagFile},
+			ExpectedOut: []string{"DAG spec is valid"},
+		})
+
+		err := th.RunCommandWithError(t, cmd.Validate(), test.CmdTest{
+			Args: []string{"validate", "--check-paths", dagFile},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), fmt.Sprintf("working directory %s does not exist", missing))
+	})
+
+	t.Run("StrictPassesWithoutWarnings", func(t *testing.T) {
+		dag := th.DAG(t, `
+steps:
+  - echo ok
+`)
+
+		th.RunCommand(t, cmd.Validate(), test.CmdTest{
+			Args:        []string{"validate", "--strict", dag.Location},
+			ExpectedOut: []string{"DAG spec is valid"},
+		})
+	})
+
 	t.Run("InvalidDependency", func(t *testing.T) {
 		// This DAG has a step depending on a non-existent step
 		dagFile := th.CreateDAGFile(t, "invalid.yaml", `
@@ -101,3 +171,141 @@ steps:
 		require.Contains(t, err.Error(), "Validation failed")
 	})
 }
+
+func TestValidateCommandGraph(t *testing.T) {
+	th := test.SetupCommand(t)
+
+	runValidateCmd := func(args ...string) (string, error) {
+		root := &cobra.Command{Use: "root"}
+		root.AddCommand(cmd.Validate())
+
+		var buf bytes.Buffer
+		root.SetOut(&buf)
+		root.SetArgs(test.WithConfigFlag(args, th.Config))
+
+		err := root.ExecuteContext(th.Context)
+		return buf.String(), err
+	}
+
+	dagFile := th.CreateDAGFile(t, "graph_diamond.yaml", `
+type: graph
+steps:
+  - id: extract
+    command: echo extract
+  - id: left
+    command: echo left
+    depends: [extract]
+  - id: right
+    command: echo right
+    depends: [extract]
+  - id: load
+    command: echo load
+    depends: [left, right]
+`)
+
+	t.Run("DOT", func(t *testing.T) {
+		out, err := runValidateCmd("validate", "--graph", "dot", dagFile)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(out, `digraph "graph_diamond" {`), out)
+		for _, edge := range []string{
+			"step0 -> step1;",
+			"step0 -> step2;",
+			"step1 -> step3;",
+			"step2 -> step3;",
+		} {
+			assert.Contains(t, out, edge)
+		}
+	})
+
+	t.Run("Mermaid", func(t *testing.T) {
+		out, err := runValidateCmd("validate", "--graph", "mermaid", dagFile)
+		require.NoError(t, err)
+		assert.Contains(t, out, "flowchart TD")
+		assert.Contains(t, out, "step1 --> step3")
+	})
+
+	t.Run("InvalidFormat", func(t *testing.T) {
+		_, err := runValidateCmd("validate", "--graph", "png", dagFile)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid --graph format "png"`)
+	})
+}
+
+func TestValidateCommandEmitSchema(t *testing.T) {
+	runValidateCmd := func(args ...string) (string, error) {
+		root := &cobra.Command{Use: "root"}
+		root.AddCommand(cmd.Validate())
+
+		var buf bytes.Buffer
+		root.SetOut(&buf)
+		root.SetArgs(args)
+
+		err := root.Execute()
+		return buf.String(), err
+	}
+
+	out, err := runValidateCmd("validate", "--emit-schema")
+	require.NoError(t, err)
+
+	var schema jsonschema.Schema
+	require.NoError(t, json.Unmarshal([]byte(out), &schema))
+	resolved, err := schema.Resolve(&jsonschema.ResolveOptions{})
+	require.NoError(t, err)
+
+	toJSONDocument := func(t *testing.T, spec string) any {
+		t.Helper()
+
+		// Round-trip through JSON so the document has the shape an editor sees.
+		var doc map[string]any
+		require.NoError(t, yaml.Unmarshal([]byte(spec), &doc))
+		data, err := json.Marshal(doc)
+		require.NoError(t, err)
+		var jsonDoc any
+		require.NoError(t, json.Unmarshal(data, &jsonDoc))
+		return jsonDoc
+	}
+
+	t.Run("AcceptsExamples", func(t *testing.T) {
+		for i := 1; i <= cmd.ExampleCount(); i++ {
+			out, err := runExampleCmd("example", strconv.Itoa(i))
+			require.NoError(t, err, "example %d failed", i)
+			doc := toJSONDocument(t, extractExampleYAML(out))
+			assert.NoError(t, resolved.Validate(doc), "example %d should match the schema", i)
+		}
+	})
+
+	t.Run("AcceptsUnionShapes", func(t *testing.T) {
+		doc := toJSONDocument(t, `
+type: graph
+shell: ["bash", "-e"]
+steps:
+  - id: fanout
+    call: child
+    parallel:
+      items: [a, b]
+      max_concurrent: 1
+  - name: tolerant
+    command: exit 1
+    depends: fanout
+    continue_on:
+      failure: true
+      exit_code: [1, 2]
+`)
+		assert.NoError(t, resolved.Validate(doc))
+	})
+
+	t.Run("RejectsInvalidShape", func(t *testing.T) {
+		doc := toJSONDocument(t, `
+steps:
+  - command: echo ok
+    parallel: true
+`)
+		assert.Error(t, resolved.Validate(doc))
+	})
+
+	t.Run("RequiresDAGWithoutFlag", func(t *testing.T) {
+		_, err := runValidateCmd("validate")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "requires a DAG definition")
+	})
+}
@@ -34,6 +34,7 @@ Flags:
   --worker.max-active-runs int             Maximum number of active runs (default: 100)
   --worker.health-port int                 Port number for the HTTP health check server (default: 8092, 0 disables)
   --worker.labels -l string                Worker labels for capability matching (format: key1=value1,key2=value2)
+  --worker.tags string                     Only run DAGs that have one of these tags (format: tag1,tag2)
   --worker.coordinators string             Coordinator addresses for static discovery (format: host1:port1,host2:port2)
 
 TLS Configuration (uses global peer settings):
@@ -53,6 +54,9 @@ Example:
   dagu worker --worker.labels gpu=true,memory=64G,region=us-east-1
   dagu worker --worker.labels cpu-arch=amd64,instance-type=m5.xlarge
 
+  # Worker that only runs DAGs tagged gpu or heavy:
+  dagu worker --worker.tags gpu,heavy
+
   # For TLS connections (when coordinator has TLS enabled):
   dagu worker --peer.insecure=false --peer.cert-file=client.crt --peer.key-file=client.key
   dagu worker --peer.insecure=false --peer.client-ca-file=ca.crt
@@ -72,6 +76,7 @@ var workerFlags = []commandLineFlag{
 	workerMaxActiveRunsFlag,
 	workerHealthPortFlag,
 	workerLabelsFlag,
+	workerTagsFlag,
 	workerCoordinatorsFlag,
 	// Peer configuration flags for TLS
 	peerInsecureFlag,
@@ -94,6 +99,7 @@ func runWorker(ctx *Context, _ []string) error {
 
 	maxActiveRuns := ctx.Config.Worker.MaxActiveRuns
 	labels := ctx.Config.Worker.Labels
+	tags := ctx.Config.Worker.Tags
 
 	coordinatorCli, useRemoteHandler, err := createCoordinatorClient(ctx)
 	if err != nil {
@@ -101,6 +107,7 @@ func runWorker(ctx *Context, _ []string) error {
 	}
 
 	w := worker.NewWorker(workerID, maxActiveRuns, coordinatorCli, labels, ctx.Config)
+	w.SetTags(tags)
 
 	if useRemoteHandler {
 		handlerCfg := worker.RemoteTaskHandlerConfig{
@@ -113,7 +120,7 @@ func runWorker(ctx *Context, _ []string) error {
 		logger.Info(ctx, "Using remote task handler for shared-nothing mode")
 	}
 
-	logger.Info(ctx, "Starting worker", tag.WorkerID(workerID), tag.MaxConcurrency(maxActiveRuns), slog.Any("labels", labels))
+	logger.Info(ctx, "Starting worker", tag.WorkerID(workerID), tag.MaxConcurrency(maxActiveRuns), slog.Any("labels", labels), slog.Any("tags", tags))
 
 	// Start the worker in a goroutine to allow for graceful shutdown
 	errCh := make(chan error, 1)
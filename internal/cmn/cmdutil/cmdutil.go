@@ -39,15 +39,44 @@ func SplitCommandArgs(cmdWithArgs string) (string, []string) {
 	return command, strings.Split(args, ArgsDelimiter)
 }
 
+// ShellAuto is the shell value that selects a platform shell when the
+// command runs: PowerShell on Windows and sh elsewhere.
+const ShellAuto = "auto"
+
+// ResolveShell returns shell unchanged unless it is ShellAuto, in which case
+// the shell for the current platform is returned.
+func ResolveShell(shell string) string {
+	if shell != ShellAuto {
+		return shell
+	}
+	return autoShell(runtime.GOOS, exec.LookPath)
+}
+
+// autoShell returns the first shell found for goos, preferring pwsh and
+// powershell on Windows and sh and bash elsewhere. The bare name of the
+// preferred shell is returned when none can be found.
+func autoShell(goos string, lookPath func(string) (string, error)) string {
+	candidates := []string{"sh", "bash"}
+	if goos == "windows" {
+		candidates = []string{"pwsh", "powershell"}
+	}
+	for _, name := range candidates {
+		if path, err := lookPath(name); err == nil {
+			return path
+		}
+	}
+	return candidates[0]
+}
+
 // GetShellCommand returns the shell to use for command execution
 func GetShellCommand(configuredShell string) string {
 	if configuredShell != "" {
-		return configuredShell
+		return ResolveShell(configuredShell)
 	}
 
 	// Check for global default shell via environment variable
 	if defaultShell := os.Getenv("DAGU_DEFAULT_SHELL"); defaultShell != "" {
-		return defaultShell
+		return ResolveShell(defaultShell)
 	}
 
 	// Platform-specific default shell detection
@@ -12,6 +12,50 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func TestAutoShell(t *testing.T) {
+	lookPath := func(available ...string) func(string) (string, error) {
+		return func(name string) (string, error) {
+			for _, a := range available {
+				if a == name {
+					return "/found/" + name, nil
+				}
+			}
+			return "", exec.ErrNotFound
+		}
+	}
+
+	tests := []struct {
+		name      string
+		goos      string
+		available []string
+		expected  string
+	}{
+		{name: "WindowsPrefersPwsh", goos: "windows", available: []string{"pwsh", "powershell"}, expected: "/found/pwsh"},
+		{name: "WindowsFallsBackToPowershell", goos: "windows", available: []string{"powershell", "sh"}, expected: "/found/powershell"},
+		{name: "LinuxPrefersSh", goos: "linux", available: []string{"sh", "bash", "pwsh"}, expected: "/found/sh"},
+		{name: "DarwinFallsBackToBash", goos: "darwin", available: []string{"bash"}, expected: "/found/bash"},
+		{name: "WindowsNoneFound", goos: "windows", expected: "pwsh"},
+		{name: "UnixNoneFound", goos: "linux", expected: "sh"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, autoShell(tt.goos, lookPath(tt.available...)))
+		})
+	}
+
+	t.Run("DiffersPerPlatform", func(t *testing.T) {
+		all := lookPath("sh", "bash", "pwsh", "powershell")
+		assert.NotEqual(t, autoShell("windows", all), autoShell("linux", all))
+	})
+}
+
+func TestResolveShell(t *testing.T) {
+	assert.Equal(t, "/bin/zsh", ResolveShell("/bin/zsh"))
+	assert.Equal(t, autoShell(runtime.GOOS, exec.LookPath), ResolveShell(ShellAuto))
+	assert.NotEqual(t, ShellAuto, ResolveShell(ShellAuto))
+	assert.Equal(t, ResolveShell(ShellAuto), GetShellCommand(ShellAuto))
+}
+
 func TestShellQuote(t *testing.T) {
 	tests := []struct {
 		name     string
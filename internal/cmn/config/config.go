@@ -180,8 +180,14 @@ type Core struct {
 	EnvPassthroughPrefixes []string
 	Peer                   Peer
 	BaseEnv                BaseEnv
+	// ParamsSchemaCacheTTL is how long remote params schemas are cached
+	// before revalidation when the server does not send Cache-Control.
+	ParamsSchemaCacheTTL time.Duration
 }
 
+// DefaultParamsSchemaCacheTTL is the default for Core.ParamsSchemaCacheTTL.
+const DefaultParamsSchemaCacheTTL = 5 * time.Minute
+
 // Server contains the API server configuration.
 type Server struct {
 	Host              string
@@ -431,6 +437,16 @@ type Queues struct {
 type QueueConfig struct {
 	Name          string
 	MaxActiveRuns int
+	// MaxStartsPerInterval limits how many runs may start within Interval.
+	// Zero disables rate limiting.
+	MaxStartsPerInterval int
+	Interval             time.Duration
+}
+
+// Concurrency returns how many runs the queue may execute at once. Unset,
+// zero and negative limits all mean one run at a time.
+func (q QueueConfig) Concurrency() int {
+	return max(q.MaxActiveRuns, 1)
 }
 
 // FindQueueConfig returns the queue config if the queue name is defined in config.
@@ -455,6 +471,15 @@ type Coordinator struct {
 	Advertise  string // Registry address (auto-detected if empty)
 	Port       int
 	HealthPort int // HTTP health check port (default: 8091, 0 disables)
+	// HeartbeatTimeout is how long a worker may go without heartbeating before
+	// the coordinator evicts it (default: 30s).
+	HeartbeatTimeout time.Duration
+	// LeaseTimeout is how long a distributed run may go without a status
+	// update before its lease is considered stale (default: 30s).
+	LeaseTimeout time.Duration
+	// RequeueEvictedRuns requeues in-flight runs of evicted workers for retry
+	// instead of leaving them failed.
+	RequeueEvictedRuns bool
 }
 
 // Worker represents the worker configuration.
@@ -462,6 +487,7 @@ type Worker struct {
 	ID            string            // Default: hostname@PID
 	MaxActiveRuns int               // Default: 100
 	Labels        map[string]string // Capability matching labels
+	Tags          []string          // Only run DAGs having one of these tags (empty: any DAG)
 	Coordinators  []string          // Static discovery addresses (host:port)
 	HealthPort    int               // HTTP health check port (default: 8092, 0 disables)
 	PostgresPool  PostgresPoolConfig
@@ -746,6 +746,23 @@ func TestConfig_ValidateRemoteNodes(t *testing.T) {
 	})
 }
 
+func TestQueueConfigConcurrency(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		maxActiveRuns int
+		expected      int
+	}{
+		{-1, 1},
+		{0, 1},
+		{1, 1},
+		{3, 3},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, QueueConfig{MaxActiveRuns: tt.maxActiveRuns}.Concurrency(), "max_active_runs=%d", tt.maxActiveRuns)
+	}
+}
+
 func TestFindQueueConfig(t *testing.T) {
 	t.Parallel()
 
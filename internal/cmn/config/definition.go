@@ -24,6 +24,7 @@ type Definition struct {
 	TZ                     string   `mapstructure:"tz"`
 	EnvPassthrough         []string `mapstructure:"env_passthrough"`
 	EnvPassthroughPrefixes []string `mapstructure:"env_passthrough_prefixes"`
+	ParamsSchemaCacheTTL   string   `mapstructure:"params_schema_cache_ttl"`
 
 	// Authentication
 	Auth *AuthDef `mapstructure:"auth"`
@@ -281,6 +282,13 @@ type CoordinatorDef struct {
 	Advertise  string `mapstructure:"advertise"` // Auto-detected if empty
 	Port       int    `mapstructure:"port"`
 	HealthPort int    `mapstructure:"health_port"`
+	// HeartbeatTimeout is a duration string such as "30s". Workers that miss
+	// heartbeats for longer than this are evicted.
+	HeartbeatTimeout string `mapstructure:"heartbeat_timeout"`
+	// LeaseTimeout is a duration string such as "30s". Runs whose worker
+	// stops pushing status for longer than this are considered stale.
+	LeaseTimeout       string `mapstructure:"lease_timeout"`
+	RequeueEvictedRuns *bool  `mapstructure:"requeue_evicted_runs"`
 }
 
 // WorkerDef configures the worker.
@@ -291,6 +299,9 @@ type WorkerDef struct {
 	// Labels accepts either a string "key=value,key2=value2,..." or map[string]string.
 	// When string, parsed as comma-separated key=value pairs.
 	Labels any `mapstructure:"labels"`
+	// Tags accepts either a comma-separated string or []string. When set, the
+	// worker only runs DAGs that have at least one of these tags.
+	Tags any `mapstructure:"tags"`
 	// Coordinators accepts either a single string URL or []string of URLs.
 	// When string, used as single coordinator address.
 	Coordinators any              `mapstructure:"coordinators"`
@@ -337,6 +348,9 @@ type QueueDef struct {
 	Name           string `mapstructure:"name"`
 	MaxActiveRuns  *int   `mapstructure:"max_active_runs"` // Deprecated: use MaxConcurrency
 	MaxConcurrency int    `mapstructure:"max_concurrency"`
+	// MaxStartsPerInterval caps run starts per Interval (token bucket).
+	MaxStartsPerInterval int    `mapstructure:"max_starts_per_interval"`
+	Interval             string `mapstructure:"interval"`
 }
 
 // -----------------------------------------------------------------------------
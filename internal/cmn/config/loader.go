@@ -10,6 +10,8 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -313,6 +315,14 @@ func (l *ConfigLoader) loadCoreConfig(cfg *Config, def Definition) error {
 		EnvPassthroughPrefixes: envPassthroughPrefixes,
 		BaseEnv:                baseEnv,
 		Peer:                   l.loadPeerConfig(def.Peer),
+		ParamsSchemaCacheTTL:   DefaultParamsSchemaCacheTTL,
+	}
+	if def.ParamsSchemaCacheTTL != "" {
+		if ttl, err := time.ParseDuration(def.ParamsSchemaCacheTTL); err == nil && ttl >= 0 {
+			cfg.Core.ParamsSchemaCacheTTL = ttl
+		} else {
+			l.warnings = append(l.warnings, fmt.Sprintf("Invalid params_schema_cache_ttl value: %s", def.ParamsSchemaCacheTTL))
+		}
 	}
 
 	if err := setTimezone(&cfg.Core); err != nil {
@@ -758,6 +768,15 @@ func (l *ConfigLoader) loadQueuesConfig(cfg *Config, def Definition) {
 		if qd.MaxActiveRuns != nil {
 			qc.MaxActiveRuns = *qd.MaxActiveRuns
 		}
+		if qd.MaxStartsPerInterval > 0 {
+			qc.MaxStartsPerInterval = qd.MaxStartsPerInterval
+			qc.Interval = l.parseDuration("queues.config.interval", qd.Interval)
+			if qc.Interval <= 0 {
+				l.warnings = append(l.warnings, fmt.Sprintf(
+					"Queue %q sets max_starts_per_interval without a valid interval; rate limiting disabled", qd.Name))
+				qc.MaxStartsPerInterval = 0
+			}
+		}
 		cfg.Queues.Config = append(cfg.Queues.Config, qc)
 	}
 }
@@ -770,6 +789,11 @@ func (l *ConfigLoader) loadCoordinatorConfig(cfg *Config, def Definition) {
 		cfg.Coordinator.Advertise = def.Coordinator.Advertise
 		cfg.Coordinator.Port = def.Coordinator.Port
 		cfg.Coordinator.HealthPort = def.Coordinator.HealthPort
+		cfg.Coordinator.HeartbeatTimeout = l.parseDuration("coordinator.heartbeat_timeout", def.Coordinator.HeartbeatTimeout)
+		cfg.Coordinator.LeaseTimeout = l.parseDuration("coordinator.lease_timeout", def.Coordinator.LeaseTimeout)
+		if def.Coordinator.RequeueEvictedRuns != nil {
+			cfg.Coordinator.RequeueEvictedRuns = *def.Coordinator.RequeueEvictedRuns
+		}
 	}
 
 	l.setCoordinatorDefaults(cfg)
@@ -795,6 +819,10 @@ func (l *ConfigLoader) loadWorkerConfig(cfg *Config, def Definition) {
 			cfg.Worker.Labels = parseLabels(def.Worker.Labels)
 		}
 
+		if def.Worker.Tags != nil {
+			cfg.Worker.Tags = parseWorkerTags(def.Worker.Tags)
+		}
+
 		if def.Worker.Coordinators != nil {
 			addresses, addrWarnings := parseCoordinatorAddresses(def.Worker.Coordinators)
 			cfg.Worker.Coordinators = addresses
@@ -814,6 +842,12 @@ func (l *ConfigLoader) setCoordinatorDefaults(cfg *Config) {
 	if !l.v.IsSet("coordinator.health_port") && cfg.Coordinator.HealthPort <= 0 {
 		cfg.Coordinator.HealthPort = 8091
 	}
+	if cfg.Coordinator.HeartbeatTimeout <= 0 {
+		cfg.Coordinator.HeartbeatTimeout = 30 * time.Second
+	}
+	if cfg.Coordinator.LeaseTimeout <= 0 {
+		cfg.Coordinator.LeaseTimeout = 30 * time.Second
+	}
 }
 
 func (l *ConfigLoader) setWorkerDefaults(cfg *Config) {
@@ -900,6 +934,35 @@ func parseCoordinatorAddresses(input any) ([]string, []string) {
 	return addresses, warnings
 }
 
+// parseWorkerTags parses worker tags from a comma-separated string or a list.
+func parseWorkerTags(input any) []string {
+	var tags []string
+	add := func(tag string) {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	switch v := input.(type) {
+	case string:
+		for tag := range strings.SplitSeq(v, ",") {
+			add(tag)
+		}
+	case []any:
+		for _, item := range v {
+			if tag, ok := item.(string); ok {
+				add(tag)
+			}
+		}
+	case []string:
+		for _, tag := range v {
+			add(tag)
+		}
+	}
+
+	return tags
+}
+
 func (l *ConfigLoader) loadSchedulerConfig(cfg *Config, def Definition) {
 	if def.Scheduler != nil {
 		cfg.Scheduler.Port = def.Scheduler.Port
@@ -1657,6 +1720,7 @@ var envBindings = []envBinding{
 	{key: "skip_examples", env: "SKIP_EXAMPLES"},
 	{key: "env_passthrough", env: "ENV_PASSTHROUGH"},
 	{key: "env_passthrough_prefixes", env: "ENV_PASSTHROUGH_PREFIXES"},
+	{key: "params_schema_cache_ttl", env: "PARAMS_SCHEMA_CACHE_TTL"},
 
 	// Secrets
 	{key: "secrets.vault.address", env: "SECRETS_VAULT_ADDRESS"},
@@ -1759,11 +1823,15 @@ var envBindings = []envBinding{
 	{key: "coordinator.advertise", env: "COORDINATOR_ADVERTISE"},
 	{key: "coordinator.port", env: "COORDINATOR_PORT"},
 	{key: "coordinator.health_port", env: "COORDINATOR_HEALTH_PORT"},
+	{key: "coordinator.heartbeat_timeout", env: "COORDINATOR_HEARTBEAT_TIMEOUT"},
+	{key: "coordinator.lease_timeout", env: "COORDINATOR_LEASE_TIMEOUT"},
+	{key: "coordinator.requeue_evicted_runs", env: "COORDINATOR_REQUEUE_EVICTED_RUNS"},
 
 	// Worker
 	{key: "worker.id", env: "WORKER_ID"},
 	{key: "worker.max_active_runs", env: "WORKER_MAX_ACTIVE_RUNS"},
 	{key: "worker.labels", env: "WORKER_LABELS"},
+	{key: "worker.tags", env: "WORKER_TAGS"},
 	{key: "worker.coordinators", env: "WORKER_COORDINATORS"},
 	{key: "worker.health_port", env: "WORKER_HEALTH_PORT"},
 
@@ -1852,6 +1920,55 @@ func (l *ConfigLoader) bindEnvironmentVariables() {
 
 		_ = l.v.BindEnv(b.key, fullEnv)
 	}
+
+	// Every other config key can be set with its prefixed, upper-cased path,
+	// e.g. scheduler.port via DAGU_SCHEDULER_PORT.
+	explicit := make(map[string]bool, len(envBindings))
+	for _, b := range envBindings {
+		explicit[b.key] = true
+	}
+	for _, key := range definitionKeys(reflect.TypeFor[Definition](), "") {
+		if !explicit[key] {
+			_ = l.v.BindEnv(key, envNameForKey(key))
+		}
+	}
+}
+
+// definitionKeys returns the dotted config keys of the leaf fields of a
+// definition struct, following the mapstructure tags. Fields of squashed
+// structs are keyed as if they were declared in the parent.
+func definitionKeys(t reflect.Type, prefix string) []string {
+	var keys []string
+	for i := range t.NumField() {
+		field := t.Field(i)
+		name, opts, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+		ft := field.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Struct && slices.Contains(strings.Split(opts, ","), "squash") {
+			keys = append(keys, definitionKeys(ft, prefix)...)
+			continue
+		}
+		if !field.IsExported() || name == "" || name == "-" {
+			continue
+		}
+		key := name
+		if prefix != "" {
+			key = prefix + "." + name
+		}
+		if ft.Kind() == reflect.Struct {
+			keys = append(keys, definitionKeys(ft, key)...)
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// envNameForKey returns the environment variable that sets a config key.
+func envNameForKey(key string) string {
+	return strings.ToUpper(AppSlug) + "_" + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
 }
 
 func (l *ConfigLoader) configureViper(configDir, configFile string) {
@@ -7,11 +7,13 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/dagucloud/dagu/internal/cmn/fileutil"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -196,6 +198,7 @@ func TestLoad_Env(t *testing.T) {
 			EnvPassthroughPrefixes: []string{},
 			Peer:                   Peer{Insecure: true}, // Default is true
 			BaseEnv:                cfg.Core.BaseEnv,     // Dynamic, copy from actual
+			ParamsSchemaCacheTTL:   5 * time.Minute,
 		},
 		Server: Server{
 			Host:         "test.example.com",
@@ -291,11 +294,13 @@ func TestLoad_Env(t *testing.T) {
 		},
 		Queues: Queues{Enabled: false},
 		Coordinator: Coordinator{
-			Enabled:    true,
-			Host:       "0.0.0.0",
-			Advertise:  "dagu-coordinator",
-			Port:       50099,
-			HealthPort: 50101,
+			Enabled:          true,
+			Host:             "0.0.0.0",
+			Advertise:        "dagu-coordinator",
+			Port:             50099,
+			HealthPort:       50101,
+			HeartbeatTimeout: 30 * time.Second,
+			LeaseTimeout:     30 * time.Second,
 		},
 		Worker: Worker{
 			ID:            "test-worker-123",
@@ -629,7 +634,8 @@ scheduler:
 				SkipTLSVerify: false,
 				Insecure:      false,
 			},
-			BaseEnv: cfg.Core.BaseEnv, // Dynamic, copy from actual
+			BaseEnv:              cfg.Core.BaseEnv, // Dynamic, copy from actual
+			ParamsSchemaCacheTTL: 5 * time.Minute,
 		},
 		Server: Server{
 			Host:              "0.0.0.0",
@@ -741,10 +747,12 @@ scheduler:
 			},
 		},
 		Coordinator: Coordinator{
-			Enabled:    true,
-			Host:       "coordinator.example.com",
-			Port:       8081,
-			HealthPort: 8091,
+			Enabled:          true,
+			Host:             "coordinator.example.com",
+			Port:             8081,
+			HealthPort:       8091,
+			HeartbeatTimeout: 30 * time.Second,
+			LeaseTimeout:     30 * time.Second,
 		},
 		Worker: Worker{
 			ID:            "worker-1",
@@ -839,6 +847,65 @@ worker:
 	})
 }
 
+func TestLoad_EdgeCases_CoordinatorEviction(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		cfg := loadFromYAML(t, "# empty")
+		assert.Equal(t, 30*time.Second, cfg.Coordinator.HeartbeatTimeout)
+		assert.Equal(t, 30*time.Second, cfg.Coordinator.LeaseTimeout)
+		assert.False(t, cfg.Coordinator.RequeueEvictedRuns)
+	})
+
+	t.Run("FromYAML", func(t *testing.T) {
+		cfg := loadFromYAML(t, `
+coordinator:
+  heartbeat_timeout: 2m
+  lease_timeout: 90s
+  requeue_evicted_runs: true
+`)
+		assert.Equal(t, 2*time.Minute, cfg.Coordinator.HeartbeatTimeout)
+		assert.Equal(t, 90*time.Second, cfg.Coordinator.LeaseTimeout)
+		assert.True(t, cfg.Coordinator.RequeueEvictedRuns)
+	})
+
+	t.Run("FromEnvironment", func(t *testing.T) {
+		cfg := loadWithEnv(t, "# empty", map[string]string{
+			"DAGU_COORDINATOR_HEARTBEAT_TIMEOUT":    "45s",
+			"DAGU_COORDINATOR_LEASE_TIMEOUT":        "2m",
+			"DAGU_COORDINATOR_REQUEUE_EVICTED_RUNS": "true",
+		})
+		assert.Equal(t, 45*time.Second, cfg.Coordinator.HeartbeatTimeout)
+		assert.Equal(t, 2*time.Minute, cfg.Coordinator.LeaseTimeout)
+		assert.True(t, cfg.Coordinator.RequeueEvictedRuns)
+	})
+}
+
+func TestLoad_EdgeCases_WorkerTags(t *testing.T) {
+	t.Run("TagsFromString", func(t *testing.T) {
+		cfg := loadFromYAML(t, `
+worker:
+  tags: "gpu, heavy"
+`)
+		assert.Equal(t, []string{"gpu", "heavy"}, cfg.Worker.Tags)
+	})
+
+	t.Run("TagsFromList", func(t *testing.T) {
+		cfg := loadFromYAML(t, `
+worker:
+  tags:
+    - gpu
+    - heavy
+`)
+		assert.Equal(t, []string{"gpu", "heavy"}, cfg.Worker.Tags)
+	})
+
+	t.Run("TagsFromEnvironment", func(t *testing.T) {
+		cfg := loadWithEnv(t, "# empty", map[string]string{
+			"DAGU_WORKER_TAGS": "gpu",
+		})
+		assert.Equal(t, []string{"gpu"}, cfg.Worker.Tags)
+	})
+}
+
 func TestLoad_EdgeCases_DerivedPaths(t *testing.T) {
 	cfg := loadFromYAML(t, `
 paths:
@@ -1239,6 +1306,81 @@ func TestBindEnv_AsPath(t *testing.T) {
 	}
 }
 
+func TestBindEnv_DefinitionKeys(t *testing.T) {
+	keys := definitionKeys(reflect.TypeFor[Definition](), "")
+	assert.Contains(t, keys, "scheduler.failure_threshold")
+	assert.Contains(t, keys, "ui.dags.sort_field")
+	assert.NotContains(t, keys, "scheduler")
+
+	names := make(map[string]string, len(keys))
+	for _, key := range keys {
+		name := envNameForKey(key)
+		prev, dup := names[name]
+		assert.False(t, dup, "keys %s and %s share env var %s", prev, key, name)
+		names[name] = key
+	}
+	assert.Equal(t, "DAGU_SCHEDULER_FAILURE_THRESHOLD", envNameForKey("scheduler.failure_threshold"))
+}
+
+func TestBindEnv_DefinitionKeysSquash(t *testing.T) {
+	type shared struct {
+		Host string `mapstructure:"host"`
+		Port int    `mapstructure:"port"`
+	}
+	type server struct {
+		shared `mapstructure:",squash"`
+		TLS    *struct {
+			shared `mapstructure:",squash"`
+		} `mapstructure:"tls"`
+		Name string `mapstructure:"name"`
+	}
+	type definition struct {
+		Server server `mapstructure:"server"`
+	}
+
+	keys := definitionKeys(reflect.TypeFor[definition](), "")
+	assert.ElementsMatch(t, []string{
+		"server.host",
+		"server.port",
+		"server.tls.host",
+		"server.tls.port",
+		"server.name",
+	}, keys)
+}
+
+func TestLoad_OverridePrecedence(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte("scheduler:\n  failure_threshold: 5\n"), 0600))
+
+	load := func(t *testing.T, flagValue string) *Config {
+		t.Helper()
+		v := viper.New()
+		if flagValue != "" {
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			flags.Int("failure-threshold", 0, "")
+			require.NoError(t, flags.Set("failure-threshold", flagValue))
+			require.NoError(t, v.BindPFlag("scheduler.failure_threshold", flags.Lookup("failure-threshold")))
+		}
+		cfg, err := NewConfigLoader(v, WithAppHomeDir(t.TempDir()), WithConfigFile(configFile)).Load()
+		require.NoError(t, err)
+		return cfg
+	}
+
+	t.Run("FileOverridesDefault", func(t *testing.T) {
+		assert.Equal(t, 5, load(t, "").Scheduler.FailureThreshold)
+	})
+
+	t.Run("EnvOverridesFile", func(t *testing.T) {
+		t.Setenv("DAGU_SCHEDULER_FAILURE_THRESHOLD", "7")
+		assert.Equal(t, 7, load(t, "").Scheduler.FailureThreshold)
+	})
+
+	t.Run("FlagOverridesEnv", func(t *testing.T) {
+		t.Setenv("DAGU_SCHEDULER_FAILURE_THRESHOLD", "7")
+		assert.Equal(t, 9, load(t, "9").Scheduler.FailureThreshold)
+	})
+}
+
 func TestLoad_BotInterestedEventTypesEnvOverridesConfig(t *testing.T) {
 	t.Run("telegram env overrides config", func(t *testing.T) {
 		cfg := loadWithEnv(t, `
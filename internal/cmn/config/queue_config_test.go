@@ -6,6 +6,7 @@ package config
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
@@ -45,3 +46,31 @@ queues:
 	require.Equal(t, "HighLoad", cfg.Queues.Config[1].Name)
 	require.Equal(t, 2, cfg.Queues.Config[1].MaxActiveRuns)
 }
+
+func TestQueueConfigLoading_StartRateLimit(t *testing.T) {
+	yaml := `
+queues:
+  enabled: true
+  config:
+    - name: paced
+      max_concurrency: 5
+      max_starts_per_interval: 10
+      interval: 1m
+    - name: missing-interval
+      max_starts_per_interval: 10
+`
+	v := viper.New()
+	v.SetConfigType("yaml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(yaml)))
+
+	loader := NewConfigLoader(v, WithService(ServiceScheduler), WithAppHomeDir(t.TempDir()))
+	cfg, err := loader.Load()
+	require.NoError(t, err)
+
+	require.Len(t, cfg.Queues.Config, 2)
+	require.Equal(t, 10, cfg.Queues.Config[0].MaxStartsPerInterval)
+	require.Equal(t, time.Minute, cfg.Queues.Config[0].Interval)
+
+	require.Zero(t, cfg.Queues.Config[1].MaxStartsPerInterval)
+	require.Contains(t, strings.Join(cfg.Warnings, "\n"), "missing-interval")
+}
@@ -5,6 +5,8 @@ package eval
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
 	"strings"
 
@@ -12,6 +14,10 @@ import (
 	"mvdan.cc/sh/v3/syntax"
 )
 
+// ErrRequiredVariable is returned when a ${VAR:?message} or ${VAR?message}
+// expression names a variable that is unset, or empty for the ":?" form.
+var ErrRequiredVariable = errors.New("required variable is not set")
+
 // ExpandEnvContext expands ${VAR} and $VAR in s using EnvScope from context,
 // falling back to os.LookupEnv if no scope in context.
 // Variables not found are preserved in their original form.
@@ -64,13 +70,44 @@ func extractPOSIXVarName(inner string) string {
 	}
 	for i, c := range inner {
 		switch c {
-		case ':', '-', '+', '=', '?', '#', '%', '/', '.':
+		case ':', '-', '+', '=', '?', '#', '%', '/', '.', '^', ',':
 			return inner[:i]
 		}
 	}
 	return inner
 }
 
+// splitCaseModifier splits a trailing ^^ (uppercase) or ,, (lowercase)
+// modifier off the inner text of ${...}. The modifier applies after any
+// substring or default operator, so ${VAR:-x^^} upper-cases the default too.
+// ok is false when a case operator appears in any other position, such as
+// ${VAR^^:0:3} or ${VAR,,^^}; such expressions are left unexpanded.
+func splitCaseModifier(inner, varName string) (base, modifier string, ok bool) {
+	base = inner
+	if strings.HasSuffix(inner, "^^") || strings.HasSuffix(inner, ",,") {
+		base, modifier = inner[:len(inner)-2], inner[len(inner)-2:]
+	}
+	if rest, found := strings.CutPrefix(base, varName); found && rest != "" && (rest[0] == '^' || rest[0] == ',') {
+		return "", "", false
+	}
+	if modifier != "" && (strings.HasSuffix(base, "^") || strings.HasSuffix(base, ",")) {
+		return "", "", false
+	}
+	return base, modifier, true
+}
+
+// applyCaseModifier applies a modifier returned by splitCaseModifier.
+func applyCaseModifier(value, modifier string) string {
+	switch modifier {
+	case "^^":
+		return strings.ToUpper(value)
+	case ",,":
+		return strings.ToLower(value)
+	default:
+		return value
+	}
+}
+
 // expandPOSIXExpression expands a single POSIX expression (e.g. "${VAR:0:3}")
 // using the mvdan.cc/sh shell parser and expander.
 func expandPOSIXExpression(expr string, env *shellEnviron) (string, error) {
@@ -81,13 +118,23 @@ func expandPOSIXExpression(expr string, env *shellEnviron) (string, error) {
 	if word == nil {
 		return "", nil
 	}
-	return expand.Literal(&expand.Config{Env: env}, word)
+	value, err := expand.Literal(&expand.Config{Env: env}, word)
+	var unset expand.UnsetParameterError
+	if errors.As(err, &unset) {
+		message := unset.Message
+		if message == "" {
+			message = "parameter null or not set"
+		}
+		return "", fmt.Errorf("%w: %s: %s", ErrRequiredVariable, unset.Node.Param.Value, message)
+	}
+	return value, err
 }
 
 // expandWithShellContext performs selective POSIX shell-style variable expansion.
 // For each variable expression in the input:
 //   - Defined variables with POSIX operators (e.g. ${VAR:0:3}) are expanded via mvdan.cc/sh.
 //   - Defined simple variables (${VAR}, $VAR) are resolved directly.
+//   - A trailing ^^ or ,, upper- or lower-cases the result (e.g. ${VAR^^}).
 //   - When ExpandOS is false (default): undefined variables are preserved for later OS shell evaluation.
 //   - When ExpandOS is true: undefined variables follow POSIX rules (empty, defaults applied, etc.).
 //   - Single-quoted variables are preserved as-is.
@@ -121,12 +168,21 @@ func expandWithShellContext(ctx context.Context, input string, opts *Options) (s
 		}
 
 		// Extract variable name and detect POSIX operators.
-		var varName string
+		var varName, expr, caseModifier string
 		var hasPOSIXOp bool
 		if loc[2] >= 0 { // Group 1: ${...}
 			inner := input[loc[2]:loc[3]]
 			varName = extractPOSIXVarName(inner)
-			hasPOSIXOp = inner != varName
+			base, modifier, ok := splitCaseModifier(inner, varName)
+			if !ok {
+				b.WriteString(match)
+				continue
+			}
+			expr, caseModifier = match, modifier
+			if modifier != "" {
+				expr = "${" + base + "}"
+			}
+			hasPOSIXOp = base != varName
 		} else { // Group 2: $VAR
 			varName = input[loc[4]:loc[5]]
 		}
@@ -141,13 +197,13 @@ func expandWithShellContext(ctx context.Context, input string, opts *Options) (s
 
 		// POSIX operator present: expand via shell parser.
 		if hasPOSIXOp {
-			expanded, err := expandPOSIXExpression(match, env)
+			expanded, err := expandPOSIXExpression(expr, env)
 			if err != nil {
 				return "", err
 			}
-			b.WriteString(expanded)
+			b.WriteString(applyCaseModifier(expanded, caseModifier))
 		} else {
-			b.WriteString(val)
+			b.WriteString(applyCaseModifier(val, caseModifier))
 		}
 	}
 	b.WriteString(input[last:])
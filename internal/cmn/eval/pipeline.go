@@ -5,6 +5,7 @@ package eval
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -124,11 +125,15 @@ func regexExpandEnv(ctx context.Context, input string, opts *Options) string {
 // When ExpandShell is true (default), uses selective POSIX expansion via mvdan.cc/sh;
 // defined variables with POSIX operators are expanded, undefined variables are preserved.
 // When ExpandShell is false or POSIX expansion fails, falls back to regex-based expansion.
+// A failed ${VAR:?message} expression is reported as ErrRequiredVariable instead.
 func shellExpandPhase(ctx context.Context, input string, opts *Options) (string, error) {
 	if !opts.ExpandShell {
 		return regexExpandEnv(ctx, input, opts), nil
 	}
 	expanded, err := expandWithShellContext(ctx, input, opts)
+	if errors.Is(err, ErrRequiredVariable) {
+		return "", err
+	}
 	if err != nil {
 		return regexExpandEnv(ctx, input, opts), nil
 	}
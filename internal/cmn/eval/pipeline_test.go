@@ -93,14 +93,14 @@ func TestShellExpandPhase_FallbackOnError(t *testing.T) {
 	assert.Contains(t, result, "value123")
 }
 
-func TestShellExpandPhase_NonCommandError(t *testing.T) {
+func TestShellExpandPhase_RequiredVariableError(t *testing.T) {
 	ctx := context.Background()
 	opts := NewOptions()
 	opts.ExpandOS = true
 
-	result, err := shellExpandPhase(ctx, "${UNSET_XYZ_99:?required}", opts)
-	require.NoError(t, err)
-	assert.Contains(t, result, "UNSET_XYZ_99")
+	_, err := shellExpandPhase(ctx, "${UNSET_XYZ_99:?required}", opts)
+	require.ErrorIs(t, err, ErrRequiredVariable)
+	assert.Contains(t, err.Error(), "UNSET_XYZ_99: required")
 }
 
 func TestShellExpandPhase_ShellDisabledWithExpandOS(t *testing.T) {
@@ -115,15 +115,14 @@ func TestShellExpandPhase_ShellDisabledWithExpandOS(t *testing.T) {
 	assert.Equal(t, "os_val", result)
 }
 
-func TestShellExpandPhase_ErrorFallbackWithoutExpandOS(t *testing.T) {
+func TestShellExpandPhase_RequiredEmptyVariableWithoutExpandOS(t *testing.T) {
 	ctx := context.Background()
 	opts := NewOptions()
 	opts.Variables = []map[string]string{{"VAR": ""}}
 
-	// :? treats empty as unset, triggering an error that falls back to expandEnvScopeOnly
-	result, err := shellExpandPhase(ctx, "${VAR:?required}", opts)
-	require.NoError(t, err)
-	assert.Equal(t, "${VAR:?required}", result)
+	// :? treats a defined but empty variable as missing.
+	_, err := shellExpandPhase(ctx, "${VAR:?required}", opts)
+	require.ErrorIs(t, err, ErrRequiredVariable)
 }
 
 func TestPipeline_DisabledPhases(t *testing.T) {
@@ -670,6 +669,81 @@ func TestString_POSIXWithUserVariables(t *testing.T) {
 	}
 }
 
+func TestString_POSIXCaseModification(t *testing.T) {
+	vars := map[string]string{"NAME": "Hello World", "EMPTY": ""}
+	tests := []struct {
+		name  string
+		input string
+		osEnv bool
+		want  string
+	}{
+		{name: "Uppercase", input: "${NAME^^}", want: "HELLO WORLD"},
+		{name: "Lowercase", input: "${NAME,,}", want: "hello world"},
+		{name: "AfterSubstring", input: "${NAME:0:5^^}", want: "HELLO"},
+		{name: "AfterDefault", input: "${EMPTY:-Fallback,,}", want: "fallback"},
+		{name: "DefaultNotUsed", input: "${NAME:-fallback^^}", want: "HELLO WORLD"},
+		{name: "UndefinedPreserved", input: "${MISSING^^}", want: "${MISSING^^}"},
+		{name: "UndefinedWithOSExpansion", input: "[${DAGU_TEST_UNSET_NAME:-Fallback^^}]", osEnv: true, want: "[FALLBACK]"},
+		{name: "ModifierBeforeSubstringPreserved", input: "${NAME^^:0:5}", want: "${NAME^^:0:5}"},
+		{name: "RepeatedModifierPreserved", input: "${NAME,,^^}", want: "${NAME,,^^}"},
+		{name: "TripleCaretPreserved", input: "${NAME^^^}", want: "${NAME^^^}"},
+		{name: "PatternPreserved", input: "${NAME^^x}", want: "${NAME^^x}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := []Option{WithVariables(vars)}
+			if tt.osEnv {
+				opts = append(opts, WithOSExpansion())
+			}
+			result, err := String(context.Background(), tt.input, opts...)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
+func TestString_POSIXRequiredVariable(t *testing.T) {
+	t.Run("SetVariable", func(t *testing.T) {
+		result, err := String(context.Background(), "https://${DOMAIN:?domain is required}/",
+			WithVariables(map[string]string{"DOMAIN": "example.com"}))
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/", result)
+	})
+
+	t.Run("EmptyVariable", func(t *testing.T) {
+		_, err := String(context.Background(), "${DOMAIN:?domain is required}",
+			WithVariables(map[string]string{"DOMAIN": ""}))
+		require.ErrorIs(t, err, ErrRequiredVariable)
+		assert.Contains(t, err.Error(), "DOMAIN: domain is required")
+	})
+
+	t.Run("EmptyVariableWithoutColonIsAllowed", func(t *testing.T) {
+		result, err := String(context.Background(), "[${DOMAIN?domain is required}]",
+			WithVariables(map[string]string{"DOMAIN": ""}))
+		require.NoError(t, err)
+		assert.Equal(t, "[]", result)
+	})
+
+	t.Run("UnsetVariableWithOSExpansion", func(t *testing.T) {
+		_, err := String(context.Background(), "${DAGU_TEST_UNSET_DOMAIN:?domain is required}", WithOSExpansion())
+		require.ErrorIs(t, err, ErrRequiredVariable)
+		assert.Contains(t, err.Error(), "DAGU_TEST_UNSET_DOMAIN: domain is required")
+	})
+
+	t.Run("DefaultMessage", func(t *testing.T) {
+		_, err := String(context.Background(), "${DAGU_TEST_UNSET_DOMAIN:?}", WithOSExpansion())
+		require.ErrorIs(t, err, ErrRequiredVariable)
+		assert.Contains(t, err.Error(), "parameter null or not set")
+	})
+
+	t.Run("UnsetVariablePreservedWithoutOSExpansion", func(t *testing.T) {
+		result, err := String(context.Background(), "${DAGU_TEST_UNSET_DOMAIN:?domain is required}")
+		require.NoError(t, err)
+		assert.Equal(t, "${DAGU_TEST_UNSET_DOMAIN:?domain is required}", result)
+	})
+}
+
 func TestString_POSIXWithScope(t *testing.T) {
 	scope := NewEnvScope(nil, false).
 		WithEntry("SCOPE_VAR", "HelloWorld", EnvSourceDAGEnv)
@@ -25,7 +25,7 @@ func resolveJSONPath(ctx context.Context, varName, jsonStr, path string) (string
 	if !ok {
 		return "", false
 	}
-	return stringifyResolvedValue(value), true
+	return StringifyResolvedValue(value), true
 }
 
 func parseJSONValue(ctx context.Context, varName, jsonStr string) (any, bool) {
@@ -49,8 +49,21 @@ func ResolveDataPath(ctx context.Context, varName string, raw any, path string)
 			tag.Error(err))
 		return nil, false
 	}
+	code, err := gojq.Compile(query)
+	if err != nil {
+		logger.Warn(ctx, "Failed to compile path in data",
+			tag.Path(path),
+			slog.String("var", varName),
+			tag.Error(err))
+		return nil, false
+	}
+	return RunDataPath(ctx, varName, raw, path, code)
+}
 
-	iter := query.Run(raw)
+// RunDataPath is ResolveDataPath for a path compiled ahead of time. path is
+// only used for logging.
+func RunDataPath(ctx context.Context, varName string, raw any, path string, code *gojq.Code) (any, bool) {
+	iter := code.Run(raw)
 	v, ok := iter.Next()
 	if !ok {
 		return nil, false
@@ -67,7 +80,9 @@ func ResolveDataPath(ctx context.Context, varName string, raw any, path string)
 	return v, true
 }
 
-func stringifyResolvedValue(value any) string {
+// StringifyResolvedValue renders a value resolved from a data path as a
+// string. Maps, slices and arrays are rendered as JSON.
+func StringifyResolvedValue(value any) string {
 	if value == nil {
 		return fmt.Sprintf("%v", value)
 	}
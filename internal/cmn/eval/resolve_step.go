@@ -18,6 +18,8 @@ type StepInfo struct {
 	Stdout   string
 	Stderr   string
 	ExitCode string
+	Duration string  // Seconds between start and finish; empty until the step finishes
+	Status   string  // Node status name (e.g. "succeeded"); empty until the step starts
 	Output   *string // nil = no output: configured; non-nil = captured value (may be "")
 }
 
@@ -62,6 +64,18 @@ func resolveStepProperty(ctx context.Context, stepName, path string, stepMap map
 		value = stepInfo.Stderr
 	case ".exitCode", ".exit_code":
 		value = stepInfo.ExitCode
+	case ".duration":
+		if stepInfo.Duration == "" {
+			logger.Debug(ctx, "Step has not finished", tag.Step(stepName))
+			return "", false
+		}
+		value = stepInfo.Duration
+	case ".status":
+		if stepInfo.Status == "" {
+			logger.Debug(ctx, "Step has no status", tag.Step(stepName))
+			return "", false
+		}
+		value = stepInfo.Status
 	case ".output":
 		if stepInfo.Output == nil {
 			logger.Debug(ctx, "Step has no output configured", tag.Step(stepName))
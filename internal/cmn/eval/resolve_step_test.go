@@ -51,6 +51,36 @@ func TestResolveStepProperty(t *testing.T) {
 			stepMap: map[string]StepInfo{"step1": {Stdout: "out", Stderr: "", ExitCode: "0"}},
 			wantOK:  false,
 		},
+		{
+			name:    "ValidDuration",
+			step:    "step1",
+			path:    ".duration",
+			stepMap: map[string]StepInfo{"step1": {Duration: "1.250", Status: "succeeded"}},
+			wantOK:  true,
+			wantVal: "1.250",
+		},
+		{
+			name:    "DurationNotFinished",
+			step:    "step1",
+			path:    ".duration",
+			stepMap: map[string]StepInfo{"step1": {Status: "running"}},
+			wantOK:  false,
+		},
+		{
+			name:    "ValidStatus",
+			step:    "step1",
+			path:    ".status",
+			stepMap: map[string]StepInfo{"step1": {Status: "failed"}},
+			wantOK:  true,
+			wantVal: "failed",
+		},
+		{
+			name:    "EmptyStatus",
+			step:    "step1",
+			path:    ".status",
+			stepMap: map[string]StepInfo{"step1": {ExitCode: "0"}},
+			wantOK:  false,
+		},
 		{
 			name:    "ValidOutput",
 			step:    "step1",
@@ -941,6 +941,24 @@ func TestExpandReferencesWithSteps_Extended(t *testing.T) {
 			},
 			want: "Invalid: ${step1.stdout:-1:2}",
 		},
+		{
+			name:    "StepStatusTakesPrecedenceOverVariable",
+			input:   "${check.status} ${check.duration}",
+			dataMap: map[string]string{"check": `{"status":"from-variable","duration":"from-variable"}`},
+			stepMap: map[string]StepInfo{
+				"check": {Status: "succeeded", Duration: "1.250"},
+			},
+			want: "succeeded 1.250",
+		},
+		{
+			name:    "StepStatusWhenVariableLacksField",
+			input:   "${check.status} ${check.duration}",
+			dataMap: map[string]string{"check": `{"other":"value"}`},
+			stepMap: map[string]StepInfo{
+				"check": {Status: "succeeded", Duration: "1.250"},
+			},
+			want: "succeeded 1.250",
+		},
 	}
 
 	for _, tt := range tests {
@@ -52,6 +52,49 @@ func (r *FileResolver) ResolveFilePath(file string) (string, error) {
 	}
 }
 
+// ResolveGlob returns the files matching pattern, sorted by name. Relative
+// patterns are tried against each location in order and the first location
+// with matches wins, mirroring ResolveFilePath.
+func (r *FileResolver) ResolveGlob(pattern string) ([]string, error) {
+	if filepath.IsAbs(pattern) || strings.HasPrefix(pattern, "~") {
+		resolved, err := ResolvePath(pattern)
+		if err != nil {
+			return nil, err
+		}
+		return globFiles(resolved)
+	}
+
+	searchPaths, err := r.getSearchPaths(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("getting search paths: %w", err)
+	}
+	for _, path := range searchPaths {
+		matches, err := globFiles(path)
+		if err != nil {
+			return nil, err
+		}
+		if len(matches) > 0 {
+			return matches, nil
+		}
+	}
+	return nil, nil
+}
+
+// globFiles returns the regular files matching pattern in lexical order.
+func globFiles(pattern string) ([]string, error) {
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+	files := matches[:0]
+	for _, match := range matches {
+		if FileExists(match) && !IsDir(match) {
+			files = append(files, match)
+		}
+	}
+	return files, nil
+}
+
 // getSearchPaths returns a list of paths to search for the file
 func (r *FileResolver) getSearchPaths(file string) ([]string, error) {
 	var paths []string
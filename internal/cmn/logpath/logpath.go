@@ -8,8 +8,11 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"text/template"
 	"time"
 
+	"github.com/dagucloud/dagu/internal/cmn/config"
 	"github.com/dagucloud/dagu/internal/cmn/eval"
 	"github.com/dagucloud/dagu/internal/cmn/fileutil"
 	"github.com/dagucloud/dagu/internal/cmn/stringutil"
@@ -40,13 +43,23 @@ func Generate(ctx context.Context, baseLogDir, dagLogDir, dagName, dagRunID stri
 }
 
 // GenerateDir expands the configured directories, creates the DAG-run
-// directory if needed, and returns the per-run directory path.
+// directory if needed, and returns the per-run directory path. Date tokens
+// use the current time in the configured timezone.
 func GenerateDir(ctx context.Context, baseDir, dagDir, dagName, dagRunID string) (string, error) {
+	startedAt := time.Now()
+	if loc := config.GetConfig(ctx).Core.Location; loc != nil {
+		startedAt = startedAt.In(loc)
+	}
+
 	baseDir, err := eval.String(ctx, baseDir, eval.WithOSExpansion())
 	if err != nil {
 		return "", fmt.Errorf("failed to expand base directory: %w", err)
 	}
 
+	dagDir, err = expandDirTokens(dagDir, dagName, dagRunID, startedAt)
+	if err != nil {
+		return "", err
+	}
 	dagDir, err = eval.String(ctx, dagDir, eval.WithOSExpansion())
 	if err != nil {
 		return "", fmt.Errorf("failed to expand DAG directory: %w", err)
@@ -63,7 +76,7 @@ func GenerateDir(ctx context.Context, baseDir, dagDir, dagName, dagRunID string)
 		return "", fmt.Errorf("invalid run directory settings: %w", err)
 	}
 
-	dir, err := cfg.RunDir()
+	dir, err := cfg.runDir(startedAt)
 	if err != nil {
 		return "", fmt.Errorf("failed to setup run directory: %w", err)
 	}
@@ -71,6 +84,37 @@ func GenerateDir(ctx context.Context, baseDir, dagDir, dagName, dagRunID string)
 	return dir, nil
 }
 
+// dirTokens are the values available to {{...}} tokens in a DAG directory.
+type dirTokens struct {
+	Date    string // date of the run start, such as 2026-01-02
+	DAGName string // DAG name made safe for use in a path
+	RunID   string // DAG-run ID
+}
+
+// expandDirTokens resolves the {{.Date}}, {{.DAGName}} and {{.RunID}} tokens
+// in a DAG directory, so that runs can be organized by day or DAG. A
+// directory without tokens is returned unchanged. The date is taken in the
+// location of startedAt.
+func expandDirTokens(dir, dagName, dagRunID string, startedAt time.Time) (string, error) {
+	if !strings.Contains(dir, "{{") {
+		return dir, nil
+	}
+	tmpl, err := template.New("dir").Option("missingkey=error").Parse(dir)
+	if err != nil {
+		return "", fmt.Errorf("invalid DAG directory template %q: %w", dir, err)
+	}
+	var b strings.Builder
+	err = tmpl.Execute(&b, dirTokens{
+		Date:    startedAt.Format(time.DateOnly),
+		DAGName: fileutil.SafeName(dagName),
+		RunID:   dagRunID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to expand DAG directory template %q: %w", dir, err)
+	}
+	return b.String(), nil
+}
+
 // Validate checks that essential fields are provided.
 func (cfg Config) Validate() error {
 	if cfg.Name == "" {
@@ -84,6 +128,11 @@ func (cfg Config) Validate() error {
 
 // RunDir creates and returns the per-run directory based on the configuration.
 func (cfg Config) RunDir() (string, error) {
+	return cfg.runDir(time.Now())
+}
+
+// runDir creates the per-run directory named after startedAt.
+func (cfg Config) runDir(startedAt time.Time) (string, error) {
 	baseDir := cfg.BaseDir
 	if cfg.DAGLogDir != "" {
 		baseDir = cfg.DAGLogDir
@@ -92,7 +141,7 @@ func (cfg Config) RunDir() (string, error) {
 		return "", fmt.Errorf("base log directory is not set")
 	}
 
-	utcTimestamp := time.Now().UTC().Format("20060102_150405Z")
+	utcTimestamp := startedAt.UTC().Format("20060102_150405Z")
 
 	safeName := fileutil.SafeName(cfg.Name)
 	logDir := filepath.Join(baseDir, safeName, "dag-run_"+utcTimestamp+"_"+cfg.DAGRunID)
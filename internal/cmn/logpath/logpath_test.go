@@ -0,0 +1,108 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package logpath
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dagucloud/dagu/internal/cmn/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandDirTokens(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 7, 23, 30, 0, 0, time.FixedZone("UTC-2", -2*60*60))
+
+	tests := []struct {
+		name    string
+		dir     string
+		want    string
+		wantErr string
+	}{
+		{
+			name: "NoTokens",
+			dir:  "/var/log/dagu",
+			want: "/var/log/dagu",
+		},
+		{
+			name: "DAGNameAndDate",
+			dir:  "/var/log/dagu/{{.DAGName}}/{{.Date}}",
+			want: "/var/log/dagu/etl/2026-03-07",
+		},
+		{
+			name: "RunID",
+			dir:  "/var/log/dagu/{{.RunID}}",
+			want: "/var/log/dagu/run-1",
+		},
+		{
+			name:    "UnknownToken",
+			dir:     "/var/log/dagu/{{.Host}}",
+			wantErr: "failed to expand DAG directory template",
+		},
+		{
+			name:    "InvalidTemplate",
+			dir:     "/var/log/dagu/{{.Date",
+			wantErr: "invalid DAG directory template",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandDirTokens(tt.dir, "etl", "run-1", now)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGenerate_DAGLogDirTokens(t *testing.T) {
+	t.Parallel()
+
+	base := t.TempDir()
+	dagLogDir := filepath.Join(base, "{{.DAGName}}", "{{.Date}}")
+
+	logFile, err := Generate(context.Background(), "", dagLogDir, "my etl", "run-1")
+	require.NoError(t, err)
+
+	rel, err := filepath.Rel(base, logFile)
+	require.NoError(t, err)
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+	require.Len(t, parts, 5)
+	assert.Equal(t, "my_etl", parts[0])
+	_, err = time.Parse(time.DateOnly, parts[1])
+	require.NoError(t, err, "unexpected date directory %q", parts[1])
+	assert.Equal(t, "my_etl", parts[2])
+	assert.True(t, strings.HasPrefix(parts[3], "dag-run_"), "unexpected run directory %q", parts[3])
+	assert.DirExists(t, filepath.Dir(logFile))
+}
+
+func TestGenerateDir_DateUsesConfiguredTimezone(t *testing.T) {
+	t.Parallel()
+
+	loc := time.FixedZone("UTC+14", 14*60*60)
+	ctx := config.WithConfig(context.Background(), &config.Config{Core: config.Core{Location: loc}})
+	base := t.TempDir()
+
+	before := time.Now().In(loc).Format(time.DateOnly)
+	dir, err := GenerateDir(ctx, "", filepath.Join(base, "{{.Date}}"), "etl", "run-1")
+	require.NoError(t, err)
+	after := time.Now().In(loc).Format(time.DateOnly)
+
+	rel, err := filepath.Rel(base, dir)
+	require.NoError(t, err)
+	date := strings.Split(filepath.ToSlash(rel), "/")[0]
+	assert.Contains(t, []string{before, after}, date)
+}
@@ -735,6 +735,70 @@ steps:
 	}
 }
 
+func TestDAGSchemaGraphQLStep(t *testing.T) {
+	t.Parallel()
+
+	resolved := mustResolveDAGSchema(t)
+
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr string
+	}{
+		{
+			name: "CanonicalWith",
+			spec: `
+steps:
+  - type: graphql
+    with:
+      url: https://api.example.com/graphql
+      query: "query($id: ID!) { user(id: $id) { name } }"
+      variables:
+        id: "42"
+      headers:
+        Authorization: Bearer token
+`,
+		},
+		{
+			name: "RejectMissingQuery",
+			spec: `
+steps:
+  - type: graphql
+    with:
+      url: https://api.example.com/graphql
+`,
+			wantErr: "steps",
+		},
+		{
+			name: "RejectUnknownField",
+			spec: `
+steps:
+  - type: graphql
+    with:
+      url: https://api.example.com/graphql
+      query: "{ viewer { login } }"
+      method: GET
+`,
+			wantErr: "steps",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc := mustParseYAMLDocument(t, tt.spec)
+			err := resolved.Validate(doc)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
 func TestDAGSchemaLogExecutorObjectRequiresMessage(t *testing.T) {
 	t.Parallel()
 
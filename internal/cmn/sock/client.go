@@ -75,12 +75,15 @@ func normalizePath(path string) string {
 	return "/" + path
 }
 
-// Request sends a request to the frontend and returns the response.
+// Request sends a request to the frontend and returns the response. path may
+// carry a query string, e.g. "/stop?signal=SIGUSR1".
 func (cl *Client) Request(method, path string) (string, error) {
+	path, query, _ := strings.Cut(path, "?")
 	requestURL := &url.URL{
-		Scheme: "http",
-		Host:   "unix",
-		Path:   normalizePath(path),
+		Scheme:   "http",
+		Host:     "unix",
+		Path:     normalizePath(path),
+		RawQuery: query,
 	}
 	request, err := http.NewRequest(method, requestURL.String(), nil)
 	if err != nil {
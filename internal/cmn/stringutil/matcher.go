@@ -7,6 +7,7 @@ import (
 	"bufio"
 	"context"
 	"errors"
+	"fmt"
 	"regexp"
 	"slices"
 	"strings"
@@ -42,6 +43,15 @@ func WithMaxBufferSize(size int) MatchOption {
 // MatchPattern matches content against patterns using either literal or regex matching.
 // For files or large content, use MatchPatternScanner instead.
 func MatchPattern(ctx context.Context, content string, patterns []string, opts ...MatchOption) bool {
+	if len(patterns) == 0 {
+		return false
+	}
+	return compilePatternsLenient(ctx, patterns).Match(ctx, content, opts...)
+}
+
+// Match reports whether any line of content matches the set. It is the
+// precompiled counterpart of MatchPattern.
+func (p *Patterns) Match(ctx context.Context, content string, opts ...MatchOption) bool {
 	// Apply options to get configuration
 	options := &matchOptions{
 		maxBufferSize: 1024 * 1024, // Default 1MB
@@ -53,7 +63,7 @@ func MatchPattern(ctx context.Context, content string, patterns []string, opts .
 	scanner := bufio.NewScanner(strings.NewReader(content))
 
 	// First try with default buffer
-	matched, err := matchPatternWithScanner(ctx, scanner, patterns, opts...)
+	matched, err := p.matchScanner(ctx, scanner, options)
 	if err == nil {
 		return matched
 	}
@@ -67,7 +77,7 @@ func MatchPattern(ctx context.Context, content string, patterns []string, opts .
 		// Use configured buffer size
 		buf := make([]byte, 0, 64*1024) // Start with 64KB buffer
 		scanner.Buffer(buf, options.maxBufferSize)
-		matched, _ = matchPatternWithScanner(ctx, scanner, patterns, opts...)
+		matched, _ = p.matchScanner(ctx, scanner, options)
 		return matched
 	}
 
@@ -79,6 +89,56 @@ func MatchPatternScanner(ctx context.Context, scanner *bufio.Scanner, patterns [
 	return matched
 }
 
+// ValidatePatterns checks that every "re:" prefixed pattern is a valid
+// regular expression. Literal patterns are always valid.
+func ValidatePatterns(patterns []string) error {
+	_, err := CompilePatterns(patterns)
+	return err
+}
+
+// Patterns is a set of literal and "re:" prefixed patterns whose regular
+// expressions are compiled once, for patterns matched repeatedly.
+type Patterns struct {
+	literals []string
+	regexps  []*regexp.Regexp
+}
+
+// CompilePatterns compiles the "re:" prefixed patterns and returns an error
+// for the first invalid regular expression.
+func CompilePatterns(patterns []string) (*Patterns, error) {
+	p := &Patterns{}
+	for _, pattern := range patterns {
+		if !strings.HasPrefix(pattern, rePrefix) {
+			p.literals = append(p.literals, pattern)
+			continue
+		}
+		re, err := regexp.Compile(strings.TrimPrefix(pattern, rePrefix))
+		if err != nil {
+			return nil, fmt.Errorf("invalid regexp pattern %q: %w", pattern, err)
+		}
+		p.regexps = append(p.regexps, re)
+	}
+	return p, nil
+}
+
+// Len returns the number of patterns in the set.
+func (p *Patterns) Len() int {
+	if p == nil {
+		return 0
+	}
+	return len(p.literals) + len(p.regexps)
+}
+
+// MatchScanner reports whether any line read from scanner matches the set.
+func (p *Patterns) MatchScanner(ctx context.Context, scanner *bufio.Scanner, opts ...MatchOption) bool {
+	options := &matchOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	matched, _ := p.matchScanner(ctx, scanner, options)
+	return matched
+}
+
 // matchPatternWithScanner is the internal implementation that returns both result and error
 func matchPatternWithScanner(ctx context.Context, scanner *bufio.Scanner, patterns []string, opts ...MatchOption) (bool, error) {
 	if len(patterns) == 0 {
@@ -91,10 +151,13 @@ func matchPatternWithScanner(ctx context.Context, scanner *bufio.Scanner, patter
 		opt(options)
 	}
 
-	var regexps []*regexp.Regexp
-	var literalPatterns []string
+	return compilePatternsLenient(ctx, patterns).matchScanner(ctx, scanner, options)
+}
 
-	// Compile regex patterns first
+// compilePatternsLenient compiles patterns, logging and skipping invalid
+// regular expressions.
+func compilePatternsLenient(ctx context.Context, patterns []string) *Patterns {
+	p := &Patterns{}
 	for _, pattern := range patterns {
 		switch {
 		case strings.HasPrefix(pattern, rePrefix):
@@ -105,11 +168,20 @@ func matchPatternWithScanner(ctx context.Context, scanner *bufio.Scanner, patter
 					tag.Error(err))
 				continue
 			}
-			regexps = append(regexps, re)
+			p.regexps = append(p.regexps, re)
 		default:
-			literalPatterns = append(literalPatterns, pattern)
+			p.literals = append(p.literals, pattern)
 		}
 	}
+	return p
+}
+
+// matchScanner matches each line read from scanner against the set.
+func (p *Patterns) matchScanner(ctx context.Context, scanner *bufio.Scanner, options *matchOptions) (bool, error) {
+	if p.Len() == 0 {
+		return false, nil
+	}
+	literalPatterns, regexps := p.literals, p.regexps
 
 	// Special case: if scanner is empty and we're looking for empty string
 	if !scanner.Scan() {
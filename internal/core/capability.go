@@ -0,0 +1,215 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// CapabilityOp is a comparison operator in a capability requirement.
+type CapabilityOp string
+
+const (
+	// CapabilityOpPresent requires the capability to be advertised.
+	CapabilityOpPresent CapabilityOp = ""
+	// CapabilityOpEqual requires the capability value to equal one of the
+	// listed values ("arch=amd64|arm64").
+	CapabilityOpEqual CapabilityOp = "="
+	CapabilityOpNotEq CapabilityOp = "!="
+	CapabilityOpGTE   CapabilityOp = ">="
+	CapabilityOpLTE   CapabilityOp = "<="
+	CapabilityOpGT    CapabilityOp = ">"
+	CapabilityOpLT    CapabilityOp = "<"
+)
+
+// capabilityValueSep separates alternative values in "=" and "!=" requirements.
+const capabilityValueSep = "|"
+
+// capabilityOps lists operators with two-character operators first so that
+// ">=" is not parsed as ">".
+var capabilityOps = []CapabilityOp{
+	CapabilityOpGTE, CapabilityOpLTE, CapabilityOpNotEq,
+	CapabilityOpEqual, CapabilityOpGT, CapabilityOpLT,
+}
+
+var capabilityKeyPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._/-]*$`)
+
+// Requirement is a capability a worker must advertise to run a DAG, such as
+// "gpu" or "cuda>=11". Workers advertise capabilities through their labels.
+type Requirement struct {
+	Key   string
+	Op    CapabilityOp
+	Value string
+}
+
+// ParseRequirement parses a requirement expression: a capability name
+// optionally followed by an operator (=, !=, >=, <=, >, <) and a value.
+func ParseRequirement(s string) (Requirement, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Requirement{}, fmt.Errorf("requirement must not be empty")
+	}
+
+	req := Requirement{Key: s}
+	for _, op := range capabilityOps {
+		if idx := strings.Index(s, string(op)); idx >= 0 {
+			req = Requirement{
+				Key:   strings.TrimSpace(s[:idx]),
+				Op:    op,
+				Value: strings.TrimSpace(s[idx+len(op):]),
+			}
+			break
+		}
+	}
+
+	if !capabilityKeyPattern.MatchString(req.Key) {
+		return Requirement{}, fmt.Errorf("invalid capability name in requirement %q", s)
+	}
+	if req.Op != CapabilityOpPresent && req.Value == "" {
+		return Requirement{}, fmt.Errorf("requirement %q is missing a value after %q", s, req.Op)
+	}
+	if req.isOrdering() {
+		if _, ok := parseCapabilityVersion(req.Value); !ok {
+			return Requirement{}, fmt.Errorf("requirement %q must compare against a version such as 11 or 11.8", s)
+		}
+	}
+	return req, nil
+}
+
+// String returns the requirement in its expression form.
+func (r Requirement) String() string {
+	return r.Key + string(r.Op) + r.Value
+}
+
+// SatisfiedBy reports whether a worker advertising the given capabilities
+// meets the requirement. A bare capability is not satisfied when the worker
+// advertises it as "false".
+func (r Requirement) SatisfiedBy(capabilities map[string]string) bool {
+	value, ok := capabilities[r.Key]
+	if !ok {
+		return false
+	}
+	value = strings.TrimSpace(value)
+
+	switch r.Op {
+	case CapabilityOpPresent:
+		return !strings.EqualFold(value, "false")
+	case CapabilityOpEqual:
+		return r.matchesAnyValue(value)
+	case CapabilityOpNotEq:
+		return !r.matchesAnyValue(value)
+	default:
+		have, ok := parseCapabilityVersion(value)
+		if !ok {
+			return false
+		}
+		want, _ := parseCapabilityVersion(r.Value)
+		cmp := compareCapabilityVersions(have, want)
+		switch r.Op {
+		case CapabilityOpGTE:
+			return cmp >= 0
+		case CapabilityOpLTE:
+			return cmp <= 0
+		case CapabilityOpGT:
+			return cmp > 0
+		case CapabilityOpLT:
+			return cmp < 0
+		}
+		return false
+	}
+}
+
+func (r Requirement) isOrdering() bool {
+	switch r.Op {
+	case CapabilityOpGTE, CapabilityOpLTE, CapabilityOpGT, CapabilityOpLT:
+		return true
+	default:
+		return false
+	}
+}
+
+// matchesAnyValue reports whether value equals one of the requirement's
+// "|"-separated values. Versions compare numerically, so "11" equals "11.0".
+func (r Requirement) matchesAnyValue(value string) bool {
+	have, haveVersion := parseCapabilityVersion(value)
+	for want := range strings.SplitSeq(r.Value, capabilityValueSep) {
+		want = strings.TrimSpace(want)
+		if strings.EqualFold(value, want) {
+			return true
+		}
+		if wantVersion, ok := parseCapabilityVersion(want); ok && haveVersion &&
+			compareCapabilityVersions(have, wantVersion) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseRequirements parses a list of requirement expressions.
+func ParseRequirements(exprs []string) ([]Requirement, error) {
+	reqs := make([]Requirement, 0, len(exprs))
+	for _, expr := range exprs {
+		req, err := ParseRequirement(expr)
+		if err != nil {
+			return nil, err
+		}
+		reqs = append(reqs, req)
+	}
+	return reqs, nil
+}
+
+// CapabilitiesSatisfy reports whether a worker advertising capabilities meets
+// every requirement expression. Invalid expressions are never satisfied.
+func CapabilitiesSatisfy(capabilities map[string]string, requires []string) bool {
+	for _, expr := range requires {
+		req, err := ParseRequirement(expr)
+		if err != nil || !req.SatisfiedBy(capabilities) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseCapabilityVersion parses a dotted numeric version such as "11",
+// "11.8" or "v12.2.1".
+func parseCapabilityVersion(s string) ([]int, bool) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if s == "" {
+		return nil, false
+	}
+	parts := strings.Split(s, ".")
+	version := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return nil, false
+		}
+		version[i] = n
+	}
+	return version, true
+}
+
+// compareCapabilityVersions compares two versions segment by segment,
+// treating missing segments as zero.
+func compareCapabilityVersions(a, b []int) int {
+	for i := range max(len(a), len(b)) {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/dagucloud/dagu/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRequirement(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    core.Requirement
+		wantErr bool
+	}{
+		{name: "Bare", input: "gpu", want: core.Requirement{Key: "gpu"}},
+		{name: "GreaterOrEqual", input: "cuda>=11", want: core.Requirement{Key: "cuda", Op: core.CapabilityOpGTE, Value: "11"}},
+		{name: "LessThan", input: " cuda < 12.2 ", want: core.Requirement{Key: "cuda", Op: core.CapabilityOpLT, Value: "12.2"}},
+		{name: "NotEqual", input: "os!=windows", want: core.Requirement{Key: "os", Op: core.CapabilityOpNotEq, Value: "windows"}},
+		{name: "EqualSet", input: "arch=amd64|arm64", want: core.Requirement{Key: "arch", Op: core.CapabilityOpEqual, Value: "amd64|arm64"}},
+		{name: "Empty", input: "  ", wantErr: true},
+		{name: "MissingValue", input: "cuda>=", wantErr: true},
+		{name: "MissingKey", input: ">=11", wantErr: true},
+		{name: "NonVersionOrdering", input: "cuda>=latest", wantErr: true},
+		{name: "InvalidKey", input: "gpu card", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := core.ParseRequirement(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRequirement_SatisfiedBy(t *testing.T) {
+	t.Parallel()
+
+	capabilities := map[string]string{
+		"gpu":    "true",
+		"cuda":   "11.8",
+		"arch":   "arm64",
+		"driver": "v535.104",
+		"fpga":   "false",
+	}
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		// Set membership
+		{expr: "gpu", want: true},
+		{expr: "tpu", want: false},
+		{expr: "fpga", want: false},
+		{expr: "arch=arm64", want: true},
+		{expr: "arch=ARM64", want: true},
+		{expr: "arch=amd64|arm64", want: true},
+		{expr: "arch=amd64", want: false},
+		{expr: "arch!=amd64", want: true},
+		{expr: "arch!=amd64|arm64", want: false},
+		// Version comparisons
+		{expr: "cuda>=11", want: true},
+		{expr: "cuda>=11.8", want: true},
+		{expr: "cuda>=12", want: false},
+		{expr: "cuda>11.8", want: false},
+		{expr: "cuda>11.7.9", want: true},
+		{expr: "cuda<12", want: true},
+		{expr: "cuda<=11.8.0", want: true},
+		{expr: "cuda=11.8.0", want: true},
+		{expr: "driver>=535", want: true},
+		{expr: "driver<535.100", want: false},
+		// A non-version value never satisfies an ordering requirement.
+		{expr: "arch>=1", want: false},
+		// Missing capability
+		{expr: "rocm>=5", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			t.Parallel()
+			req, err := core.ParseRequirement(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, req.SatisfiedBy(capabilities))
+		})
+	}
+}
+
+func TestCapabilitiesSatisfy(t *testing.T) {
+	t.Parallel()
+
+	capabilities := map[string]string{"gpu": "true", "cuda": "12.1"}
+
+	assert.True(t, core.CapabilitiesSatisfy(capabilities, nil))
+	assert.True(t, core.CapabilitiesSatisfy(capabilities, []string{"gpu", "cuda>=11"}))
+	assert.False(t, core.CapabilitiesSatisfy(capabilities, []string{"gpu", "cuda<12"}))
+	assert.False(t, core.CapabilitiesSatisfy(nil, []string{"gpu"}))
+	assert.False(t, core.CapabilitiesSatisfy(capabilities, []string{"cuda>=bad"}))
+}
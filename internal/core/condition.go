@@ -6,27 +6,44 @@ package core
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"sync"
+
+	"github.com/dagucloud/dagu/internal/cmn/stringutil"
+	"github.com/itchyny/gojq"
 )
 
+// JSONPathConditionPrefix marks a condition that extracts a JSON path (e.g.,
+// "jsonpath:$.status") from Source and compares it to Expected.
+const JSONPathConditionPrefix = "jsonpath:"
+
 // Condition contains a condition and the expected value.
 // Conditions are evaluated and compared to the expected value.
 // The condition can be a command substitution or an environment variable.
 // The expected value must be a string without any substitutions.
+// A condition with Any set is a group that is met when at least one of
+// its conditions is met; it has no condition of its own.
 type Condition struct {
 	mu sync.RWMutex
 
-	Condition    string // Condition to evaluate
-	Expected     string // Expected value
-	Negate       bool   // Negate the condition result (run when condition does NOT match)
-	errorMessage string // Error message if the condition is not met
+	Condition    string       // Condition to evaluate
+	Expected     string       // Expected value
+	Negate       bool         // Negate the condition result (run when condition does NOT match)
+	Source       string       // JSON document for jsonpath conditions
+	Any          []*Condition // Alternatives of which at least one must be met
+	errorMessage string       // Error message if the condition is not met
+
+	expected *stringutil.Patterns // Expected compiled by Compile
+	query    *gojq.Code           // JSON path compiled by Compile
 }
 
 type conditionJSON struct {
-	Condition    string `json:"condition,omitempty"`
-	Expected     string `json:"expected,omitempty"`
-	Negate       bool   `json:"negate,omitempty"`
-	ErrorMessage string `json:"error,omitempty"`
+	Condition    string       `json:"condition,omitempty"`
+	Expected     string       `json:"expected,omitempty"`
+	Negate       bool         `json:"negate,omitempty"`
+	Source       string       `json:"source,omitempty"`
+	Any          []*Condition `json:"any,omitempty"`
+	ErrorMessage string       `json:"error,omitempty"`
 }
 
 func (c *Condition) MarshalJSON() ([]byte, error) { return json.Marshal(c.snapshot()) }
@@ -42,17 +59,132 @@ func (c *Condition) UnmarshalJSON(data []byte) error {
 	c.Condition = decoded.Condition
 	c.Expected = decoded.Expected
 	c.Negate = decoded.Negate
+	c.Source = decoded.Source
+	c.Any = decoded.Any
 	c.errorMessage = decoded.ErrorMessage
 	return nil
 }
 
 func (c *Condition) Validate() error {
+	if c.IsAnyGroup() {
+		if c.Condition != "" || c.Expected != "" || c.Source != "" {
+			return fmt.Errorf("an any group cannot have a condition of its own")
+		}
+		for _, alt := range c.Any {
+			if err := alt.Validate(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 	if c.Condition == "" {
 		return fmt.Errorf("condition is required")
 	}
+	if c.IsJSONPath() {
+		if c.JSONPath() == "" {
+			return fmt.Errorf("jsonpath condition requires a path (e.g., %q)", JSONPathConditionPrefix+"$.status")
+		}
+		if c.Source == "" {
+			return fmt.Errorf("jsonpath condition requires source")
+		}
+		if c.Expected == "" {
+			return fmt.Errorf("jsonpath condition requires expected")
+		}
+	} else if c.Source != "" {
+		return fmt.Errorf("source is only supported with %q conditions", JSONPathConditionPrefix)
+	}
+	return nil
+}
+
+// Compile parses the condition's "re:" expected pattern and JSON path ahead
+// of evaluation, so malformed ones are reported when the DAG is loaded rather
+// than when the condition runs. Literal expected values need no compiling.
+func (c *Condition) Compile() error {
+	for _, alt := range c.Any {
+		if err := alt.Compile(); err != nil {
+			return err
+		}
+	}
+	var query *gojq.Code
+	if c.IsJSONPath() {
+		var err error
+		if query, err = parseJSONPath(c.JSONPath()); err != nil {
+			return err
+		}
+	}
+	var expected *stringutil.Patterns
+	if strings.HasPrefix(c.Expected, "re:") {
+		var err error
+		if expected, err = stringutil.CompilePatterns([]string{c.Expected}); err != nil {
+			return fmt.Errorf("invalid expected value: %w", err)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.query = query
+	c.expected = expected
 	return nil
 }
 
+// ExpectedPatterns returns the compiled expected value. Conditions that were
+// not compiled when loaded, such as those decoded from JSON, are compiled
+// here.
+func (c *Condition) ExpectedPatterns() (*stringutil.Patterns, error) {
+	c.mu.RLock()
+	expected := c.expected
+	c.mu.RUnlock()
+	if expected != nil {
+		return expected, nil
+	}
+	return stringutil.CompilePatterns([]string{c.Expected})
+}
+
+// JSONPathQuery returns the compiled jq query equivalent to the condition's
+// JSON path.
+func (c *Condition) JSONPathQuery() (*gojq.Code, error) {
+	c.mu.RLock()
+	query := c.query
+	c.mu.RUnlock()
+	if query != nil {
+		return query, nil
+	}
+	return parseJSONPath(c.JSONPath())
+}
+
+// parseJSONPath compiles a JSONPath expression rooted at "$" (e.g.,
+// "$.items[0].name") as the equivalent jq path.
+func parseJSONPath(path string) (*gojq.Code, error) {
+	jqPath := strings.TrimPrefix(path, "$")
+	if jqPath == "" || !strings.HasPrefix(jqPath, ".") {
+		jqPath = "." + jqPath
+	}
+	query, err := gojq.Parse(jqPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid jsonpath %q: %w", path, err)
+	}
+	code, err := gojq.Compile(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid jsonpath %q: %w", path, err)
+	}
+	return code, nil
+}
+
+// IsAnyGroup reports whether the condition is a group of alternatives.
+func (c *Condition) IsAnyGroup() bool {
+	return len(c.Any) > 0
+}
+
+// IsJSONPath reports whether the condition is a jsonpath condition.
+func (c *Condition) IsJSONPath() bool {
+	return strings.HasPrefix(c.Condition, JSONPathConditionPrefix)
+}
+
+// JSONPath returns the path of a jsonpath condition without the prefix.
+func (c *Condition) JSONPath() string {
+	return strings.TrimSpace(strings.TrimPrefix(c.Condition, JSONPathConditionPrefix))
+}
+
 func (c *Condition) SetErrorMessage(msg string) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -65,6 +197,30 @@ func (c *Condition) GetErrorMessage() string {
 	return c.errorMessage
 }
 
+// Clone returns a copy of the condition, including its evaluation error.
+func (c *Condition) Clone() *Condition {
+	if c == nil {
+		return nil
+	}
+	snap := c.snapshot()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var alternatives []*Condition
+	for _, alt := range snap.Any {
+		alternatives = append(alternatives, alt.Clone())
+	}
+	return &Condition{
+		Condition:    snap.Condition,
+		Expected:     snap.Expected,
+		Negate:       snap.Negate,
+		Source:       snap.Source,
+		Any:          alternatives,
+		errorMessage: snap.ErrorMessage,
+		expected:     c.expected,
+		query:        c.query,
+	}
+}
+
 func (c *Condition) snapshot() conditionJSON {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -72,6 +228,8 @@ func (c *Condition) snapshot() conditionJSON {
 		Condition:    c.Condition,
 		Expected:     c.Expected,
 		Negate:       c.Negate,
+		Source:       c.Source,
+		Any:          c.Any,
 		ErrorMessage: c.errorMessage,
 	}
 }
@@ -40,6 +40,14 @@ func TestCondition_MarshalJSON(t *testing.T) {
 			}(),
 			expected: `{"condition":"test -f file.txt","expected":"true","error":"file not found"}`,
 		},
+		{
+			name: "AnyGroup",
+			condition: &core.Condition{Any: []*core.Condition{
+				{Condition: "${ENV}", Expected: "prod"},
+				{Condition: "test -f file.txt"},
+			}},
+			expected: `{"any":[{"condition":"${ENV}","expected":"prod"},{"condition":"test -f file.txt"}]}`,
+		},
 		{
 			name:      "EmptyFields",
 			condition: &core.Condition{},
@@ -86,6 +94,14 @@ func TestCondition_UnmarshalJSON(t *testing.T) {
 				return c
 			}(),
 		},
+		{
+			name: "AnyGroup",
+			json: `{"any":[{"condition":"${ENV}","expected":"prod"},{"condition":"test -f file.txt"}]}`,
+			expected: &core.Condition{Any: []*core.Condition{
+				{Condition: "${ENV}", Expected: "prod"},
+				{Condition: "test -f file.txt"},
+			}},
+		},
 		{
 			name:     "EmptyFields",
 			json:     `{}`,
@@ -104,6 +120,11 @@ func TestCondition_UnmarshalJSON(t *testing.T) {
 			assert.Equal(t, tt.expected.Condition, condition.Condition)
 			assert.Equal(t, tt.expected.Expected, condition.Expected)
 			assert.Equal(t, tt.expected.GetErrorMessage(), condition.GetErrorMessage())
+			require.Len(t, condition.Any, len(tt.expected.Any))
+			for i, alt := range tt.expected.Any {
+				assert.Equal(t, alt.Condition, condition.Any[i].Condition)
+				assert.Equal(t, alt.Expected, condition.Any[i].Expected)
+			}
 		})
 	}
 }
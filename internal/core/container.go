@@ -16,8 +16,11 @@ type Container struct {
 	Exec string `yaml:"exec,omitempty"`
 	// Name is the container name to use. If empty, Docker generates a random name.
 	Name string `yaml:"name,omitempty"`
-	// Image is the container image to use.
+	// Image is the container image to use. When Build is set, it is the tag
+	// of the image built from the build context.
 	Image string `yaml:"image,omitempty"`
+	// Build builds the image from a local context instead of pulling it.
+	Build *ContainerBuild `yaml:"build,omitempty"`
 	// PullPolicy is the policy to pull the image (e.g., "Always", "IfNotPresent").
 	PullPolicy PullPolicy `yaml:"pull_policy,omitempty"`
 	// Env specifies environment variables for the container.
@@ -43,12 +46,18 @@ type Container struct {
 	Startup ContainerStartup `yaml:"startup,omitempty"`
 	// Command is used when Startup == "command".
 	Command []string `yaml:"command,omitempty"`
+	// Entrypoint overrides the image ENTRYPOINT.
+	Entrypoint []string `yaml:"entrypoint,omitempty"`
 	// WaitFor determines readiness gate before steps run: "running" (default) or "healthy".
 	WaitFor ContainerWaitFor `yaml:"wait_for,omitempty"`
 	// LogPattern optionally waits for a regex to appear in container logs before proceeding.
 	LogPattern string `yaml:"log_pattern,omitempty"`
 	// RestartPolicy applies Docker restart policy for long-running containers ("no", "always", or "unless-stopped").
 	RestartPolicy string `yaml:"restart_policy,omitempty"`
+	// CPUs limits the number of CPUs the container may use (e.g., "1.5").
+	CPUs string `yaml:"cpus,omitempty"`
+	// Memory limits the memory the container may use (e.g., "512m", "2g").
+	Memory string `yaml:"memory,omitempty"`
 	// Healthcheck specifies a custom healthcheck for the container.
 	// If specified with waitFor: healthy, this healthcheck is used instead of
 	// relying on the image's built-in healthcheck.
@@ -59,6 +68,9 @@ type Container struct {
 	// The step command will be appended as the final argument.
 	// Works in both exec mode and image mode.
 	Shell []string `yaml:"shell,omitempty"`
+	// FallbackToLocal runs steps on the host shell instead of failing when the
+	// Docker daemon cannot be reached at the start of the DAG run.
+	FallbackToLocal bool `yaml:"fallback_to_local,omitempty"`
 }
 
 // Healthcheck defines a custom health check for a container.
@@ -79,6 +91,17 @@ type Healthcheck struct {
 	Retries int `yaml:"retries,omitempty"`
 }
 
+// ContainerBuild defines how to build a container image from a Dockerfile.
+type ContainerBuild struct {
+	// Context is the build context directory. Relative paths are resolved
+	// against the DAG working directory.
+	Context string `yaml:"context,omitempty"`
+	// Dockerfile is the Dockerfile path relative to Context. Empty uses Docker's default.
+	Dockerfile string `yaml:"dockerfile,omitempty"`
+	// Tag is the tag applied to the built image.
+	Tag string `yaml:"tag,omitempty"`
+}
+
 // ContainerStartup is an enum for DAG-level container startup modes.
 type ContainerStartup string
 
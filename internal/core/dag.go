@@ -4,14 +4,18 @@
 package core
 
 import (
+	"bytes"
 	"context"
 	"crypto/md5" //nolint:gosec
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"maps"
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -105,6 +109,9 @@ type DAG struct {
 	Schedule []Schedule `json:"schedule,omitempty"`
 	// StopSchedule contains the cron expressions for stopping the DAG.
 	StopSchedule []Schedule `json:"stopSchedule,omitempty"`
+	// StopSignal is the signal sent to the running DAG when a stop schedule
+	// fires. Empty means the default stop behavior (SIGTERM).
+	StopSignal string `json:"stopSignal,omitempty"`
 	// RestartSchedule contains the cron expressions for restarting the DAG.
 	RestartSchedule []Schedule `json:"restartSchedule,omitempty"`
 	// SkipIfSuccessful indicates whether to skip the DAG if it was successful previously.
@@ -177,8 +184,11 @@ type DAG struct {
 	// MaxActiveSteps specifies the maximum concurrent steps to run in an execution.
 	MaxActiveSteps int `json:"maxActiveSteps,omitempty"`
 	// MaxActiveRuns specifies the maximum number of concurrent dag-runs.
-	// DEPRECATED: This field is ignored for local (DAG-based) queues.
-	// For concurrency control, define a global queue in config and use the 'queue' field.
+	// DEPRECATED: This field is ignored for local (DAG-based) queues, which
+	// always run one dag-run at a time. Zero defaults to 1; values above 1 and
+	// the legacy -1 ("no queueing") are kept only so a build warning can be
+	// reported. For concurrency control, define a global queue in config and
+	// use the 'queue' field.
 	MaxActiveRuns int `json:"maxActiveRuns,omitempty"`
 	// MaxCleanUpTime is the maximum time to wait for cleanup when the DAG is stopped.
 	MaxCleanUpTime time.Duration `json:"maxCleanUpTime,omitempty"`
@@ -188,11 +198,17 @@ type DAG struct {
 	HistRetentionRuns int `json:"histRetentionRuns,omitempty"`
 	// Queue is the name of the queue to assign this DAG to.
 	Queue string `json:"queue,omitempty"`
+	// QueuePriority is the priority of this DAG's runs within its queue.
+	// High-priority runs are dequeued first; empty means low (FIFO).
+	QueuePriority QueuePriority `json:"queuePriority,omitempty"`
 	// RetryPolicy controls automatic DAG-level retry behavior for failed runs.
 	RetryPolicy *DAGRetryPolicy `json:"retryPolicy,omitempty"`
 	// WorkerSelector defines labels required for worker selection in distributed execution.
 	// If specified, the DAG will only run on workers with matching labels.
 	WorkerSelector map[string]string `json:"workerSelector,omitempty"`
+	// Requires lists capability requirements (e.g. "gpu", "cuda>=11") that a
+	// worker's labels must satisfy for the DAG to be dispatched to it.
+	Requires []string `json:"requires,omitempty"`
 	// ForceLocal forces the DAG to run locally even when the server default is distributed.
 	// Set by worker_selector: local in the DAG spec.
 	ForceLocal bool `json:"forceLocal,omitempty"`
@@ -204,7 +220,7 @@ type DAG struct {
 	// BuildErrors contains any errors encountered while building the DAG.
 	BuildErrors []error `json:"-"`
 	// BuildWarnings contains non-fatal warnings detected while building the DAG.
-	BuildWarnings []string `json:"-"`
+	BuildWarnings []BuildWarning `json:"-"`
 	// LocalDAGs contains DAGs defined in the same file, keyed by DAG name
 	LocalDAGs map[string]*DAG `json:"localDAGs,omitempty"`
 	// YamlData contains the raw YAML data of the DAG.
@@ -354,15 +370,28 @@ func (d *DAG) HasTag(tag string) bool {
 	return d.HasLabel(tag)
 }
 
-// Clone creates a shallow copy of the DAG.
+// Clone creates a copy of the DAG. Steps, handler steps, Env and Params are
+// deep-copied so they can be modified on the clone; other reference fields
+// not listed below are shared with the original.
 // The sync.Once field is reset to zero value, allowing LoadDotEnv to be called
-// independently on the clone. This is safe for read-only field modifications
-// like changing Location.
+// independently on the clone.
 func (d *DAG) Clone() *DAG {
 	//nolint:govet // intentional copy; sync.Once is immediately reset below
 	clone := *d
 	// Reset sync.Once so LoadDotEnv can be called on the clone
 	clone.dotenvOnce = sync.Once{}
+	clone.Steps = cloneSteps(d.Steps)
+	clone.HandlerOn = HandlerOn{
+		Init:    cloneStepPtrs(d.HandlerOn.Init),
+		Failure: cloneStepPtrs(d.HandlerOn.Failure),
+		Success: cloneStepPtrs(d.HandlerOn.Success),
+		Abort:   cloneStepPtrs(d.HandlerOn.Abort),
+		Exit:    cloneStepPtrs(d.HandlerOn.Exit),
+		Wait:    cloneStepPtrs(d.HandlerOn.Wait),
+		Retry:   cloneStepPtrs(d.HandlerOn.Retry),
+	}
+	clone.Env = slices.Clone(d.Env)
+	clone.Params = slices.Clone(d.Params)
 	if d.PresolvedBuildEnv != nil {
 		clone.PresolvedBuildEnv = maps.Clone(d.PresolvedBuildEnv)
 	}
@@ -387,6 +416,71 @@ func (d *DAG) Clone() *DAG {
 	return &clone
 }
 
+// Hash returns a hex-encoded SHA-256 digest of the DAG's semantic content.
+// Raw YAML, file locations and presolved environment values are left out, so
+// reformatting a DAG file does not change the hash while editing a command
+// does. DAGs should be loaded with BuildFlagNoEval before hashing to keep
+// environment-dependent values out of the digest. Hash returns an empty
+// string when the DAG cannot be serialized.
+func (d *DAG) Hash() string {
+	content, err := json.Marshal(d.hashContent())
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// dagHashContent groups the fields hashed by DAG.Hash, including the ones
+// excluded from the regular JSON encoding.
+type dagHashContent struct {
+	DAG           *DAG
+	Env           []string
+	Params        []string
+	SMTP          *SMTPConfig
+	RegistryAuths map[string]*AuthConfig
+	SSH           *SSHConfig
+	S3            *S3Config
+	Redis         *RedisConfig
+	Harness       *HarnessConfig
+	Harnesses     HarnessDefinitions
+	Kubernetes    KubernetesConfig
+	LocalDAGs     map[string]string
+}
+
+func (d *DAG) hashContent() dagHashContent {
+	clone := d.Clone()
+	clone.Location = ""
+	clone.SourceFile = ""
+	clone.YamlData = nil
+	clone.BaseConfigData = nil
+	clone.PresolvedBuildEnv = nil
+	clone.LocalDAGs = nil
+
+	var localDAGs map[string]string
+	if len(d.LocalDAGs) > 0 {
+		localDAGs = make(map[string]string, len(d.LocalDAGs))
+		for name, local := range d.LocalDAGs {
+			localDAGs[name] = local.Hash()
+		}
+	}
+
+	return dagHashContent{
+		DAG:           clone,
+		Env:           d.Env,
+		Params:        d.Params,
+		SMTP:          d.SMTP,
+		RegistryAuths: d.RegistryAuths,
+		SSH:           d.SSH,
+		S3:            d.S3,
+		Redis:         d.Redis,
+		Harness:       d.Harness,
+		Harnesses:     d.Harnesses,
+		Kubernetes:    d.Kubernetes,
+		LocalDAGs:     localDAGs,
+	}
+}
+
 // HasApprovalSteps returns true if the DAG contains any steps that require
 // human approval. DAGs with approval steps cannot be dispatched to workers
 // because approval steps require local storage access.
@@ -399,6 +493,54 @@ func (d *DAG) HasApprovalSteps() bool {
 	return false
 }
 
+// Requirements returns the capability requirements a worker must satisfy to
+// run the DAG: its own requirements followed by those declared on its steps,
+// without duplicates.
+func (d *DAG) Requirements() []string {
+	var requires []string
+	add := func(exprs []string) {
+		for _, expr := range exprs {
+			if !slices.Contains(requires, expr) {
+				requires = append(requires, expr)
+			}
+		}
+	}
+	add(d.Requires)
+	for _, step := range d.Steps {
+		add(step.Requires)
+	}
+	return requires
+}
+
+// StepByIDOrName returns the step referenced by ref, which may be either a
+// step ID or a step name. IDs take precedence over names. A
+// *StepNotFoundError is returned when no step matches.
+func (d *DAG) StepByIDOrName(ref string) (*Step, error) {
+	if ref != "" {
+		for i := range d.Steps {
+			if d.Steps[i].ID == ref {
+				return &d.Steps[i], nil
+			}
+		}
+		for i := range d.Steps {
+			if d.Steps[i].Name == ref {
+				return &d.Steps[i], nil
+			}
+		}
+	}
+	return nil, &StepNotFoundError{Ref: ref}
+}
+
+// ResolveStepName returns the name of the step referenced by ref, which may be
+// either a step ID or a step name.
+func (d *DAG) ResolveStepName(ref string) (string, bool) {
+	step, err := d.StepByIDOrName(ref)
+	if err != nil {
+		return "", false
+	}
+	return step.Name, true
+}
+
 // SockAddr returns the unix socket address for the DAG.
 // The address is used to communicate with the agent process.
 func (d *DAG) SockAddr(dagRunID string) string {
@@ -442,8 +584,12 @@ func (d *DAG) String() string {
 	return sb.String()
 }
 
-// Validate performs basic validation of the DAG structure.
-// It collects all validation errors instead of returning on first error.
+// Validate checks the structure of an already constructed DAG: the DAG name,
+// unique and well-formed step names and IDs, resolvable and acyclic depends,
+// and parseable schedules. It is meant for DAGs built or edited in code, where
+// the checks done by the spec builder have not run. Depends must reference
+// step names, as they do after building. All errors are collected and
+// returned as an ErrorList.
 func (d *DAG) Validate() error {
 	var errs ErrorList
 
@@ -451,19 +597,13 @@ func (d *DAG) Validate() error {
 		errs = append(errs, fmt.Errorf("DAG name is required"))
 	}
 
-	stepExists := make(map[string]bool, len(d.Steps))
-	for _, step := range d.Steps {
-		stepExists[step.Name] = true
-	}
-
-	for _, step := range d.Steps {
-		for _, dep := range step.Depends {
-			if !stepExists[dep] {
-				errs = append(errs, NewValidationError("depends", dep,
-					fmt.Errorf("step %s depends on non-existent step", step.Name)))
-			}
-		}
-	}
+	stepNames, stepIDs := collectNamesAndIDs(d, &errs)
+	validateNameIDConflicts(d, stepNames, stepIDs, &errs)
+	validateDependenciesExist(d, stepNames, &errs)
+	validateNoDependencyCycles(d, &errs)
+	validateSchedules("schedule", d.Schedule, &errs)
+	validateSchedules("stop_schedule", d.StopSchedule, &errs)
+	validateSchedules("restart_schedule", d.RestartSchedule, &errs)
 
 	if len(errs) == 0 {
 		return nil
@@ -486,6 +626,39 @@ func (d *DAG) NextRun(now time.Time) time.Time {
 	return next
 }
 
+// NextRuns returns up to n upcoming run times after from, in ascending order.
+// Times from all start schedules are merged, and a time produced by several
+// schedules is returned once. Stop and restart schedules are not included.
+func (d *DAG) NextRuns(n int, from time.Time) []time.Time {
+	if n <= 0 {
+		return nil
+	}
+	next := make([]time.Time, len(d.Schedule))
+	for i, sched := range d.Schedule {
+		next[i] = sched.Next(from)
+	}
+
+	var runs []time.Time
+	for len(runs) < n {
+		var earliest time.Time
+		for _, t := range next {
+			if !t.IsZero() && (earliest.IsZero() || t.Before(earliest)) {
+				earliest = t
+			}
+		}
+		if earliest.IsZero() {
+			break
+		}
+		runs = append(runs, earliest)
+		for i, t := range next {
+			if t.Equal(earliest) {
+				next[i] = d.Schedule[i].Next(t)
+			}
+		}
+	}
+	return runs
+}
+
 // deduplicateStrings removes duplicate strings while preserving order.
 func deduplicateStrings(input []string) []string {
 	seen := make(map[string]bool, len(input))
@@ -501,6 +674,7 @@ func deduplicateStrings(input []string) []string {
 }
 
 // LoadDotEnv loads all dotenv files in order, with later files overriding earlier ones.
+// Entries may be glob patterns; their matches are loaded in lexical order.
 // This method is thread-safe and idempotent - concurrent calls will only load once.
 func (d *DAG) LoadDotEnv(ctx context.Context) {
 	d.dotenvOnce.Do(func() {
@@ -527,7 +701,8 @@ func (d *DAG) loadDotEnvFiles(ctx context.Context) {
 	}
 }
 
-// loadSingleDotEnvFile loads a single dotenv file and appends its variables to d.Env.
+// loadSingleDotEnvFile loads the dotenv files matching a single dotenv entry
+// and appends their variables to d.Env.
 func (d *DAG) loadSingleDotEnvFile(ctx context.Context, resolver *fileutil.FileResolver, filePath string) {
 	if strings.TrimSpace(filePath) == "" {
 		return
@@ -539,11 +714,27 @@ func (d *DAG) loadSingleDotEnvFile(ctx context.Context, resolver *fileutil.FileR
 		return
 	}
 
-	resolvedPath, err := resolver.ResolveFilePath(evaluatedPath)
-	if err != nil || !fileutil.FileExists(resolvedPath) {
+	if !strings.ContainsAny(evaluatedPath, "*?[") {
+		resolvedPath, err := resolver.ResolveFilePath(evaluatedPath)
+		if err != nil || !fileutil.FileExists(resolvedPath) {
+			return
+		}
+		d.readDotEnvFile(ctx, resolvedPath)
+		return
+	}
+
+	matches, err := resolver.ResolveGlob(evaluatedPath)
+	if err != nil {
+		logger.Warn(ctx, "Invalid dotenv glob pattern", tag.File(evaluatedPath), tag.Error(err))
 		return
 	}
+	for _, match := range matches {
+		d.readDotEnvFile(ctx, match)
+	}
+}
 
+// readDotEnvFile reads a dotenv file and appends its variables to d.Env.
+func (d *DAG) readDotEnvFile(ctx context.Context, resolvedPath string) {
 	vars, err := godotenv.Read(resolvedPath)
 	if err != nil {
 		logger.Warn(ctx, "Failed to load .env file", tag.File(resolvedPath), tag.Error(err))
@@ -595,6 +786,31 @@ func (d *DAG) ArtifactsEnabled() bool {
 	return d != nil && d.Artifacts != nil && d.Artifacts.Enabled
 }
 
+// EnvMap returns the environment variables as a map. Entries without "=" are
+// ignored, and later entries win when a key appears more than once.
+func (d *DAG) EnvMap() map[string]string {
+	env := make(map[string]string, len(d.Env))
+	for _, e := range d.Env {
+		key, value, found := strings.Cut(e, "=")
+		if found {
+			env[key] = value
+		}
+	}
+	return env
+}
+
+// EnvGet returns the value of the environment variable with the given key.
+// Like EnvMap, the last entry for a duplicated key wins.
+func (d *DAG) EnvGet(key string) (string, bool) {
+	for i := len(d.Env) - 1; i >= 0; i-- {
+		k, v, found := strings.Cut(d.Env[i], "=")
+		if found && k == key {
+			return v, true
+		}
+	}
+	return "", false
+}
+
 // ParamsMap returns the parameters as a map.
 func (d *DAG) ParamsMap() map[string]string {
 	params := make(map[string]string)
@@ -694,6 +910,9 @@ type SSHConfig struct {
 	Timeout string `json:"timeout,omitempty"`
 	// Bastion is the jump host / bastion server configuration for connecting to the target host.
 	Bastion *BastionConfig `json:"bastion,omitempty"`
+	// ProxyJump is the chain of jump hosts to tunnel through, in connection order.
+	// Jump hosts authenticate with the target's key or password.
+	ProxyJump []BastionConfig `json:"proxyJump,omitempty"`
 }
 
 // BastionConfig contains the configuration for a bastion/jump host.
@@ -944,12 +1163,65 @@ func (s *Schedule) UnmarshalJSON(data []byte) error {
 
 // HandlerOn contains the steps to be executed on different events in the DAG.
 type HandlerOn struct {
-	Init    *Step `json:"init,omitempty"`
-	Failure *Step `json:"failure,omitempty"`
-	Success *Step `json:"success,omitempty"`
-	Abort   *Step `json:"abort,omitempty"`
-	Exit    *Step `json:"exit,omitempty"`
-	Wait    *Step `json:"wait,omitempty"`
+	Init    HandlerSteps `json:"init,omitempty"`
+	Failure HandlerSteps `json:"failure,omitempty"`
+	Success HandlerSteps `json:"success,omitempty"`
+	Abort   HandlerSteps `json:"abort,omitempty"`
+	Exit    HandlerSteps `json:"exit,omitempty"`
+	Wait    HandlerSteps `json:"wait,omitempty"`
+	Retry   HandlerSteps `json:"retry,omitempty"`
+}
+
+// Steps returns every step of the given handler in execution order.
+func (h HandlerOn) Steps(handler HandlerType) HandlerSteps {
+	switch handler {
+	case HandlerOnInit:
+		return h.Init
+	case HandlerOnFailure:
+		return h.Failure
+	case HandlerOnSuccess:
+		return h.Success
+	case HandlerOnAbort:
+		return h.Abort
+	case HandlerOnExit:
+		return h.Exit
+	case HandlerOnWait:
+		return h.Wait
+	case HandlerOnRetry:
+		return h.Retry
+	}
+	return nil
+}
+
+// HandlerSteps is the ordered list of steps run for a single handler.
+type HandlerSteps []*Step
+
+// First returns the first step of the handler, or nil if it has none.
+func (s HandlerSteps) First() *Step {
+	if len(s) == 0 {
+		return nil
+	}
+	return s[0]
+}
+
+// UnmarshalJSON deserializes handler steps written either as a list or as
+// the single step object used by older persisted dag.json files.
+func (s *HandlerSteps) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		var step Step
+		if err := json.Unmarshal(trimmed, &step); err != nil {
+			return err
+		}
+		*s = HandlerSteps{&step}
+		return nil
+	}
+	var steps []*Step
+	if err := json.Unmarshal(data, &steps); err != nil {
+		return err
+	}
+	*s = steps
+	return nil
 }
 
 // MailOn contains the conditions to send mail.
@@ -995,12 +1267,35 @@ const (
 	HandlerOnAbort   HandlerType = "onAbort"
 	HandlerOnExit    HandlerType = "onExit"
 	HandlerOnWait    HandlerType = "onWait"
+	HandlerOnRetry   HandlerType = "onRetry"
 )
 
+// HandlerRenderOrder is the order in which handler steps are drawn by the
+// graph renderers.
+var HandlerRenderOrder = []HandlerType{
+	HandlerOnInit,
+	HandlerOnSuccess,
+	HandlerOnFailure,
+	HandlerOnAbort,
+	HandlerOnExit,
+	HandlerOnWait,
+	HandlerOnRetry,
+}
+
 func (h HandlerType) String() string {
 	return string(h)
 }
 
+// StepName returns the name of the handler step at index i. The first step
+// is named after the handler; later steps get a 1-based suffix, e.g.
+// "onFailure_2", so that every step of a handler has a unique name.
+func (h HandlerType) StepName(i int) string {
+	if i == 0 {
+		return string(h)
+	}
+	return fmt.Sprintf("%s_%d", h, i+1)
+}
+
 // SockAddr returns the unix socket address for the DAG.
 // The address is used to communicate with the agent process.
 func SockAddr(name, dagRunID string) string {
@@ -45,3 +45,28 @@ func TestDAGCloneDeepCopiesKubernetesConfig(t *testing.T) {
 	assert.Equal(t, "100m", original.Kubernetes["resources"].(map[string]any)["requests"].(map[string]any)["cpu"])
 	assert.Equal(t, "/shared", original.Kubernetes["volume_mounts"].([]any)[0].(map[string]any)["mount_path"])
 }
+
+func TestDAGCloneDeepCopiesSteps(t *testing.T) {
+	t.Parallel()
+
+	original := &DAG{
+		Env: []string{"FOO=bar"},
+		Steps: []Step{
+			{Name: "a", Depends: []string{"b"}, RetryPolicy: RetryPolicy{ExitCodes: []int{1}}},
+		},
+		HandlerOn: HandlerOn{
+			Failure: HandlerSteps{{Name: "onFailure", Env: []string{"X=1"}}},
+		},
+	}
+
+	cloned := original.Clone()
+	cloned.Env[0] = "FOO=changed"
+	cloned.Steps[0].Depends[0] = "changed"
+	cloned.Steps[0].RetryPolicy.ExitCodes[0] = 99
+	cloned.HandlerOn.Failure[0].Env[0] = "X=2"
+
+	assert.Equal(t, []string{"FOO=bar"}, original.Env)
+	assert.Equal(t, []string{"b"}, original.Steps[0].Depends)
+	assert.Equal(t, []int{1}, original.Steps[0].RetryPolicy.ExitCodes)
+	assert.Equal(t, []string{"X=1"}, original.HandlerOn.Failure[0].Env)
+}
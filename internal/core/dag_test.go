@@ -12,6 +12,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"runtime"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -196,6 +197,30 @@ func TestDAGUnmarshalJSONDeprecatedTags(t *testing.T) {
 	assert.Empty(t, explicitLabels.Labels.Strings())
 }
 
+func TestHandlerStepsJSON(t *testing.T) {
+	t.Parallel()
+
+	var legacy core.DAG
+	err := json.Unmarshal([]byte(`{"name":"legacy","handlerOn":{"failure":{"name":"onFailure"}}}`), &legacy)
+	require.NoError(t, err)
+	require.Len(t, legacy.HandlerOn.Failure, 1)
+	assert.Equal(t, "onFailure", legacy.HandlerOn.Failure.First().Name)
+
+	dag := core.DAG{Name: "chain", HandlerOn: core.HandlerOn{
+		Failure: core.HandlerSteps{{Name: "notify"}, {Name: "cleanup"}},
+	}}
+	data, err := json.Marshal(&dag)
+	require.NoError(t, err)
+
+	var decoded core.DAG
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	steps := decoded.HandlerOn.Steps(core.HandlerOnFailure)
+	require.Len(t, steps, 2)
+	assert.Equal(t, "notify", steps[0].Name)
+	assert.Equal(t, "cleanup", steps[1].Name)
+	assert.Nil(t, decoded.HandlerOn.Exit.First())
+}
+
 func TestScheduleJSON(t *testing.T) {
 	t.Parallel()
 
@@ -299,6 +324,82 @@ func TestNextRun_OneOffPastIsIgnored(t *testing.T) {
 	assert.True(t, dag.NextRun(now).IsZero())
 }
 
+func TestNextRuns(t *testing.T) {
+	t.Parallel()
+
+	mustCron := func(t *testing.T, expr string) core.Schedule {
+		t.Helper()
+		sched, err := core.NewCronSchedule(expr)
+		require.NoError(t, err)
+		return sched
+	}
+
+	t.Run("SingleCron", func(t *testing.T) {
+		t.Parallel()
+
+		dag := &core.DAG{Schedule: []core.Schedule{mustCron(t, "0 9 * * *")}}
+		from := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+		assert.Equal(t, []time.Time{
+			time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 4, 9, 0, 0, 0, time.UTC),
+		}, dag.NextRuns(3, from))
+	})
+
+	t.Run("MultipleCronsMerged", func(t *testing.T) {
+		t.Parallel()
+
+		dag := &core.DAG{
+			Schedule: []core.Schedule{
+				mustCron(t, "0 * * * *"),
+				mustCron(t, "*/30 * * * *"),
+			},
+			StopSchedule:    []core.Schedule{mustCron(t, "*/5 * * * *")},
+			RestartSchedule: []core.Schedule{mustCron(t, "*/10 * * * *")},
+		}
+		from := time.Date(2024, 1, 1, 12, 15, 0, 0, time.UTC)
+
+		assert.Equal(t, []time.Time{
+			time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC),
+			time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 1, 13, 30, 0, 0, time.UTC),
+			time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC),
+		}, dag.NextRuns(4, from))
+	})
+
+	t.Run("OneOffScheduleEndsEarly", func(t *testing.T) {
+		t.Parallel()
+
+		oneOff, err := core.NewOneOffSchedule("2024-01-02T00:00:00Z")
+		require.NoError(t, err)
+		dag := &core.DAG{Schedule: []core.Schedule{oneOff}}
+
+		assert.Equal(t, []time.Time{time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+			dag.NextRuns(3, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("DSTGapIsSkipped", func(t *testing.T) {
+		t.Parallel()
+
+		// 02:30 does not exist in New York on 2024-03-10.
+		dag := &core.DAG{Schedule: []core.Schedule{mustCron(t, "CRON_TZ=America/New_York 30 2 * * *")}}
+		runs := dag.NextRuns(3, time.Date(2024, 3, 8, 12, 0, 0, 0, time.UTC))
+
+		require.Len(t, runs, 3)
+		assert.True(t, runs[0].Equal(time.Date(2024, 3, 9, 7, 30, 0, 0, time.UTC)))
+		assert.True(t, runs[1].Equal(time.Date(2024, 3, 11, 6, 30, 0, 0, time.UTC)))
+		assert.True(t, runs[2].Equal(time.Date(2024, 3, 12, 6, 30, 0, 0, time.UTC)))
+	})
+
+	t.Run("NoSchedules", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, (&core.DAG{}).NextRuns(3, time.Now()))
+		assert.Empty(t, (&core.DAG{Schedule: []core.Schedule{mustCron(t, "0 * * * *")}}).NextRuns(0, time.Now()))
+	})
+}
+
 func TestEffectiveLogOutput(t *testing.T) {
 	t.Parallel()
 
@@ -492,6 +593,95 @@ func TestDAG_Validate_MultipleErrors(t *testing.T) {
 	}
 }
 
+func TestDAG_Validate_StructuralErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("DuplicateStepID", func(t *testing.T) {
+		t.Parallel()
+
+		dag := &core.DAG{
+			Name: "test-dag",
+			Steps: []core.Step{
+				{Name: "first", ID: "fetch"},
+				{Name: "second", ID: "fetch"},
+			},
+		}
+
+		err := dag.Validate()
+		require.Error(t, err)
+
+		var errList core.ErrorList
+		require.True(t, errors.As(err, &errList), "error should be an ErrorList")
+		require.Len(t, errList, 1)
+
+		var validationErr *core.ValidationError
+		require.True(t, errors.As(errList[0], &validationErr), "error should be a ValidationError")
+		assert.Equal(t, "steps", validationErr.Field)
+		assert.Equal(t, "fetch", validationErr.Value)
+		assert.Contains(t, validationErr.Error(), "duplicate step ID: fetch")
+	})
+
+	t.Run("DuplicateStepName", func(t *testing.T) {
+		t.Parallel()
+
+		dag := &core.DAG{
+			Name:  "test-dag",
+			Steps: []core.Step{{Name: "step1"}, {Name: "step1"}},
+		}
+
+		err := dag.Validate()
+		require.Error(t, err)
+		assert.ErrorIs(t, err, core.ErrStepNameDuplicate)
+	})
+
+	t.Run("DependencyCycle", func(t *testing.T) {
+		t.Parallel()
+
+		dag := &core.DAG{
+			Name: "test-dag",
+			Steps: []core.Step{
+				{Name: "a", Depends: []string{"b"}},
+				{Name: "b", Depends: []string{"a"}},
+			},
+		}
+
+		err := dag.Validate()
+		require.Error(t, err)
+		assert.ErrorIs(t, err, core.ErrDependencyCycle)
+	})
+
+	t.Run("InvalidSchedule", func(t *testing.T) {
+		t.Parallel()
+
+		dag := &core.DAG{
+			Name:         "test-dag",
+			Schedule:     []core.Schedule{{Expression: "not a cron"}},
+			StopSchedule: []core.Schedule{{Expression: "0 18 * * *"}},
+		}
+
+		err := dag.Validate()
+		require.Error(t, err)
+
+		var validationErr *core.ValidationError
+		require.True(t, errors.As(err, &validationErr), "error should be a ValidationError")
+		assert.Equal(t, "schedule", validationErr.Field)
+		assert.Equal(t, "not a cron", validationErr.Value)
+	})
+
+	t.Run("ParsedScheduleOnly", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := cron.ParseStandard("0 9 * * *")
+		require.NoError(t, err)
+
+		dag := &core.DAG{
+			Name:     "test-dag",
+			Schedule: []core.Schedule{{Parsed: parsed}},
+		}
+		assert.NoError(t, dag.Validate())
+	})
+}
+
 func TestDAG_HasLabel(t *testing.T) {
 	t.Parallel()
 
@@ -974,3 +1164,101 @@ func TestDAGHasApprovalSteps(t *testing.T) {
 		})
 	}
 }
+
+func TestDAG_StepByIDOrName(t *testing.T) {
+	t.Parallel()
+
+	dag := &core.DAG{
+		Steps: []core.Step{
+			{Name: "extract", ID: "ex"},
+			{Name: "load"},
+			{Name: "same", ID: "same"},
+			{Name: "report", ID: "load"},
+		},
+	}
+
+	tests := []struct {
+		name string
+		ref  string
+		want string
+	}{
+		{name: "IDHit", ref: "ex", want: "extract"},
+		{name: "NameHit", ref: "extract", want: "extract"},
+		{name: "IDEqualsOwnName", ref: "same", want: "same"},
+		{name: "IDPreferredOverName", ref: "load", want: "report"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			step, err := dag.StepByIDOrName(tt.ref)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, step.Name)
+			assert.Same(t, step, &dag.Steps[slices.IndexFunc(dag.Steps, func(s core.Step) bool { return s.Name == tt.want })])
+		})
+	}
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+		for _, ref := range []string{"missing", ""} {
+			step, err := dag.StepByIDOrName(ref)
+			assert.Nil(t, step)
+			var notFound *core.StepNotFoundError
+			require.ErrorAs(t, err, &notFound)
+			assert.Equal(t, ref, notFound.Ref)
+			assert.EqualError(t, err, fmt.Sprintf("step %q not found", ref))
+		}
+	})
+}
+
+func TestDAG_EnvMap(t *testing.T) {
+	t.Parallel()
+
+	dag := &core.DAG{
+		Env: []string{
+			"MODE=dev",
+			"DSN=postgres://u:p@host/db?sslmode=disable&x=1",
+			"NOVALUE",
+			"EMPTY=",
+			"MODE=prod",
+		},
+	}
+
+	assert.Equal(t, map[string]string{
+		"MODE":  "prod",
+		"DSN":   "postgres://u:p@host/db?sslmode=disable&x=1",
+		"EMPTY": "",
+	}, dag.EnvMap())
+
+	tests := []struct {
+		key   string
+		want  string
+		found bool
+	}{
+		{key: "MODE", want: "prod", found: true},
+		{key: "DSN", want: "postgres://u:p@host/db?sslmode=disable&x=1", found: true},
+		{key: "EMPTY", want: "", found: true},
+		{key: "NOVALUE"},
+		{key: "MISSING"},
+	}
+	for _, tt := range tests {
+		value, found := dag.EnvGet(tt.key)
+		assert.Equal(t, tt.found, found, tt.key)
+		assert.Equal(t, tt.want, value, tt.key)
+	}
+
+	assert.Empty(t, (&core.DAG{}).EnvMap())
+}
+
+func TestDAG_Requirements(t *testing.T) {
+	t.Parallel()
+
+	dag := &core.DAG{
+		Requires: []string{"gpu", "cuda>=11"},
+		Steps: []core.Step{
+			{Name: "train", Requires: []string{"gpu", "mem>=64"}},
+			{Name: "report"},
+		},
+	}
+	assert.Equal(t, []string{"gpu", "cuda>=11", "mem>=64"}, dag.Requirements())
+	assert.Nil(t, (&core.DAG{}).Requirements())
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DependencyOutcome is a terminal outcome of an upstream step that a
+// dependent step can be configured to start on.
+type DependencyOutcome string
+
+const (
+	// DependencySuccess matches succeeded and partially succeeded steps.
+	DependencySuccess DependencyOutcome = "success"
+	// DependencyFailed matches failed steps.
+	DependencyFailed DependencyOutcome = "failed"
+	// DependencySkipped matches skipped steps.
+	DependencySkipped DependencyOutcome = "skipped"
+)
+
+// ParseDependencyOutcome parses an outcome name used in depends[].on.
+func ParseDependencyOutcome(s string) (DependencyOutcome, error) {
+	switch o := DependencyOutcome(strings.ToLower(strings.TrimSpace(s))); o {
+	case DependencySuccess, DependencyFailed, DependencySkipped:
+		return o, nil
+	default:
+		return "", fmt.Errorf("invalid dependency outcome %q: must be one of success, failed, skipped", s)
+	}
+}
+
+// JoinMode selects how many dependencies must succeed before a step starts.
+type JoinMode string
+
+const (
+	// JoinModeAll waits for every dependency (default behavior).
+	JoinModeAll JoinMode = "all"
+	// JoinModeAny starts the step once Count dependencies have succeeded.
+	JoinModeAny JoinMode = "any"
+)
+
+// JoinPolicy lets a step start before all of its dependencies finish.
+// With JoinModeAny the step starts as soon as Count dependencies succeed and
+// the dependencies still in flight are stopped.
+type JoinPolicy struct {
+	Mode  JoinMode `json:"mode,omitempty"`
+	Count int      `json:"count,omitempty"`
+}
+
+// IsAny reports whether the policy starts the step on the first Count
+// successful dependencies.
+func (p *JoinPolicy) IsAny() bool {
+	return p != nil && p.Mode == JoinModeAny
+}
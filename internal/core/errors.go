@@ -49,6 +49,7 @@ var (
 	ErrDependsMustBeStringOrArray          = errors.New("depends must be a string or an array of strings")
 	ErrDependsNotAllowedInChainType        = errors.New("depends field is not allowed for DAGs with type 'chain'; use type 'graph' for explicit dependencies")
 	ErrStepsMustBeArrayOrMap               = errors.New("steps must be an array or a map")
+	ErrDependencyCycle                     = errors.New("cycle detected")
 )
 
 // ErrorList is just a list of errors.
@@ -112,3 +113,42 @@ func NewValidationError(field string, value any, err error) error {
 		Err:   err,
 	}
 }
+
+// NewDependencyCycleError returns an error naming the full cycle path,
+// e.g. "cycle detected: a -> b -> c -> a".
+func NewDependencyCycleError(path []string) error {
+	return fmt.Errorf("%w: %s", ErrDependencyCycle, strings.Join(path, " -> "))
+}
+
+// StepNotFoundError is returned when a step reference matches no step ID or
+// name in a DAG.
+type StepNotFoundError struct {
+	Ref string
+}
+
+func (e *StepNotFoundError) Error() string {
+	return fmt.Sprintf("step %q not found", e.Ref)
+}
+
+// BuildWarningCode identifies the kind of a build warning. Codes are stable
+// so that tooling can filter warnings without matching on messages.
+type BuildWarningCode string
+
+// Build warning codes.
+const (
+	WarningDeprecatedMaxActiveRuns BuildWarningCode = "deprecated_max_active_runs"
+	WarningMisleadingSchedule      BuildWarningCode = "misleading_schedule"
+	WarningRepeatMaxIntervalCapped BuildWarningCode = "repeat_max_interval_capped"
+	WarningEnvValueCoerced         BuildWarningCode = "env_value_coerced"
+)
+
+// BuildWarning is a non-fatal issue detected while building a DAG.
+type BuildWarning struct {
+	Code    BuildWarningCode
+	Message string
+}
+
+// String returns the warning as "code: message".
+func (w BuildWarning) String() string {
+	return fmt.Sprintf("%s: %s", w.Code, w.Message)
+}
@@ -52,6 +52,7 @@ type DispatchTaskClaim struct {
 	WorkerID     string
 	PollerID     string
 	Labels       map[string]string
+	Tags         []string
 	Owner        CoordinatorEndpoint
 	ClaimTimeout time.Duration
 }
@@ -81,6 +82,7 @@ type DispatchTaskStore interface {
 type WorkerHeartbeatRecord struct {
 	WorkerID        string                     `json:"workerId"`
 	Labels          map[string]string          `json:"labels,omitempty"`
+	Tags            []string                   `json:"tags,omitempty"`
 	Stats           *coordinatorv1.WorkerStats `json:"stats,omitempty"`
 	LastHeartbeatAt int64                      `json:"lastHeartbeatAt"`
 }
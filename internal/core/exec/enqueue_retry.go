@@ -94,7 +94,7 @@ func EnqueueRetry(
 		)
 		return errors.New("enqueue retry: proc group is empty")
 	}
-	if err := queueStore.Enqueue(ctx, procGroup, QueuePriorityLow, dagRun); err != nil {
+	if err := queueStore.Enqueue(ctx, procGroup, QueuePriorityForDAG(dag), dagRun); err != nil {
 		_, _, _ = dagRunStore.CompareAndSwapLatestAttemptStatus(
 			ctx,
 			dagRun,
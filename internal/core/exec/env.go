@@ -23,6 +23,23 @@ const (
 	// EnvKeyDAGRunStepStderrFile holds the path to the stderr log file for the current step.
 	EnvKeyDAGRunStepStderrFile = "DAG_RUN_STEP_STDERR_FILE"
 
+	// EnvKeyDAGRunStepStartedAt holds the RFC3339 start time of the current step execution.
+	// It is refreshed when the step is retried.
+	EnvKeyDAGRunStepStartedAt = "DAG_RUN_STEP_STARTED_AT"
+
+	// EnvKeyDAGRunStepTimeoutSec holds the step timeout in whole seconds.
+	// It is only set when the step has a timeout.
+	EnvKeyDAGRunStepTimeoutSec = "DAG_RUN_STEP_TIMEOUT_SEC"
+
+	// EnvKeyDAGRetryStepName holds the name of the step about to be retried (onRetry handler only).
+	EnvKeyDAGRetryStepName = "DAG_RETRY_STEP_NAME"
+
+	// EnvKeyDAGRetryStepID holds the ID of the step about to be retried (onRetry handler only).
+	EnvKeyDAGRetryStepID = "DAG_RETRY_STEP_ID"
+
+	// EnvKeyDAGRetryAttempt holds the 1-based retry attempt about to run (onRetry handler only).
+	EnvKeyDAGRetryAttempt = "DAG_RETRY_ATTEMPT"
+
 	// EnvKeyDAGRunStatus holds the current status of the DAG run (e.g., "running", "success", "failed").
 	EnvKeyDAGRunStatus = "DAG_RUN_STATUS"
 
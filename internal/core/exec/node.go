@@ -30,6 +30,8 @@ type Node struct {
 	Repeated        bool                 `json:"repeated,omitempty"` // indicates if the node has been repeated
 	SkippedByRetry  bool                 `json:"skippedByRetry,omitempty"`
 	Error           string               `json:"error,omitempty"`
+	CancelReason    core.CancelReason    `json:"cancelReason,omitempty"`    // why the node was aborted by an outside cause
+	OnErrorExitCode *int                 `json:"onErrorExitCode,omitempty"` // exit code of the step's onError command
 	SubRuns         []SubDAGRun          `json:"children,omitempty"`
 	SubRunsRepeated []SubDAGRun          `json:"childrenRepeated,omitempty"` // repeated sub DAG runs
 	OutputVariables *collections.SyncMap `json:"outputVariables,omitempty"`
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/dagucloud/dagu/internal/core"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -59,6 +60,15 @@ const (
 	QueuePriorityLow
 )
 
+// QueuePriorityForDAG returns the queue priority for runs of the given DAG.
+// DAGs without a queue priority use QueuePriorityLow, which keeps FIFO order.
+func QueuePriorityForDAG(dag *core.DAG) QueuePriority {
+	if dag != nil && dag.QueuePriority == core.QueuePriorityHigh {
+		return QueuePriorityHigh
+	}
+	return QueuePriorityLow
+}
+
 // QueuedItem is a wrapper for QueuedItemData
 type QueuedItem struct {
 	QueuedItemData
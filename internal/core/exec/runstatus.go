@@ -49,12 +49,14 @@ func InitialStatus(dag *core.DAG) DAGRunStatus {
 		Status:               core.NotStarted,
 		PID:                  PID(0),
 		Nodes:                NewNodesFromSteps(dag.Steps),
-		OnInit:               NewNodeOrNil(dag.HandlerOn.Init),
-		OnExit:               NewNodeOrNil(dag.HandlerOn.Exit),
-		OnSuccess:            NewNodeOrNil(dag.HandlerOn.Success),
-		OnFailure:            NewNodeOrNil(dag.HandlerOn.Failure),
-		OnAbort:              NewNodeOrNil(dag.HandlerOn.Abort),
-		OnWait:               NewNodeOrNil(dag.HandlerOn.Wait),
+		OnInit:               NewNodeOrNil(dag.HandlerOn.Init.First()),
+		OnExit:               NewNodeOrNil(dag.HandlerOn.Exit.First()),
+		OnSuccess:            NewNodeOrNil(dag.HandlerOn.Success.First()),
+		OnFailure:            NewNodeOrNil(dag.HandlerOn.Failure.First()),
+		OnAbort:              NewNodeOrNil(dag.HandlerOn.Abort.First()),
+		OnWait:               NewNodeOrNil(dag.HandlerOn.Wait.First()),
+		OnRetry:              NewNodeOrNil(dag.HandlerOn.Retry.First()),
+		HandlerChains:        newHandlerChainNodes(dag.HandlerOn),
 		Params:               strings.Join(dag.Params, " "),
 		ParamsList:           dag.Params,
 		AutoRetryCount:       0,
@@ -74,30 +76,34 @@ func InitialStatus(dag *core.DAG) DAGRunStatus {
 
 // DAGRunStatus represents the complete execution state of a dag-run.
 type DAGRunStatus struct {
-	Root           DAGRunRef        `json:"root,omitzero"`
-	Parent         DAGRunRef        `json:"parent,omitzero"`
-	Name           string           `json:"name"`
-	DAGRunID       string           `json:"dagRunId"`
-	AttemptID      string           `json:"attemptId"`
-	AttemptKey     string           `json:"attemptKey,omitempty"` // Globally unique attempt identifier
-	Status         core.Status      `json:"status"`
-	TriggerType    core.TriggerType `json:"triggerType,omitempty"`
-	WorkerID       string           `json:"workerId,omitempty"`
-	PID            PID              `json:"pid,omitempty"`
-	Nodes          []*Node          `json:"nodes,omitempty"`
-	OnInit         *Node            `json:"onInit,omitempty"`
-	OnExit         *Node            `json:"onExit,omitempty"`
-	OnSuccess      *Node            `json:"onSuccess,omitempty"`
-	OnFailure      *Node            `json:"onFailure,omitempty"`
-	OnAbort        *Node            `json:"onAbort,omitempty"`
-	OnWait         *Node            `json:"onWait,omitempty"`
-	CreatedAt      int64            `json:"createdAt,omitempty"`
-	QueuedAt       string           `json:"queuedAt,omitempty"`
-	ScheduleTime   string           `json:"scheduleTime,omitempty"`
-	StartedAt      string           `json:"startedAt,omitempty"`
-	FinishedAt     string           `json:"finishedAt,omitempty"`
-	AutoRetryCount int              `json:"autoRetryCount,omitempty"`
-	AutoRetryLimit int              `json:"autoRetryLimit,omitempty"`
+	Root        DAGRunRef        `json:"root,omitzero"`
+	Parent      DAGRunRef        `json:"parent,omitzero"`
+	Name        string           `json:"name"`
+	DAGRunID    string           `json:"dagRunId"`
+	AttemptID   string           `json:"attemptId"`
+	AttemptKey  string           `json:"attemptKey,omitempty"` // Globally unique attempt identifier
+	Status      core.Status      `json:"status"`
+	TriggerType core.TriggerType `json:"triggerType,omitempty"`
+	WorkerID    string           `json:"workerId,omitempty"`
+	PID         PID              `json:"pid,omitempty"`
+	Nodes       []*Node          `json:"nodes,omitempty"`
+	OnInit      *Node            `json:"onInit,omitempty"`
+	OnExit      *Node            `json:"onExit,omitempty"`
+	OnSuccess   *Node            `json:"onSuccess,omitempty"`
+	OnFailure   *Node            `json:"onFailure,omitempty"`
+	OnAbort     *Node            `json:"onAbort,omitempty"`
+	OnWait      *Node            `json:"onWait,omitempty"`
+	OnRetry     *Node            `json:"onRetry,omitempty"`
+	// HandlerChains holds the nodes of the steps that follow the first step of
+	// a handler configured as a list. The first node stays in its On* field.
+	HandlerChains  map[core.HandlerType][]*Node `json:"handlerChains,omitempty"`
+	CreatedAt      int64                        `json:"createdAt,omitempty"`
+	QueuedAt       string                       `json:"queuedAt,omitempty"`
+	ScheduleTime   string                       `json:"scheduleTime,omitempty"`
+	StartedAt      string                       `json:"startedAt,omitempty"`
+	FinishedAt     string                       `json:"finishedAt,omitempty"`
+	AutoRetryCount int                          `json:"autoRetryCount,omitempty"`
+	AutoRetryLimit int                          `json:"autoRetryLimit,omitempty"`
 	// AutoRetryInterval is stored as a duration snapshot for retry scanner decisions.
 	AutoRetryInterval time.Duration `json:"autoRetryInterval,omitempty"`
 	AutoRetryBackoff  float64       `json:"autoRetryBackoff,omitempty"`
@@ -112,6 +118,8 @@ type DAGRunStatus struct {
 	ParamsList           []string           `json:"paramsList,omitempty"`
 	PendingStepRetries   []PendingStepRetry `json:"pendingStepRetries"`
 	Preconditions        []*core.Condition  `json:"preconditions,omitempty"`
+	FailedPrecondition   *core.Condition    `json:"failedPrecondition,omitempty"` // DAG-level precondition that aborted the run
+	CancelReason         core.CancelReason  `json:"cancelReason,omitempty"`       // Why the run was canceled, e.g. the DAG timeout
 	Labels               []string           `json:"labels,omitempty"`
 	LeaseAt              int64              `json:"leaseAt,omitempty"` // Unix millis; stamped by coordinator on observed run liveness
 }
@@ -278,16 +286,63 @@ type handlerNode struct {
 	node *Node
 }
 
-// handlerNodes returns all handler nodes for iteration
+// handlerNodes returns all handler nodes for iteration, with the chained
+// nodes of each handler following its first node.
 func (st *DAGRunStatus) handlerNodes() []handlerNode {
-	return []handlerNode{
-		{"onInit", st.OnInit},
-		{"onExit", st.OnExit},
-		{"onSuccess", st.OnSuccess},
-		{"onFailure", st.OnFailure},
-		{canonicalAbortHandlerName, st.OnAbort},
-		{"onWait", st.OnWait},
+	var nodes []handlerNode
+	for _, h := range []struct {
+		handler core.HandlerType
+		node    *Node
+	}{
+		{core.HandlerOnInit, st.OnInit},
+		{core.HandlerOnExit, st.OnExit},
+		{core.HandlerOnSuccess, st.OnSuccess},
+		{core.HandlerOnFailure, st.OnFailure},
+		{core.HandlerOnAbort, st.OnAbort},
+		{core.HandlerOnWait, st.OnWait},
+		{core.HandlerOnRetry, st.OnRetry},
+	} {
+		nodes = append(nodes, handlerNode{h.handler.String(), h.node})
+		for _, node := range st.HandlerChains[h.handler] {
+			nodes = append(nodes, handlerNode{h.handler.String(), node})
+		}
+	}
+	return nodes
+}
+
+// AllHandlerNodes returns the nodes of every handler step that has one, in
+// the same order as handlerNodes.
+func (st *DAGRunStatus) AllHandlerNodes() []*Node {
+	var nodes []*Node
+	for _, handler := range st.handlerNodes() {
+		if handler.node != nil {
+			nodes = append(nodes, handler.node)
+		}
+	}
+	return nodes
+}
+
+// newHandlerChainNodes returns the nodes for the steps that follow the first
+// step of each handler.
+func newHandlerChainNodes(handlerOn core.HandlerOn) map[core.HandlerType][]*Node {
+	var chains map[core.HandlerType][]*Node
+	for _, handler := range []core.HandlerType{
+		core.HandlerOnInit, core.HandlerOnExit, core.HandlerOnSuccess,
+		core.HandlerOnFailure, core.HandlerOnAbort, core.HandlerOnWait,
+		core.HandlerOnRetry,
+	} {
+		steps := handlerOn.Steps(handler)
+		if len(steps) < 2 {
+			continue
+		}
+		if chains == nil {
+			chains = make(map[core.HandlerType][]*Node)
+		}
+		for _, step := range steps[1:] {
+			chains[handler] = append(chains[handler], NewNodeOrNil(step))
+		}
 	}
+	return chains
 }
 
 func normalizeAbortHandlerLookup(name string) string {
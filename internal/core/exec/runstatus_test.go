@@ -64,6 +64,31 @@ func TestInitialStatusSnapshotsDisabledDAGRetryPolicy(t *testing.T) {
 	assert.Equal(t, "retry-disabled-dag", status.SuspendFlagName)
 }
 
+func TestInitialStatusIncludesRetryHandlerNodes(t *testing.T) {
+	t.Parallel()
+
+	dag := &core.DAG{
+		Name: "retry-handler-dag",
+		HandlerOn: core.HandlerOn{
+			Retry: core.HandlerSteps{
+				{Name: "notify", Command: "echo retry"},
+				{Name: "cleanup", Command: "echo cleanup"},
+			},
+		},
+	}
+
+	status := exec.InitialStatus(dag)
+
+	require.NotNil(t, status.OnRetry)
+	assert.Equal(t, "notify", status.OnRetry.Step.Name)
+	require.Len(t, status.HandlerChains[core.HandlerOnRetry], 1)
+	assert.Equal(t, "cleanup", status.HandlerChains[core.HandlerOnRetry][0].Step.Name)
+
+	node, err := status.NodeByName(core.HandlerOnRetry.String())
+	require.NoError(t, err)
+	assert.Same(t, status.OnRetry, node)
+}
+
 func TestPendingStepRetriesFromStatus(t *testing.T) {
 	t.Parallel()
 
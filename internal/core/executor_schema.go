@@ -36,6 +36,18 @@ func RegisterExecutorConfigSchema(executorType string, schema *jsonschema.Schema
 	executorSchemas.entries[executorType] = &schemaEntry{schema: schema}
 }
 
+// ExecutorConfigSchema returns the JSON schema registered for an executor
+// config, if any.
+func ExecutorConfigSchema(executorType string) (*jsonschema.Schema, bool) {
+	executorSchemas.mu.RLock()
+	defer executorSchemas.mu.RUnlock()
+	entry, ok := executorSchemas.entries[executorType]
+	if !ok {
+		return nil, false
+	}
+	return entry.schema, true
+}
+
 // ValidateExecutorConfig validates config against the registered schema.
 // Returns nil if no schema is registered (backward compatible).
 func ValidateExecutorConfig(executorType string, config map[string]any) error {
@@ -352,6 +352,33 @@ func (t Labels) MatchesFilters(filters []LabelFilter) bool {
 	return true
 }
 
+// MatchesAnyFilter checks if labels match at least one filter (OR logic).
+// An empty filter list matches any labels.
+func (t Labels) MatchesAnyFilter(filters []LabelFilter) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	for _, f := range filters {
+		if f.MatchesLabels(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchesWorkerTags reports whether a DAG with dagTags may run on a worker
+// that only accepts workerTags. A worker without tags accepts every DAG.
+func MatchesWorkerTags(workerTags, dagTags []string) bool {
+	if len(workerTags) == 0 {
+		return true
+	}
+	filters := make([]LabelFilter, 0, len(workerTags))
+	for _, tag := range workerTags {
+		filters = append(filters, ParseLabelFilter(tag))
+	}
+	return NewLabels(dagTags).MatchesAnyFilter(filters)
+}
+
 // Deprecated compatibility aliases. Prefer the Label/Labels names for new code.
 //
 // Deprecated: use Label instead.
@@ -434,6 +434,63 @@ func TestLabels_MatchesFilters(t *testing.T) {
 	}
 }
 
+func TestLabels_MatchesAnyFilter(t *testing.T) {
+	t.Parallel()
+
+	labels := Labels{
+		{Key: "env", Value: "prod"},
+		{Key: "gpu", Value: ""},
+	}
+
+	tests := []struct {
+		name    string
+		filters []string
+		want    bool
+	}{
+		{
+			name:    "no filters",
+			filters: []string{},
+			want:    true,
+		},
+		{
+			name:    "single match",
+			filters: []string{"gpu"},
+			want:    true,
+		},
+		{
+			name:    "one of many matches (OR)",
+			filters: []string{"heavy", "gpu"},
+			want:    true,
+		},
+		{
+			name:    "none match",
+			filters: []string{"heavy", "env=dev"},
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filters := make([]LabelFilter, len(tt.filters))
+			for i, f := range tt.filters {
+				filters[i] = ParseLabelFilter(f)
+			}
+			assert.Equal(t, tt.want, labels.MatchesAnyFilter(filters))
+		})
+	}
+}
+
+func TestMatchesWorkerTags(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, MatchesWorkerTags(nil, nil), "untagged worker accepts untagged DAG")
+	assert.True(t, MatchesWorkerTags(nil, []string{"gpu"}), "untagged worker accepts tagged DAG")
+	assert.True(t, MatchesWorkerTags([]string{"heavy", "gpu"}, []string{"gpu", "env=prod"}))
+	assert.True(t, MatchesWorkerTags([]string{"env=prod"}, []string{"env=prod"}))
+	assert.False(t, MatchesWorkerTags([]string{"gpu"}, nil), "tagged worker rejects untagged DAG")
+	assert.False(t, MatchesWorkerTags([]string{"env=dev"}, []string{"env=prod"}))
+}
+
 func TestParseLabelFilter_Wildcard(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,66 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+// mermaidEscaper replaces characters that would end a quoted Mermaid label or
+// be interpreted as markup with Mermaid entity codes. The replacement runs in
+// a single pass, so the '#' of an inserted entity code is not escaped again.
+var mermaidEscaper = strings.NewReplacer(
+	"#", "#35;",
+	`"`, "#quot;",
+	"<", "#60;",
+	">", "#62;",
+	"\r\n", " ",
+	"\n", " ",
+)
+
+// MermaidDiagram renders the steps of the DAG and their dependencies as a
+// Mermaid flowchart. Nodes are labeled with step names. Handler steps are not
+// part of the dependency graph; they are drawn as separate nodes with the
+// "handler" class, chained in execution order within each handler.
+func (d *DAG) MermaidDiagram() string {
+	var b strings.Builder
+	b.WriteString("flowchart TD\n")
+
+	ids := make(map[string]string, len(d.Steps))
+	for i, step := range d.Steps {
+		id := fmt.Sprintf("step%d", i)
+		ids[step.Name] = id
+		fmt.Fprintf(&b, "    %s[\"%s\"]\n", id, mermaidEscaper.Replace(step.Name))
+	}
+	for _, step := range d.Steps {
+		for _, dep := range step.Depends {
+			name, ok := d.ResolveStepName(dep)
+			if !ok {
+				continue
+			}
+			fmt.Fprintf(&b, "    %s --> %s\n", ids[name], ids[step.Name])
+		}
+	}
+
+	var handlerIDs []string
+	for _, handler := range HandlerRenderOrder {
+		prev := ""
+		for i, step := range d.HandlerOn.Steps(handler) {
+			id := fmt.Sprintf("%s%d", handler, i)
+			handlerIDs = append(handlerIDs, id)
+			fmt.Fprintf(&b, "    %s([\"%s: %s\"])\n", id, handler, mermaidEscaper.Replace(step.Name))
+			if prev != "" {
+				fmt.Fprintf(&b, "    %s --> %s\n", prev, id)
+			}
+			prev = id
+		}
+	}
+	if len(handlerIDs) > 0 {
+		b.WriteString("    classDef handler stroke-dasharray: 5 5\n")
+		fmt.Fprintf(&b, "    class %s handler\n", strings.Join(handlerIDs, ","))
+	}
+
+	return b.String()
+}
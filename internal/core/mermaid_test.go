@@ -0,0 +1,63 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/dagucloud/dagu/internal/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDAG_MermaidDiagram(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Diamond", func(t *testing.T) {
+		t.Parallel()
+
+		dag := &core.DAG{
+			Steps: []core.Step{
+				{Name: "extract"},
+				{Name: `say "hi"`, ID: "hi", Depends: []string{"extract"}},
+				{Name: "a<b> #1", Depends: []string{"extract"}},
+				{Name: "load", Depends: []string{"hi", "a<b> #1"}},
+			},
+			HandlerOn: core.HandlerOn{
+				Failure: core.HandlerSteps{{Name: "notify"}},
+				Exit:    core.HandlerSteps{{Name: "cleanup"}, {Name: "report"}},
+			},
+		}
+
+		want := `flowchart TD
+    step0["extract"]
+    step1["say #quot;hi#quot;"]
+    step2["a#60;b#62; #35;1"]
+    step3["load"]
+    step0 --> step1
+    step0 --> step2
+    step1 --> step3
+    step2 --> step3
+    onFailure0(["onFailure: notify"])
+    onExit0(["onExit: cleanup"])
+    onExit1(["onExit: report"])
+    onExit0 --> onExit1
+    classDef handler stroke-dasharray: 5 5
+    class onFailure0,onExit0,onExit1 handler
+`
+		assert.Equal(t, want, dag.MermaidDiagram())
+	})
+
+	t.Run("ParallelWithoutHandlers", func(t *testing.T) {
+		t.Parallel()
+
+		dag := &core.DAG{
+			Steps: []core.Step{
+				{Name: "a"},
+				{Name: "b"},
+			},
+		}
+
+		assert.Equal(t, "flowchart TD\n    step0[\"a\"]\n    step1[\"b\"]\n", dag.MermaidDiagram())
+	})
+}
@@ -18,6 +18,23 @@ type ParallelConfig struct {
 	// MaxConcurrent is the maximum number of parallel executions.
 	// Default is 10 if not specified.
 	MaxConcurrent int `json:"max_concurrent,omitempty"`
+
+	// Dedupe drops items whose evaluated value or params repeat an earlier
+	// item, keeping the first occurrence. Identical items share a run anyway
+	// unless RandomIDs is set, but dedupe also applies before the item limit.
+	Dedupe bool `json:"dedupe,omitempty"`
+
+	// Sort orders the expanded items by their evaluated value or params so
+	// the sub DAG-runs are created in a deterministic order.
+	Sort bool `json:"sort,omitempty"`
+
+	// RandomIDs gives every sub DAG-run a random ID instead of one derived
+	// from the parent DAG-run and the item.
+	RandomIDs bool `json:"random_ids,omitempty"`
+
+	// StableRepeatIDs derives the IDs of the sub DAG-runs of a repeated step
+	// from the repetition count instead of giving each round random IDs.
+	StableRepeatIDs bool `json:"stable_repeat_ids,omitempty"`
 }
 
 // DefaultMaxConcurrent is the default maximum concurrent executions for parallel steps
@@ -142,7 +142,7 @@ func (p *Params) IsEmpty() bool {
 }
 
 // AsStringMap returns all parameters as map[string]string
-// Non-string values are converted using fmt.Sprintf
+// Non-string values are converted using ParamValueString
 func (p *Params) AsStringMap() (map[string]string, error) {
 	switch p.Type() {
 	case ParamTypeString:
@@ -150,7 +150,7 @@ func (p *Params) AsStringMap() (map[string]string, error) {
 	case ParamTypeAny:
 		result := make(map[string]string, len(p.Rich))
 		for k, v := range p.Rich {
-			result[k] = fmt.Sprintf("%v", v)
+			result[k] = ParamValueString(v)
 		}
 		return result, nil
 	case ParamTypeRaw:
@@ -160,7 +160,7 @@ func (p *Params) AsStringMap() (map[string]string, error) {
 		}
 		result := make(map[string]string, len(m))
 		for k, v := range m {
-			result[k] = fmt.Sprintf("%v", v)
+			result[k] = ParamValueString(v)
 		}
 		return result, nil
 	case ParamTypeUnknown:
@@ -170,6 +170,41 @@ func (p *Params) AsStringMap() (map[string]string, error) {
 	}
 }
 
+// ParamValueString converts a param value to its string form. Objects and
+// arrays are JSON-encoded so that they can be passed on as a single param and
+// decoded again by the receiver; other values are formatted with fmt.
+func ParamValueString(v any) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case map[string]any, []any:
+		if data, err := json.Marshal(val); err == nil {
+			return string(data)
+		}
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// AsJSON returns all parameters as a JSON object, preserving nested objects,
+// arrays, numbers, and booleans instead of flattening them to strings.
+func (p *Params) AsJSON() (json.RawMessage, error) {
+	switch p.Type() {
+	case ParamTypeString:
+		return json.Marshal(p.Simple)
+	case ParamTypeAny:
+		return json.Marshal(p.Rich)
+	case ParamTypeRaw:
+		if _, err := p.parseRaw(); err != nil {
+			return nil, err
+		}
+		return p.Raw, nil
+	case ParamTypeUnknown:
+		fallthrough
+	default:
+		return json.RawMessage("{}"), nil
+	}
+}
+
 // parseRaw parses Raw field on demand
 func (p *Params) parseRaw() (map[string]any, error) {
 	if p.Raw == nil {
@@ -182,6 +182,62 @@ func TestParams_AsStringMap(t *testing.T) {
 	})
 }
 
+func TestParams_AsJSON(t *testing.T) {
+	t.Run("NestedRoundTrip", func(t *testing.T) {
+		nested := map[string]any{
+			"name": "deploy",
+			"target": map[string]any{
+				"region":   "us-east-1",
+				"replicas": float64(3),
+				"zones":    []any{"a", "b"},
+			},
+			"dryRun": true,
+		}
+		params := NewRichParams(nested)
+
+		data, err := params.AsJSON()
+		require.NoError(t, err)
+
+		var decoded map[string]any
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, nested, decoded)
+
+		// The encoded form parses back into equivalent params.
+		reparsed, err := ParseParams(data)
+		require.NoError(t, err)
+		again, err := reparsed.AsJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(data), string(again))
+	})
+
+	t.Run("Simple", func(t *testing.T) {
+		params := NewSimpleParams(map[string]string{"key": "value"})
+		data, err := params.AsJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"key":"value"}`, string(data))
+	})
+
+	t.Run("InvalidRaw", func(t *testing.T) {
+		params := NewRawParams(json.RawMessage(`not json`))
+		_, err := params.AsJSON()
+		require.Error(t, err)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		params := Params{}
+		data, err := params.AsJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, `{}`, string(data))
+	})
+
+	t.Run("AsStringMapEncodesNested", func(t *testing.T) {
+		params := NewRichParams(map[string]any{"target": map[string]any{"region": "us-east-1"}})
+		m, err := params.AsStringMap()
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"region":"us-east-1"}`, m["target"])
+	})
+}
+
 func TestParams_JSON(t *testing.T) {
 	t.Run("Marshal_Simple", func(t *testing.T) {
 		params := NewSimpleParams(map[string]string{"key": "value"})
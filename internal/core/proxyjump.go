@@ -0,0 +1,71 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package core
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// ParseProxyJump parses an OpenSSH-style ProxyJump value into jump hosts in
+// connection order. The value is a comma-separated list of
+// "[user@]host[:port]" entries; IPv6 hosts must be bracketed when a port is
+// given (e.g., "[2001:db8::1]:2222"). Ports default to "22".
+func ParseProxyJump(value string) ([]BastionConfig, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	var jumps []BastionConfig
+	for i, entry := range strings.Split(value, ",") {
+		jump, err := parseJumpHost(strings.TrimSpace(entry))
+		if err != nil {
+			return nil, fmt.Errorf("jump host %d (%q): %w", i+1, entry, err)
+		}
+		jumps = append(jumps, jump)
+	}
+	return jumps, nil
+}
+
+func parseJumpHost(entry string) (BastionConfig, error) {
+	if entry == "" {
+		return BastionConfig{}, fmt.Errorf("empty jump host")
+	}
+
+	var jump BastionConfig
+	if at := strings.LastIndex(entry, "@"); at >= 0 {
+		jump.User = entry[:at]
+		entry = entry[at+1:]
+		if jump.User == "" {
+			return BastionConfig{}, fmt.Errorf("empty user")
+		}
+	}
+
+	host, port := entry, "22"
+	switch {
+	case strings.HasPrefix(entry, "[") && strings.HasSuffix(entry, "]"):
+		host = entry[1 : len(entry)-1]
+	case strings.HasPrefix(entry, "[") || strings.Count(entry, ":") == 1:
+		var err error
+		if host, port, err = net.SplitHostPort(entry); err != nil {
+			return BastionConfig{}, err
+		}
+	case strings.Contains(entry, ":"):
+		return BastionConfig{}, fmt.Errorf("IPv6 addresses must be enclosed in brackets")
+	}
+
+	if host == "" {
+		return BastionConfig{}, fmt.Errorf("empty host")
+	}
+	if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+		return BastionConfig{}, fmt.Errorf("invalid port %q", port)
+	}
+
+	jump.Host = host
+	jump.Port = port
+	return jump, nil
+}
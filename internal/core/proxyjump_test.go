@@ -0,0 +1,98 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProxyJump(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    []BastionConfig
+		wantErr string
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			want:  nil,
+		},
+		{
+			name:  "HostOnly",
+			input: "bastion.example.com",
+			want:  []BastionConfig{{Host: "bastion.example.com", Port: "22"}},
+		},
+		{
+			name:  "UserHostPort",
+			input: "admin@bastion.example.com:2222",
+			want:  []BastionConfig{{Host: "bastion.example.com", Port: "2222", User: "admin"}},
+		},
+		{
+			name:  "Chain",
+			input: "alice@jump1:22, bob@jump2.internal:2200",
+			want: []BastionConfig{
+				{Host: "jump1", Port: "22", User: "alice"},
+				{Host: "jump2.internal", Port: "2200", User: "bob"},
+			},
+		},
+		{
+			name:  "IPv6WithPort",
+			input: "ops@[2001:db8::1]:2222",
+			want:  []BastionConfig{{Host: "2001:db8::1", Port: "2222", User: "ops"}},
+		},
+		{
+			name:  "IPv6WithoutPort",
+			input: "[2001:db8::1]",
+			want:  []BastionConfig{{Host: "2001:db8::1", Port: "22"}},
+		},
+		{
+			name:    "EmptyEntry",
+			input:   "jump1,,jump2",
+			wantErr: "jump host 2",
+		},
+		{
+			name:    "InvalidPort",
+			input:   "jump1:ssh",
+			wantErr: `invalid port "ssh"`,
+		},
+		{
+			name:    "PortOutOfRange",
+			input:   "jump1:70000",
+			wantErr: `invalid port "70000"`,
+		},
+		{
+			name:    "EmptyUser",
+			input:   "@jump1",
+			wantErr: "empty user",
+		},
+		{
+			name:    "EmptyHost",
+			input:   "user@:22",
+			wantErr: "empty host",
+		},
+		{
+			name:    "UnbracketedIPv6",
+			input:   "2001:db8::1",
+			wantErr: "must be enclosed in brackets",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseProxyJump(tt.input)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
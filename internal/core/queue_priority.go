@@ -0,0 +1,34 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+// QueuePriority controls the order in which queued runs of a DAG are dequeued
+// relative to other runs in the same queue.
+type QueuePriority string
+
+const (
+	// QueuePriorityLow is the default priority. Runs are dequeued in FIFO order.
+	QueuePriorityLow QueuePriority = "low"
+
+	// QueuePriorityHigh runs are dequeued before any low-priority runs.
+	QueuePriorityHigh QueuePriority = "high"
+)
+
+// ParseQueuePriority parses a string into a QueuePriority.
+// Empty string defaults to QueuePriorityLow.
+func ParseQueuePriority(s string) (QueuePriority, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "low":
+		return QueuePriorityLow, nil
+	case "high":
+		return QueuePriorityHigh, nil
+	default:
+		return "", fmt.Errorf("invalid queue priority %q: must be \"high\" or \"low\"", s)
+	}
+}
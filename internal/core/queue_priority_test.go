@@ -0,0 +1,41 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueuePriority(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    QueuePriority
+		wantErr bool
+	}{
+		{name: "high", input: "high", want: QueuePriorityHigh},
+		{name: "low", input: "low", want: QueuePriorityLow},
+		{name: "empty defaults to low", input: "", want: QueuePriorityLow},
+		{name: "uppercase HIGH", input: "HIGH", want: QueuePriorityHigh},
+		{name: "whitespace padded", input: "  high  ", want: QueuePriorityHigh},
+		{name: "invalid value", input: "urgent", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseQueuePriority(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
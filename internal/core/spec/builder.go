@@ -7,9 +7,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/dagucloud/dagu/internal/cmn/eval"
 	"github.com/dagucloud/dagu/internal/core"
+	"github.com/dagucloud/dagu/internal/core/spec/types"
 	"github.com/go-viper/mapstructure/v2"
 )
 
@@ -42,6 +44,30 @@ type BuildContext struct {
 	// paramsState caches DAG-level parameter parsing/resolution during a single build.
 	// This avoids reparsing params for Params, DefaultParams, ParamsJSON, and ParamDefs.
 	paramsState *paramsState
+
+	// warnings collects build warnings raised by step transformers, which
+	// cannot reach the DAG being built. build() copies them to the result.
+	warnings *[]core.BuildWarning
+}
+
+// addWarnings records build warnings when the context collects them.
+func (c BuildContext) addWarnings(warnings ...core.BuildWarning) {
+	if c.warnings != nil {
+		*c.warnings = append(*c.warnings, warnings...)
+	}
+}
+
+// envCoercionWarnings returns a build warning for each non-string env value
+// that was converted to a string.
+func envCoercionWarnings(prefix string, env types.EnvValue) []core.BuildWarning {
+	var warnings []core.BuildWarning
+	for _, msg := range env.CoercionWarnings() {
+		warnings = append(warnings, core.BuildWarning{
+			Code:    core.WarningEnvValueCoerced,
+			Message: prefix + msg,
+		})
+	}
+	return warnings
 }
 
 // envScopeState holds mutable state that needs to be shared across transformers.
@@ -94,6 +120,7 @@ const (
 	BuildFlagSkipSchemaValidation
 	BuildFlagSkipBaseHandlers // Skip merging handlerOn from base config (for sub-DAG runs)
 	BuildFlagValidateRuntimeParams
+	BuildFlagCheckPaths // Verify that paths such as working_dir exist on this host
 )
 
 // BuildOpts is used to control the behavior of the builder.
@@ -124,6 +151,9 @@ type BuildOpts struct {
 	// them via ${VAR}. Used for retry/restart where dotenv values need to be
 	// available during rebuild from YamlData.
 	BuildEnv map[string]string
+	// RemoteSchemaCacheTTL is how long remote params schemas are served from
+	// cache when the server sends no max-age. Nil uses DefaultRemoteSchemaCacheTTL.
+	RemoteSchemaCacheTTL *time.Duration
 }
 
 // Has reports whether the flag is enabled on the current BuildOpts.
@@ -131,6 +161,15 @@ func (o BuildOpts) Has(flag BuildFlag) bool {
 	return o.Flags&flag != 0
 }
 
+// remoteSchemaCacheTTL returns the freshness lifetime for cached remote
+// params schemas.
+func (o BuildOpts) remoteSchemaCacheTTL() time.Duration {
+	if o.RemoteSchemaCacheTTL == nil {
+		return DefaultRemoteSchemaCacheTTL
+	}
+	return max(*o.RemoteSchemaCacheTTL, 0)
+}
+
 // parsePrecondition parses the precondition field.
 func parsePrecondition(ctx BuildContext, precondition any) ([]*core.Condition, error) {
 	switch v := precondition.(type) {
@@ -141,6 +180,9 @@ func parsePrecondition(ctx BuildContext, precondition any) ([]*core.Condition, e
 		return []*core.Condition{{Condition: v}}, nil
 
 	case map[string]any:
+		if conds, ok, err := parsePreconditionGroup(ctx, v); ok {
+			return conds, err
+		}
 		var ret core.Condition
 		for key, vv := range v {
 			switch strings.ToLower(key) {
@@ -158,6 +200,13 @@ func parsePrecondition(ctx BuildContext, precondition any) ([]*core.Condition, e
 				}
 				ret.Expected = val
 
+			case "source":
+				val, ok := vv.(string)
+				if !ok {
+					return nil, core.NewValidationError("preconditions", vv, ErrPreconditionValueMustBeString)
+				}
+				ret.Source = val
+
 			case "command":
 				val, ok := vv.(string)
 				if !ok {
@@ -181,6 +230,9 @@ func parsePrecondition(ctx BuildContext, precondition any) ([]*core.Condition, e
 		if err := ret.Validate(); err != nil {
 			return nil, core.NewValidationError("preconditions", v, err)
 		}
+		if err := ret.Compile(); err != nil {
+			return nil, core.NewValidationError("preconditions", v, err)
+		}
 
 		return []*core.Condition{&ret}, nil
 
@@ -201,6 +253,40 @@ func parsePrecondition(ctx BuildContext, precondition any) ([]*core.Condition, e
 	}
 }
 
+// parsePreconditionGroup parses the `{ any: [...] }` and `{ all: [...] }`
+// forms of a precondition. An all group is the same as a bare list, so its
+// conditions are returned as they are; an any group becomes a single
+// condition that is met when one of its alternatives is. It reports ok=false
+// when the map is a plain condition.
+func parsePreconditionGroup(ctx BuildContext, v map[string]any) ([]*core.Condition, bool, error) {
+	var mode string
+	var items any
+	for key, vv := range v {
+		switch strings.ToLower(key) {
+		case "any", "all":
+			mode, items = strings.ToLower(key), vv
+		}
+	}
+	if mode == "" {
+		return nil, false, nil
+	}
+	if len(v) != 1 {
+		return nil, true, core.NewValidationError("preconditions", v, ErrPreconditionGroupHasOtherKeys)
+	}
+
+	conds, err := parsePrecondition(ctx, items)
+	if err != nil {
+		return nil, true, err
+	}
+	if len(conds) == 0 {
+		return nil, true, core.NewValidationError("preconditions", v, ErrPreconditionGroupIsEmpty)
+	}
+	if mode == "all" {
+		return conds, true, nil
+	}
+	return []*core.Condition{{Any: conds}}, true, nil
+}
+
 // parseSecretRefs parses secret references from the YAML definition.
 func parseSecretRefs(secretRefs []secretRef) ([]core.SecretRef, error) {
 
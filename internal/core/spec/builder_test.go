@@ -4,6 +4,7 @@
 package spec_test
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
@@ -178,6 +179,27 @@ params:
 `,
 			wantParams: []string{"BASE=${SOURCE_ID}", "PREFIX=${BASE:0:5}", "REMAINDER=${BASE:5}", "FALLBACK=${MISSING_VALUE:-fallback}"},
 		},
+		{
+			name: "ParamsWithCaseModification",
+			yaml: `
+params:
+  - NAME: Hello World
+  - UPPER: ${NAME^^}
+  - LOWER_PREFIX: ${NAME:0:5,,}
+  - FALLBACK: ${MISSING_VALUE:-fallback^^}
+`,
+			wantParams: []string{"NAME=Hello World", "UPPER=${NAME^^}", "LOWER_PREFIX=${NAME:0:5,,}", "FALLBACK=${MISSING_VALUE:-fallback^^}"},
+		},
+		{
+			name: "ParamsMapKeepsDeclarationOrder",
+			yaml: `
+params:
+  Z_BASE: HBL01_22OCT2025_0536
+  A_PREFIX: ${Z_BASE:0:5}
+  M_REMAINDER: $Z_BASE
+`,
+			wantParams: []string{"Z_BASE=HBL01_22OCT2025_0536", "A_PREFIX=${Z_BASE:0:5}", "M_REMAINDER=$Z_BASE"},
+		},
 		{
 			name: "ParamsNoEvalPreservesRaw",
 			yaml: `
@@ -209,6 +231,68 @@ params:
 	}
 }
 
+func TestParamsMapForwardReference(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr string
+	}{
+		{
+			name: "BracedReference",
+			yaml: `
+params:
+  PREFIX: ${BASE:0:5}
+  BASE: HBL01_22OCT2025_0536
+`,
+			wantErr: `parameter "PREFIX" references "BASE", which is declared after it`,
+		},
+		{
+			name: "PlainReference",
+			yaml: `
+params:
+  GREETING: hello $NAME
+  NAME: world
+`,
+			wantErr: `parameter "GREETING" references "NAME", which is declared after it`,
+		},
+		{
+			name: "LaterOfSeveralReferences",
+			yaml: `
+params:
+  FIRST: one
+  JOINED: ${FIRST}-${LAST}
+  LAST: three
+`,
+			wantErr: `parameter "JOINED" references "LAST", which is declared after it`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := spec.LoadYAML(context.Background(), []byte(tt.yaml))
+			require.Error(t, err)
+			assert.ErrorIs(t, err, spec.ErrInvalidParamValue)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+
+	t.Run("EnvReferenceIsNotForward", func(t *testing.T) {
+		t.Parallel()
+		dag, err := spec.LoadYAML(context.Background(), []byte(`
+env:
+  - NAME: env
+params:
+  GREETING: hello ${HOME} ${NAME}
+`))
+		require.NoError(t, err)
+		th := DAG{t: t, DAG: dag}
+		th.AssertParam(t, "GREETING=hello ${HOME} ${NAME}")
+	})
+}
+
 func TestBuildChainType(t *testing.T) {
 	t.Parallel()
 
@@ -392,6 +476,29 @@ steps:
 				"FOO": "BEE:BAZ:BOO:FOO",
 			},
 		},
+		{
+			name: "ValidEnvWithCaseModification",
+			yaml: `
+env:
+  - NAME: "Hello World"
+  - EMPTY: ""
+  - UPPER: "${NAME^^}"
+  - LOWER: "${NAME,,}"
+  - PREFIX: "${NAME:0:5^^}"
+  - FALLBACK: "${EMPTY:-Fallback,,}"
+  - INVALID: "${NAME^^:0:5}"
+
+steps:
+  - "true"
+`,
+			expected: map[string]string{
+				"UPPER":    "HELLO WORLD",
+				"LOWER":    "hello world",
+				"PREFIX":   "HELLO",
+				"FALLBACK": "fallback",
+				"INVALID":  "${NAME^^:0:5}",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -507,6 +614,124 @@ steps:
 	}
 }
 
+func TestBuildStopSignal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Parsed", func(t *testing.T) {
+		t.Parallel()
+
+		dag, err := spec.LoadYAML(context.Background(), []byte(`
+schedule:
+  stop: "0 2 * * *"
+  stop_signal: SIGUSR1
+
+steps:
+  - "true"
+`))
+		require.NoError(t, err)
+		assert.Equal(t, "SIGUSR1", dag.StopSignal)
+		require.Len(t, dag.StopSchedule, 1)
+		assert.Equal(t, "0 2 * * *", dag.StopSchedule[0].Expression)
+	})
+
+	t.Run("Unset", func(t *testing.T) {
+		t.Parallel()
+
+		dag, err := spec.LoadYAML(context.Background(), []byte(`
+schedule:
+  stop: "0 2 * * *"
+
+steps:
+  - "true"
+`))
+		require.NoError(t, err)
+		assert.Empty(t, dag.StopSignal)
+	})
+
+	t.Run("InvalidSignal", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := spec.LoadYAML(context.Background(), []byte(`
+schedule:
+  stop: "0 2 * * *"
+  stop_signal: SIGBOGUS
+
+steps:
+  - "true"
+`))
+		require.ErrorIs(t, err, spec.ErrInvalidSignal)
+	})
+
+	t.Run("WithoutStopSchedule", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := spec.LoadYAML(context.Background(), []byte(`
+schedule:
+  start: "0 1 * * *"
+  stop_signal: SIGUSR1
+
+steps:
+  - "true"
+`))
+		require.ErrorContains(t, err, "stop_signal requires a stop schedule")
+	})
+}
+
+func TestBuildWorkingDirCheckPaths(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "file.txt")
+	require.NoError(t, os.WriteFile(file, []byte("x"), 0o600))
+	missing := filepath.Join(dir, "missing")
+
+	load := func(t *testing.T, wd string, opts ...spec.LoadOption) (*core.DAG, error) {
+		t.Helper()
+		return spec.LoadYAML(context.Background(), fmt.Appendf(nil, "working_dir: %s\nsteps:\n  - echo hello\n", wd), opts...)
+	}
+
+	t.Run("ExistingDir", func(t *testing.T) {
+		t.Parallel()
+		dag, err := load(t, dir, spec.WithCheckPaths())
+		require.NoError(t, err)
+		assert.Equal(t, dir, dag.WorkingDir)
+	})
+
+	t.Run("MissingDir", func(t *testing.T) {
+		t.Parallel()
+		_, err := load(t, missing, spec.WithCheckPaths())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "working_dir")
+		assert.Contains(t, err.Error(), fmt.Sprintf("working directory %s does not exist", missing))
+	})
+
+	t.Run("NotADirectory", func(t *testing.T) {
+		t.Parallel()
+		_, err := load(t, file, spec.WithCheckPaths())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is not a directory")
+	})
+
+	t.Run("MissingDirWithoutFlag", func(t *testing.T) {
+		t.Parallel()
+		dag, err := load(t, missing)
+		require.NoError(t, err)
+		assert.Equal(t, missing, dag.WorkingDir)
+	})
+
+	t.Run("RuntimeExpandedPathSkipped", func(t *testing.T) {
+		t.Parallel()
+		_, err := load(t, "${HOME}/does-not-exist", spec.WithCheckPaths())
+		require.NoError(t, err)
+	})
+
+	t.Run("AbsolutePathWithVariableSkipped", func(t *testing.T) {
+		t.Parallel()
+		_, err := load(t, filepath.Join(dir, "${ENV_NAME}", "work"), spec.WithCheckPaths())
+		require.NoError(t, err)
+	})
+}
+
 func TestBuildStep(t *testing.T) {
 	t.Parallel()
 	t.Run("ValidCommand", func(t *testing.T) {
@@ -527,6 +752,90 @@ steps:
 		assert.Equal(t, []string{"1"}, th.Steps[0].Commands[0].Args)
 		assert.Equal(t, "step1", th.Steps[0].Name)
 	})
+	t.Run("CreateDir", func(t *testing.T) {
+		t.Parallel()
+
+		dag, err := spec.LoadYAML(context.Background(), []byte(`
+steps:
+  - name: step1
+    command: echo 1
+    working_dir: /tmp/out
+    create_dir: true
+`))
+		require.NoError(t, err)
+		require.Len(t, dag.Steps, 1)
+		assert.Equal(t, "/tmp/out", dag.Steps[0].Dir)
+		assert.True(t, dag.Steps[0].CreateDir)
+
+		_, err = spec.LoadYAML(context.Background(), []byte(`
+steps:
+  - name: step1
+    command: echo 1
+    create_dir: true
+`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "create_dir requires working_dir")
+	})
+	t.Run("MarkFailureConflictsWithMarkSuccess", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := spec.LoadYAML(context.Background(), []byte(`
+steps:
+  - name: step1
+    command: echo ERROR
+    mark_failure:
+      output: ERROR
+    continue_on:
+      failure: true
+      mark_success: true
+`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "conflicts with continue_on.mark_success")
+	})
+	t.Run("Stdin", func(t *testing.T) {
+		t.Parallel()
+
+		dag, err := spec.LoadYAML(context.Background(), []byte(`
+steps:
+  - name: literal
+    command: cat
+    stdin: hello
+  - name: file
+    command: cat
+    stdin: "@ ${DATA_DIR}/input.txt"
+  - name: escaped
+    command: cat
+    stdin: "@@handle"
+`))
+		require.NoError(t, err)
+		require.Len(t, dag.Steps, 3)
+		assert.Equal(t, &core.StepStdin{Content: "hello"}, dag.Steps[0].Stdin)
+		assert.Equal(t, &core.StepStdin{File: "${DATA_DIR}/input.txt"}, dag.Steps[1].Stdin)
+		assert.Equal(t, &core.StepStdin{Content: "@handle"}, dag.Steps[2].Stdin)
+
+		_, err = spec.LoadYAML(context.Background(), []byte(`
+steps:
+  - name: step1
+    command: cat
+    stdin: "@"
+`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "file path is required")
+	})
+	t.Run("Template", func(t *testing.T) {
+		t.Parallel()
+
+		dag, err := spec.LoadYAML(context.Background(), []byte(`
+steps:
+  - name: step1
+    template: true
+    command: '{{ if eq .Env.MODE "prod" }}echo deploy{{ end }}'
+`))
+		require.NoError(t, err)
+		require.Len(t, dag.Steps, 1)
+		assert.True(t, dag.Steps[0].Template)
+		assert.Equal(t, `{{ if eq .Env.MODE "prod" }}echo deploy{{ end }}`, dag.Steps[0].Commands[0].CmdWithArgs)
+	})
 	t.Run("CommandAsScript", func(t *testing.T) {
 		t.Parallel()
 
@@ -749,6 +1058,24 @@ steps:
 		})
 	}
 
+	t.Run("ContinueOnOutputCompiled", func(t *testing.T) {
+		t.Parallel()
+		dag, err := spec.LoadYAML(context.Background(), []byte(`
+steps:
+  - command: "echo 1"
+    continue_on:
+      output: ["WARN", "re:^panic: .+"]
+`))
+		require.NoError(t, err)
+		require.Len(t, dag.Steps, 1)
+		patterns, err := dag.Steps[0].ContinueOn.OutputPatterns()
+		require.NoError(t, err)
+		assert.Equal(t, 2, patterns.Len())
+		assert.True(t, patterns.MatchScanner(context.Background(), bufio.NewScanner(strings.NewReader("starting\npanic: boom\n"))))
+		assert.True(t, patterns.MatchScanner(context.Background(), bufio.NewScanner(strings.NewReader("WARN disk low\n"))))
+		assert.False(t, patterns.MatchScanner(context.Background(), bufio.NewScanner(strings.NewReader("all good\n"))))
+	})
+
 	// ContinueOn error cases
 	continueOnErrorTests := []struct {
 		name        string
@@ -794,6 +1121,16 @@ steps:
 `,
 			errContains: []string{"continue_on.mark_success", "bool"},
 		},
+		{
+			name: "ContinueOnInvalidOutputRegex",
+			yaml: `
+steps:
+  - command: "echo 1"
+    continue_on:
+      output: ["ok", "re:[unclosed"]
+`,
+			errContains: []string{"continue_on.output", "invalid regexp pattern"},
+		},
 	}
 
 	for _, tt := range continueOnErrorTests {
@@ -935,17 +1272,18 @@ steps:
 	}
 	// RepeatPolicy success tests
 	repeatPolicyTests := []struct {
-		name            string
-		yaml            string
-		wantMode        core.RepeatMode
-		wantInterval    time.Duration
-		wantLimit       int
-		wantExitCode    []int
-		wantCondition   string
-		wantExpected    string
-		wantBackoff     float64
-		wantMaxInterval time.Duration
-		wantNoCondition bool
+		name                 string
+		yaml                 string
+		wantMode             core.RepeatMode
+		wantInterval         time.Duration
+		wantLimit            int
+		wantExitCode         []int
+		wantCondition        string
+		wantExpected         string
+		wantBackoff          float64
+		wantMaxInterval      time.Duration
+		wantNoCondition      bool
+		wantConditionTimeout time.Duration
 	}{
 		{
 			name: "RepeatPolicyBasic",
@@ -997,6 +1335,36 @@ steps:
 			wantCondition: "echo hello",
 			wantExpected:  "hello",
 		},
+		{
+			name: "RepeatPolicyMaxRepeats",
+			yaml: `
+steps:
+  - name: "repeat-max-repeats"
+    command: "echo test"
+    repeat_policy:
+      repeat: true
+      interval_sec: 5
+      max_repeats: 3
+`,
+			wantMode:     core.RepeatModeWhile,
+			wantInterval: 5 * time.Second,
+			wantLimit:    4,
+		},
+		{
+			name: "RepeatPolicyConditionTimeout",
+			yaml: `
+steps:
+  - name: "repeat-condition-timeout"
+    command: "echo test"
+    repeat_policy:
+      repeat: "while"
+      condition: "curl -sf http://localhost/health"
+      condition_timeout_sec: 30
+`,
+			wantMode:             core.RepeatModeWhile,
+			wantCondition:        "curl -sf http://localhost/health",
+			wantConditionTimeout: 30 * time.Second,
+		},
 		{
 			name: "RepeatPolicyWhileExitCode",
 			yaml: `
@@ -1163,39 +1531,101 @@ steps:
 			if tt.wantMaxInterval > 0 {
 				assert.Equal(t, tt.wantMaxInterval, rp.MaxInterval)
 			}
+			assert.Equal(t, tt.wantConditionTimeout, rp.ConditionTimeout)
 		})
 	}
-	t.Run("SignalOnStop", func(t *testing.T) {
+	t.Run("RepeatPolicyBackoffDefaultMaxInterval", func(t *testing.T) {
 		t.Parallel()
 
-		data := []byte(`
+		dag, err := spec.LoadYAML(context.Background(), []byte(`
 steps:
-  - command: echo 1
-    name: step1
-    signal_on_stop: SIGINT
-`)
-		dag, err := spec.LoadYAML(context.Background(), data)
+  - name: "poll"
+    command: "echo test"
+    repeat_policy:
+      repeat: while
+      interval_sec: 5
+      backoff: 2.0
+      exit_code: [1]
+`))
 		require.NoError(t, err)
-		th := DAG{t: t, DAG: dag}
-		assert.Len(t, th.Steps, 1)
-		assert.Equal(t, "SIGINT", th.Steps[0].SignalOnStop)
+		require.Len(t, dag.Steps, 1)
+		assert.Equal(t, time.Hour, dag.Steps[0].RepeatPolicy.MaxInterval)
+		require.Len(t, dag.BuildWarnings, 1)
+		assert.Equal(t, core.WarningRepeatMaxIntervalCapped, dag.BuildWarnings[0].Code)
+		assert.Contains(t, dag.BuildWarnings[0].Message, `step "poll"`)
+		assert.Contains(t, dag.BuildWarnings[0].Message, "max_interval_sec")
 	})
-	t.Run("StepWithID", func(t *testing.T) {
+	t.Run("RepeatPolicyBackoffDefaultMaxIntervalHandler", func(t *testing.T) {
 		t.Parallel()
 
-		data := []byte(`
+		dag, err := spec.LoadYAML(context.Background(), []byte(`
+handler_on:
+  exit:
+    command: "echo cleanup"
+    repeat_policy:
+      repeat: until
+      interval_sec: 5
+      backoff: 2.0
+      exit_code: [1]
 steps:
-  - name: step1
-    id: unique_step_1
-    command: echo "Step with ID"
-  - name: step2
-    command: echo "Step without ID"
-  - name: step3
-    id: custom_id_123
-    command: echo "Another step with ID"
-`)
-		dag, err := spec.LoadYAML(context.Background(), data)
-		require.NoError(t, err)
+  - name: "main"
+    command: "echo test"
+`))
+		require.NoError(t, err)
+		require.NotNil(t, dag.HandlerOn.Exit.First())
+		assert.Equal(t, time.Hour, dag.HandlerOn.Exit.First().RepeatPolicy.MaxInterval)
+		require.Len(t, dag.BuildWarnings, 1)
+		assert.Contains(t, dag.BuildWarnings[0].Message, "max_interval_sec")
+	})
+	t.Run("RepeatPolicyBackoffExplicitMaxInterval", func(t *testing.T) {
+		t.Parallel()
+
+		dag, err := spec.LoadYAML(context.Background(), []byte(`
+steps:
+  - name: "poll"
+    command: "echo test"
+    repeat_policy:
+      repeat: while
+      interval_sec: 5
+      backoff: 2.0
+      max_interval_sec: 120
+      exit_code: [1]
+`))
+		require.NoError(t, err)
+		assert.Equal(t, 2*time.Minute, dag.Steps[0].RepeatPolicy.MaxInterval)
+		assert.Empty(t, dag.BuildWarnings)
+	})
+	t.Run("SignalOnStop", func(t *testing.T) {
+		t.Parallel()
+
+		data := []byte(`
+steps:
+  - command: echo 1
+    name: step1
+    signal_on_stop: SIGINT
+`)
+		dag, err := spec.LoadYAML(context.Background(), data)
+		require.NoError(t, err)
+		th := DAG{t: t, DAG: dag}
+		assert.Len(t, th.Steps, 1)
+		assert.Equal(t, "SIGINT", th.Steps[0].SignalOnStop)
+	})
+	t.Run("StepWithID", func(t *testing.T) {
+		t.Parallel()
+
+		data := []byte(`
+steps:
+  - name: step1
+    id: unique_step_1
+    command: echo "Step with ID"
+  - name: step2
+    command: echo "Step without ID"
+  - name: step3
+    id: custom_id_123
+    command: echo "Another step with ID"
+`)
+		dag, err := spec.LoadYAML(context.Background(), data)
+		require.NoError(t, err)
 		th := DAG{t: t, DAG: dag}
 		assert.Len(t, th.Steps, 3)
 
@@ -1248,6 +1678,109 @@ steps:
 		assert.Len(t, th.Steps[0].Preconditions, 1)
 		assert.Equal(t, &core.Condition{Condition: "${STATUS}", Expected: "success", Negate: true}, th.Steps[0].Preconditions[0])
 	})
+	t.Run("StepPreconditionsJSONPath", func(t *testing.T) {
+		t.Parallel()
+
+		data := []byte(`
+steps:
+  - name: "gated"
+    command: "echo hello"
+    preconditions:
+      - condition: "jsonpath:$.status"
+        source: "${RESP}"
+        expected: "ready"
+`)
+		dag, err := spec.LoadYAML(context.Background(), data)
+		require.NoError(t, err)
+		th := DAG{t: t, DAG: dag}
+		assert.Len(t, th.Steps[0].Preconditions, 1)
+		cond := th.Steps[0].Preconditions[0]
+		assert.Equal(t, "jsonpath:$.status", cond.Condition)
+		assert.Equal(t, "${RESP}", cond.Source)
+		assert.Equal(t, "ready", cond.Expected)
+	})
+	t.Run("StepPreconditionsInvalidRegex", func(t *testing.T) {
+		t.Parallel()
+
+		data := []byte(`
+steps:
+  - name: "check"
+    command: "echo hello"
+    preconditions:
+      - condition: "${STATUS}"
+        expected: "re:^(ok|done"
+`)
+		_, err := spec.LoadYAML(context.Background(), data)
+		require.ErrorContains(t, err, `step "check": field 'preconditions': invalid expected value: invalid regexp pattern "re:^(ok|done"`)
+	})
+	t.Run("StepPreconditionsCompiled", func(t *testing.T) {
+		t.Parallel()
+
+		data := []byte(`
+steps:
+  - name: "check"
+    command: "echo hello"
+    preconditions:
+      - condition: "${STATUS}"
+        expected: "re:^(ok|done)$"
+`)
+		dag, err := spec.LoadYAML(context.Background(), data)
+		require.NoError(t, err)
+		require.Len(t, dag.Steps[0].Preconditions, 1)
+		patterns, err := dag.Steps[0].Preconditions[0].ExpectedPatterns()
+		require.NoError(t, err)
+		assert.True(t, patterns.Match(context.Background(), "done"))
+		assert.False(t, patterns.Match(context.Background(), "failed"))
+	})
+	t.Run("StepPreconditionsJSONPathInvalid", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name        string
+			condition   string
+			errContains string
+		}{
+			{
+				name:        "MissingSource",
+				condition:   `{condition: "jsonpath:$.status", expected: "ready"}`,
+				errContains: "jsonpath condition requires source",
+			},
+			{
+				name:        "MissingExpected",
+				condition:   `{condition: "jsonpath:$.status", source: "${RESP}"}`,
+				errContains: "jsonpath condition requires expected",
+			},
+			{
+				name:        "SourceWithoutJSONPath",
+				condition:   `{condition: "test -f x", source: "${RESP}"}`,
+				errContains: "source is only supported with",
+			},
+			{
+				name:        "MalformedPath",
+				condition:   `{condition: "jsonpath:$.items[", source: "${RESP}", expected: "ready"}`,
+				errContains: `step "gated": field 'preconditions': invalid jsonpath "$.items["`,
+			},
+			{
+				name:        "MalformedExpectedRegex",
+				condition:   `{condition: "jsonpath:$.status", source: "${RESP}", expected: "re:(ready"}`,
+				errContains: `step "gated": field 'preconditions': invalid expected value: invalid regexp pattern "re:(ready"`,
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+				data := []byte(`
+steps:
+  - name: "gated"
+    command: "echo hello"
+    preconditions:
+      - ` + tt.condition + `
+`)
+				_, err := spec.LoadYAML(context.Background(), data)
+				require.ErrorContains(t, err, tt.errContains)
+			})
+		}
+	})
 	// RepeatPolicy error tests
 	repeatPolicyErrorTests := []struct {
 		name        string
@@ -1266,6 +1799,44 @@ steps:
 `,
 			errContains: "invalid value for repeat: 'invalid'",
 		},
+		{
+			name: "RepeatPolicyLimitAndMaxRepeats",
+			yaml: `
+steps:
+  - name: "limit-and-max-repeats"
+    command: "echo test"
+    repeat_policy:
+      repeat: true
+      limit: 3
+      max_repeats: 2
+`,
+			errContains: "'limit' and 'max_repeats' are mutually exclusive",
+		},
+		{
+			name: "RepeatPolicyConditionTimeoutWithoutCondition",
+			yaml: `
+steps:
+  - name: "timeout-without-condition"
+    command: "echo test"
+    repeat_policy:
+      repeat: "while"
+      exit_code: [1]
+      condition_timeout_sec: 5
+`,
+			errContains: "'condition_timeout_sec' requires 'condition'",
+		},
+		{
+			name: "RepeatPolicyNegativeMaxRepeats",
+			yaml: `
+steps:
+  - name: "negative-max-repeats"
+    command: "echo test"
+    repeat_policy:
+      repeat: true
+      max_repeats: -1
+`,
+			errContains: "'max_repeats' must be non-negative",
+		},
 		{
 			name: "RepeatPolicyWhileNoCondition",
 			yaml: `
@@ -2596,7 +3167,7 @@ steps:
   - name: step1
     command: echo test
 `,
-			errContains: "either 'exec' or 'image' must be specified",
+			errContains: "either 'exec', 'image', or 'build' must be specified",
 		},
 		{
 			name: "ContainerExecAndImageMutualExclusive",
@@ -2682,6 +3253,93 @@ steps:
 `,
 			errContains: "'exec' and 'image' are mutually exclusive",
 		},
+		{
+			name: "ContainerEntrypointNonStringElement",
+			yaml: `
+container:
+  image: alpine:latest
+  entrypoint: ["/bin/sh", 1]
+steps:
+  - name: step1
+    command: echo test
+`,
+			errContains: "entrypoint array elements must be strings",
+		},
+		{
+			name: "ContainerEntrypointWithKeepalive",
+			yaml: `
+container:
+  image: alpine:latest
+  entrypoint: /bin/sh -c
+steps:
+  - name: step1
+    command: echo test
+`,
+			errContains: "entrypoint cannot be used with startup 'keepalive'",
+		},
+		{
+			name: "ContainerImageAndBuildMutualExclusive",
+			yaml: `
+container:
+  image: alpine:latest
+  build:
+    context: .
+    tag: myapp:dev
+steps:
+  - name: step1
+    command: echo test
+`,
+			errContains: "'image' and 'build' are mutually exclusive",
+		},
+		{
+			name: "ContainerExecWithBuild",
+			yaml: `
+container:
+  exec: my-container
+  build:
+    tag: myapp:dev
+steps:
+  - name: step1
+    command: echo test
+`,
+			errContains: "cannot be used with 'exec'",
+		},
+		{
+			name: "ContainerBuildRequiresTag",
+			yaml: `
+container:
+  build:
+    context: .
+steps:
+  - name: step1
+    command: echo test
+`,
+			errContains: "tag is required",
+		},
+		{
+			name: "ContainerExecWithFallbackToLocal",
+			yaml: `
+container:
+  exec: my-container
+  fallback_to_local: true
+steps:
+  - name: step1
+    command: echo test
+`,
+			errContains: "cannot be used with 'exec'",
+		},
+		{
+			name: "StepContainerFallbackToLocal",
+			yaml: `
+steps:
+  - name: step1
+    container:
+      image: alpine:latest
+      fallback_to_local: true
+    command: echo test
+`,
+			errContains: "only supported on the DAG-level container",
+		},
 	}
 
 	for _, tt := range errorTests {
@@ -2733,6 +3391,94 @@ steps:
 		assert.Equal(t, "bridge", dag.Container.Network)
 		assert.True(t, dag.Container.KeepContainer)
 	})
+
+	t.Run("ContainerBuild", func(t *testing.T) {
+		t.Parallel()
+		yaml := `
+container:
+  build:
+    context: ./app
+    dockerfile: Dockerfile.dev
+    tag: myapp:dev
+  pull_policy: missing
+steps:
+  - name: step1
+    command: echo test
+`
+		dag, err := spec.LoadYAML(context.Background(), []byte(yaml))
+		require.NoError(t, err)
+		require.NotNil(t, dag.Container)
+		require.NotNil(t, dag.Container.Build)
+		assert.Equal(t, "myapp:dev", dag.Container.Image)
+		assert.Equal(t, "./app", dag.Container.Build.Context)
+		assert.Equal(t, "Dockerfile.dev", dag.Container.Build.Dockerfile)
+		assert.Equal(t, core.PullPolicyMissing, dag.Container.PullPolicy)
+	})
+
+	t.Run("ContainerBuildDefaultContext", func(t *testing.T) {
+		t.Parallel()
+		yaml := `
+container:
+  build:
+    tag: myapp:dev
+steps:
+  - name: step1
+    command: echo test
+`
+		dag, err := spec.LoadYAML(context.Background(), []byte(yaml))
+		require.NoError(t, err)
+		require.NotNil(t, dag.Container.Build)
+		assert.Equal(t, ".", dag.Container.Build.Context)
+		assert.Empty(t, dag.Container.Build.Dockerfile)
+	})
+
+	t.Run("ContainerEntrypoint", func(t *testing.T) {
+		t.Parallel()
+		tests := []struct {
+			name       string
+			entrypoint string
+			want       []string
+		}{
+			{name: "String", entrypoint: `"/bin/sh -c"`, want: []string{"/bin/sh", "-c"}},
+			{name: "QuotedString", entrypoint: `'python -m "my app"'`, want: []string{"python", "-m", "my app"}},
+			{name: "Array", entrypoint: `["/usr/bin/env", "bash", "-c"]`, want: []string{"/usr/bin/env", "bash", "-c"}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+				yaml := `
+container:
+  image: alpine:latest
+  startup: entrypoint
+  entrypoint: ` + tt.entrypoint + `
+steps:
+  - name: step1
+    command: echo test
+`
+				dag, err := spec.LoadYAML(context.Background(), []byte(yaml))
+				require.NoError(t, err)
+				require.NotNil(t, dag.Container)
+				assert.Equal(t, tt.want, dag.Container.Entrypoint)
+			})
+		}
+	})
+
+	t.Run("ContainerFallbackToLocal", func(t *testing.T) {
+		t.Parallel()
+		yaml := `
+container:
+  image: alpine:latest
+  fallback_to_local: true
+steps:
+  - name: step1
+    command: echo test
+`
+		dag, err := spec.LoadYAML(context.Background(), []byte(yaml))
+		require.NoError(t, err)
+		require.NotNil(t, dag.Container)
+		assert.True(t, dag.Container.FallbackToLocal)
+		assert.Equal(t, "container", dag.Steps[0].ExecutorConfig.Type)
+	})
 }
 
 func TestContainerExecutorIntegration(t *testing.T) {
@@ -2767,124 +3513,494 @@ steps:
 		require.NoError(t, err)
 		require.Len(t, dag.Steps, 1)
 
-		// Explicit type should override DAG-level container
-		assert.Equal(t, "shell", dag.Steps[0].ExecutorConfig.Type)
+		// Explicit type should override DAG-level container
+		assert.Equal(t, "shell", dag.Steps[0].ExecutorConfig.Type)
+	})
+
+	t.Run("NoContainerNoExecutor", func(t *testing.T) {
+		yaml := `
+steps:
+  - name: step1
+    command: echo test
+`
+		ctx := context.Background()
+		dag, err := spec.LoadYAML(ctx, []byte(yaml))
+		require.NoError(t, err)
+		require.Len(t, dag.Steps, 1)
+
+		// No container and no executor means default (empty) executor
+		assert.Equal(t, "", dag.Steps[0].ExecutorConfig.Type)
+	})
+
+	t.Run("StepWithDockerExecutorConfig", func(t *testing.T) {
+		yaml := `
+container:
+  image: node:18-alpine
+steps:
+  - name: step1
+    command: node app.js
+    type: docker
+    with:
+      image: python:3.11
+`
+		ctx := context.Background()
+		dag, err := spec.LoadYAML(ctx, []byte(yaml))
+		require.NoError(t, err)
+		require.Len(t, dag.Steps, 1)
+
+		// Step-level docker config should override DAG container
+		assert.Equal(t, "docker", dag.Steps[0].ExecutorConfig.Type)
+		assert.Equal(t, "python:3.11", dag.Steps[0].ExecutorConfig.Config["image"])
+	})
+
+	t.Run("MultipleStepsWithContainer", func(t *testing.T) {
+		yaml := `
+container:
+  image: alpine:latest
+steps:
+  - name: step1
+    command: echo "step 1"
+  - name: step2
+    command: echo "step 2"
+    type: shell
+  - name: step3
+    command: echo "step 3"
+`
+		ctx := context.Background()
+		dag, err := spec.LoadYAML(ctx, []byte(yaml))
+		require.NoError(t, err)
+		require.Len(t, dag.Steps, 3)
+
+		// Step 1 and 3 should inherit docker executor
+		assert.Equal(t, "container", dag.Steps[0].ExecutorConfig.Type)
+		assert.Equal(t, "shell", dag.Steps[1].ExecutorConfig.Type)
+		assert.Equal(t, "container", dag.Steps[2].ExecutorConfig.Type)
+	})
+}
+
+func TestSSHInheritance(t *testing.T) {
+	t.Run("StepInheritsSSHFromDAG", func(t *testing.T) {
+		yaml := `
+ssh:
+  user: testuser
+  host: example.com
+  key: ~/.ssh/id_rsa
+steps:
+  - name: step1
+    command: echo hello
+  - name: step2
+    command: ls -la
+`
+		ctx := context.Background()
+		dag, err := spec.LoadYAML(ctx, []byte(yaml))
+		require.NoError(t, err)
+		require.Len(t, dag.Steps, 2)
+
+		// Both steps should inherit SSH executor
+		for _, step := range dag.Steps {
+			assert.Equal(t, "ssh", step.ExecutorConfig.Type)
+		}
+	})
+
+	t.Run("StepOverridesSSHConfig", func(t *testing.T) {
+		yaml := `
+ssh:
+  user: defaultuser
+  host: default.com
+  key: ~/.ssh/default_key
+steps:
+  - name: step1
+    command: echo hello
+    type: ssh
+    with:
+      user: overrideuser
+      ip: override.com
+  - name: step2
+    command: echo world
+    type: command
+`
+		ctx := context.Background()
+		dag, err := spec.LoadYAML(ctx, []byte(yaml))
+		require.NoError(t, err)
+		require.Len(t, dag.Steps, 2)
+
+		// Step 1 should have overridden values
+		step1 := dag.Steps[0]
+		assert.Equal(t, "ssh", step1.ExecutorConfig.Type)
+
+		// Step 2 should use command executor
+		step2 := dag.Steps[1]
+		assert.Equal(t, "command", step2.ExecutorConfig.Type)
+	})
+}
+
+func TestSSHPassword(t *testing.T) {
+	t.Setenv("SSH_PASS", "s3cret")
+
+	yaml := `
+ssh:
+  user: legacy
+  host: legacy.example.com
+  password: ${SSH_PASS}
+steps:
+  - name: step1
+    command: uptime
+`
+	dag, err := spec.LoadYAML(context.Background(), []byte(yaml))
+	require.NoError(t, err)
+	require.NotNil(t, dag.SSH)
+	// Credentials are expanded when the SSH client is created at runtime so
+	// secrets never end up in the stored DAG definition.
+	assert.Equal(t, "${SSH_PASS}", dag.SSH.Password)
+	assert.Empty(t, dag.SSH.Key)
+	assert.Equal(t, "ssh", dag.Steps[0].ExecutorConfig.Type)
+}
+
+func TestSSHProxyJump(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ParsesChain", func(t *testing.T) {
+		t.Parallel()
+		yaml := `
+ssh:
+  user: deploy
+  host: app.internal
+  key: ~/.ssh/id_ed25519
+  proxy_jump: ops@bastion.example.com:2222,jump.internal
+steps:
+  - name: step1
+    command: uptime
+`
+		dag, err := spec.LoadYAML(context.Background(), []byte(yaml))
+		require.NoError(t, err)
+		require.NotNil(t, dag.SSH)
+		assert.Equal(t, []core.BastionConfig{
+			{Host: "bastion.example.com", Port: "2222", User: "ops"},
+			{Host: "jump.internal", Port: "22"},
+		}, dag.SSH.ProxyJump)
+	})
+
+	t.Run("InvalidHostSpec", func(t *testing.T) {
+		t.Parallel()
+		yaml := `
+ssh:
+  user: deploy
+  host: app.internal
+  proxy_jump: bastion:notaport
+steps:
+  - name: step1
+    command: uptime
+`
+		_, err := spec.LoadYAML(context.Background(), []byte(yaml))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ssh.proxy_jump")
+		assert.Contains(t, err.Error(), `invalid port "notaport"`)
+	})
+
+	t.Run("BastionAndProxyJumpMutuallyExclusive", func(t *testing.T) {
+		t.Parallel()
+		yaml := `
+ssh:
+  user: deploy
+  host: app.internal
+  proxy_jump: bastion.example.com
+  bastion:
+    host: other-bastion.example.com
+steps:
+  - name: step1
+    command: uptime
+`
+		_, err := spec.LoadYAML(context.Background(), []byte(yaml))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "'bastion' and 'proxy_jump' are mutually exclusive")
+	})
+}
+
+func TestDAGBaseInheritance(t *testing.T) {
+	t.Parallel()
+
+	writeFile := func(t *testing.T, dir, name, content string) string {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+
+	const baseYAML = `
+env:
+  - REGION: us-east-1
+  - TIER: base
+smtp:
+  host: smtp.example.com
+  port: "587"
+  username: mailer
+schedule: "0 1 * * *"
+ssh:
+  user: deploy
+  host: base.example.com
+steps:
+  - name: base-step
+    command: echo base
+`
+
+	t.Run("InheritsSMTPButOverridesEnv", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		writeFile(t, dir, "base.yaml", baseYAML)
+		child := writeFile(t, dir, "child.yaml", `
+base: base.yaml
+env:
+  - TIER: child
+steps:
+  - name: child-step
+    command: echo child
+`)
+
+		dag, err := spec.Load(context.Background(), child)
+		require.NoError(t, err)
+
+		require.NotNil(t, dag.SMTP)
+		assert.Equal(t, "smtp.example.com", dag.SMTP.Host)
+		assert.Equal(t, "587", dag.SMTP.Port)
+		assert.Equal(t, "mailer", dag.SMTP.Username)
+
+		th := DAG{t: t, DAG: dag}
+		th.AssertEnv(t, "TIER", "child")
+		assert.NotContains(t, dag.Env, "REGION=us-east-1", "child env replaces base env")
+
+		require.Len(t, dag.Schedule, 1)
+		assert.Equal(t, "0 1 * * *", dag.Schedule[0].Expression)
+
+		require.Len(t, dag.Steps, 1, "steps are not inherited by default")
+		assert.Equal(t, "child-step", dag.Steps[0].Name)
+		assert.Equal(t, "ssh", dag.Steps[0].ExecutorConfig.Type)
+	})
+
+	t.Run("InheritsStepsWhenOptedIn", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		writeFile(t, dir, "base.yaml", baseYAML)
+		child := writeFile(t, dir, "child.yaml", `
+base:
+  file: base.yaml
+  inherit_steps: true
+schedule: "0 2 * * *"
+`)
+
+		dag, err := spec.Load(context.Background(), child)
+		require.NoError(t, err)
+		require.Len(t, dag.Steps, 1)
+		assert.Equal(t, "base-step", dag.Steps[0].Name)
+		require.Len(t, dag.Schedule, 1)
+		assert.Equal(t, "0 2 * * *", dag.Schedule[0].Expression)
+	})
+
+	t.Run("ResolvesAgainstWorkingDir", func(t *testing.T) {
+		t.Parallel()
+
+		dagDir := t.TempDir()
+		sharedDir := t.TempDir()
+		writeFile(t, sharedDir, "shared.yaml", baseYAML)
+		child := writeFile(t, dagDir, "child.yaml", fmt.Sprintf(`
+base: shared.yaml
+working_dir: %s
+steps:
+  - command: echo child
+`, sharedDir))
+
+		dag, err := spec.Load(context.Background(), child)
+		require.NoError(t, err)
+		require.NotNil(t, dag.SMTP)
+		assert.Equal(t, "smtp.example.com", dag.SMTP.Host)
+	})
+
+	t.Run("MissingBaseFile", func(t *testing.T) {
+		t.Parallel()
+
+		child := writeFile(t, t.TempDir(), "child.yaml", `
+base: missing.yaml
+steps:
+  - command: echo child
+`)
+
+		_, err := spec.Load(context.Background(), child)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "base DAG file not found")
 	})
 
-	t.Run("NoContainerNoExecutor", func(t *testing.T) {
-		yaml := `
+	t.Run("BaseCycle", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		writeFile(t, dir, "a.yaml", "base: b.yaml\n")
+		writeFile(t, dir, "b.yaml", "base: a.yaml\n")
+		child := writeFile(t, dir, "child.yaml", `
+base: a.yaml
 steps:
-  - name: step1
-    command: echo test
-`
-		ctx := context.Background()
-		dag, err := spec.LoadYAML(ctx, []byte(yaml))
-		require.NoError(t, err)
-		require.Len(t, dag.Steps, 1)
+  - command: echo child
+`)
 
-		// No container and no executor means default (empty) executor
-		assert.Equal(t, "", dag.Steps[0].ExecutorConfig.Type)
+		_, err := spec.Load(context.Background(), child)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "base DAG cycle detected")
 	})
+}
 
-	t.Run("StepWithDockerExecutorConfig", func(t *testing.T) {
-		yaml := `
-container:
-  image: node:18-alpine
+func TestDAGInclude(t *testing.T) {
+	t.Parallel()
+
+	writeFile := func(t *testing.T, dir, name, content string) string {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0750))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+
+	const commonYAML = `
+env:
+  - REGION: us-east-1
+  - TIER: common
+defaults:
+  timeout_sec: 600
+handler_on:
+  failure:
+    name: cleanup
+    command: echo cleanup
+step_types:
+  greet:
+    type: command
+    input_schema:
+      type: object
+      additionalProperties: false
+      required: [message]
+      properties:
+        message:
+          type: string
+    template:
+      exec:
+        command: /bin/echo
+        args:
+          - {$input: message}
+`
+
+	t.Run("SimpleInclude", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		writeFile(t, dir, "common.yaml", commonYAML)
+		child := writeFile(t, dir, "child.yaml", `
+include: [common.yaml]
+env:
+  - TIER: child
 steps:
-  - name: step1
-    command: node app.js
-    type: docker
+  - name: hello
+    type: greet
     with:
-      image: python:3.11
-`
-		ctx := context.Background()
-		dag, err := spec.LoadYAML(ctx, []byte(yaml))
+      message: hello
+`)
+
+		dag, err := spec.Load(context.Background(), child)
 		require.NoError(t, err)
+
+		th := DAG{t: t, DAG: dag}
+		th.AssertEnv(t, "REGION", "us-east-1")
+		th.AssertEnv(t, "TIER", "child")
+
 		require.Len(t, dag.Steps, 1)
+		assert.Equal(t, 600*time.Second, dag.Steps[0].Timeout)
+		require.Len(t, dag.Steps[0].Commands, 1)
+		assert.Equal(t, []string{"hello"}, dag.Steps[0].Commands[0].Args)
 
-		// Step-level docker config should override DAG container
-		assert.Equal(t, "docker", dag.Steps[0].ExecutorConfig.Type)
-		assert.Equal(t, "python:3.11", dag.Steps[0].ExecutorConfig.Config["image"])
+		require.NotNil(t, dag.HandlerOn.Failure)
+		require.Len(t, dag.HandlerOn.Failure.First().Commands, 1)
+		assert.Equal(t, []string{"cleanup"}, dag.HandlerOn.Failure.First().Commands[0].Args)
 	})
 
-	t.Run("MultipleStepsWithContainer", func(t *testing.T) {
-		yaml := `
-container:
-  image: alpine:latest
+	t.Run("NestedIncludeResolvesRelativeToIncludingFile", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		writeFile(t, dir, "lib/common.yaml", commonYAML)
+		writeFile(t, dir, "lib/team.yaml", `
+include: common.yaml
+env:
+  - TEAM: data
+`)
+		child := writeFile(t, dir, "child.yaml", `
+include: lib/team.yaml
+handler_on:
+  failure:
+    command: echo own
 steps:
-  - name: step1
-    command: echo "step 1"
-  - name: step2
-    command: echo "step 2"
-    type: shell
-  - name: step3
-    command: echo "step 3"
-`
-		ctx := context.Background()
-		dag, err := spec.LoadYAML(ctx, []byte(yaml))
+  - command: echo child
+`)
+
+		dag, err := spec.Load(context.Background(), child)
 		require.NoError(t, err)
-		require.Len(t, dag.Steps, 3)
 
-		// Step 1 and 3 should inherit docker executor
-		assert.Equal(t, "container", dag.Steps[0].ExecutorConfig.Type)
-		assert.Equal(t, "shell", dag.Steps[1].ExecutorConfig.Type)
-		assert.Equal(t, "container", dag.Steps[2].ExecutorConfig.Type)
+		th := DAG{t: t, DAG: dag}
+		th.AssertEnv(t, "REGION", "us-east-1")
+		th.AssertEnv(t, "TEAM", "data")
+		require.NotNil(t, dag.HandlerOn.Failure)
+		require.Len(t, dag.HandlerOn.Failure.First().Commands, 1)
+		assert.Equal(t, []string{"own"}, dag.HandlerOn.Failure.First().Commands[0].Args, "the DAG's own handler wins")
 	})
-}
 
-func TestSSHInheritance(t *testing.T) {
-	t.Run("StepInheritsSSHFromDAG", func(t *testing.T) {
-		yaml := `
-ssh:
-  user: testuser
-  host: example.com
-  key: ~/.ssh/id_rsa
+	t.Run("DuplicateStepType", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		writeFile(t, dir, "common.yaml", commonYAML)
+		writeFile(t, dir, "again.yaml", commonYAML)
+		child := writeFile(t, dir, "child.yaml", `
+include: [common.yaml, again.yaml]
 steps:
-  - name: step1
-    command: echo hello
-  - name: step2
-    command: ls -la
-`
-		ctx := context.Background()
-		dag, err := spec.LoadYAML(ctx, []byte(yaml))
-		require.NoError(t, err)
-		require.Len(t, dag.Steps, 2)
+  - command: echo child
+`)
 
-		// Both steps should inherit SSH executor
-		for _, step := range dag.Steps {
-			assert.Equal(t, "ssh", step.ExecutorConfig.Type)
-		}
+		_, err := spec.Load(context.Background(), child)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `duplicate custom step type "greet"`)
 	})
 
-	t.Run("StepOverridesSSHConfig", func(t *testing.T) {
-		yaml := `
-ssh:
-  user: defaultuser
-  host: default.com
-  key: ~/.ssh/default_key
+	t.Run("UnsupportedKey", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		writeFile(t, dir, "common.yaml", `
 steps:
-  - name: step1
-    command: echo hello
-    type: ssh
-    with:
-      user: overrideuser
-      ip: override.com
-  - name: step2
-    command: echo world
-    type: command
-`
-		ctx := context.Background()
-		dag, err := spec.LoadYAML(ctx, []byte(yaml))
-		require.NoError(t, err)
-		require.Len(t, dag.Steps, 2)
+  - command: echo shared
+`)
+		child := writeFile(t, dir, "child.yaml", `
+include: common.yaml
+steps:
+  - command: echo child
+`)
 
-		// Step 1 should have overridden values
-		step1 := dag.Steps[0]
-		assert.Equal(t, "ssh", step1.ExecutorConfig.Type)
+		_, err := spec.Load(context.Background(), child)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported keys steps")
+	})
 
-		// Step 2 should use command executor
-		step2 := dag.Steps[1]
-		assert.Equal(t, "command", step2.ExecutorConfig.Type)
+	t.Run("IncludeCycle", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		a := writeFile(t, dir, "a.yaml", "include: b.yaml\n")
+		b := writeFile(t, dir, "b.yaml", "include: a.yaml\n")
+		child := writeFile(t, dir, "child.yaml", `
+include: a.yaml
+steps:
+  - command: echo child
+`)
+
+		_, err := spec.Load(context.Background(), child)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "include cycle detected")
+		assert.Contains(t, err.Error(), strings.Join([]string{child, a, b, a}, " -> "))
 	})
 }
 
@@ -3444,18 +4560,44 @@ steps:
 
 		// Verify environment variables are in dag.Env (not process env)
 		// Child processes will receive them via cmd.Env = AllEnvs()
-		envMap := make(map[string]string)
-		for _, env := range dag.Env {
-			key, value, found := strings.Cut(env, "=")
-			if found {
-				envMap[key] = value
-			}
-		}
+		envMap := dag.EnvMap()
 		assert.Equal(t, "from_file", envMap["LOAD_ENV_DOTENV_VAR"])
 		assert.Equal(t, "from_dag", envMap["LOAD_ENV_ENV_VAR"])
 		assert.Equal(t, "another_value", envMap["LOAD_ENV_ANOTHER_VAR"])
 	})
 
+	t.Run("LoadEnvWithDotenvGlob", func(t *testing.T) {
+		tempDir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(tempDir, "config"), 0755))
+		files := map[string]string{
+			".env":               "GLOB_SHARED=dotenv\nGLOB_ORDER=dotenv\n",
+			"config/20-b.env":    "GLOB_ORDER=b\n",
+			"config/10-a.env":    "GLOB_ORDER=a\nGLOB_A_ONLY=a\n",
+			".env.local":         "GLOB_SHARED=local\n",
+			"config/ignored.txt": "GLOB_ORDER=ignored\n",
+		}
+		for name, content := range files {
+			require.NoError(t, os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644))
+		}
+
+		yaml := fmt.Sprintf(`
+working_dir: %s
+dotenv: [".env", "config/*.env", ".env.local", "missing/*.env"]
+steps:
+  - echo hello
+`, tempDir)
+
+		dag, err := spec.LoadYAMLWithOpts(context.Background(), []byte(yaml), spec.BuildOpts{Flags: spec.BuildFlagNoEval})
+		require.NoError(t, err)
+		dag.LoadDotEnv(context.Background())
+
+		// Later files override earlier ones, and glob matches load in lexical order.
+		envMap := dag.EnvMap()
+		assert.Equal(t, "b", envMap["GLOB_ORDER"])
+		assert.Equal(t, "a", envMap["GLOB_A_ONLY"])
+		assert.Equal(t, "local", envMap["GLOB_SHARED"])
+	})
+
 	t.Run("LoadEnvWithMissingDotenvFile", func(t *testing.T) {
 		yaml := `
 dotenv: nonexistent.env
@@ -3472,13 +4614,7 @@ steps:
 		dag.LoadDotEnv(context.Background())
 
 		// Environment variables from env should still be in dag.Env
-		envMap := make(map[string]string)
-		for _, env := range dag.Env {
-			key, value, found := strings.Cut(env, "=")
-			if found {
-				envMap[key] = value
-			}
-		}
+		envMap := dag.EnvMap()
 		assert.Equal(t, "test_value", envMap["TEST_VAR_LOAD_ENV"])
 	})
 }
@@ -3517,6 +4653,22 @@ steps:
 		assert.Equal(t, []string{"$SHELL_ARG"}, dag.ShellArgs)
 	})
 
+	t.Run("AutoPreserved", func(t *testing.T) {
+		data := []byte(`
+shell: auto
+steps:
+  - name: step1
+    shell: auto -e
+    command: echo hello
+`)
+		dag, err := spec.LoadYAML(context.Background(), data)
+		require.NoError(t, err)
+		// The platform shell is chosen at runtime, not when the DAG is built
+		assert.Equal(t, "auto", dag.Shell)
+		assert.Equal(t, "auto", dag.Steps[0].Shell)
+		assert.Equal(t, []string{"-e"}, dag.Steps[0].ShellArgs)
+	})
+
 	// NoEval tests (cannot use t.Parallel due to t.Setenv)
 	t.Run("NoEvalPreservesRaw", func(t *testing.T) {
 		t.Setenv("MY_SHELL", "/bin/zsh")
@@ -3610,6 +4762,49 @@ steps:
 			wantStepShell:     "zsh",
 			wantStepShellArgs: nil,
 		},
+		{
+			name: "ArgsExtendDAGShell",
+			yaml: `
+shell: bash -e
+steps:
+  - name: test
+    shell_args: [-x]
+    command: echo hello
+`,
+			wantDAGShell:      "bash",
+			wantDAGShellArgs:  []string{"-e"},
+			wantStepShell:     "bash",
+			wantStepShellArgs: []string{"-e", "-x"},
+		},
+		{
+			name: "ArgsExtendStepShell",
+			yaml: `
+shell: bash -e
+steps:
+  - name: test
+    shell: zsh -u
+    shell_args: [-x]
+    command: echo hello
+`,
+			wantDAGShell:      "bash",
+			wantDAGShellArgs:  []string{"-e"},
+			wantStepShell:     "zsh",
+			wantStepShellArgs: []string{"-u", "-x"},
+		},
+		{
+			name: "ArgsExtendDAGShellArray",
+			yaml: `
+shell: [bash, -o, pipefail]
+steps:
+  - name: test
+    shell_args: [-x, -u]
+    command: echo hello
+`,
+			wantDAGShell:      "bash",
+			wantDAGShellArgs:  []string{"-o", "pipefail"},
+			wantStepShell:     "bash",
+			wantStepShellArgs: []string{"-o", "pipefail", "-x", "-u"},
+		},
 		{
 			name: "NotSpecified",
 			yaml: `
@@ -3855,6 +5050,53 @@ steps:
 	})
 }
 
+func TestEnvCoercionWarning(t *testing.T) {
+	t.Parallel()
+
+	t.Run("BoolAndNumberValues", func(t *testing.T) {
+		t.Parallel()
+
+		dag, err := spec.LoadYAML(context.Background(), []byte(`
+env:
+  DEBUG: true
+  PORT: 8080
+steps:
+  - name: step1
+    command: echo hello
+    env:
+      - RATIO: 1.5
+`))
+		require.NoError(t, err)
+		require.Len(t, dag.BuildWarnings, 3)
+		for _, w := range dag.BuildWarnings {
+			assert.Equal(t, core.WarningEnvValueCoerced, w.Code)
+		}
+		assert.Contains(t, dag.BuildWarnings[0].Message, `env DEBUG: boolean value was converted to the string "true"`)
+		assert.Contains(t, dag.BuildWarnings[1].Message, `env PORT: number value was converted to the string "8080"`)
+		assert.Contains(t, dag.BuildWarnings[2].Message, `step "step1": env RATIO: number value`)
+		assert.Contains(t, dag.Env, "DEBUG=true")
+		assert.Contains(t, dag.Env, "PORT=8080")
+	})
+
+	t.Run("NoWarningForQuotedStrings", func(t *testing.T) {
+		t.Parallel()
+
+		dag, err := spec.LoadYAML(context.Background(), []byte(`
+env:
+  DEBUG: "true"
+  PORT: "8080"
+  NAME: app
+steps:
+  - name: step1
+    command: echo hello
+    env:
+      - RATIO=1.5
+`))
+		require.NoError(t, err)
+		assert.Empty(t, dag.BuildWarnings)
+	})
+}
+
 func TestMaxActiveRunsDeprecationWarning(t *testing.T) {
 	t.Parallel()
 
@@ -3900,8 +5142,9 @@ steps:
 		dag, err := spec.LoadYAML(context.Background(), data)
 		require.NoError(t, err)
 		require.Len(t, dag.BuildWarnings, 1)
-		assert.Contains(t, dag.BuildWarnings[0], "max_active_runs=3 is deprecated")
-		assert.Contains(t, dag.BuildWarnings[0], "global queue")
+		assert.Equal(t, core.WarningDeprecatedMaxActiveRuns, dag.BuildWarnings[0].Code)
+		assert.Contains(t, dag.BuildWarnings[0].Message, "max_active_runs=3 is deprecated")
+		assert.Contains(t, dag.BuildWarnings[0].Message, "global queue")
 	})
 
 	t.Run("WarningForNegativeMaxActiveRuns", func(t *testing.T) {
@@ -3917,7 +5160,7 @@ steps:
 		dag, err := spec.LoadYAML(context.Background(), data)
 		require.NoError(t, err)
 		require.Len(t, dag.BuildWarnings, 1)
-		assert.Contains(t, dag.BuildWarnings[0], "max_active_runs=-1 is deprecated")
+		assert.Contains(t, dag.BuildWarnings[0].Message, "max_active_runs=-1 is deprecated")
 	})
 
 	t.Run("NoWarningWithGlobalQueue", func(t *testing.T) {
@@ -3937,3 +5180,44 @@ steps:
 		assert.Empty(t, dag.BuildWarnings)
 	})
 }
+
+func TestBuildQueuePriority(t *testing.T) {
+	t.Parallel()
+
+	t.Run("High", func(t *testing.T) {
+		t.Parallel()
+
+		dag, err := spec.LoadYAML(context.Background(), []byte(`
+queue: batch
+queue_priority: high
+steps:
+  - command: echo hello
+`))
+		require.NoError(t, err)
+		assert.Equal(t, core.QueuePriorityHigh, dag.QueuePriority)
+	})
+
+	t.Run("DefaultIsUnset", func(t *testing.T) {
+		t.Parallel()
+
+		dag, err := spec.LoadYAML(context.Background(), []byte(`
+queue: batch
+steps:
+  - command: echo hello
+`))
+		require.NoError(t, err)
+		assert.Empty(t, dag.QueuePriority)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := spec.LoadYAML(context.Background(), []byte(`
+queue_priority: urgent
+steps:
+  - command: echo hello
+`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid queue priority")
+	})
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package spec
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dagucloud/dagu/internal/core"
+	"github.com/docker/go-units"
+)
+
+// ParseContainerCPUs parses a CPU limit such as "1.5" into nano CPUs.
+// An empty value returns zero, meaning no limit.
+func ParseContainerCPUs(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+	cpus, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(cpus) || math.IsInf(cpus, 0) {
+		return 0, fmt.Errorf("invalid cpus %q: must be a number such as \"1.5\"", value)
+	}
+	nano := math.Round(cpus * 1e9)
+	if nano <= 0 {
+		return 0, fmt.Errorf("invalid cpus %q: must be greater than zero", value)
+	}
+	return int64(nano), nil
+}
+
+// ParseContainerMemory parses a memory limit such as "512m" or "2g" into
+// bytes. An empty value returns zero, meaning no limit.
+func ParseContainerMemory(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+	bytes, err := units.RAMInBytes(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid memory %q: must be a size such as \"512m\" or \"2g\"", value)
+	}
+	if bytes <= 0 {
+		return 0, fmt.Errorf("invalid memory %q: must be greater than zero", value)
+	}
+	return bytes, nil
+}
+
+// validateStepContainerResources checks the cpus and memory limits in the
+// with block of docker steps. Values holding runtime expressions are left to
+// the executor.
+func validateStepContainerResources(s *step, result *core.Step) error {
+	switch result.ExecutorConfig.Type {
+	case "docker", "container":
+	default:
+		return nil
+	}
+	field := s.executorConfigFieldName()
+	parsers := []struct {
+		key   string
+		parse func(string) (int64, error)
+	}{
+		{key: "cpus", parse: ParseContainerCPUs},
+		{key: "memory", parse: ParseContainerMemory},
+	}
+	for _, p := range parsers {
+		raw, ok := result.ExecutorConfig.Config[p.key]
+		if !ok {
+			continue
+		}
+		value, err := resourceString(raw)
+		if err != nil {
+			return core.NewValidationError(field+"."+p.key, raw, err)
+		}
+		if customStepRuntimeExpressionRegexp.MatchString(value) {
+			continue
+		}
+		if _, err := p.parse(value); err != nil {
+			return core.NewValidationError(field+"."+p.key, raw, err)
+		}
+	}
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/dagucloud/dagu/internal/cmn/cmdutil"
 	"github.com/dagucloud/dagu/internal/cmn/eval"
+	"github.com/dagucloud/dagu/internal/cmn/signal"
 	"github.com/dagucloud/dagu/internal/core"
 	"github.com/dagucloud/dagu/internal/core/spec/types"
 	"github.com/go-viper/mapstructure/v2"
@@ -44,6 +45,13 @@ type dag struct {
 	Group string `yaml:"group,omitempty"`
 	// Description is the description of the DAG.
 	Description string `yaml:"description,omitempty"`
+	// Base names another DAG file whose settings fill in fields this DAG
+	// leaves unset. It is a file path, or an object with file and
+	// inherit_steps keys.
+	Base any `yaml:"base,omitempty"`
+	// Include lists shared files whose env, defaults, handlers and step
+	// types are merged into this DAG.
+	Include types.StringOrArray `yaml:"include,omitempty"`
 	// Type is the execution type for steps (graph, chain, or agent).
 	// Default is "chain" which executes steps in the order they are defined.
 	// "graph" uses dependency-based parallel execution.
@@ -80,9 +88,12 @@ type dag struct {
 	Env types.EnvValue `yaml:"env,omitempty"`
 	// HandlerOn is the handler configuration.
 	HandlerOn handlerOn `yaml:"handler_on,omitempty"`
-	// handlerOnRaw preserves raw handler maps so explicit zero-value call-site
-	// overrides remain distinguishable from omission during build.
-	handlerOnRaw map[string]map[string]any
+	// handlerOnRaw preserves raw handler step maps, in handler order, so
+	// explicit zero-value call-site overrides remain distinguishable from
+	// omission during build.
+	handlerOnRaw map[string][]map[string]any
+	// paramsOrder preserves the declaration order of map-form params.
+	paramsOrder []string
 	// defaultsRaw preserves the authored defaults map so explicit zero/empty
 	// DAG-local overrides can replace inherited base defaults during merge.
 	defaultsRaw map[string]any
@@ -128,6 +139,8 @@ type dag struct {
 	DeprecatedTags types.LabelsValue `yaml:"tags,omitempty"`
 	// Queue is the name of the queue to assign this DAG to.
 	Queue string `yaml:"queue,omitempty"`
+	// QueuePriority is the priority of this DAG's runs within its queue ("high" or "low").
+	QueuePriority string `yaml:"queue_priority,omitempty"`
 	// RetryPolicy is the DAG-level retry policy.
 	RetryPolicy *dagRetryPolicy `yaml:"retry_policy,omitempty"`
 	// MaxOutputSize is the maximum size of the output for each step.
@@ -137,6 +150,9 @@ type dag struct {
 	// WorkerSelector specifies required worker labels for execution.
 	// Can be a map of label key-value pairs or the string "local" to force local execution.
 	WorkerSelector any `yaml:"worker_selector,omitempty"`
+	// Requires lists capabilities a worker must advertise to run the DAG,
+	// such as "gpu" or "cuda>=11".
+	Requires types.StringOrArray `yaml:"requires,omitempty"`
 	// Container is the container definition for the DAG.
 	// Can be a string (existing container name to exec into) or an object (container configuration).
 	Container any `yaml:"container,omitempty"`
@@ -188,15 +204,20 @@ type artifactsConfig struct {
 
 // handlerOn defines the steps to be executed on different events.
 type handlerOn struct {
-	Init    *step `yaml:"init,omitempty"`    // Step to execute before steps (after preconditions pass)
-	Failure *step `yaml:"failure,omitempty"` // Step to execute on failure
-	Success *step `yaml:"success,omitempty"` // Step to execute on success
-	Abort   *step `yaml:"abort,omitempty"`   // Step to execute on abort
-	Exit    *step `yaml:"exit,omitempty"`    // Step to execute on exit
-	Wait    *step `yaml:"wait,omitempty"`    // Step to execute when DAG enters wait status (approval)
+	Init    handlerSteps `yaml:"init,omitempty"`    // Steps to execute before steps (after preconditions pass)
+	Failure handlerSteps `yaml:"failure,omitempty"` // Steps to execute on failure
+	Success handlerSteps `yaml:"success,omitempty"` // Steps to execute on success
+	Abort   handlerSteps `yaml:"abort,omitempty"`   // Steps to execute on abort
+	Exit    handlerSteps `yaml:"exit,omitempty"`    // Steps to execute on exit
+	Wait    handlerSteps `yaml:"wait,omitempty"`    // Steps to execute when DAG enters wait status (approval)
+	Retry   handlerSteps `yaml:"retry,omitempty"`   // Steps to execute before each retry of a step
 }
 
-func (d *dag) rawHandler(name core.HandlerType) map[string]any {
+// handlerSteps is the steps of a single handler, run in order. A handler may
+// be written as a single step or as a list of steps.
+type handlerSteps []*step
+
+func (d *dag) rawHandler(name core.HandlerType, idx int) map[string]any {
 	if d == nil || d.handlerOnRaw == nil {
 		return nil
 	}
@@ -215,11 +236,17 @@ func (d *dag) rawHandler(name core.HandlerType) map[string]any {
 		key = "exit"
 	case core.HandlerOnWait:
 		key = "wait"
+	case core.HandlerOnRetry:
+		key = "retry"
 	default:
 		return nil
 	}
 
-	return d.handlerOnRaw[key]
+	raws := d.handlerOnRaw[key]
+	if idx >= len(raws) {
+		return nil
+	}
+	return raws[idx]
 }
 
 // smtpConfig defines the SMTP configuration.
@@ -264,6 +291,8 @@ type container struct {
 	Name string `yaml:"name,omitempty"`
 	// Image is the container image to use.
 	Image string `yaml:"image,omitempty"`
+	// Build builds the image from a Dockerfile. Mutually exclusive with Image.
+	Build *containerBuild `yaml:"build,omitempty"`
 	// PullPolicy is the policy to pull the image (e.g., "Always", "IfNotPresent").
 	PullPolicy any `yaml:"pull_policy,omitempty"`
 	// Env specifies environment variables for the container.
@@ -286,16 +315,34 @@ type container struct {
 	Startup string `yaml:"startup,omitempty"`
 	// Command used when Startup == "command".
 	Command []string `yaml:"command,omitempty"`
+	// Entrypoint overrides the image ENTRYPOINT. Can be a string or an array.
+	Entrypoint any `yaml:"entrypoint,omitempty"`
 	// WaitFor readiness condition: running|healthy
 	WaitFor string `yaml:"wait_for,omitempty"`
 	// LogPattern regex to wait for in container logs.
 	LogPattern string `yaml:"log_pattern,omitempty"`
 	// RestartPolicy: no|always|unless-stopped
 	RestartPolicy string `yaml:"restart_policy,omitempty"`
+	// CPUs limits the CPUs available to the container. Can be a number or a string (e.g., 1.5).
+	CPUs any `yaml:"cpus,omitempty"`
+	// Memory limits the container memory (e.g., "512m", "2g").
+	Memory any `yaml:"memory,omitempty"`
 	// Healthcheck defines a custom healthcheck for the container.
 	Healthcheck *healthcheck `yaml:"healthcheck,omitempty"`
 	// Shell specifies the shell wrapper for executing step commands.
 	Shell []string `yaml:"shell,omitempty"`
+	// FallbackToLocal runs steps locally when the Docker daemon is unavailable.
+	FallbackToLocal bool `yaml:"fallback_to_local,omitempty"`
+}
+
+// containerBuild is the spec representation for building a container image.
+type containerBuild struct {
+	// Context is the build context directory (default ".").
+	Context string `yaml:"context,omitempty"`
+	// Dockerfile is the Dockerfile path relative to the context.
+	Dockerfile string `yaml:"dockerfile,omitempty"`
+	// Tag is the tag for the built image.
+	Tag string `yaml:"tag,omitempty"`
 }
 
 // healthcheck is the spec representation for custom health checks.
@@ -347,6 +394,8 @@ type ssh struct {
 	Timeout string `yaml:"timeout,omitempty"`
 	// Bastion is the jump host / bastion server configuration.
 	Bastion *bastion `yaml:"bastion,omitempty"`
+	// ProxyJump is a comma-separated chain of "[user@]host[:port]" jump hosts.
+	ProxyJump string `yaml:"proxy_jump,omitempty"`
 }
 
 // bastion defines the bastion/jump host configuration.
@@ -487,14 +536,17 @@ var metadataTransformers = []transform{
 	{"env", newTransformer("Env", buildEnvs)},
 	{"schedule", newTransformer("Schedule", buildSchedule)},
 	{"stop_schedule", newTransformer("StopSchedule", buildStopSchedule)},
+	{"stop_signal", newTransformer("StopSignal", buildStopSignal)},
 	{"restart_schedule", newTransformer("RestartSchedule", buildRestartSchedule)},
 	{"worker_selector", &workerSelectorTransformer{}},
+	{"requires", newTransformer("Requires", buildRequires)},
 	{"timeout", newTransformer("Timeout", buildTimeout)},
 	{"delay", newTransformer("Delay", buildDelay)},
 	{"restart_wait", newTransformer("RestartWait", buildRestartWait)},
 	{"max_active_runs", newTransformer("MaxActiveRuns", buildMaxActiveRuns)},
 	{"max_active_steps", newTransformer("MaxActiveSteps", buildMaxActiveSteps)},
 	{"queue", newTransformer("Queue", buildQueue)},
+	{"queue_priority", newTransformer("QueuePriority", buildQueuePriority)},
 	{"retry_policy", newTransformer("RetryPolicy", buildDAGRetryPolicy)},
 	{"max_output_size", newTransformer("MaxOutputSize", buildMaxOutputSize)},
 	{"skip_if_successful", newTransformer("SkipIfSuccessful", buildSkipIfSuccessful)},
@@ -543,7 +595,7 @@ func runTransformers(ctx BuildContext, spec *dag, result *core.DAG) core.ErrorLi
 	// Always run metadata transformers
 	for _, t := range metadataTransformers {
 		if err := t.transformer.Transform(ctx, spec, out); err != nil {
-			errs = append(errs, wrapTransformError(t.name, err))
+			errs = appendTransformError(errs, t.name, err)
 		}
 	}
 
@@ -551,7 +603,7 @@ func runTransformers(ctx BuildContext, spec *dag, result *core.DAG) core.ErrorLi
 	if !ctx.opts.Has(BuildFlagOnlyMetadata) {
 		for _, t := range fullTransformers {
 			if err := t.transformer.Transform(ctx, spec, out); err != nil {
-				errs = append(errs, wrapTransformError(t.name, err))
+				errs = appendTransformError(errs, t.name, err)
 			}
 		}
 	}
@@ -559,6 +611,19 @@ func runTransformers(ctx BuildContext, spec *dag, result *core.DAG) core.ErrorLi
 	return errs
 }
 
+// appendTransformError appends a transformer error to errs. An ErrorList is
+// flattened so each entry is reported on its own.
+func appendTransformError(errs core.ErrorList, name string, err error) core.ErrorList {
+	list, ok := err.(core.ErrorList)
+	if !ok {
+		return append(errs, wrapTransformError(name, err))
+	}
+	for _, e := range list {
+		errs = append(errs, wrapTransformError(name, e))
+	}
+	return errs
+}
+
 // wrapTransformError wraps an error with the transformer name if it's not already a ValidationError
 func wrapTransformError(name string, err error) error {
 	var ve *core.ValidationError
@@ -597,6 +662,7 @@ func (d *dag) build(ctx BuildContext) (*core.DAG, error) {
 		buildEnv: buildEnv,
 	}
 	ctx.paramsState = &paramsState{}
+	ctx.warnings = &[]core.BuildWarning{}
 
 	// Run the transformer pipeline
 	errs = append(errs, runTransformers(ctx, d, result)...)
@@ -614,22 +680,26 @@ func (d *dag) build(ctx BuildContext) (*core.DAG, error) {
 	// Add deprecation warning for max_active_runs on local queues.
 	// Both max_active_runs > 1 (concurrency) and max_active_runs < 0 (queue bypass) are deprecated.
 	if result.Queue == "" && (result.MaxActiveRuns > 1 || result.MaxActiveRuns < 0) {
-		result.BuildWarnings = append(result.BuildWarnings, fmt.Sprintf(
-			"max_active_runs=%d is deprecated for local queues and will be ignored. "+
-				"Use a global queue with 'queue:' field for concurrency control.",
-			result.MaxActiveRuns,
-		))
+		result.BuildWarnings = append(result.BuildWarnings, core.BuildWarning{
+			Code: core.WarningDeprecatedMaxActiveRuns,
+			Message: fmt.Sprintf(
+				"max_active_runs=%d is deprecated for local queues and will be ignored. "+
+					"Use a global queue with 'queue:' field for concurrency control.",
+				result.MaxActiveRuns,
+			),
+		})
 	}
 
 	// Collect schedule warnings (misleading step values like */33).
-	for _, sched := range result.Schedule {
-		result.BuildWarnings = append(result.BuildWarnings, sched.Warnings...)
-	}
-	for _, sched := range result.StopSchedule {
-		result.BuildWarnings = append(result.BuildWarnings, sched.Warnings...)
-	}
-	for _, sched := range result.RestartSchedule {
-		result.BuildWarnings = append(result.BuildWarnings, sched.Warnings...)
+	for _, schedules := range [][]core.Schedule{result.Schedule, result.StopSchedule, result.RestartSchedule} {
+		for _, sched := range schedules {
+			for _, msg := range sched.Warnings {
+				result.BuildWarnings = append(result.BuildWarnings, core.BuildWarning{
+					Code:    core.WarningMisleadingSchedule,
+					Message: msg,
+				})
+			}
+		}
 	}
 
 	// Build handlers and steps directly (they need access to partially built result)
@@ -645,7 +715,9 @@ func (d *dag) build(ctx BuildContext) (*core.DAG, error) {
 		} else {
 			result.Steps = steps
 		}
+		result.BuildWarnings = append(result.BuildWarnings, applyDefaultRepeatMaxInterval(repeatableSteps(result))...)
 	}
+	result.BuildWarnings = append(result.BuildWarnings, *ctx.warnings...)
 
 	// Validate steps
 	if err := core.ValidateSteps(result); err != nil {
@@ -683,6 +755,27 @@ func (d *dag) build(ctx BuildContext) (*core.DAG, error) {
 	return result, nil
 }
 
+// repeatableSteps returns pointers to the main steps followed by every
+// handler step so that build-time defaults apply to both.
+func repeatableSteps(d *core.DAG) []*core.Step {
+	steps := make([]*core.Step, 0, len(d.Steps))
+	for i := range d.Steps {
+		steps = append(steps, &d.Steps[i])
+	}
+	for _, handler := range []core.HandlerType{
+		core.HandlerOnInit,
+		core.HandlerOnSuccess,
+		core.HandlerOnFailure,
+		core.HandlerOnAbort,
+		core.HandlerOnExit,
+		core.HandlerOnWait,
+		core.HandlerOnRetry,
+	} {
+		steps = append(steps, d.HandlerOn.Steps(handler)...)
+	}
+	return steps
+}
+
 func composeBuildDAGContext(base, current *core.DAG, currentSpec *dag) (*core.DAG, error) {
 	if base == nil {
 		return current, nil
@@ -797,6 +890,13 @@ func buildQueue(_ BuildContext, d *dag) (string, error) {
 	return strings.TrimSpace(d.Queue), nil
 }
 
+func buildQueuePriority(_ BuildContext, d *dag) (core.QueuePriority, error) {
+	if strings.TrimSpace(d.QueuePriority) == "" {
+		return "", nil
+	}
+	return core.ParseQueuePriority(d.QueuePriority)
+}
+
 func buildDAGRetryPolicy(_ BuildContext, d *dag) (*core.DAGRetryPolicy, error) {
 	if d.RetryPolicy == nil {
 		return nil, nil
@@ -1063,6 +1163,7 @@ func buildEnvs(ctx BuildContext, d *dag) ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
+	ctx.addWarnings(envCoercionWarnings("", d.Env)...)
 
 	// Add vars to the shared envScope state so subsequent transformers can use it.
 	// This replaces the old pattern of using os.Setenv which caused race conditions.
@@ -1092,6 +1193,20 @@ func buildStopSchedule(_ BuildContext, d *dag) ([]core.Schedule, error) {
 	return slices.Clone(d.Schedule.Stops()), nil
 }
 
+func buildStopSignal(_ BuildContext, d *dag) (string, error) {
+	sig := d.Schedule.StopSignal()
+	if sig == "" {
+		return "", nil
+	}
+	if !d.Schedule.HasStopSchedule() {
+		return "", core.NewValidationError("schedule.stop_signal", sig, fmt.Errorf("stop_signal requires a stop schedule"))
+	}
+	if signal.GetSignalNum(sig, 0) == 0 {
+		return "", core.NewValidationError("schedule.stop_signal", sig, fmt.Errorf("%w: %s", ErrInvalidSignal, sig))
+	}
+	return sig, nil
+}
+
 func buildRestartSchedule(_ BuildContext, d *dag) ([]core.Schedule, error) {
 	if d.Schedule.IsZero() {
 		return nil, nil
@@ -1127,35 +1242,46 @@ func buildParams(ctx BuildContext, d *dag) ([]string, error) {
 }
 
 func buildDefaultParams(ctx BuildContext, d *dag) (string, error) {
-	result, err := parseParamsInternal(ctx, d)
-	if err != nil {
-		return "", err
+	result, ok := derivedParamsResult(ctx, d)
+	if !ok {
+		return "", nil
 	}
 	return result.DefaultParams, nil
 }
 
 func buildParamDefs(ctx BuildContext, d *dag) ([]core.ParamDef, error) {
-	result, err := parseParamsInternal(ctx, d)
-	if err != nil {
-		return nil, err
+	result, ok := derivedParamsResult(ctx, d)
+	if !ok {
+		return nil, nil
 	}
 	return result.ParamDefs, nil
 }
 
 func buildParamsJSON(ctx BuildContext, d *dag) (string, error) {
-	result, err := parseParamsInternal(ctx, d)
-	if err != nil {
-		return "", err
+	result, ok := derivedParamsResult(ctx, d)
+	if !ok {
+		return "", nil
 	}
 	return result.ParamsJSON, nil
 }
 
 func buildParamSchema(ctx BuildContext, d *dag) (json.RawMessage, error) {
+	result, ok := derivedParamsResult(ctx, d)
+	if !ok {
+		return nil, nil
+	}
+	return cloneParamSchema(result.ParamSchema), nil
+}
+
+// derivedParamsResult returns the parsed params for the transformers that
+// only derive fields from them. A parse failure is reported once by the
+// params transformer, so here it just reports that there is nothing to derive.
+func derivedParamsResult(ctx BuildContext, d *dag) (*paramsResult, bool) {
 	result, err := parseParamsInternal(ctx, d)
 	if err != nil {
-		return nil, err
+		return nil, false
 	}
-	return cloneParamSchema(result.ParamSchema), nil
+	return result, true
 }
 
 // detectJSONParams checks if the input string is valid JSON and returns it if so.
@@ -1314,6 +1440,27 @@ func parseParamsInternal(ctx BuildContext, d *dag) (*paramsResult, error) {
 	return result, err
 }
 
+func buildRequires(_ BuildContext, d *dag) ([]string, error) {
+	return parseRequires(d.Requires)
+}
+
+// parseRequires validates capability requirement expressions and returns
+// them trimmed.
+func parseRequires(v types.StringOrArray) ([]string, error) {
+	if v.IsZero() {
+		return nil, nil
+	}
+	var requires []string
+	for _, expr := range v.Values() {
+		req, err := core.ParseRequirement(expr)
+		if err != nil {
+			return nil, core.NewValidationError("requires", expr, err)
+		}
+		requires = append(requires, req.String())
+	}
+	return requires, nil
+}
+
 // workerSelectorTransformer is a custom transformer that sets both WorkerSelector and ForceLocal fields.
 type workerSelectorTransformer struct{}
 
@@ -1447,7 +1594,16 @@ func buildShellArgs(ctx BuildContext, d *dag) ([]string, error) {
 
 func buildWorkingDir(ctx BuildContext, d *dag) (string, error) {
 	if d.WorkingDir != "" {
-		return resolveWorkingDirPath(d.WorkingDir, ctx.file)
+		wd, err := resolveWorkingDirPath(d.WorkingDir, ctx.file)
+		if err != nil {
+			return "", err
+		}
+		if ctx.opts.Has(BuildFlagCheckPaths) {
+			if err := checkWorkingDirExists(wd); err != nil {
+				return "", core.NewValidationError("working_dir", d.WorkingDir, err)
+			}
+		}
+		return wd, nil
 	}
 	if ctx.opts.DefaultWorkingDir != "" {
 		return ctx.opts.DefaultWorkingDir, nil
@@ -1470,6 +1626,26 @@ func resolveWorkingDirPath(wd, dagFile string) (string, error) {
 	return wd, nil
 }
 
+// checkWorkingDirExists verifies that a resolved absolute working directory
+// exists and is a directory. Paths expanded at runtime (~, variables and
+// command substitutions anywhere in the path) are skipped.
+func checkWorkingDirExists(wd string) error {
+	if !filepath.IsAbs(wd) || strings.ContainsAny(wd, "$`") {
+		return nil
+	}
+	info, err := os.Stat(wd)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("working directory %s does not exist", wd)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check working directory %s: %w", wd, err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("working directory %s is not a directory", wd)
+	}
+	return nil
+}
+
 // getDefaultWorkingDir returns the current working directory or user home as fallback.
 func getDefaultWorkingDir() (string, error) {
 	if dir, _ := os.Getwd(); dir != "" {
@@ -1483,7 +1659,17 @@ func getDefaultWorkingDir() (string, error) {
 }
 
 func buildContainer(ctx BuildContext, d *dag) (*core.Container, error) {
-	return buildContainerField(ctx, d.Container)
+	ct, err := buildContainerField(ctx, d.Container)
+	if err != nil || ct == nil {
+		return ct, err
+	}
+	// The keepalive container replaces the image ENTRYPOINT with its own
+	// sleep command, so a user entrypoint would be silently dropped.
+	if len(ct.Entrypoint) > 0 && (ct.Startup == "" || ct.Startup == core.StartupKeepalive) {
+		return nil, core.NewValidationError("container.entrypoint", ct.Entrypoint,
+			fmt.Errorf("entrypoint cannot be used with startup 'keepalive'; use startup 'entrypoint' or 'command'"))
+	}
+	return ct, nil
 }
 
 // buildContainerField handles both string and object forms of container field.
@@ -1546,11 +1732,15 @@ func buildContainerFromSpec(_ BuildContext, c *container) (*core.Container, erro
 		return nil, core.NewValidationError("container", nil,
 			fmt.Errorf("'exec' and 'image' are mutually exclusive"))
 	}
+	if c.Image != "" && c.Build != nil {
+		return nil, core.NewValidationError("container", nil,
+			fmt.Errorf("'image' and 'build' are mutually exclusive"))
+	}
 
-	// Require one of exec or image
-	if c.Exec == "" && c.Image == "" {
+	// Require one of exec, image, or build
+	if c.Exec == "" && c.Image == "" && c.Build == nil {
 		return nil, core.NewValidationError("container", nil,
-			fmt.Errorf("either 'exec' or 'image' must be specified"))
+			fmt.Errorf("either 'exec', 'image', or 'build' must be specified"))
 	}
 
 	// Handle exec mode
@@ -1560,6 +1750,9 @@ func buildContainerFromSpec(_ BuildContext, c *container) (*core.Container, erro
 		if c.Name != "" {
 			invalidFields = append(invalidFields, "name")
 		}
+		if c.Build != nil {
+			invalidFields = append(invalidFields, "build")
+		}
 		if c.PullPolicy != nil {
 			invalidFields = append(invalidFields, "pull_policy")
 		}
@@ -1581,6 +1774,9 @@ func buildContainerFromSpec(_ BuildContext, c *container) (*core.Container, erro
 		if len(c.Command) > 0 {
 			invalidFields = append(invalidFields, "command")
 		}
+		if c.Entrypoint != nil {
+			invalidFields = append(invalidFields, "entrypoint")
+		}
 		if c.WaitFor != "" {
 			invalidFields = append(invalidFields, "wait_for")
 		}
@@ -1590,12 +1786,21 @@ func buildContainerFromSpec(_ BuildContext, c *container) (*core.Container, erro
 		if c.RestartPolicy != "" {
 			invalidFields = append(invalidFields, "restart_policy")
 		}
+		if c.CPUs != nil {
+			invalidFields = append(invalidFields, "cpus")
+		}
+		if c.Memory != nil {
+			invalidFields = append(invalidFields, "memory")
+		}
 		if c.KeepContainer {
 			invalidFields = append(invalidFields, "keep_container")
 		}
 		if c.Healthcheck != nil {
 			invalidFields = append(invalidFields, "healthcheck")
 		}
+		if c.FallbackToLocal {
+			invalidFields = append(invalidFields, "fallback_to_local")
+		}
 
 		if len(invalidFields) > 0 {
 			return nil, core.NewValidationError("container", nil,
@@ -1642,28 +1847,140 @@ func buildContainerFromSpec(_ BuildContext, c *container) (*core.Container, erro
 		}
 	}
 
+	entrypoint, err := parseContainerEntrypoint(c.Entrypoint)
+	if err != nil {
+		return nil, err
+	}
+
+	cpus, memory, err := parseContainerResources(c.CPUs, c.Memory)
+	if err != nil {
+		return nil, err
+	}
+
+	image := c.Image
+	var build *core.ContainerBuild
+	if c.Build != nil {
+		build, err = parseContainerBuild(c.Build)
+		if err != nil {
+			return nil, err
+		}
+		image = build.Tag
+	}
+
 	return &core.Container{
-		Name:          strings.TrimSpace(c.Name),
-		Image:         c.Image,
-		PullPolicy:    pullPolicy,
-		Env:           envs,
-		Volumes:       c.Volumes,
-		User:          c.User,
-		WorkingDir:    c.WorkingDir,
-		Platform:      c.Platform,
-		Ports:         c.Ports,
-		Network:       c.Network,
-		KeepContainer: c.KeepContainer,
-		Startup:       core.ContainerStartup(strings.ToLower(strings.TrimSpace(c.Startup))),
-		Command:       c.Command,
-		WaitFor:       core.ContainerWaitFor(strings.ToLower(strings.TrimSpace(c.WaitFor))),
-		LogPattern:    c.LogPattern,
-		RestartPolicy: strings.TrimSpace(c.RestartPolicy),
-		Healthcheck:   hc,
-		Shell:         c.Shell,
+		Name:            strings.TrimSpace(c.Name),
+		Image:           image,
+		Build:           build,
+		PullPolicy:      pullPolicy,
+		Env:             envs,
+		Volumes:         c.Volumes,
+		User:            c.User,
+		WorkingDir:      c.WorkingDir,
+		Platform:        c.Platform,
+		Ports:           c.Ports,
+		Network:         c.Network,
+		KeepContainer:   c.KeepContainer,
+		Startup:         core.ContainerStartup(strings.ToLower(strings.TrimSpace(c.Startup))),
+		Command:         c.Command,
+		Entrypoint:      entrypoint,
+		WaitFor:         core.ContainerWaitFor(strings.ToLower(strings.TrimSpace(c.WaitFor))),
+		LogPattern:      c.LogPattern,
+		RestartPolicy:   strings.TrimSpace(c.RestartPolicy),
+		CPUs:            cpus,
+		Memory:          memory,
+		Healthcheck:     hc,
+		Shell:           c.Shell,
+		FallbackToLocal: c.FallbackToLocal,
 	}, nil
 }
 
+// parseContainerResources validates container.cpus and container.memory and
+// returns them as normalized strings.
+func parseContainerResources(rawCPUs, rawMemory any) (string, string, error) {
+	cpus, err := resourceString(rawCPUs)
+	if err != nil {
+		return "", "", core.NewValidationError("container.cpus", rawCPUs, err)
+	}
+	if _, err := ParseContainerCPUs(cpus); err != nil {
+		return "", "", core.NewValidationError("container.cpus", rawCPUs, err)
+	}
+	memory, err := resourceString(rawMemory)
+	if err != nil {
+		return "", "", core.NewValidationError("container.memory", rawMemory, err)
+	}
+	if _, err := ParseContainerMemory(memory); err != nil {
+		return "", "", core.NewValidationError("container.memory", rawMemory, err)
+	}
+	return cpus, memory, nil
+}
+
+// resourceString converts a YAML scalar resource limit to a trimmed string.
+func resourceString(v any) (string, error) {
+	switch val := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return strings.TrimSpace(val), nil
+	case int, int64, uint64, float64:
+		return fmt.Sprint(val), nil
+	default:
+		return "", fmt.Errorf("must be a number or a string, got %T", v)
+	}
+}
+
+// parseContainerBuild validates container.build and applies defaults.
+func parseContainerBuild(b *containerBuild) (*core.ContainerBuild, error) {
+	tag := strings.TrimSpace(b.Tag)
+	if tag == "" {
+		return nil, core.NewValidationError("container.build.tag", b.Tag,
+			fmt.Errorf("tag is required when building an image"))
+	}
+	buildContext := strings.TrimSpace(b.Context)
+	if buildContext == "" {
+		buildContext = "."
+	}
+	return &core.ContainerBuild{
+		Context:    buildContext,
+		Dockerfile: strings.TrimSpace(b.Dockerfile),
+		Tag:        tag,
+	}, nil
+}
+
+// parseContainerEntrypoint parses container.entrypoint, which is either a
+// command string split like a step command or an array of strings.
+func parseContainerEntrypoint(v any) ([]string, error) {
+	switch val := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		val = strings.TrimSpace(val)
+		if val == "" {
+			return nil, core.NewValidationError("container.entrypoint", v, fmt.Errorf("entrypoint must not be empty"))
+		}
+		cmd, args, err := cmdutil.SplitCommand(val)
+		if err != nil {
+			return nil, core.NewValidationError("container.entrypoint", v, fmt.Errorf("failed to parse entrypoint: %w", err))
+		}
+		return append([]string{cmd}, args...), nil
+	case []any:
+		entrypoint := make([]string, 0, len(val))
+		for i, item := range val {
+			s, ok := item.(string)
+			if !ok {
+				return nil, core.NewValidationError(
+					fmt.Sprintf("container.entrypoint[%d]", i),
+					item,
+					fmt.Errorf("entrypoint array elements must be strings, got %T", item),
+				)
+			}
+			entrypoint = append(entrypoint, s)
+		}
+		return entrypoint, nil
+	default:
+		return nil, core.NewValidationError("container.entrypoint", v, fmt.Errorf("entrypoint must be a string or an array of strings, got %T", v))
+	}
+}
+
 // parseHealthcheck converts a spec healthcheck to a core.Healthcheck with validation.
 func parseHealthcheck(h *healthcheck) (*core.Healthcheck, error) {
 	if h == nil {
@@ -1781,6 +2098,11 @@ func buildRegistryAuths(_ BuildContext, d *dag) (map[string]*core.AuthConfig, er
 
 	switch v := d.RegistryAuths.(type) {
 	case string:
+		if strings.TrimSpace(v) == "docker-config" {
+			// Resolve credentials from the Docker CLI config at pull time.
+			registryAuths["_docker_config"] = &core.AuthConfig{}
+			break
+		}
 		registryAuths["_json"] = &core.AuthConfig{Auth: v}
 
 	case map[string]any:
@@ -1812,6 +2134,15 @@ func buildSSH(_ BuildContext, d *dag) (*core.SSHConfig, error) {
 		return nil, err
 	}
 
+	proxyJump, err := core.ParseProxyJump(d.SSH.ProxyJump)
+	if err != nil {
+		return nil, core.NewValidationError("ssh.proxy_jump", d.SSH.ProxyJump, err)
+	}
+	if len(proxyJump) > 0 && d.SSH.Bastion != nil {
+		return nil, core.NewValidationError("ssh.proxy_jump", d.SSH.ProxyJump,
+			fmt.Errorf("'bastion' and 'proxy_jump' are mutually exclusive"))
+	}
+
 	return &core.SSHConfig{
 		User:          d.SSH.User,
 		Host:          d.SSH.Host,
@@ -1824,6 +2155,7 @@ func buildSSH(_ BuildContext, d *dag) (*core.SSHConfig, error) {
 		ShellArgs:     shellArgs,
 		Timeout:       d.SSH.Timeout,
 		Bastion:       buildBastionConfig(d.SSH.Bastion),
+		ProxyJump:     proxyJump,
 	}, nil
 }
 
@@ -2389,12 +2721,20 @@ func buildHandlers(ctx BuildContext, d *dag, result *core.DAG) (core.HandlerOn,
 	}
 	defs := mergeDefaults(ctx.baseDefaults, localDefs, d.defaultsRaw)
 
-	// buildHandler is a helper that builds a single handler step.
-	buildHandler := func(s *step, name core.HandlerType) (*core.Step, error) {
-		if s == nil {
-			return nil, nil
+	// buildHandler builds the steps of a single handler in order.
+	buildHandler := func(steps handlerSteps, name core.HandlerType) (core.HandlerSteps, error) {
+		var built core.HandlerSteps
+		for i, s := range steps {
+			if s == nil {
+				continue
+			}
+			st, err := buildStepFromSpec(buildCtx, 0, s, d.rawHandler(name, i), map[string]struct{}{}, defs, name.StepName(len(built)))
+			if err != nil {
+				return nil, err
+			}
+			built = append(built, st)
 		}
-		return buildStepFromSpec(buildCtx, 0, s, d.rawHandler(name), map[string]struct{}{}, defs, name.String())
+		return built, nil
 	}
 
 	if handlerOn.Init, err = buildHandler(d.HandlerOn.Init, core.HandlerOnInit); err != nil {
@@ -2418,6 +2758,10 @@ func buildHandlers(ctx BuildContext, d *dag, result *core.DAG) (core.HandlerOn,
 		return handlerOn, err
 	}
 
+	if handlerOn.Retry, err = buildHandler(d.HandlerOn.Retry, core.HandlerOnRetry); err != nil {
+		return handlerOn, err
+	}
+
 	return handlerOn, nil
 }
 
@@ -2716,6 +3060,10 @@ func transformRouterSteps(steps []core.Step) error {
 					Condition: router.Value,
 					Expected:  route.Pattern,
 				}
+				if err := condition.Compile(); err != nil {
+					return core.NewValidationError("routes", route.Pattern,
+						fmt.Errorf("router %q: %w", routerName, err))
+				}
 				target.Preconditions = append(target.Preconditions, condition)
 
 				// Add router as dependency if not already present
@@ -0,0 +1,142 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package spec
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/dagucloud/dagu/internal/core"
+)
+
+// dagBaseRef is the parsed form of the base key.
+type dagBaseRef struct {
+	// File is the path of the base DAG file.
+	File string
+	// InheritSteps opts in to inheriting steps when the DAG defines none.
+	InheritSteps bool
+}
+
+// parseDAGBaseRef parses the base key, which is either a file path or an
+// object of the form {file: <path>, inherit_steps: <bool>}.
+func parseDAGBaseRef(v any) (*dagBaseRef, error) {
+	switch val := v.(type) {
+	case nil:
+		return nil, nil
+
+	case string:
+		file := strings.TrimSpace(val)
+		if file == "" {
+			return nil, fmt.Errorf("base file cannot be empty")
+		}
+		return &dagBaseRef{File: file}, nil
+
+	case map[string]any:
+		ref := &dagBaseRef{}
+		for key, value := range val {
+			switch key {
+			case "file":
+				file, ok := value.(string)
+				if !ok {
+					return nil, fmt.Errorf("base.file must be a string, got %T", value)
+				}
+				ref.File = strings.TrimSpace(file)
+			case "inherit_steps":
+				inherit, ok := value.(bool)
+				if !ok {
+					return nil, fmt.Errorf("base.inherit_steps must be a boolean, got %T", value)
+				}
+				ref.InheritSteps = inherit
+			default:
+				return nil, fmt.Errorf("unknown base key %q", key)
+			}
+		}
+		if ref.File == "" {
+			return nil, fmt.Errorf("base.file is required")
+		}
+		return ref, nil
+
+	default:
+		return nil, fmt.Errorf("base must be a string or an object, got %T", v)
+	}
+}
+
+// applyDAGBase fills in the fields of d that are not set in its YAML from
+// the DAG file named by its base key. Bases may themselves declare a base;
+// the nearest definition of a field wins. The base file is resolved like
+// params schema files: as given, then against the DAG's working_dir, then
+// against the directory of the DAG file.
+func applyDAGBase(d *dag, dagLocation string) error {
+	var visited []string
+	if dagLocation != "" {
+		if abs, err := filepath.Abs(dagLocation); err == nil {
+			visited = append(visited, abs)
+		}
+	}
+	return applyDAGBaseChain(d, dagLocation, visited)
+}
+
+// applyDAGBaseChain applies the base of d, following nested bases. visited
+// holds the files already in the chain to detect cycles.
+func applyDAGBaseChain(d *dag, dagLocation string, visited []string) error {
+	ref, err := parseDAGBaseRef(d.Base)
+	if err != nil {
+		return core.NewValidationError("base", d.Base, err)
+	}
+	if ref == nil {
+		return nil
+	}
+
+	data, resolved, err := loadFileFromDAGPaths("base DAG", d.WorkingDir, dagLocation, ref.File)
+	if err != nil {
+		return core.NewValidationError("base", ref.File, err)
+	}
+	if slices.Contains(visited, resolved) {
+		chain := append(slices.Clone(visited), resolved)
+		return core.NewValidationError("base", ref.File, fmt.Errorf("base DAG cycle detected: %s", strings.Join(chain, " -> ")))
+	}
+
+	base, err := decodeDefinitionData(data, "base DAG "+resolved)
+	if err != nil {
+		return err
+	}
+	if err := applyDAGBaseChain(base, resolved, append(visited, resolved)); err != nil {
+		return err
+	}
+
+	inheritDAGBaseFields(d, base, ref.InheritSteps)
+	return nil
+}
+
+// inheritDAGBaseFields copies each supported field from base into d when d
+// leaves it unset.
+func inheritDAGBaseFields(d, base *dag, inheritSteps bool) {
+	if d.Env.IsZero() {
+		d.Env = base.Env
+	}
+	if d.SMTP.IsZero() {
+		d.SMTP = base.SMTP
+	}
+	if d.Schedule.IsZero() {
+		d.Schedule = base.Schedule
+	}
+	if d.Container == nil {
+		d.Container = base.Container
+	}
+	if d.SSH == nil {
+		d.SSH = base.SSH
+	}
+	if d.Defaults == nil {
+		d.Defaults = base.Defaults
+		d.defaultsRaw = base.defaultsRaw
+	}
+	if inheritSteps && d.Steps == nil {
+		d.Steps = base.Steps
+		if d.Type == "" {
+			d.Type = base.Type
+		}
+	}
+}
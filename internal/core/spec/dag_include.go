@@ -0,0 +1,158 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package spec
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/dagucloud/dagu/internal/core"
+	"github.com/dagucloud/dagu/internal/core/spec/types"
+)
+
+// includeAllowedKeys lists the top-level keys a shared include file may
+// define. Everything else is DAG-specific and rejected.
+var includeAllowedKeys = []string{"include", "env", "defaults", "handler_on", "step_types"}
+
+// applyDAGIncludes merges the shared files named by the include key into d.
+// Included env entries come before the DAG's own, in include order, so later
+// entries override earlier ones. Defaults and each handler are taken from the
+// first include defining them when the DAG leaves them unset. Step types are
+// added to the DAG's own and must be unique. Include files may include other
+// files; paths are resolved like base files, relative to the including file.
+func applyDAGIncludes(d *dag, dagLocation string) error {
+	var chain []string
+	if dagLocation != "" {
+		if abs, err := filepath.Abs(dagLocation); err == nil {
+			chain = append(chain, abs)
+		}
+	}
+	return applyDAGIncludeChain(d, dagLocation, chain)
+}
+
+// applyDAGIncludeChain applies the includes of d, following nested includes.
+// chain holds the files currently being included to detect cycles.
+func applyDAGIncludeChain(d *dag, dagLocation string, chain []string) error {
+	if d.Include.IsZero() {
+		return nil
+	}
+
+	var includedEnv types.EnvValue
+	for _, file := range d.Include.Values() {
+		file = strings.TrimSpace(file)
+		if file == "" {
+			return core.NewValidationError("include", d.Include.Value(), fmt.Errorf("include file cannot be empty"))
+		}
+
+		data, resolved, err := loadFileFromDAGPaths("include", d.WorkingDir, dagLocation, file)
+		if err != nil {
+			return core.NewValidationError("include", file, err)
+		}
+		if slices.Contains(chain, resolved) {
+			cycle := append(slices.Clone(chain), resolved)
+			return core.NewValidationError("include", file, fmt.Errorf("include cycle detected: %s", strings.Join(cycle, " -> ")))
+		}
+
+		included, err := decodeIncludeData(data, resolved)
+		if err != nil {
+			return err
+		}
+		if err := applyDAGIncludeChain(included, resolved, append(chain, resolved)); err != nil {
+			return err
+		}
+		includedEnv = included.Env.Prepend(includedEnv)
+		if err := mergeIncludedFields(d, included, resolved); err != nil {
+			return err
+		}
+	}
+	d.Env = d.Env.Prepend(includedEnv)
+	return nil
+}
+
+// decodeIncludeData parses an include file after checking that it only
+// defines keys that can be shared between DAGs.
+func decodeIncludeData(data []byte, file string) (*dag, error) {
+	raw, err := unmarshalData(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal include file %s: %w", file, err)
+	}
+
+	var unsupported []string
+	for key := range raw {
+		if !slices.Contains(includeAllowedKeys, key) {
+			unsupported = append(unsupported, key)
+		}
+	}
+	if len(unsupported) > 0 {
+		sort.Strings(unsupported)
+		return nil, core.NewValidationError("include", file, fmt.Errorf(
+			"include file defines unsupported keys %s; only %s are allowed",
+			strings.Join(unsupported, ", "), strings.Join(includeAllowedKeys, ", "),
+		))
+	}
+
+	def, err := decode(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode include file %s: %w", file, err)
+	}
+	return def, nil
+}
+
+// mergeIncludedFields merges the defaults, handlers and step types of
+// included into d. Env is merged by the caller to keep include order.
+func mergeIncludedFields(d, included *dag, file string) error {
+	if included.Defaults != nil && d.Defaults == nil {
+		d.Defaults = included.Defaults
+		d.defaultsRaw = included.defaultsRaw
+	}
+	mergeIncludedHandlers(d, included)
+
+	for name, spec := range included.StepTypes {
+		if _, exists := d.StepTypes[name]; exists {
+			return core.NewValidationError(
+				fmt.Sprintf("step_types.%s", name),
+				name,
+				fmt.Errorf("duplicate custom step type %q is defined in include file %s", name, file),
+			)
+		}
+		if d.StepTypes == nil {
+			d.StepTypes = make(map[string]customStepTypeSpec, len(included.StepTypes))
+		}
+		d.StepTypes[name] = spec
+	}
+	return nil
+}
+
+// mergeIncludedHandlers copies each handler the DAG does not define, along
+// with its raw step maps.
+func mergeIncludedHandlers(d, included *dag) {
+	handlers := []struct {
+		key  string
+		dest *handlerSteps
+		src  handlerSteps
+	}{
+		{"init", &d.HandlerOn.Init, included.HandlerOn.Init},
+		{"failure", &d.HandlerOn.Failure, included.HandlerOn.Failure},
+		{"success", &d.HandlerOn.Success, included.HandlerOn.Success},
+		{"abort", &d.HandlerOn.Abort, included.HandlerOn.Abort},
+		{"exit", &d.HandlerOn.Exit, included.HandlerOn.Exit},
+		{"wait", &d.HandlerOn.Wait, included.HandlerOn.Wait},
+		{"retry", &d.HandlerOn.Retry, included.HandlerOn.Retry},
+	}
+	for _, h := range handlers {
+		if h.src == nil || *h.dest != nil {
+			continue
+		}
+		*h.dest = h.src
+		if raw := included.handlerOnRaw[h.key]; raw != nil {
+			if d.handlerOnRaw == nil {
+				d.handlerOnRaw = make(map[string][]map[string]any)
+			}
+			d.handlerOnRaw[h.key] = raw
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package spec
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/dagucloud/dagu/internal/core/spec/types"
+	"github.com/google/jsonschema-go/jsonschema"
+)
+
+// DAGJSONSchema returns a JSON Schema of the DAG YAML format generated from
+// the spec types, so it always accepts exactly the keys the loader accepts.
+// Structs become closed objects keyed by their YAML tags. Union types with
+// custom unmarshalers and untyped fields are described by the shapes their
+// decoders accept.
+func DAGJSONSchema() ([]byte, error) {
+	g := &dagSchemaGenerator{definitions: make(map[string]*jsonschema.Schema)}
+	root := g.structSchema(reflect.TypeFor[dag]())
+	root.Schema = "http://json-schema.org/draft-07/schema#"
+	root.Title = "Dagu DAG"
+	root.Definitions = g.definitions
+	return json.MarshalIndent(root, "", "  ")
+}
+
+// dagSchemaGenerator builds schemas for spec types. Named structs are emitted
+// once into definitions and referenced from every field that uses them.
+type dagSchemaGenerator struct {
+	definitions map[string]*jsonschema.Schema
+}
+
+// unionTypeSchemas describes the YAML shapes accepted by the union types of
+// the types package, which decode themselves from any YAML value.
+var unionTypeSchemas = map[reflect.Type][]string{
+	reflect.TypeFor[types.BackoffValue]():      {"boolean", "number"},
+	reflect.TypeFor[types.ContinueOnValue]():   {"string", "object"},
+	reflect.TypeFor[types.DependsValue]():      {"string", "object", "array"},
+	reflect.TypeFor[types.EnvValue]():          {"object", "array"},
+	reflect.TypeFor[types.IntOrDynamic]():      {"integer", "string"},
+	reflect.TypeFor[types.LabelsValue]():       {"string", "object", "array"},
+	reflect.TypeFor[types.LogOutputValue]():    {"string"},
+	reflect.TypeFor[types.ModelValue]():        {"string", "array"},
+	reflect.TypeFor[types.PortValue]():         {"integer", "string"},
+	reflect.TypeFor[types.RepeatMode]():        {"boolean", "string"},
+	reflect.TypeFor[types.ScheduleValue]():     {"string", "array", "object"},
+	reflect.TypeFor[types.ShellValue]():        {"string", "array"},
+	reflect.TypeFor[types.SignalOnStopValue](): {"string", "object"},
+	reflect.TypeFor[types.StringOrArray]():     {"string", "array"},
+}
+
+// untypedFieldSchema describes an untyped (any) field whose accepted shapes
+// are fixed by the builder. Other untyped fields accept any value.
+func (g *dagSchemaGenerator) untypedFieldSchema(owner reflect.Type, key string) (*jsonschema.Schema, bool) {
+	switch owner.Name() + "." + key {
+	case "dag.steps":
+		step := g.schemaFor(reflect.TypeFor[step]())
+		return &jsonschema.Schema{OneOf: []*jsonschema.Schema{
+			{Type: "array", Items: &jsonschema.Schema{AnyOf: []*jsonschema.Schema{{Type: "string"}, step}}},
+			{Type: "object", AdditionalProperties: step},
+		}}, true
+	case "step.command":
+		return &jsonschema.Schema{Types: []string{"string", "array"}}, true
+	case "step.parallel":
+		return &jsonschema.Schema{Types: []string{"string", "array", "object"}}, true
+	default:
+		return nil, false
+	}
+}
+
+func (g *dagSchemaGenerator) schemaFor(t reflect.Type) *jsonschema.Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if shapes, ok := unionTypeSchemas[t]; ok {
+		return &jsonschema.Schema{Types: shapes}
+	}
+	if t == reflect.TypeFor[handlerSteps]() {
+		step := g.schemaFor(reflect.TypeFor[step]())
+		return &jsonschema.Schema{OneOf: []*jsonschema.Schema{
+			step,
+			{Type: "array", Items: step},
+		}}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return &jsonschema.Schema{Type: "string"}
+	case reflect.Bool:
+		return &jsonschema.Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &jsonschema.Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &jsonschema.Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &jsonschema.Schema{Type: "array", Items: g.schemaFor(t.Elem())}
+	case reflect.Map:
+		return &jsonschema.Schema{Type: "object", AdditionalProperties: g.schemaFor(t.Elem())}
+	case reflect.Struct:
+		name := t.Name()
+		if name == "" {
+			return g.structSchema(t)
+		}
+		if _, ok := g.definitions[name]; !ok {
+			// Reserve the name first so recursive types terminate.
+			g.definitions[name] = nil
+			g.definitions[name] = g.structSchema(t)
+		}
+		return &jsonschema.Schema{Ref: "#/definitions/" + name}
+	default:
+		return &jsonschema.Schema{}
+	}
+}
+
+// structSchema returns a closed object schema with one property per YAML
+// tagged field, matching the loader's rejection of unknown keys.
+func (g *dagSchemaGenerator) structSchema(t reflect.Type) *jsonschema.Schema {
+	schema := &jsonschema.Schema{
+		Type:                 "object",
+		Properties:           make(map[string]*jsonschema.Schema),
+		AdditionalProperties: &jsonschema.Schema{Not: &jsonschema.Schema{}},
+	}
+	for field := range t.Fields() {
+		name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if !field.IsExported() || name == "" || name == "-" {
+			continue
+		}
+		if untyped, ok := g.untypedFieldSchema(t, name); ok {
+			schema.Properties[name] = untyped
+			continue
+		}
+		schema.Properties[name] = g.schemaFor(field.Type)
+	}
+	return schema
+}
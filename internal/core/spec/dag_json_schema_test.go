@@ -0,0 +1,122 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package spec
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDAGJSONSchema(t *testing.T) {
+	t.Parallel()
+
+	data, err := DAGJSONSchema()
+	require.NoError(t, err)
+
+	var schema jsonschema.Schema
+	require.NoError(t, json.Unmarshal(data, &schema))
+	resolved, err := schema.Resolve(&jsonschema.ResolveOptions{})
+	require.NoError(t, err)
+
+	validate := func(t *testing.T, spec string) error {
+		t.Helper()
+
+		var doc map[string]any
+		require.NoError(t, yaml.Unmarshal([]byte(spec), &doc))
+		raw, err := json.Marshal(doc)
+		require.NoError(t, err)
+		var jsonDoc any
+		require.NoError(t, json.Unmarshal(raw, &jsonDoc))
+		return resolved.Validate(jsonDoc)
+	}
+
+	t.Run("DeclaresEverySpecField", func(t *testing.T) {
+		t.Parallel()
+
+		for field := range reflect.TypeFor[dag]().Fields() {
+			name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+			if !field.IsExported() || name == "" || name == "-" {
+				continue
+			}
+			assert.Contains(t, schema.Properties, name)
+		}
+		for field := range reflect.TypeFor[step]().Fields() {
+			name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+			if !field.IsExported() || name == "" || name == "-" {
+				continue
+			}
+			assert.Contains(t, schema.Definitions["step"].Properties, name)
+		}
+	})
+
+	t.Run("AcceptsValidDAG", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NoError(t, validate(t, `
+type: graph
+shell: ["bash", "-e"]
+schedule: "0 * * * *"
+env:
+  - FOO: bar
+handler_on:
+  failure:
+    command: echo failed
+  exit:
+    - command: echo one
+    - command: echo two
+steps:
+  - echo plain
+  - id: fanout
+    call: child
+    parallel:
+      items: [a, b]
+      max_concurrent: 1
+  - name: tolerant
+    command: exit 1
+    depends: fanout
+    retry_policy:
+      limit: 2
+      interval_sec: 1
+    continue_on:
+      failure: true
+`))
+	})
+
+	t.Run("RejectsUnknownKeys", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Error(t, validate(t, `
+steps:
+  - command: echo ok
+    workingDir: /tmp
+`))
+		assert.Error(t, validate(t, `
+unknown: true
+steps:
+  - command: echo ok
+`))
+	})
+
+	t.Run("RejectsInvalidShapes", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Error(t, validate(t, `
+steps:
+  - command: echo ok
+    parallel: true
+`))
+		assert.Error(t, validate(t, `
+max_active_steps: "two"
+steps:
+  - command: echo ok
+`))
+	})
+}
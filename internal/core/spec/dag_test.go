@@ -5,12 +5,16 @@ package spec
 
 import (
 	"context"
+	"encoding/json"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/dagucloud/dagu/internal/cmn/eval"
+	cmnschema "github.com/dagucloud/dagu/internal/cmn/schema"
 	"github.com/dagucloud/dagu/internal/core"
 	"github.com/dagucloud/dagu/internal/core/spec/types"
 	"github.com/goccy/go-yaml"
@@ -462,6 +466,35 @@ steps:
 	assert.Contains(t, err.Error(), "labels and deprecated tags cannot both be set")
 }
 
+func TestLoadYAMLHandlerOnCancelSuggestsAbort(t *testing.T) {
+	t.Parallel()
+
+	_, err := LoadYAML(context.Background(), []byte(`
+handler_on:
+  cancel:
+    command: echo cancelled
+steps:
+  - name: step
+    command: echo ok
+`), WithoutEval())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cancel -> abort")
+}
+
+func TestLoadYAMLCancelHintScopedToHandlerOn(t *testing.T) {
+	t.Parallel()
+
+	_, err := LoadYAML(context.Background(), []byte(`
+cancel: true
+steps:
+  - name: step
+    command: echo ok
+`), WithoutEval())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cancel")
+	assert.NotContains(t, err.Error(), "cancel -> abort")
+}
+
 func TestBuildMaxActiveRuns(t *testing.T) {
 	t.Parallel()
 
@@ -1180,6 +1213,52 @@ func TestBuildDotenv(t *testing.T) {
 	}
 }
 
+func TestBuildRequires(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    types.StringOrArray
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:     "Empty",
+			input:    types.StringOrArray{},
+			expected: nil,
+		},
+		{
+			name:     "Single",
+			input:    stringOrArray("gpu"),
+			expected: []string{"gpu"},
+		},
+		{
+			name:     "TrimsOperators",
+			input:    stringOrArrayList([]string{"gpu", "cuda >= 11", "arch=amd64|arm64"}),
+			expected: []string{"gpu", "cuda>=11", "arch=amd64|arm64"},
+		},
+		{
+			name:    "InvalidVersion",
+			input:   stringOrArray("cuda>=latest"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			d := &dag{Requires: tt.input}
+			result, err := buildRequires(testBuildContext(), d)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func TestBuildSMTPConfig(t *testing.T) {
 	t.Parallel()
 
@@ -1286,6 +1365,54 @@ func TestBuildContainer(t *testing.T) {
 				RestartPolicy: "always",
 			},
 		},
+		{
+			name: "ResourceLimits",
+			input: &container{
+				Image:  "alpine:latest",
+				CPUs:   1.5,
+				Memory: " 512m ",
+			},
+			expected: &core.Container{
+				Image:      "alpine:latest",
+				PullPolicy: core.PullPolicyMissing,
+				CPUs:       "1.5",
+				Memory:     "512m",
+			},
+		},
+		{
+			name: "ResourceLimitsAsStrings",
+			input: &container{
+				Image:  "alpine:latest",
+				CPUs:   "2",
+				Memory: "2g",
+			},
+			expected: &core.Container{
+				Image:      "alpine:latest",
+				PullPolicy: core.PullPolicyMissing,
+				CPUs:       "2",
+				Memory:     "2g",
+			},
+		},
+		{
+			name:    "InvalidCPUs",
+			input:   &container{Image: "alpine:latest", CPUs: "two"},
+			wantErr: true,
+		},
+		{
+			name:    "ZeroCPUs",
+			input:   &container{Image: "alpine:latest", CPUs: 0},
+			wantErr: true,
+		},
+		{
+			name:    "InvalidMemory",
+			input:   &container{Image: "alpine:latest", Memory: "lots"},
+			wantErr: true,
+		},
+		{
+			name:    "ResourceLimitsWithExec",
+			input:   &container{Exec: "my-container", Memory: "512m"},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1325,6 +1452,13 @@ func TestBuildRegistryAuths(t *testing.T) {
 				"_json": {Auth: `{"auths":{"registry.example.com":{"auth":"base64encoded"}}}`},
 			},
 		},
+		{
+			name:  "DockerConfigDirective",
+			input: "docker-config",
+			expected: map[string]*core.AuthConfig{
+				"_docker_config": {},
+			},
+		},
 		{
 			name: "MapWithStringAuth",
 			input: map[string]any{
@@ -1767,6 +1901,74 @@ func TestBuildPreconditions(t *testing.T) {
 		_, err := buildPreconditions(testBuildContext(), d)
 		require.Error(t, err)
 	})
+
+	t.Run("AnyGroup", func(t *testing.T) {
+		t.Parallel()
+		d := &dag{
+			Preconditions: map[string]any{
+				"any": []any{
+					map[string]any{"condition": "${ENV}", "expected": "prod"},
+					"test -f /file",
+				},
+			},
+		}
+		result, err := buildPreconditions(testBuildContext(), d)
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		require.True(t, result[0].IsAnyGroup())
+		require.Len(t, result[0].Any, 2)
+		assert.Equal(t, "${ENV}", result[0].Any[0].Condition)
+		assert.Equal(t, "prod", result[0].Any[0].Expected)
+		assert.Equal(t, "test -f /file", result[0].Any[1].Condition)
+	})
+
+	t.Run("AllGroupIsFlattened", func(t *testing.T) {
+		t.Parallel()
+		d := &dag{
+			Preconditions: map[string]any{
+				"all": []any{"test -f /file1", "test -f /file2"},
+			},
+		}
+		result, err := buildPreconditions(testBuildContext(), d)
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.False(t, result[0].IsAnyGroup())
+		assert.Equal(t, "test -f /file2", result[1].Condition)
+	})
+
+	t.Run("AnyGroupInList", func(t *testing.T) {
+		t.Parallel()
+		d := &dag{
+			Preconditions: []any{
+				"test -f /file",
+				map[string]any{"any": []any{"test -d /a", "test -d /b"}},
+			},
+		}
+		result, err := buildPreconditions(testBuildContext(), d)
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.False(t, result[0].IsAnyGroup())
+		assert.True(t, result[1].IsAnyGroup())
+	})
+
+	t.Run("GroupWithOtherKeys", func(t *testing.T) {
+		t.Parallel()
+		d := &dag{
+			Preconditions: map[string]any{
+				"any":       []any{"test -f /file"},
+				"condition": "true",
+			},
+		}
+		_, err := buildPreconditions(testBuildContext(), d)
+		require.ErrorIs(t, err, ErrPreconditionGroupHasOtherKeys)
+	})
+
+	t.Run("EmptyGroup", func(t *testing.T) {
+		t.Parallel()
+		d := &dag{Preconditions: map[string]any{"any": []any{}}}
+		_, err := buildPreconditions(testBuildContext(), d)
+		require.ErrorIs(t, err, ErrPreconditionGroupIsEmpty)
+	})
 }
 
 func TestBuildSteps(t *testing.T) {
@@ -2108,35 +2310,40 @@ func TestBuildHandlers(t *testing.T) {
 		t.Parallel()
 		d := &dag{
 			HandlerOn: handlerOn{
-				Init:    &step{Command: "echo init"},
-				Exit:    &step{Command: "echo exit"},
-				Success: &step{Command: "echo success"},
-				Failure: &step{Command: "echo failure"},
-				Abort:   &step{Command: "echo abort"},
-				Wait:    &step{Command: "echo wait"},
+				Init:    handlerSteps{{Command: "echo init"}},
+				Exit:    handlerSteps{{Command: "echo exit"}},
+				Success: handlerSteps{{Command: "echo success"}},
+				Failure: handlerSteps{{Command: "echo failure"}},
+				Abort:   handlerSteps{{Command: "echo abort"}},
+				Wait:    handlerSteps{{Command: "echo wait"}},
+				Retry:   handlerSteps{{Command: "echo retry"}},
 			},
 		}
 		result := &core.DAG{}
 		handlerOn, err := buildHandlers(testBuildContext(), d, result)
 		require.NoError(t, err)
 		require.NotNil(t, handlerOn.Init)
-		require.Len(t, handlerOn.Init.Commands, 1)
-		assert.Equal(t, "echo init", handlerOn.Init.Commands[0].CmdWithArgs)
+		require.Len(t, handlerOn.Init[0].Commands, 1)
+		assert.Equal(t, "echo init", handlerOn.Init[0].Commands[0].CmdWithArgs)
 		require.NotNil(t, handlerOn.Exit)
-		require.Len(t, handlerOn.Exit.Commands, 1)
-		assert.Equal(t, "echo exit", handlerOn.Exit.Commands[0].CmdWithArgs)
+		require.Len(t, handlerOn.Exit[0].Commands, 1)
+		assert.Equal(t, "echo exit", handlerOn.Exit[0].Commands[0].CmdWithArgs)
 		require.NotNil(t, handlerOn.Success)
-		require.Len(t, handlerOn.Success.Commands, 1)
-		assert.Equal(t, "echo success", handlerOn.Success.Commands[0].CmdWithArgs)
+		require.Len(t, handlerOn.Success[0].Commands, 1)
+		assert.Equal(t, "echo success", handlerOn.Success[0].Commands[0].CmdWithArgs)
 		require.NotNil(t, handlerOn.Failure)
-		require.Len(t, handlerOn.Failure.Commands, 1)
-		assert.Equal(t, "echo failure", handlerOn.Failure.Commands[0].CmdWithArgs)
+		require.Len(t, handlerOn.Failure[0].Commands, 1)
+		assert.Equal(t, "echo failure", handlerOn.Failure[0].Commands[0].CmdWithArgs)
 		require.NotNil(t, handlerOn.Abort)
-		require.Len(t, handlerOn.Abort.Commands, 1)
-		assert.Equal(t, "echo abort", handlerOn.Abort.Commands[0].CmdWithArgs)
+		require.Len(t, handlerOn.Abort[0].Commands, 1)
+		assert.Equal(t, "echo abort", handlerOn.Abort[0].Commands[0].CmdWithArgs)
 		require.NotNil(t, handlerOn.Wait)
-		require.Len(t, handlerOn.Wait.Commands, 1)
-		assert.Equal(t, "echo wait", handlerOn.Wait.Commands[0].CmdWithArgs)
+		require.Len(t, handlerOn.Wait[0].Commands, 1)
+		assert.Equal(t, "echo wait", handlerOn.Wait[0].Commands[0].CmdWithArgs)
+		require.NotNil(t, handlerOn.Retry)
+		require.Len(t, handlerOn.Retry[0].Commands, 1)
+		assert.Equal(t, "echo retry", handlerOn.Retry[0].Commands[0].CmdWithArgs)
+		assert.Equal(t, core.HandlerOnRetry.String(), handlerOn.Retry[0].Name)
 	})
 
 	t.Run("NoHandlers", func(t *testing.T) {
@@ -2151,13 +2358,14 @@ func TestBuildHandlers(t *testing.T) {
 		assert.Nil(t, handlerOn.Failure)
 		assert.Nil(t, handlerOn.Abort)
 		assert.Nil(t, handlerOn.Wait)
+		assert.Nil(t, handlerOn.Retry)
 	})
 
 	t.Run("InitHandlerError", func(t *testing.T) {
 		t.Parallel()
 		d := &dag{
 			HandlerOn: handlerOn{
-				Init: &step{Command: "   "}, // Empty command after trim causes error
+				Init: handlerSteps{{Command: "   "}}, // Empty command after trim causes error
 			},
 		}
 		result := &core.DAG{}
@@ -2169,7 +2377,7 @@ func TestBuildHandlers(t *testing.T) {
 		t.Parallel()
 		d := &dag{
 			HandlerOn: handlerOn{
-				Exit: &step{Command: "   "}, // Empty command after trim causes error
+				Exit: handlerSteps{{Command: "   "}}, // Empty command after trim causes error
 			},
 		}
 		result := &core.DAG{}
@@ -2181,7 +2389,7 @@ func TestBuildHandlers(t *testing.T) {
 		t.Parallel()
 		d := &dag{
 			HandlerOn: handlerOn{
-				Success: &step{Command: "   "}, // Empty command after trim causes error
+				Success: handlerSteps{{Command: "   "}}, // Empty command after trim causes error
 			},
 		}
 		result := &core.DAG{}
@@ -2193,7 +2401,7 @@ func TestBuildHandlers(t *testing.T) {
 		t.Parallel()
 		d := &dag{
 			HandlerOn: handlerOn{
-				Failure: &step{Command: "   "}, // Empty command after trim causes error
+				Failure: handlerSteps{{Command: "   "}}, // Empty command after trim causes error
 			},
 		}
 		result := &core.DAG{}
@@ -2205,7 +2413,7 @@ func TestBuildHandlers(t *testing.T) {
 		t.Parallel()
 		d := &dag{
 			HandlerOn: handlerOn{
-				Abort: &step{Command: "   "}, // Empty command after trim causes error
+				Abort: handlerSteps{{Command: "   "}}, // Empty command after trim causes error
 			},
 		}
 		result := &core.DAG{}
@@ -2217,23 +2425,64 @@ func TestBuildHandlers(t *testing.T) {
 		t.Parallel()
 		d := &dag{
 			HandlerOn: handlerOn{
-				Wait: &step{Command: "echo wait"},
+				Wait: handlerSteps{{Command: "echo wait"}},
 			},
 		}
 		result := &core.DAG{}
 		handlerOn, err := buildHandlers(testBuildContext(), d, result)
 		require.NoError(t, err)
 		require.NotNil(t, handlerOn.Wait)
-		require.Len(t, handlerOn.Wait.Commands, 1)
-		assert.Equal(t, "echo wait", handlerOn.Wait.Commands[0].CmdWithArgs)
-		assert.Equal(t, "onWait", handlerOn.Wait.Name)
+		require.Len(t, handlerOn.Wait[0].Commands, 1)
+		assert.Equal(t, "echo wait", handlerOn.Wait[0].Commands[0].CmdWithArgs)
+		assert.Equal(t, "onWait", handlerOn.Wait[0].Name)
+	})
+
+	t.Run("FailureHandlerList", func(t *testing.T) {
+		t.Parallel()
+		d := &dag{
+			HandlerOn: handlerOn{
+				Failure: handlerSteps{
+					{Command: "echo notify"},
+					{Command: "echo cleanup"},
+					{Command: "echo report"},
+				},
+			},
+		}
+		result := &core.DAG{}
+		handlerOn, err := buildHandlers(testBuildContext(), d, result)
+		require.NoError(t, err)
+		steps := handlerOn.Failure
+		require.Len(t, steps, 3)
+		for i, want := range []string{"echo notify", "echo cleanup", "echo report"} {
+			require.Len(t, steps[i].Commands, 1)
+			assert.Equal(t, want, steps[i].Commands[0].CmdWithArgs)
+		}
+		assert.Equal(t, "onFailure", steps[0].Name)
+		assert.Equal(t, "onFailure_2", steps[1].Name)
+		assert.Equal(t, "onFailure_3", steps[2].Name)
+		assert.Empty(t, handlerOn.Exit)
+	})
+
+	t.Run("FailureHandlerListError", func(t *testing.T) {
+		t.Parallel()
+		d := &dag{
+			HandlerOn: handlerOn{
+				Failure: handlerSteps{
+					{Command: "echo notify"},
+					{Command: "   "}, // Empty command after trim causes error
+				},
+			},
+		}
+		result := &core.DAG{}
+		_, err := buildHandlers(testBuildContext(), d, result)
+		require.Error(t, err)
 	})
 
 	t.Run("WaitHandlerError", func(t *testing.T) {
 		t.Parallel()
 		d := &dag{
 			HandlerOn: handlerOn{
-				Wait: &step{Command: "   "}, // Empty command after trim causes error
+				Wait: handlerSteps{{Command: "   "}}, // Empty command after trim causes error
 			},
 		}
 		result := &core.DAG{}
@@ -2901,6 +3150,90 @@ steps:
 	require.True(t, found, "FULL_PATH env var not found")
 }
 
+func TestBuildRequiredVariableExpansion(t *testing.T) {
+	t.Parallel()
+
+	envValue := func(t *testing.T, d *core.DAG, key string) string {
+		t.Helper()
+		for _, e := range d.Env {
+			if k, v, ok := strings.Cut(e, "="); ok && k == key {
+				return v
+			}
+		}
+		t.Fatalf("env var %s not found", key)
+		return ""
+	}
+
+	t.Run("Satisfied", func(t *testing.T) {
+		t.Parallel()
+		d, err := LoadYAML(context.Background(), []byte(`
+params:
+  domain: example.com
+env:
+  - URL: "https://${domain:?domain is required}/"
+steps:
+  - command: echo test
+`))
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/", envValue(t, d, "URL"))
+	})
+
+	t.Run("UnsetFailsBuild", func(t *testing.T) {
+		t.Parallel()
+		_, err := LoadYAML(context.Background(), []byte(`
+env:
+  - URL: "https://${DAGU_TEST_REQUIRED_DOMAIN:?domain is required}/"
+steps:
+  - command: echo test
+`))
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidEnvValue)
+		assert.ErrorIs(t, err, eval.ErrRequiredVariable)
+		assert.Contains(t, err.Error(), "domain is required")
+	})
+
+	t.Run("EmptyFailsBuild", func(t *testing.T) {
+		t.Parallel()
+		_, err := LoadYAML(context.Background(), []byte(`
+env:
+  - DOMAIN: ""
+  - URL: "https://${DOMAIN:?domain is required}/"
+steps:
+  - command: echo test
+`))
+		require.Error(t, err)
+		assert.ErrorIs(t, err, eval.ErrRequiredVariable)
+	})
+
+	t.Run("EvalParamFailsBuild", func(t *testing.T) {
+		t.Parallel()
+		_, err := LoadYAML(context.Background(), []byte(`
+params:
+  - name: domain
+    eval: "${DAGU_TEST_REQUIRED_DOMAIN:?domain is required}"
+steps:
+  - command: echo test
+`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "domain is required")
+	})
+
+	t.Run("NoEvalPreservesRawForm", func(t *testing.T) {
+		t.Parallel()
+		d, err := LoadYAML(context.Background(), []byte(`
+params:
+  - name: domain
+    eval: "${DAGU_TEST_REQUIRED_DOMAIN:?domain is required}"
+env:
+  - URL: "https://${DAGU_TEST_REQUIRED_DOMAIN:?domain is required}/"
+steps:
+  - command: echo test
+`), WithoutEval())
+		require.NoError(t, err)
+		assert.Equal(t, "https://${DAGU_TEST_REQUIRED_DOMAIN:?domain is required}/", envValue(t, d, "URL"))
+	})
+}
+
 func TestRouterNotAllowedInChainType(t *testing.T) {
 	t.Parallel()
 
@@ -3040,3 +3373,73 @@ func TestRouterNotAllowedInChainType(t *testing.T) {
 		})
 	}
 }
+
+// The DAG JSON Schema is maintained by hand, so every YAML field the spec
+// types accept must also be declared there; otherwise editors flag valid
+// DAGs as errors.
+func TestDAGSchemaPropertiesCoverSpecFields(t *testing.T) {
+	t.Parallel()
+
+	var root map[string]any
+	require.NoError(t, json.Unmarshal(cmnschema.DAGSchemaJSON, &root))
+	definitions, _ := root["definitions"].(map[string]any)
+
+	// objectSchemas returns the object schemas a property may take, following
+	// references and union branches.
+	var objectSchemas func(node map[string]any) []map[string]any
+	objectSchemas = func(node map[string]any) []map[string]any {
+		if ref, ok := node["$ref"].(string); ok {
+			def, _ := definitions[strings.TrimPrefix(ref, "#/definitions/")].(map[string]any)
+			return objectSchemas(def)
+		}
+		var out []map[string]any
+		if _, ok := node["properties"].(map[string]any); ok {
+			out = append(out, node)
+		}
+		for _, key := range []string{"oneOf", "anyOf", "allOf"} {
+			branches, _ := node[key].([]any)
+			for _, branch := range branches {
+				if b, ok := branch.(map[string]any); ok {
+					out = append(out, objectSchemas(b)...)
+				}
+			}
+		}
+		return out
+	}
+
+	specPkg := reflect.TypeFor[dag]().PkgPath()
+	visited := make(map[reflect.Type]bool)
+	var check func(path string, typ reflect.Type, schemas []map[string]any)
+	check = func(path string, typ reflect.Type, schemas []map[string]any) {
+		for typ.Kind() == reflect.Pointer || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Map {
+			typ = typ.Elem()
+		}
+		// Types with custom unmarshalers live in the types package and are
+		// covered by their own schema tests.
+		if typ.Kind() != reflect.Struct || typ.PkgPath() != specPkg || len(schemas) == 0 || visited[typ] {
+			return
+		}
+		visited[typ] = true
+
+		for field := range typ.Fields() {
+			name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+			if name == "" || name == "-" {
+				continue
+			}
+			var fieldSchemas []map[string]any
+			declared := false
+			for _, schema := range schemas {
+				if prop, ok := schema["properties"].(map[string]any)[name].(map[string]any); ok {
+					declared = true
+					fieldSchemas = append(fieldSchemas, objectSchemas(prop)...)
+				}
+			}
+			if !assert.True(t, declared, "%s.%s is not declared in the DAG schema", path, name) {
+				continue
+			}
+			check(path+"."+name, field.Type, fieldSchemas)
+		}
+	}
+
+	check("dag", reflect.TypeFor[dag](), []map[string]any{root})
+}
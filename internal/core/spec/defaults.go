@@ -12,15 +12,15 @@ import (
 // defaults defines the default values for step configuration fields.
 // These are applied to every step that does not explicitly set its own value.
 type defaults struct {
-	ContinueOn    types.ContinueOnValue `yaml:"continue_on,omitempty"`
-	RetryPolicy   *retryPolicy          `yaml:"retry_policy,omitempty"`
-	RepeatPolicy  *repeatPolicy         `yaml:"repeat_policy,omitempty"`
-	TimeoutSec    int                   `yaml:"timeout_sec,omitempty"`
-	MailOnError   *bool                 `yaml:"mail_on_error,omitempty"`
-	SignalOnStop  *string               `yaml:"signal_on_stop,omitempty"`
-	Env           types.EnvValue        `yaml:"env,omitempty"`
-	Preconditions any                   `yaml:"preconditions,omitempty"`
-	Agent         *agentDefaults        `yaml:"agent,omitempty"`
+	ContinueOn    types.ContinueOnValue   `yaml:"continue_on,omitempty"`
+	RetryPolicy   *retryPolicy            `yaml:"retry_policy,omitempty"`
+	RepeatPolicy  *repeatPolicy           `yaml:"repeat_policy,omitempty"`
+	TimeoutSec    int                     `yaml:"timeout_sec,omitempty"`
+	MailOnError   *bool                   `yaml:"mail_on_error,omitempty"`
+	SignalOnStop  types.SignalOnStopValue `yaml:"signal_on_stop,omitempty"`
+	Env           types.EnvValue          `yaml:"env,omitempty"`
+	Preconditions any                     `yaml:"preconditions,omitempty"`
+	Agent         *agentDefaults          `yaml:"agent,omitempty"`
 }
 
 // agentDefaults defines default values for agent step configuration.
@@ -100,7 +100,7 @@ func applyDefaults(s *step, d *defaults, raw map[string]any) {
 	if shouldApply("mail_on_error", !s.MailOnError) && d.MailOnError != nil {
 		s.MailOnError = *d.MailOnError
 	}
-	if shouldApply("signal_on_stop", s.SignalOnStop == nil) && d.SignalOnStop != nil {
+	if shouldApply("signal_on_stop", s.SignalOnStop.IsZero()) && !d.SignalOnStop.IsZero() {
 		s.SignalOnStop = d.SignalOnStop
 	}
 
@@ -200,7 +200,7 @@ func mergeDefaults(base, override *defaults, overrideRaw map[string]any) *defaul
 	if shouldOverride("mail_on_error", override.MailOnError != nil) {
 		merged.MailOnError = override.MailOnError
 	}
-	if shouldOverride("signal_on_stop", override.SignalOnStop != nil) {
+	if shouldOverride("signal_on_stop", !override.SignalOnStop.IsZero()) {
 		merged.SignalOnStop = override.SignalOnStop
 	}
 	if shouldOverride("env", !override.Env.IsZero()) {
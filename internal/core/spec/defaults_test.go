@@ -14,7 +14,6 @@ func TestApplyDefaults(t *testing.T) {
 	t.Parallel()
 
 	boolPtr := func(b bool) *bool { return &b }
-	strPtr := func(s string) *string { return &s }
 
 	t.Run("NilDefaults", func(t *testing.T) {
 		t.Parallel()
@@ -151,16 +150,16 @@ func TestApplyDefaults(t *testing.T) {
 	t.Run("SignalOnStop_Inherits", func(t *testing.T) {
 		t.Parallel()
 		s := &step{}
-		applyDefaults(s, &defaults{SignalOnStop: strPtr("SIGTERM")}, nil)
-		require.NotNil(t, s.SignalOnStop)
-		require.Equal(t, "SIGTERM", *s.SignalOnStop)
+		applyDefaults(s, &defaults{SignalOnStop: signalOnStopValue("SIGTERM")}, nil)
+		require.False(t, s.SignalOnStop.IsZero())
+		require.Equal(t, "SIGTERM", s.SignalOnStop.Signal())
 	})
 
 	t.Run("SignalOnStop_StepOverrides", func(t *testing.T) {
 		t.Parallel()
-		s := &step{SignalOnStop: strPtr("SIGINT")}
-		applyDefaults(s, &defaults{SignalOnStop: strPtr("SIGTERM")}, nil)
-		require.Equal(t, "SIGINT", *s.SignalOnStop)
+		s := &step{SignalOnStop: signalOnStopValue("SIGINT")}
+		applyDefaults(s, &defaults{SignalOnStop: signalOnStopValue("SIGTERM")}, nil)
+		require.Equal(t, "SIGINT", s.SignalOnStop.Signal())
 	})
 
 	t.Run("Env_Inherits_WhenStepEmpty", func(t *testing.T) {
@@ -230,7 +229,7 @@ func TestApplyDefaults(t *testing.T) {
 			RepeatPolicy:  &repeatPolicy{Repeat: types.RepeatModeFromString("while"), IntervalSec: types.IntOrDynamicFromInt(30)},
 			TimeoutSec:    600,
 			MailOnError:   boolPtr(true),
-			SignalOnStop:  strPtr("SIGTERM"),
+			SignalOnStop:  signalOnStopValue("SIGTERM"),
 			Env:           envValueMap(map[string]string{"DEFAULT_VAR": "value"}),
 			Preconditions: "test -f /ready",
 		}
@@ -243,8 +242,8 @@ func TestApplyDefaults(t *testing.T) {
 		require.NotNil(t, s.RepeatPolicy)
 		require.Equal(t, 600, s.TimeoutSec)
 		require.True(t, s.MailOnError)
-		require.NotNil(t, s.SignalOnStop)
-		require.Equal(t, "SIGTERM", *s.SignalOnStop)
+		require.False(t, s.SignalOnStop.IsZero())
+		require.Equal(t, "SIGTERM", s.SignalOnStop.Signal())
 		require.False(t, s.Env.IsZero())
 		require.Equal(t, "test -f /ready", s.Preconditions)
 	})
@@ -254,7 +253,6 @@ func TestMergeDefaults(t *testing.T) {
 	t.Parallel()
 
 	boolPtr := func(b bool) *bool { return &b }
-	strPtr := func(s string) *string { return &s }
 	intPtr := func(v int) *int { return &v }
 
 	t.Run("NilHandling", func(t *testing.T) {
@@ -277,7 +275,7 @@ func TestMergeDefaults(t *testing.T) {
 			RepeatPolicy:  &repeatPolicy{Repeat: types.RepeatModeFromString("while"), Condition: "true", IntervalSec: types.IntOrDynamicFromInt(30)},
 			TimeoutSec:    600,
 			MailOnError:   boolPtr(true),
-			SignalOnStop:  strPtr("SIGTERM"),
+			SignalOnStop:  signalOnStopValue("SIGTERM"),
 			Env:           envValueMap(map[string]string{"BASE_ONLY": "base-only"}),
 			Preconditions: []any{"base-check"},
 			Agent: &agentDefaults{
@@ -297,7 +295,7 @@ func TestMergeDefaults(t *testing.T) {
 			RepeatPolicy:  &repeatPolicy{Repeat: types.RepeatModeFromString("until"), Condition: "cat /tmp/status", Expected: "done", IntervalSec: types.IntOrDynamicFromInt(11)},
 			TimeoutSec:    300,
 			MailOnError:   boolPtr(false),
-			SignalOnStop:  strPtr("SIGINT"),
+			SignalOnStop:  signalOnStopValue("SIGINT"),
 			Env:           envValueMap(map[string]string{"OVERRIDE_ONLY": "override-only"}),
 			Preconditions: []any{"override-check"},
 			Agent: &agentDefaults{
@@ -321,7 +319,7 @@ func TestMergeDefaults(t *testing.T) {
 		require.Equal(t, override.RepeatPolicy, merged.RepeatPolicy)
 		require.Equal(t, 300, merged.TimeoutSec)
 		require.Equal(t, false, *merged.MailOnError)
-		require.Equal(t, "SIGINT", *merged.SignalOnStop)
+		require.Equal(t, "SIGINT", merged.SignalOnStop.Signal())
 
 		envEntries := merged.Env.Entries()
 		require.Len(t, envEntries, 2)
@@ -511,8 +509,8 @@ func TestDecodeDefaults(t *testing.T) {
 		require.Equal(t, 600, d.TimeoutSec)
 		require.NotNil(t, d.MailOnError)
 		require.True(t, *d.MailOnError)
-		require.NotNil(t, d.SignalOnStop)
-		require.Equal(t, "SIGTERM", *d.SignalOnStop)
+		require.False(t, d.SignalOnStop.IsZero())
+		require.Equal(t, "SIGTERM", d.SignalOnStop.Signal())
 		require.False(t, d.Env.IsZero())
 		require.Equal(t, "test -f /ready", d.Preconditions)
 	})
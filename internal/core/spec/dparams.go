@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"maps"
 	"strings"
+	"time"
 
 	"github.com/dagucloud/dagu/internal/core"
 	"github.com/google/jsonschema-go/jsonschema"
@@ -155,9 +156,9 @@ func legacyPlanHasEval(plan *dagParamPlan) bool {
 func buildDAGParamPlan(ctx BuildContext, d *dag) (*dagParamPlan, error) {
 	if _, ok := extractParamsSchemaDeclaration(d.Params); ok {
 		if ctx.opts.Has(BuildFlagSkipSchemaValidation) {
-			return buildLegacyParamPlan(extractSchemaValues(d.Params))
+			return buildLegacyParamPlan(extractSchemaValues(d.Params), nil)
 		}
-		return buildExternalSchemaParamPlan(d.Params, d.WorkingDir, ctx.file)
+		return buildExternalSchemaParamPlan(d.Params, d.WorkingDir, ctx.file, ctx.opts.remoteSchemaCacheTTL())
 	}
 	if err := malformedInlineJSONSchemaShapeError(d.Params); err != nil {
 		return nil, err
@@ -165,10 +166,13 @@ func buildDAGParamPlan(ctx BuildContext, d *dag) (*dagParamPlan, error) {
 	if isInlineJSONSchema(d.Params) {
 		return buildInlineSchemaParamPlan(d.Params, ctx.opts.Has(BuildFlagSkipSchemaValidation))
 	}
-	return buildLegacyParamPlan(d.Params)
+	return buildLegacyParamPlan(d.Params, d.paramsOrder)
 }
 
-func buildLegacyParamPlan(input any) (*dagParamPlan, error) {
+// buildLegacyParamPlan builds the plan for params without a schema. order is
+// the declaration order of map-form params; when it is unknown the names are
+// sorted instead.
+func buildLegacyParamPlan(input any, order []string) (*dagParamPlan, error) {
 	noEvalCtx := BuildContext{opts: BuildOpts{Flags: BuildFlagNoEval}}
 	plan := &dagParamPlan{kind: dagParamKindLegacy}
 	seenNames := map[string]struct{}{}
@@ -198,10 +202,17 @@ func buildLegacyParamPlan(input any) (*dagParamPlan, error) {
 		return plan, nil
 
 	case map[string]any:
-		pairs, err := parseMapParams(noEvalCtx, []any{v})
+		pairs, err := parseOrderedMapParams(noEvalCtx, v, order)
 		if err != nil {
 			return nil, core.NewValidationError("params", v, fmt.Errorf("%w: %s", ErrInvalidParamValue, err))
 		}
+		// Sorted names say nothing about which param the author declared
+		// first, so forward references can only be detected with the order.
+		if order != nil {
+			if err := rejectForwardParamReferences(pairs); err != nil {
+				return nil, err
+			}
+		}
 		if err := appendLegacyPairs(plan, pairs, seenNames); err != nil {
 			return nil, err
 		}
@@ -273,8 +284,8 @@ func buildLegacyParamPlan(input any) (*dagParamPlan, error) {
 	}
 }
 
-func buildExternalSchemaParamPlan(input any, workingDir, dagLocation string) (*dagParamPlan, error) {
-	resolvedSchema, err := resolveSchemaFromParams(input, workingDir, dagLocation)
+func buildExternalSchemaParamPlan(input any, workingDir, dagLocation string, cacheTTL time.Duration) (*dagParamPlan, error) {
+	resolvedSchema, err := resolveSchemaFromParams(input, workingDir, dagLocation, cacheTTL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get JSON schema: %w", err)
 	}
@@ -5,8 +5,10 @@ package spec
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"maps"
+	"slices"
 	"sort"
 
 	"github.com/dagucloud/dagu/internal/core"
@@ -158,12 +160,82 @@ func validateSchemaMap(values map[string]any, schema *jsonschema.Resolved, metad
 	}
 
 	if err := validateSchema.Validate(working); err != nil {
-		return nil, fmt.Errorf("parameter validation failed: %w", err)
+		return nil, schemaValidationErrors(working, validateSchema, err)
 	}
 
 	return working, nil
 }
 
+// schemaValidationErrors breaks a schema validation failure down into one
+// error per violated property so that callers can report each problem
+// separately. The validator stops at the first failure, so each value is
+// validated again against the sub-schema of its property. When no individual
+// property can be blamed, the original failure is returned as a single entry.
+func schemaValidationErrors(values map[string]any, schema *jsonschema.Resolved, cause error) core.ErrorList {
+	var errs core.ErrorList
+
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		propertySchema, err := resolvePropertySchema(schema.Schema(), name)
+		if err != nil || propertySchema == nil {
+			continue
+		}
+		if err := propertySchema.Validate(values[name]); err != nil {
+			errs = append(errs, paramValidationError(name, schemaFailure(err)))
+		}
+	}
+
+	for _, name := range schema.Schema().Required {
+		if _, ok := values[name]; !ok {
+			errs = append(errs, paramValidationError(name, "required property is missing"))
+		}
+	}
+
+	if len(errs) == 0 {
+		errs = append(errs, core.NewValidationError("params", nil,
+			fmt.Errorf("parameter validation failed: %s", schemaFailure(cause))))
+	}
+	return errs
+}
+
+// resolvePropertySchema resolves the sub-schema that applies to the named
+// property as a schema of its own, carrying over the root definitions so that
+// local references keep resolving. It returns nil when no single sub-schema
+// governs the property.
+func resolvePropertySchema(root *jsonschema.Schema, name string) (*jsonschema.Resolved, error) {
+	clone := root.CloneSchemas()
+	sub, ok := clone.Properties[name]
+	if !ok && len(clone.PatternProperties) == 0 {
+		sub = clone.AdditionalProperties
+	}
+	if sub == nil {
+		return nil, nil
+	}
+	sub.Schema = clone.Schema
+	if sub.Defs == nil {
+		sub.Defs = clone.Defs
+	}
+	if sub.Definitions == nil {
+		sub.Definitions = clone.Definitions
+	}
+	return sub.Resolve(nil)
+}
+
+// schemaFailure returns the innermost message of a schema validation error,
+// without the "validating <schema>" context the validator wraps around it.
+func schemaFailure(err error) string {
+	for {
+		inner := errors.Unwrap(err)
+		if inner == nil {
+			return err.Error()
+		}
+		err = inner
+	}
+}
+
+func paramValidationError(name, failure string) error {
+	return core.NewValidationError("params."+name, nil, fmt.Errorf("parameter validation failed: %s", failure))
+}
+
 func schemaPairsToMap(pairs []paramPair, properties map[string]*jsonschema.Schema, allowSchemaFallbackJSON bool) (map[string]any, error) {
 	result := make(map[string]any, len(pairs))
 	for _, pair := range pairs {
@@ -395,8 +395,22 @@ params:
 
 	dag, err := LoadYAML(context.Background(), yaml, WithoutEval())
 	require.NoError(t, err)
-	assert.Equal(t, []string{"region=us", "schema=prod"}, dag.Params)
-	assert.Equal(t, `region="us" schema="prod"`, dag.DefaultParams)
+	assert.Equal(t, []string{"schema=prod", "region=us"}, dag.Params)
+	assert.Equal(t, `schema="prod" region="us"`, dag.DefaultParams)
+}
+
+func TestLegacyParamsMap_ForwardReferencesNeedDeclarationOrder(t *testing.T) {
+	t.Parallel()
+
+	params := map[string]any{"GREETING": "hello $NAME", "NAME": "world"}
+
+	_, err := buildLegacyParamPlan(params, []string{"GREETING", "NAME"})
+	require.ErrorIs(t, err, ErrInvalidParamValue)
+
+	// Without the declaration order the sorted names cannot tell which
+	// param was declared first.
+	_, err = buildLegacyParamPlan(params, nil)
+	require.NoError(t, err)
 }
 
 func TestLegacyParamsMap_AllowsBooleanSchemaKeyWithoutValues(t *testing.T) {
@@ -411,8 +425,8 @@ params:
 
 	dag, err := LoadYAML(context.Background(), yaml, WithoutEval())
 	require.NoError(t, err)
-	assert.Equal(t, []string{"debug=false", "schema=true"}, dag.Params)
-	assert.Equal(t, `debug="false" schema="true"`, dag.DefaultParams)
+	assert.Equal(t, []string{"schema=true", "debug=false"}, dag.Params)
+	assert.Equal(t, `schema="true" debug="false"`, dag.DefaultParams)
 }
 
 func TestInlineParamDefs_RejectNegativeStringLengthConstraint(t *testing.T) {
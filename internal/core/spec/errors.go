@@ -14,6 +14,8 @@ var (
 	ErrPreconditionNegateMustBeBool        = errors.New("precondition negate must be a boolean")
 	ErrPreconditionHasInvalidKey           = errors.New("precondition has invalid key")
 	ErrPreconditionMustBeArrayOrString     = errors.New("precondition must be a string or an array of strings")
+	ErrPreconditionGroupHasOtherKeys       = errors.New("precondition any/all group must be the only key")
+	ErrPreconditionGroupIsEmpty            = errors.New("precondition any/all group must not be empty")
 	ErrInvalidStepData                     = errors.New("invalid step data")
 	ErrStepsMustBeArrayOrMap               = errors.New("steps must be an array or a map")
 	ErrContinueOnExitCodeMustBeIntOrArray  = errors.New("continue_on.exit_code must be an int or an array of ints")
@@ -10,6 +10,7 @@ import (
 
 var legacyToSnakeCaseKey = map[string]string{
 	"workingDir":        "working_dir",
+	"createDir":         "create_dir",
 	"skipIfSuccessful":  "skip_if_successful",
 	"catchupWindow":     "catchup_window",
 	"overlapPolicy":     "overlap_policy",
@@ -17,6 +18,9 @@ var legacyToSnakeCaseKey = map[string]string{
 	"artifactDir":       "artifacts.dir",
 	"enableArtifact":    "artifacts.enabled",
 	"logOutput":         "log_output",
+	"outputFile":        "output_file",
+	"logRotate":         "log_rotate",
+	"maxSizeMB":         "max_size_mb",
 	"handlerOn":         "handler_on",
 	"mailOn":            "mail_on",
 	"errorMail":         "error_mail",
@@ -36,7 +40,9 @@ var legacyToSnakeCaseKey = map[string]string{
 	"workerSelector":    "worker_selector",
 	"registryAuths":     "registry_auths",
 	"shellPackages":     "shell_packages",
+	"shellArgs":         "shell_args",
 	"continueOn":        "continue_on",
+	"onError":           "on_error",
 	"retryPolicy":       "retry_policy",
 	"repeatPolicy":      "repeat_policy",
 	"mailOnError":       "mail_on_error",
@@ -44,7 +50,9 @@ var legacyToSnakeCaseKey = map[string]string{
 	"intervalSec":       "interval_sec",
 	"exitCode":          "exit_code",
 	"maxIntervalSec":    "max_interval_sec",
+	"maxRepeats":        "max_repeats",
 	"markSuccess":       "mark_success",
+	"markFailure":       "mark_failure",
 	"maxConcurrent":     "max_concurrent",
 	"disableParamEdit":  "disable_param_edit",
 	"disableRunIdEdit":  "disable_run_id_edit",
@@ -80,6 +88,12 @@ var legacyToSnakeCaseKey = map[string]string{
 	"run":          "call",
 }
 
+// scopedKeyHints holds renames that only apply to keys of a specific parent,
+// keyed by the parent key reported in the decode error.
+var scopedKeyHints = map[string]map[string]string{
+	"handler_on": {"cancel": "abort"},
+}
+
 func withSnakeCaseKeyHint(err error) error {
 	if err == nil {
 		return nil
@@ -87,10 +101,14 @@ func withSnakeCaseKeyHint(err error) error {
 
 	msg := err.Error()
 	const marker = "has invalid keys:"
-	_, after, ok := strings.Cut(msg, marker)
+	before, after, ok := strings.Cut(msg, marker)
 	if !ok {
 		return err
 	}
+	var parent string
+	if fields := strings.Fields(before); len(fields) > 0 {
+		parent = strings.Trim(fields[len(fields)-1], `"'`)
+	}
 
 	raw := strings.TrimSpace(after)
 	if raw == "" {
@@ -104,7 +122,10 @@ func withSnakeCaseKeyHint(err error) error {
 		if k == "" {
 			continue
 		}
-		snake, ok := legacyToSnakeCaseKey[k]
+		snake, ok := scopedKeyHints[parent][k]
+		if !ok {
+			snake, ok = legacyToSnakeCaseKey[k]
+		}
 		if !ok {
 			continue
 		}
@@ -14,6 +14,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"strings"
+	"time"
 
 	"dario.cat/mergo"
 	"github.com/dagucloud/dagu/internal/cmn/fileutil"
@@ -43,6 +44,7 @@ type LoadOptions struct {
 	dagsDir                string            // Directory containing the core.DAG files.
 	defaultWorkingDir      string            // Default working directory for DAGs without explicit workingDir.
 	buildEnv               map[string]string // Pre-populated env vars for build (used for retry with dotenv).
+	remoteSchemaCacheTTL   *time.Duration    // Freshness lifetime of cached remote params schemas.
 }
 
 // LoadOption is a function type for setting LoadOptions.
@@ -87,6 +89,15 @@ func WithParams(params any) LoadOption {
 	}
 }
 
+// WithRemoteSchemaCacheTTL sets how long remote params schemas are served from
+// cache before revalidation when the server sends no max-age. A zero TTL
+// revalidates on every load.
+func WithRemoteSchemaCacheTTL(ttl time.Duration) LoadOption {
+	return func(o *LoadOptions) {
+		o.remoteSchemaCacheTTL = &ttl
+	}
+}
+
 // WithoutEval disables the evaluation of dynamic fields.
 func WithoutEval() LoadOption {
 	return func(o *LoadOptions) {
@@ -146,6 +157,14 @@ func WithSkipBaseHandlers() LoadOption {
 	}
 }
 
+// WithCheckPaths makes the builder verify that an absolute working_dir exists
+// and is a directory. Loading stays free of filesystem checks without it.
+func WithCheckPaths() LoadOption {
+	return func(o *LoadOptions) {
+		o.flags |= BuildFlagCheckPaths
+	}
+}
+
 // WithDefaultWorkingDir sets the default working directory for DAGs without explicit workingDir.
 // This is used for sub-DAG execution to inherit the parent's working directory.
 func WithDefaultWorkingDir(defaultWorkingDir string) LoadOption {
@@ -204,6 +223,7 @@ func Load(ctx context.Context, nameOrPath string, opts ...LoadOption) (*core.DAG
 			DefaultWorkingDir:      options.defaultWorkingDir,
 			Flags:                  options.flags,
 			BuildEnv:               options.buildEnv,
+			RemoteSchemaCacheTTL:   options.remoteSchemaCacheTTL,
 		},
 	}
 	return loadDAG(buildContext, nameOrPath)
@@ -226,6 +246,7 @@ func LoadYAML(ctx context.Context, data []byte, opts ...LoadOption) (*core.DAG,
 		DefaultWorkingDir:      options.defaultWorkingDir,
 		Flags:                  options.flags,
 		BuildEnv:               options.buildEnv,
+		RemoteSchemaCacheTTL:   options.remoteSchemaCacheTTL,
 	})
 }
 
@@ -408,6 +429,9 @@ func loadDAGsFromFile(ctx BuildContext, filePath string, baseDef *dag, baseRaw [
 type dagDocument struct {
 	index int
 	data  map[string]any
+	// paramsOrder holds the declaration order of map-form params, which is
+	// lost when the document is decoded into a Go map.
+	paramsOrder []string
 }
 
 // loadDAGsFromData builds DAGs from every non-empty YAML document in the input.
@@ -435,7 +459,7 @@ func loadDAGsFromData(ctx BuildContext, data []byte, filePath string, baseDef *d
 			}
 		}
 
-		dag, err := processDAGDocument(buildDocumentContext(ctx, doc.index), doc.data, docBaseDef, docBaseRaw, filePath, data)
+		dag, err := processDAGDocument(buildDocumentContext(ctx, doc.index), doc, docBaseDef, docBaseRaw, filePath, data)
 		if err != nil {
 			return nil, fmt.Errorf("failed to process document %d: %w", doc.index, err)
 		}
@@ -458,6 +482,11 @@ func decodeDocuments(data []byte) ([]dagDocument, error) {
 		err := decoder.Decode(&doc)
 		if err != nil {
 			if errors.Is(err, io.EOF) {
+				if orders := documentParamsOrders(data); len(orders) == len(docs) {
+					for i := range docs {
+						docs[i].paramsOrder = orders[i]
+					}
+				}
 				return docs, nil
 			}
 			return nil, fmt.Errorf("failed to decode document %d: %w", index, err)
@@ -469,6 +498,45 @@ func decodeDocuments(data []byte) ([]dagDocument, error) {
 	}
 }
 
+// documentParamsOrders returns the declaration order of map-form params for
+// each non-empty document, or nil when the stream cannot be decoded in order.
+func documentParamsOrders(data []byte) [][]string {
+	decoder := yaml.NewDecoder(bytes.NewReader(data), yaml.UseOrderedMap())
+	var orders [][]string
+
+	for {
+		var doc yaml.MapSlice
+		if err := decoder.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				return orders
+			}
+			return nil
+		}
+		if len(doc) == 0 {
+			continue
+		}
+
+		var order []string
+		for _, item := range doc {
+			if item.Key != "params" {
+				continue
+			}
+			params, ok := item.Value.(yaml.MapSlice)
+			if !ok {
+				break
+			}
+			for _, param := range params {
+				name, ok := param.Key.(string)
+				if !ok {
+					return nil
+				}
+				order = append(order, name)
+			}
+		}
+		orders = append(orders, order)
+	}
+}
+
 // loadBaseDefinition loads and decodes the optional base manifest.
 func loadBaseDefinition(opts BuildOpts) (*dag, []byte, error) {
 	if opts.Has(BuildFlagOnlyMetadata) {
@@ -522,16 +590,24 @@ func decodeDefinitionData(data []byte, description string) (*dag, error) {
 // processDAGDocument processes a single DAG document from the YAML file.
 func processDAGDocument(
 	ctx BuildContext,
-	doc map[string]any,
+	document dagDocument,
 	baseDef *dag,
 	baseRaw []byte,
 	filePath string,
 	fullData []byte,
 ) (*core.DAG, error) {
+	doc := document.data
 	spec, err := decode(doc)
 	if err != nil {
 		return nil, err
 	}
+	spec.paramsOrder = document.paramsOrder
+	if err := applyDAGIncludes(spec, filePath); err != nil {
+		return nil, err
+	}
+	if err := applyDAGBase(spec, filePath); err != nil {
+		return nil, err
+	}
 
 	docCtx, dest, err := prepareDocumentContext(ctx, baseDef, spec)
 	if err != nil {
@@ -1081,19 +1157,27 @@ func decode(cm map[string]any) (*dag, error) {
 }
 
 // extractRawHandlerOn copies raw handler definitions for later processing.
-func extractRawHandlerOn(cm map[string]any) map[string]map[string]any {
+func extractRawHandlerOn(cm map[string]any) map[string][]map[string]any {
 	rawHandlers, ok := cm["handler_on"].(map[string]any)
 	if !ok || len(rawHandlers) == 0 {
 		return nil
 	}
 
-	cloned := make(map[string]map[string]any, len(rawHandlers))
+	cloned := make(map[string][]map[string]any, len(rawHandlers))
 	for key, value := range rawHandlers {
-		rawStep, ok := value.(map[string]any)
-		if !ok {
-			continue
+		switch v := value.(type) {
+		case map[string]any:
+			cloned[key] = []map[string]any{cloneMap(v)}
+		case []any:
+			// Keep list positions aligned with the decoded handler steps.
+			rawSteps := make([]map[string]any, len(v))
+			for i, item := range v {
+				if rawStep, ok := item.(map[string]any); ok {
+					rawSteps[i] = cloneMap(rawStep)
+				}
+			}
+			cloned[key] = rawSteps
 		}
-		cloned[key] = cloneMap(rawStep)
 	}
 	if len(cloned) == 0 {
 		return nil
@@ -1122,6 +1206,10 @@ func TypedUnionDecodeHook() mapstructure.DecodeHookFunc {
 		if to == reflect.TypeFor[types.StringOrArray]() {
 			return decodeViaYAML[types.StringOrArray](data)
 		}
+		// Handle types.DependsValue
+		if to == reflect.TypeFor[types.DependsValue]() {
+			return decodeViaYAML[types.DependsValue](data)
+		}
 		// Handle types.ScheduleValue
 		if to == reflect.TypeFor[types.ScheduleValue]() {
 			return decodeViaYAML[types.ScheduleValue](data)
@@ -1162,6 +1250,16 @@ func TypedUnionDecodeHook() mapstructure.DecodeHookFunc {
 		if to == reflect.TypeFor[types.BackoffValue]() {
 			return decodeViaYAML[types.BackoffValue](data)
 		}
+		// Handle types.SignalOnStopValue
+		if to == reflect.TypeFor[types.SignalOnStopValue]() {
+			return decodeViaYAML[types.SignalOnStopValue](data)
+		}
+		// Handle handlerSteps: a single step map is a one-step handler
+		if to == reflect.TypeFor[handlerSteps]() {
+			if m, ok := data.(map[string]any); ok {
+				return []any{m}, nil
+			}
+		}
 		return data, nil
 	}
 }
@@ -86,7 +86,7 @@ func TestDecode(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, manifest)
 		require.Contains(t, manifest.handlerOnRaw, "failure")
-		require.Equal(t, "echo fail", manifest.handlerOnRaw["failure"]["command"])
+		require.Equal(t, "echo fail", manifest.handlerOnRaw["failure"][0]["command"])
 		require.Equal(t, "bash", manifest.defaultsRaw["shell"])
 	})
 
@@ -5,6 +5,7 @@ package spec_test
 
 import (
 	"context"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -1218,6 +1219,183 @@ func TestLoadYAML(t *testing.T) {
 	}
 }
 
+// DAG.Validate runs on DAGs that were already built by the loader, including
+// ones read back from dag-run records, so anything the loader accepts must
+// still pass it.
+func TestLoadedDAGPassesValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name: "DependsByID",
+			input: `name: depends-by-id
+type: graph
+steps:
+  - id: extract
+    name: Extract data
+    command: echo extract
+  - id: load
+    name: Load data
+    command: echo load
+    depends: extract
+`,
+		},
+		{
+			name: "ChainType",
+			input: `name: chain
+type: chain
+steps:
+  - command: echo one
+  - command: echo two
+  - command: echo three
+`,
+		},
+		{
+			name: "Schedules",
+			input: `name: schedules
+schedule:
+  start: "0 1 * * *"
+  stop: "0 2 * * *"
+  restart: "CRON_TZ=Asia/Tokyo 0 3 * * *"
+steps:
+  - command: echo scheduled
+`,
+		},
+		{
+			name: "Handlers",
+			input: `name: handlers
+handler_on:
+  success:
+    command: echo success
+  failure:
+    command: echo failure
+steps:
+  - name: main
+    command: echo main
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, opts := range []spec.BuildOpts{{}, {Flags: spec.BuildFlagNoEval}} {
+				dag, err := spec.LoadYAMLWithOpts(context.Background(), []byte(tt.input), opts)
+				require.NoError(t, err)
+				require.NoError(t, dag.Validate())
+
+				data, err := json.Marshal(dag)
+				require.NoError(t, err)
+				var stored core.DAG
+				require.NoError(t, json.Unmarshal(data, &stored))
+				require.NoError(t, stored.Validate())
+			}
+		})
+	}
+}
+
+func TestDAGHash(t *testing.T) {
+	t.Parallel()
+
+	const original = `name: hash-test
+type: graph
+env:
+  - GREETING: hello
+steps:
+  - name: greet
+    command: echo ${GREETING}
+  - name: done
+    command: echo done
+    depends: greet
+---
+name: child
+steps:
+  - command: echo child
+`
+	const reformatted = `# Same DAG, different formatting.
+name:   "hash-test"
+type: "graph"
+env: [{GREETING: hello}]
+
+steps:
+  - {name: greet, command: "echo ${GREETING}"}
+  - name: done
+    # trailing step
+    command: "echo done"
+    depends: [greet]
+---
+name: child
+steps: [{command: echo child}]
+`
+	const changedCommand = `name: hash-test
+type: graph
+env:
+  - GREETING: hello
+steps:
+  - name: greet
+    command: echo ${GREETING} world
+  - name: done
+    command: echo done
+    depends: greet
+---
+name: child
+steps:
+  - command: echo child
+`
+	const changedLocalDAG = `name: hash-test
+type: graph
+env:
+  - GREETING: hello
+steps:
+  - name: greet
+    command: echo ${GREETING}
+  - name: done
+    command: echo done
+    depends: greet
+---
+name: child
+steps:
+  - command: echo changed
+`
+
+	hash := func(t *testing.T, input string) string {
+		t.Helper()
+		dag, err := spec.LoadYAMLWithOpts(context.Background(), []byte(input), spec.BuildOpts{Flags: spec.BuildFlagNoEval})
+		require.NoError(t, err)
+		h := dag.Hash()
+		require.NotEmpty(t, h)
+		return h
+	}
+
+	base := hash(t, original)
+	assert.Equal(t, base, hash(t, original), "hash must be stable")
+	assert.Equal(t, base, hash(t, reformatted), "formatting must not change the hash")
+	assert.NotEqual(t, base, hash(t, changedCommand), "a changed command must change the hash")
+	assert.NotEqual(t, base, hash(t, changedLocalDAG), "a changed local DAG must change the hash")
+}
+
+func TestDAGHashIgnoresReformattedFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "hashed.yaml")
+	load := func(t *testing.T, content string) string {
+		t.Helper()
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		dag, err := spec.Load(context.Background(), path, spec.WithoutEval())
+		require.NoError(t, err)
+		return dag.Hash()
+	}
+
+	before := load(t, "steps:\n  - command: echo ${HOME}\n")
+	after := load(t, "# comment\nsteps:\n\n  - command:   \"echo ${HOME}\"\n")
+	assert.Equal(t, before, after)
+	assert.NotEqual(t, before, load(t, "steps:\n  - command: echo ${USER}\n"))
+}
+
 func TestLoadPreservesSourceFileForFileBasedDAG(t *testing.T) {
 	t.Parallel()
 
@@ -2316,11 +2494,72 @@ steps:
 
 		// failure handler inherits default timeout
 		require.NotNil(t, dag.HandlerOn.Failure)
-		require.Equal(t, 300*time.Second, dag.HandlerOn.Failure.Timeout)
+		require.Equal(t, 300*time.Second, dag.HandlerOn.Failure[0].Timeout)
 
 		// exit handler overrides default timeout
 		require.NotNil(t, dag.HandlerOn.Exit)
-		require.Equal(t, 60*time.Second, dag.HandlerOn.Exit.Timeout)
+		require.Equal(t, 60*time.Second, dag.HandlerOn.Exit[0].Timeout)
+	})
+
+	t.Run("HandlerListInheritsDefaults", func(t *testing.T) {
+		t.Parallel()
+
+		testDAG := createTempYAMLFile(t, `
+defaults:
+  timeout_sec: 300
+
+handler_on:
+  failure:
+    - command: echo notify
+    - command: echo cleanup
+      timeout_sec: 60
+      continue_on:
+        failure: true
+
+steps:
+  - name: step1
+    command: echo "test"
+`)
+		dag, err := spec.Load(context.Background(), testDAG)
+		require.NoError(t, err)
+
+		steps := dag.HandlerOn.Steps(core.HandlerOnFailure)
+		require.Len(t, steps, 2)
+		require.Equal(t, "echo notify", steps[0].Commands[0].CmdWithArgs)
+		require.Equal(t, 300*time.Second, steps[0].Timeout)
+		require.Equal(t, "echo cleanup", steps[1].Commands[0].CmdWithArgs)
+		require.Equal(t, 60*time.Second, steps[1].Timeout)
+		require.True(t, steps[1].ContinueOn.Failure)
+	})
+
+	t.Run("HandlerListReplacesBaseHandler", func(t *testing.T) {
+		t.Parallel()
+
+		base := createTempYAMLFile(t, `
+handler_on:
+  failure:
+    - command: echo base-notify
+    - command: echo base-cleanup
+  exit:
+    - command: echo base-exit
+    - command: echo base-exit-cleanup
+`)
+		testDAG := createTempYAMLFile(t, `
+handler_on:
+  failure:
+    command: echo notify
+
+steps:
+  - name: step1
+    command: echo "test"
+`)
+		dag, err := spec.Load(context.Background(), testDAG, spec.WithBaseConfig(base))
+		require.NoError(t, err)
+
+		failure := dag.HandlerOn.Steps(core.HandlerOnFailure)
+		require.Len(t, failure, 1)
+		require.Equal(t, "echo notify", failure[0].Commands[0].CmdWithArgs)
+		require.Len(t, dag.HandlerOn.Steps(core.HandlerOnExit), 2)
 	})
 
 	t.Run("BaseConfigDefaults", func(t *testing.T) {
@@ -226,6 +226,59 @@ func parseMapParams(ctx BuildContext, input []any) ([]paramPair, error) {
 	return params, nil
 }
 
+// parseOrderedMapParams parses a params map in the given declaration order.
+// It falls back to sorted names when order does not list exactly the keys
+// of the map.
+func parseOrderedMapParams(ctx BuildContext, input map[string]any, order []string) ([]paramPair, error) {
+	if len(order) != len(input) {
+		return parseMapParams(ctx, []any{input})
+	}
+	params := make([]paramPair, 0, len(order))
+	for _, name := range order {
+		value, ok := input[name]
+		if !ok {
+			return parseMapParams(ctx, []any{input})
+		}
+		pairs, err := parseMapParams(ctx, []any{map[string]any{name: value}})
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, pairs...)
+	}
+	return params, nil
+}
+
+// paramReferenceRegex matches ${NAME}, ${NAME...} and $NAME references.
+var paramReferenceRegex = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)|\$([A-Za-z_][A-Za-z0-9_]*)`)
+
+// rejectForwardParamReferences reports a param whose value references a
+// param declared after it. Params are resolved in declaration order, so such
+// a reference would otherwise be left unexpanded.
+func rejectForwardParamReferences(params []paramPair) error {
+	declaredAt := make(map[string]int, len(params))
+	for i, p := range params {
+		if p.Name != "" {
+			declaredAt[p.Name] = i
+		}
+	}
+	for i, p := range params {
+		for _, match := range paramReferenceRegex.FindAllStringSubmatch(p.Value, -1) {
+			ref := match[1]
+			if ref == "" {
+				ref = match[2]
+			}
+			if at, ok := declaredAt[ref]; ok && at > i {
+				return core.NewValidationError(
+					"params",
+					p.Value,
+					fmt.Errorf("%w: parameter %q references %q, which is declared after it; declare %q first", ErrInvalidParamValue, p.Name, ref, ref),
+				)
+			}
+		}
+	}
+	return nil
+}
+
 // paramRegex is a regex to match the parameters in the command.
 var paramRegex = regexp.MustCompile(
 	`(?:([^\s=]+)=)?("(?:\\"|[^"])*"|` + "`[^`]*`" + `|[^"\s]+)`,
@@ -0,0 +1,45 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package spec
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/dagucloud/dagu/internal/core"
+	"github.com/goccy/go-yaml"
+)
+
+// ReadParamsFile reads a YAML or JSON file containing a map of parameter
+// names to values and returns them as quoted KEY="VALUE" pairs suitable for
+// WithParams. Keys are returned in sorted order. Nested maps and lists are
+// encoded as JSON strings so they can be validated against a params schema.
+func ReadParamsFile(path string) ([]string, error) {
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("failed to read params file %s: %w", path, err)
+	}
+
+	var values map[string]any
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("failed to parse params file %s: %w", path, err)
+	}
+
+	keys := make([]string, 0, len(values))
+	for name := range values {
+		keys = append(keys, name)
+	}
+	sort.Strings(keys)
+
+	params := make([]string, 0, len(keys))
+	for _, name := range keys {
+		var value string
+		if v := values[name]; v != nil {
+			value = core.ParamValueString(v)
+		}
+		params = append(params, paramPair{Name: name, Value: value}.Escaped())
+	}
+	return params, nil
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package spec
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadParamsFileSchemaValidation(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	schemaPath := filepath.Join(dir, "schema.json")
+	require.NoError(t, os.WriteFile(schemaPath, []byte(`{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "properties": {
+    "batch_size": {
+      "type": "integer",
+      "default": 10,
+      "minimum": 1,
+      "maximum": 50
+    },
+    "environment": {
+      "type": "string",
+      "default": "dev",
+      "enum": ["dev", "staging", "prod"]
+    },
+    "note": {
+      "type": "string"
+    }
+  }
+}`), 0o600))
+
+	data := fmt.Appendf(nil, `
+params:
+  schema: "%s"
+`, filepath.ToSlash(schemaPath))
+
+	writeParamsFile := func(t *testing.T, name, content string) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	t.Run("YAMLFileMergedAndValidated", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeParamsFile(t, "params.yaml", "batch_size: 20\nnote: hello world\n")
+		fileParams, err := ReadParamsFile(path)
+		require.NoError(t, err)
+		require.Equal(t, []string{`batch_size="20"`, `note="hello world"`}, fileParams)
+
+		dag, err := LoadYAML(context.Background(), data, WithParams(fileParams))
+		require.NoError(t, err)
+		require.Contains(t, dag.Params, "batch_size=20")
+		require.Contains(t, dag.Params, "environment=dev")
+		require.Contains(t, dag.Params, "note=hello world")
+	})
+
+	t.Run("CLIParamsOverrideFile", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeParamsFile(t, "params.json", `{"batch_size": 20, "environment": "staging"}`)
+		fileParams, err := ReadParamsFile(path)
+		require.NoError(t, err)
+
+		dag, err := LoadYAML(context.Background(), data, WithParams(append(fileParams, "environment=prod")))
+		require.NoError(t, err)
+		require.Contains(t, dag.Params, "batch_size=20")
+		require.Contains(t, dag.Params, "environment=prod")
+	})
+
+	t.Run("FileValuesValidationFails", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeParamsFile(t, "params.yaml", "batch_size: 100\n")
+		fileParams, err := ReadParamsFile(path)
+		require.NoError(t, err)
+
+		_, err = LoadYAML(context.Background(), data, WithParams(fileParams))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "parameter validation failed")
+	})
+
+	t.Run("InvalidFile", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ReadParamsFile(filepath.Join(t.TempDir(), "missing.yaml"))
+		require.ErrorContains(t, err, "failed to read params file")
+
+		path := writeParamsFile(t, "params.yaml", "- not\n- a map\n")
+		_, err = ReadParamsFile(path)
+		require.ErrorContains(t, err, "failed to parse params file")
+	})
+}
@@ -6,7 +6,6 @@ package spec
 import (
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"os"
@@ -15,6 +14,8 @@ import (
 	"time"
 
 	"github.com/dagucloud/dagu/internal/cmn/fileutil"
+	"github.com/dagucloud/dagu/internal/core"
+	"github.com/goccy/go-yaml"
 	"github.com/google/jsonschema-go/jsonschema"
 )
 
@@ -22,17 +23,18 @@ const schemaHTTPTimeout = 30 * time.Second
 
 // resolveSchemaFromParams extracts a schema declaration from params and resolves it.
 // Returns (nil, nil) if no schema is declared.
-func resolveSchemaFromParams(params any, workingDir, dagLocation string) (*jsonschema.Resolved, error) {
+func resolveSchemaFromParams(params any, workingDir, dagLocation string, cacheTTL time.Duration) (*jsonschema.Resolved, error) {
 	schemaDecl, ok := extractParamsSchemaDeclaration(params)
 	if !ok {
 		return nil, nil
 	}
-	return resolveSchemaDeclaration(schemaDecl, workingDir, dagLocation)
+	return resolveSchemaDeclaration(schemaDecl, workingDir, dagLocation, cacheTTL)
 }
 
 // resolveSchemaDeclaration resolves a schema declaration.
 // A declaration can be a path/URL string, an inline JSON Schema object, or a boolean schema.
-func resolveSchemaDeclaration(schemaDecl any, workingDir, dagLocation string) (*jsonschema.Resolved, error) {
+// Remote schemas are cached for cacheTTL unless the server says otherwise.
+func resolveSchemaDeclaration(schemaDecl any, workingDir, dagLocation string, cacheTTL time.Duration) (*jsonschema.Resolved, error) {
 	switch v := schemaDecl.(type) {
 	case nil:
 		return nil, nil
@@ -42,7 +44,7 @@ func resolveSchemaDeclaration(schemaDecl any, workingDir, dagLocation string) (*
 		if schemaRef == "" {
 			return nil, fmt.Errorf("schema reference cannot be empty")
 		}
-		return getSchemaFromRef(workingDir, dagLocation, schemaRef)
+		return getSchemaFromRef(workingDir, dagLocation, schemaRef, cacheTTL)
 
 	case map[string]any, bool:
 		data, err := json.Marshal(schemaDecl)
@@ -73,13 +75,13 @@ func resolveSchemaData(schemaData []byte) (*jsonschema.Resolved, error) {
 }
 
 // Schema Ref can be a local file (relative or absolute paths), or a remote URL
-func getSchemaFromRef(workingDir string, dagLocation string, schemaRef string) (*jsonschema.Resolved, error) {
+func getSchemaFromRef(workingDir string, dagLocation string, schemaRef string, cacheTTL time.Duration) (*jsonschema.Resolved, error) {
 	var schemaData []byte
 	var err error
 
 	// Check if it's a URL or file path
 	if strings.HasPrefix(schemaRef, "http://") || strings.HasPrefix(schemaRef, "https://") {
-		schemaData, err = loadSchemaFromURL(schemaRef)
+		schemaData, err = loadSchemaFromURL(schemaRef, cacheTTL)
 	} else {
 		schemaData, err = loadSchemaFromFile(workingDir, dagLocation, schemaRef)
 	}
@@ -96,8 +98,9 @@ func getSchemaFromRef(workingDir string, dagLocation string, schemaRef string) (
 	return resolvedSchema, nil
 }
 
-// loadSchemaFromURL loads a JSON schema from a URL.
-func loadSchemaFromURL(schemaURL string) (data []byte, err error) {
+// loadSchemaFromURL loads a JSON schema from a URL. Responses are cached per
+// URL; see remoteSchemaCache.
+func loadSchemaFromURL(schemaURL string, cacheTTL time.Duration) ([]byte, error) {
 	// Validate URL to prevent potential security issues (and satisfy linter :P)
 	parsedURL, err := url.Parse(schemaURL)
 	if err != nil {
@@ -107,30 +110,10 @@ func loadSchemaFromURL(schemaURL string) (data []byte, err error) {
 		return nil, fmt.Errorf("unsupported URL scheme: %s", parsedURL.Scheme)
 	}
 
-	req, err := http.NewRequest("GET", schemaURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
 	client := newSchemaHTTPClient()
 	defer closeSchemaHTTPClient(client)
 
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer func() {
-		if closeErr := resp.Body.Close(); closeErr != nil && err == nil {
-			err = closeErr
-		}
-	}()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, resp.Status)
-	}
-
-	data, err = io.ReadAll(resp.Body)
-	return data, err
+	return remoteSchemas.fetch(client, schemaURL, cacheTTL)
 }
 
 func newSchemaHTTPClient() *http.Client {
@@ -156,7 +139,14 @@ func closeSchemaHTTPClient(client *http.Client) {
 
 // loadSchemaFromFile loads a JSON schema from a file path.
 func loadSchemaFromFile(workingDir string, dagLocation string, filePath string) ([]byte, error) {
-	// Try to resolve the schema file path in the following order:
+	data, _, err := loadFileFromDAGPaths("schema", workingDir, dagLocation, filePath)
+	return data, err
+}
+
+// loadFileFromDAGPaths reads a file referenced from a DAG and returns its
+// content and resolved path. kind names the file in error messages.
+func loadFileFromDAGPaths(kind, workingDir, dagLocation, filePath string) ([]byte, string, error) {
+	// Try to resolve the file path in the following order:
 	// 1) Current working directory (default ResolvePath behavior)
 	// 2) DAG's workingDir value
 	// 3) Directory of the DAG file (where it was loaded from)
@@ -190,29 +180,55 @@ func loadSchemaFromFile(workingDir string, dagLocation string, filePath string)
 	}
 
 	// 1) As provided (CWD/env/tilde expansion handled by ResolvePath)
-	if data, _, err := tryCandidate("cwd", ""); err == nil {
-		return data, nil
+	if data, resolved, err := tryCandidate("cwd", ""); err == nil {
+		return data, resolved, nil
 	}
 
 	// 2) From DAG's workingDir value if present
 	if wd := strings.TrimSpace(workingDir); wd != "" {
-		if data, _, err := tryCandidate(fmt.Sprintf("workingDir(%s)", wd), wd); err == nil {
-			return data, nil
+		if data, resolved, err := tryCandidate(fmt.Sprintf("workingDir(%s)", wd), wd); err == nil {
+			return data, resolved, nil
 		}
 	}
 
 	// 3) From the directory of the DAG file used to build
 	if dagLocation != "" {
 		base := filepath.Dir(dagLocation)
-		if data, _, err := tryCandidate(fmt.Sprintf("dagDir(%s)", base), base); err == nil {
-			return data, nil
+		if data, resolved, err := tryCandidate(fmt.Sprintf("dagDir(%s)", base), base); err == nil {
+			return data, resolved, nil
 		}
 	}
 
 	if len(tried) == 0 {
-		return nil, fmt.Errorf("failed to resolve schema file path: %s (no candidates)", filePath)
+		return nil, "", fmt.Errorf("failed to resolve %s file path: %s (no candidates)", kind, filePath)
+	}
+	return nil, "", fmt.Errorf("%s file not found for %q; tried %s", kind, filePath, strings.Join(tried, ", "))
+}
+
+// ParamsSchemaFile returns the path of the local JSON schema file that the
+// DAG's params reference, or "" when the params declare no schema file or the
+// file cannot be found.
+func ParamsSchemaFile(dag *core.DAG) string {
+	var doc struct {
+		Params any `yaml:"params"`
+	}
+	if err := yaml.Unmarshal(dag.YamlData, &doc); err != nil {
+		return ""
+	}
+	schemaDecl, ok := extractParamsSchemaDeclaration(doc.Params)
+	if !ok {
+		return ""
+	}
+	schemaRef, ok := schemaDecl.(string)
+	schemaRef = strings.TrimSpace(schemaRef)
+	if !ok || schemaRef == "" || strings.HasPrefix(schemaRef, "http://") || strings.HasPrefix(schemaRef, "https://") {
+		return ""
+	}
+	_, resolved, err := loadFileFromDAGPaths("schema", dag.WorkingDir, dag.Location, schemaRef)
+	if err != nil {
+		return ""
 	}
-	return nil, fmt.Errorf("schema file not found for %q; tried %s", filePath, strings.Join(tried, ", "))
+	return resolved
 }
 
 // extractParamsSchemaDeclaration extracts the schema declaration from a params map.
@@ -0,0 +1,147 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package spec
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultRemoteSchemaCacheTTL is how long a remote params schema is served
+// from cache before it is revalidated when the server sends no max-age.
+const DefaultRemoteSchemaCacheTTL = 5 * time.Minute
+
+// remoteSchemas caches remote params schemas for the lifetime of the process.
+var remoteSchemas = newRemoteSchemaCache()
+
+type remoteSchemaEntry struct {
+	data      []byte
+	etag      string
+	expiresAt time.Time
+}
+
+// remoteSchemaCache keeps the last good copy of each remote schema keyed by
+// URL. Entries are revalidated with If-None-Match once they expire, and the
+// cached copy is reused when the server is unreachable or returns 5xx.
+type remoteSchemaCache struct {
+	mu      sync.Mutex
+	entries map[string]*remoteSchemaEntry
+	now     func() time.Time
+}
+
+func newRemoteSchemaCache() *remoteSchemaCache {
+	return &remoteSchemaCache{
+		entries: make(map[string]*remoteSchemaEntry),
+		now:     time.Now,
+	}
+}
+
+func (c *remoteSchemaCache) lookup(schemaURL string) (*remoteSchemaEntry, time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.entries[schemaURL], c.now()
+}
+
+func (c *remoteSchemaCache) store(schemaURL string, entry *remoteSchemaEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry == nil {
+		delete(c.entries, schemaURL)
+		return
+	}
+	c.entries[schemaURL] = entry
+}
+
+// fetch returns the schema at schemaURL, using the cache where possible.
+// Responses without a max-age stay fresh for ttl.
+func (c *remoteSchemaCache) fetch(client *http.Client, schemaURL string, ttl time.Duration) ([]byte, error) {
+	cached, now := c.lookup(schemaURL)
+	if cached != nil && now.Before(cached.expiresAt) {
+		return cached.data, nil
+	}
+
+	req, err := http.NewRequest("GET", schemaURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if cached != nil && cached.etag != "" {
+		req.Header.Set("If-None-Match", cached.etag)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		if cached != nil {
+			return cached.data, nil
+		}
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && cached != nil:
+		maxAge, cacheable := cacheControlMaxAge(resp.Header.Get("Cache-Control"), ttl)
+		if !cacheable {
+			maxAge = 0
+		}
+		c.store(schemaURL, &remoteSchemaEntry{
+			data:      cached.data,
+			etag:      cached.etag,
+			expiresAt: now.Add(maxAge),
+		})
+		return cached.data, nil
+
+	case resp.StatusCode >= http.StatusInternalServerError && cached != nil:
+		return cached.data, nil
+
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	maxAge, cacheable := cacheControlMaxAge(resp.Header.Get("Cache-Control"), ttl)
+	if !cacheable {
+		c.store(schemaURL, nil)
+		return data, nil
+	}
+	c.store(schemaURL, &remoteSchemaEntry{
+		data:      data,
+		etag:      resp.Header.Get("ETag"),
+		expiresAt: now.Add(maxAge),
+	})
+	return data, nil
+}
+
+// cacheControlMaxAge returns the freshness lifetime advertised by a
+// Cache-Control header, falling back to ttl. The second result is false when
+// the response must not be stored.
+func cacheControlMaxAge(header string, ttl time.Duration) (time.Duration, bool) {
+	maxAge := ttl
+	noCache := false
+	for directive := range strings.SplitSeq(header, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		switch strings.ToLower(name) {
+		case "no-store":
+			return 0, false
+		case "no-cache":
+			noCache = true
+		case "max-age":
+			if secs, err := strconv.Atoi(strings.Trim(value, `"`)); err == nil && secs >= 0 {
+				maxAge = time.Duration(secs) * time.Second
+			}
+		}
+	}
+	if noCache {
+		return 0, true
+	}
+	return maxAge, true
+}
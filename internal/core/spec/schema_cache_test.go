@@ -0,0 +1,174 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package spec
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteSchemaCache(t *testing.T) {
+	t.Parallel()
+
+	const schemaContent = `{"type": "object", "properties": {"foo": {"type": "string"}}}`
+
+	t.Run("FreshEntryServedWithoutRequest", func(t *testing.T) {
+		t.Parallel()
+
+		var requests atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			requests.Add(1)
+			_, _ = w.Write([]byte(schemaContent))
+		}))
+		defer server.Close()
+
+		cache := newRemoteSchemaCache()
+		for range 3 {
+			data, err := cache.fetch(server.Client(), server.URL+"/schema.json", time.Hour)
+			require.NoError(t, err)
+			assert.Equal(t, schemaContent, string(data))
+		}
+		assert.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("NotModifiedUsesCache", func(t *testing.T) {
+		t.Parallel()
+
+		var requests, revalidations atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			w.Header().Set("Cache-Control", "no-cache")
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				revalidations.Add(1)
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write([]byte(schemaContent))
+		}))
+		defer server.Close()
+
+		cache := newRemoteSchemaCache()
+		for range 3 {
+			data, err := cache.fetch(server.Client(), server.URL+"/schema.json", time.Hour)
+			require.NoError(t, err)
+			assert.Equal(t, schemaContent, string(data))
+		}
+		assert.Equal(t, int32(3), requests.Load())
+		assert.Equal(t, int32(2), revalidations.Load())
+	})
+
+	t.Run("ServerErrorReusesLastGoodSchema", func(t *testing.T) {
+		t.Parallel()
+
+		var failing atomic.Bool
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			if failing.Load() {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			_, _ = w.Write([]byte(schemaContent))
+		}))
+		defer server.Close()
+
+		cache := newRemoteSchemaCache()
+		data, err := cache.fetch(server.Client(), server.URL+"/schema.json", 0)
+		require.NoError(t, err)
+		require.Equal(t, schemaContent, string(data))
+
+		failing.Store(true)
+		data, err = cache.fetch(server.Client(), server.URL+"/schema.json", 0)
+		require.NoError(t, err)
+		assert.Equal(t, schemaContent, string(data))
+
+		_, err = cache.fetch(server.Client(), server.URL+"/other.json", 0)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "500")
+	})
+
+	t.Run("ExpiredEntryRefetched", func(t *testing.T) {
+		t.Parallel()
+
+		var requests atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			requests.Add(1)
+			w.Header().Set("Cache-Control", "max-age=60")
+			_, _ = w.Write([]byte(schemaContent))
+		}))
+		defer server.Close()
+
+		now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		cache := newRemoteSchemaCache()
+		cache.now = func() time.Time { return now }
+
+		_, err := cache.fetch(server.Client(), server.URL+"/schema.json", time.Hour)
+		require.NoError(t, err)
+		now = now.Add(30 * time.Second)
+		_, err = cache.fetch(server.Client(), server.URL+"/schema.json", time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), requests.Load())
+
+		now = now.Add(time.Minute)
+		_, err = cache.fetch(server.Client(), server.URL+"/schema.json", time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("NoStoreIsNotCached", func(t *testing.T) {
+		t.Parallel()
+
+		var requests atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			requests.Add(1)
+			w.Header().Set("Cache-Control", "no-store")
+			_, _ = w.Write([]byte(schemaContent))
+		}))
+		defer server.Close()
+
+		cache := newRemoteSchemaCache()
+		for range 2 {
+			_, err := cache.fetch(server.Client(), server.URL+"/schema.json", time.Hour)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("LoadOptionSetsTTL", func(t *testing.T) {
+		t.Parallel()
+
+		var requests atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			requests.Add(1)
+			_, _ = w.Write([]byte(schemaContent))
+		}))
+		defer server.Close()
+
+		data := fmt.Appendf(nil, `
+params:
+  schema: "%s/load-option.json"
+  values:
+    foo: bar
+`, server.URL)
+
+		for range 2 {
+			_, err := LoadYAML(context.Background(), data, WithRemoteSchemaCacheTTL(0))
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(2), requests.Load())
+
+		for range 2 {
+			_, err := LoadYAML(context.Background(), data, WithRemoteSchemaCacheTTL(time.Hour))
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(3), requests.Load())
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/dagucloud/dagu/internal/core"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -110,6 +111,52 @@ func TestExtractParamsSchemaDeclaration(t *testing.T) {
 	}
 }
 
+func TestParamsSchemaFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	schemaPath := filepath.Join(dir, "params.json")
+	require.NoError(t, os.WriteFile(schemaPath, []byte(`{"type": "object"}`), 0600))
+	dagLocation := filepath.Join(dir, "dag.yaml")
+
+	tests := []struct {
+		name string
+		yaml string
+		want string
+	}{
+		{
+			name: "RelativeToDAGFile",
+			yaml: "params:\n  schema: params.json\n",
+			want: schemaPath,
+		},
+		{
+			name: "RemoteSchema",
+			yaml: "params:\n  schema: https://example.com/params.json\n",
+		},
+		{
+			name: "InlineSchema",
+			yaml: "params:\n  schema:\n    type: object\n",
+		},
+		{
+			name: "MissingFile",
+			yaml: "params:\n  schema: missing.json\n",
+		},
+		{
+			name: "NoSchema",
+			yaml: "params:\n  - FOO: bar\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dag := &core.DAG{Location: dagLocation, YamlData: []byte(tt.yaml)}
+			assert.Equal(t, tt.want, ParamsSchemaFile(dag))
+		})
+	}
+}
+
 func TestLoadSchemaFromURL(t *testing.T) {
 	t.Parallel()
 
@@ -124,7 +171,7 @@ func TestLoadSchemaFromURL(t *testing.T) {
 		}))
 		defer server.Close()
 
-		data, err := loadSchemaFromURL(server.URL + "/schema.json")
+		data, err := loadSchemaFromURL(server.URL+"/schema.json", DefaultRemoteSchemaCacheTTL)
 		require.NoError(t, err)
 		assert.Equal(t, schemaContent, string(data))
 	})
@@ -137,7 +184,7 @@ func TestLoadSchemaFromURL(t *testing.T) {
 		}))
 		defer server.Close()
 
-		_, err := loadSchemaFromURL(server.URL + "/missing.json")
+		_, err := loadSchemaFromURL(server.URL+"/missing.json", DefaultRemoteSchemaCacheTTL)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "404")
 	})
@@ -150,7 +197,7 @@ func TestLoadSchemaFromURL(t *testing.T) {
 		}))
 		defer server.Close()
 
-		_, err := loadSchemaFromURL(server.URL + "/schema.json")
+		_, err := loadSchemaFromURL(server.URL+"/schema.json", DefaultRemoteSchemaCacheTTL)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "500")
 	})
@@ -158,7 +205,7 @@ func TestLoadSchemaFromURL(t *testing.T) {
 	t.Run("InvalidURL", func(t *testing.T) {
 		t.Parallel()
 
-		_, err := loadSchemaFromURL("://invalid-url")
+		_, err := loadSchemaFromURL("://invalid-url", DefaultRemoteSchemaCacheTTL)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "invalid")
 	})
@@ -166,7 +213,7 @@ func TestLoadSchemaFromURL(t *testing.T) {
 	t.Run("UnsupportedScheme", func(t *testing.T) {
 		t.Parallel()
 
-		_, err := loadSchemaFromURL("ftp://example.com/schema.json")
+		_, err := loadSchemaFromURL("ftp://example.com/schema.json", DefaultRemoteSchemaCacheTTL)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "unsupported URL scheme")
 	})
@@ -175,7 +222,7 @@ func TestLoadSchemaFromURL(t *testing.T) {
 		t.Parallel()
 
 		// Use a port that's unlikely to be in use
-		_, err := loadSchemaFromURL("http://127.0.0.1:59999/schema.json")
+		_, err := loadSchemaFromURL("http://127.0.0.1:59999/schema.json", DefaultRemoteSchemaCacheTTL)
 		require.Error(t, err)
 	})
 }
@@ -198,7 +245,7 @@ func TestLoadSchemaFromURLUsesIsolatedHTTPClient(t *testing.T) {
 	}))
 	defer server.Close()
 
-	data, err := loadSchemaFromURL(server.URL + "/schema.json")
+	data, err := loadSchemaFromURL(server.URL+"/schema.json", DefaultRemoteSchemaCacheTTL)
 	require.NoError(t, err)
 	assert.Equal(t, `{"type":"object"}`, string(data))
 }
@@ -344,7 +391,7 @@ func TestGetSchemaFromRef(t *testing.T) {
 		schemaPath := filepath.Join(tmpDir, "schema.json")
 		require.NoError(t, os.WriteFile(schemaPath, []byte(validSchemaContent), 0600))
 
-		resolved, err := getSchemaFromRef("", "", schemaPath)
+		resolved, err := getSchemaFromRef("", "", schemaPath, DefaultRemoteSchemaCacheTTL)
 		require.NoError(t, err)
 		assert.NotNil(t, resolved)
 	})
@@ -358,7 +405,7 @@ func TestGetSchemaFromRef(t *testing.T) {
 		}))
 		defer server.Close()
 
-		resolved, err := getSchemaFromRef("", "", server.URL+"/schema.json")
+		resolved, err := getSchemaFromRef("", "", server.URL+"/schema.json", DefaultRemoteSchemaCacheTTL)
 		require.NoError(t, err)
 		assert.NotNil(t, resolved)
 	})
@@ -373,7 +420,7 @@ func TestGetSchemaFromRef(t *testing.T) {
 		defer server.Close()
 
 		// This will fail due to self-signed cert, but tests the https:// detection
-		_, err := getSchemaFromRef("", "", server.URL+"/schema.json")
+		_, err := getSchemaFromRef("", "", server.URL+"/schema.json", DefaultRemoteSchemaCacheTTL)
 		// We expect an error due to certificate verification
 		require.Error(t, err)
 	})
@@ -385,7 +432,7 @@ func TestGetSchemaFromRef(t *testing.T) {
 		schemaPath := filepath.Join(tmpDir, "invalid.json")
 		require.NoError(t, os.WriteFile(schemaPath, []byte("not valid json"), 0600))
 
-		_, err := getSchemaFromRef("", "", schemaPath)
+		_, err := getSchemaFromRef("", "", schemaPath, DefaultRemoteSchemaCacheTTL)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "parse schema JSON")
 	})
@@ -393,7 +440,7 @@ func TestGetSchemaFromRef(t *testing.T) {
 	t.Run("SchemaFileNotFound", func(t *testing.T) {
 		t.Parallel()
 
-		_, err := getSchemaFromRef("", "", "nonexistent.json")
+		_, err := getSchemaFromRef("", "", "nonexistent.json", DefaultRemoteSchemaCacheTTL)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to load schema")
 	})
@@ -406,7 +453,7 @@ func TestGetSchemaFromRef(t *testing.T) {
 		}))
 		defer server.Close()
 
-		_, err := getSchemaFromRef("", "", server.URL+"/missing.json")
+		_, err := getSchemaFromRef("", "", server.URL+"/missing.json", DefaultRemoteSchemaCacheTTL)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to load schema")
 	})
@@ -424,7 +471,7 @@ func TestResolveSchemaDeclaration(t *testing.T) {
 				"name": map[string]any{"type": "string"},
 			},
 			"required": []any{"name"},
-		}, "", "")
+		}, "", "", DefaultRemoteSchemaCacheTTL)
 		require.NoError(t, err)
 		require.NotNil(t, resolved)
 		assert.NoError(t, resolved.Validate(map[string]any{"name": "dagu"}))
@@ -434,7 +481,7 @@ func TestResolveSchemaDeclaration(t *testing.T) {
 	t.Run("BooleanSchema", func(t *testing.T) {
 		t.Parallel()
 
-		resolved, err := resolveSchemaDeclaration(false, "", "")
+		resolved, err := resolveSchemaDeclaration(false, "", "", DefaultRemoteSchemaCacheTTL)
 		require.NoError(t, err)
 		require.NotNil(t, resolved)
 		assert.Error(t, resolved.Validate(map[string]any{}))
@@ -443,7 +490,7 @@ func TestResolveSchemaDeclaration(t *testing.T) {
 	t.Run("InvalidType", func(t *testing.T) {
 		t.Parallel()
 
-		_, err := resolveSchemaDeclaration(123, "", "")
+		_, err := resolveSchemaDeclaration(123, "", "", DefaultRemoteSchemaCacheTTL)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "string, object, or boolean")
 	})
@@ -463,7 +510,7 @@ func TestResolveSchemaFromParams(t *testing.T) {
 	t.Run("NoSchemaReference", func(t *testing.T) {
 		t.Parallel()
 
-		resolved, err := resolveSchemaFromParams(nil, "", "")
+		resolved, err := resolveSchemaFromParams(nil, "", "", DefaultRemoteSchemaCacheTTL)
 		require.NoError(t, err)
 		assert.Nil(t, resolved)
 	})
@@ -471,7 +518,7 @@ func TestResolveSchemaFromParams(t *testing.T) {
 	t.Run("ParamsNotMap", func(t *testing.T) {
 		t.Parallel()
 
-		resolved, err := resolveSchemaFromParams("string params", "", "")
+		resolved, err := resolveSchemaFromParams("string params", "", "", DefaultRemoteSchemaCacheTTL)
 		require.NoError(t, err)
 		assert.Nil(t, resolved)
 	})
@@ -482,7 +529,7 @@ func TestResolveSchemaFromParams(t *testing.T) {
 		params := map[string]any{
 			"values": map[string]any{"foo": "bar"},
 		}
-		resolved, err := resolveSchemaFromParams(params, "", "")
+		resolved, err := resolveSchemaFromParams(params, "", "", DefaultRemoteSchemaCacheTTL)
 		require.NoError(t, err)
 		assert.Nil(t, resolved)
 	})
@@ -498,7 +545,7 @@ func TestResolveSchemaFromParams(t *testing.T) {
 			"schema": schemaPath,
 			"values": map[string]any{"batch_size": 20},
 		}
-		resolved, err := resolveSchemaFromParams(params, "", "")
+		resolved, err := resolveSchemaFromParams(params, "", "", DefaultRemoteSchemaCacheTTL)
 		require.NoError(t, err)
 		assert.NotNil(t, resolved)
 	})
@@ -515,7 +562,7 @@ func TestResolveSchemaFromParams(t *testing.T) {
 			},
 			"values": map[string]any{"batch_size": 20},
 		}
-		resolved, err := resolveSchemaFromParams(params, "", "")
+		resolved, err := resolveSchemaFromParams(params, "", "", DefaultRemoteSchemaCacheTTL)
 		require.NoError(t, err)
 		assert.NotNil(t, resolved)
 	})
@@ -527,7 +574,7 @@ func TestResolveSchemaFromParams(t *testing.T) {
 			"schema": true,
 			"values": map[string]any{"batch_size": 20},
 		}
-		resolved, err := resolveSchemaFromParams(params, "", "")
+		resolved, err := resolveSchemaFromParams(params, "", "", DefaultRemoteSchemaCacheTTL)
 		require.NoError(t, err)
 		assert.NotNil(t, resolved)
 	})
@@ -538,7 +585,7 @@ func TestResolveSchemaFromParams(t *testing.T) {
 		params := map[string]any{
 			"schema": true,
 		}
-		resolved, err := resolveSchemaFromParams(params, "", "")
+		resolved, err := resolveSchemaFromParams(params, "", "", DefaultRemoteSchemaCacheTTL)
 		require.NoError(t, err)
 		assert.Nil(t, resolved)
 	})
@@ -549,7 +596,7 @@ func TestResolveSchemaFromParams(t *testing.T) {
 		params := map[string]any{
 			"schema": "nonexistent.json",
 		}
-		_, err := resolveSchemaFromParams(params, "", "")
+		_, err := resolveSchemaFromParams(params, "", "", DefaultRemoteSchemaCacheTTL)
 		require.Error(t, err)
 	})
 
@@ -565,7 +612,7 @@ func TestResolveSchemaFromParams(t *testing.T) {
 		params := map[string]any{
 			"schema": server.URL + "/schema.json",
 		}
-		resolved, err := resolveSchemaFromParams(params, "", "")
+		resolved, err := resolveSchemaFromParams(params, "", "", DefaultRemoteSchemaCacheTTL)
 		require.NoError(t, err)
 		assert.NotNil(t, resolved)
 	})
@@ -580,7 +627,7 @@ func TestResolveSchemaFromParams(t *testing.T) {
 		params := map[string]any{
 			"schema": "schema.json",
 		}
-		resolved, err := resolveSchemaFromParams(params, workingDir, "")
+		resolved, err := resolveSchemaFromParams(params, workingDir, "", DefaultRemoteSchemaCacheTTL)
 		require.NoError(t, err)
 		assert.NotNil(t, resolved)
 	})
@@ -596,7 +643,7 @@ func TestResolveSchemaFromParams(t *testing.T) {
 		params := map[string]any{
 			"schema": "schema.json",
 		}
-		resolved, err := resolveSchemaFromParams(params, "", dagPath)
+		resolved, err := resolveSchemaFromParams(params, "", dagPath, DefaultRemoteSchemaCacheTTL)
 		require.NoError(t, err)
 		assert.NotNil(t, resolved)
 	})
@@ -906,6 +953,85 @@ params:
 		require.Contains(t, err.Error(), "maximum: 100/1 is greater than 50")
 	})
 
+	t.Run("MultipleViolationsReportedSeparately", func(t *testing.T) {
+		schemaContent := `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "properties": {
+    "batch_size": {
+      "type": "integer",
+      "minimum": 1,
+      "maximum": 50
+    },
+    "environment": {
+      "type": "string",
+      "enum": ["dev", "staging", "prod"]
+    }
+  }
+}`
+
+		schemaPath := filepath.Join(t.TempDir(), "schema.json")
+		require.NoError(t, os.WriteFile(schemaPath, []byte(schemaContent), 0o600))
+		data := fmt.Appendf(nil, `
+params:
+  schema: "%s"
+`, filepath.ToSlash(schemaPath))
+
+		_, err := LoadYAML(context.Background(), data, WithParams("batch_size=100 environment=qa"))
+		require.Error(t, err)
+
+		var loadErrs core.ErrorList
+		require.ErrorAs(t, err, &loadErrs)
+		require.Len(t, loadErrs, 1)
+		var buildErrs core.ErrorList
+		require.ErrorAs(t, loadErrs[0], &buildErrs)
+		require.Len(t, buildErrs, 2)
+
+		var batchErr, envErr *core.ValidationError
+		require.ErrorAs(t, buildErrs[0], &batchErr)
+		require.ErrorAs(t, buildErrs[1], &envErr)
+		assert.Equal(t, "params.batch_size", batchErr.Field)
+		assert.Equal(t, "field 'params.batch_size': parameter validation failed: maximum: 100/1 is greater than 50.000000", batchErr.Error())
+		assert.Equal(t, "params.environment", envErr.Field)
+		assert.Equal(t, "field 'params.environment': parameter validation failed: enum: qa does not equal any of: [dev staging prod]", envErr.Error())
+	})
+
+	t.Run("ViolationBehindRefReportedForProperty", func(t *testing.T) {
+		schemaContent := `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "$defs": {
+    "size": {"type": "integer", "maximum": 50}
+  },
+  "properties": {
+    "batch_size": {"$ref": "#/$defs/size"},
+    "retries": {"$ref": "#/$defs/size"}
+  }
+}`
+
+		schemaPath := filepath.Join(t.TempDir(), "schema.json")
+		require.NoError(t, os.WriteFile(schemaPath, []byte(schemaContent), 0o600))
+		data := fmt.Appendf(nil, `
+params:
+  schema: "%s"
+`, filepath.ToSlash(schemaPath))
+
+		_, err := LoadYAML(context.Background(), data, WithParams("batch_size=100 retries=3"))
+		require.Error(t, err)
+
+		var loadErrs core.ErrorList
+		require.ErrorAs(t, err, &loadErrs)
+		require.Len(t, loadErrs, 1)
+		var buildErrs core.ErrorList
+		require.ErrorAs(t, loadErrs[0], &buildErrs)
+		require.Len(t, buildErrs, 1)
+
+		var batchErr *core.ValidationError
+		require.ErrorAs(t, buildErrs[0], &batchErr)
+		assert.Equal(t, "params.batch_size", batchErr.Field)
+		assert.Contains(t, batchErr.Error(), "maximum: 100/1 is greater than 50")
+	})
+
 	t.Run("DefaultsApplied", func(t *testing.T) {
 		schemaContent := `{
   "$schema": "https://json-schema.org/draft/2020-12/schema",
@@ -12,12 +12,14 @@ import (
 	"regexp"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/dagucloud/dagu/internal/cmn/cmdutil"
 	"github.com/dagucloud/dagu/internal/cmn/collections"
 	"github.com/dagucloud/dagu/internal/cmn/signal"
+	"github.com/dagucloud/dagu/internal/cmn/stringutil"
 	"github.com/dagucloud/dagu/internal/core"
 	"github.com/dagucloud/dagu/internal/core/spec/types"
 	"github.com/dagucloud/dagu/internal/llm"
@@ -33,6 +35,8 @@ type step struct {
 	Description string `yaml:"description,omitempty"`
 	// WorkingDir is the working directory of the step.
 	WorkingDir string `yaml:"working_dir,omitempty"`
+	// CreateDir creates the working directory before the step runs.
+	CreateDir bool `yaml:"create_dir,omitempty"`
 	// Command is the command to run (on shell).
 	Command any `yaml:"command,omitempty"`
 	// Exec is a structured argv form for direct execution without shell parsing.
@@ -40,13 +44,23 @@ type step struct {
 	// Shell is the shell to run the command. Default is `$SHELL` or `sh`.
 	// Can be a string (e.g., "bash -e") or an array (e.g., ["bash", "-e"]).
 	Shell types.ShellValue `yaml:"shell,omitempty"`
+	// ShellArgs are extra shell arguments. Without shell they extend the
+	// DAG's shell and arguments instead of replacing them.
+	ShellArgs []string `yaml:"shell_args,omitempty"`
 	// ShellPackages is the list of packages to install.
 	// This is used only when the shell is `nix-shell`.
 	ShellPackages []string `yaml:"shell_packages,omitempty"`
 	// Script is the script to run.
 	Script string `yaml:"script,omitempty"`
+	// Template enables Go template rendering of command and script at runtime.
+	Template bool `yaml:"template,omitempty"`
 	// Stdout is the file to write the stdout.
 	Stdout string `yaml:"stdout,omitempty"`
+	// Stdin is the standard input of the command: literal text, or "@path"
+	// to read it from a file. Use "@@" to start literal text with "@".
+	Stdin string `yaml:"stdin,omitempty"`
+	// OutputFile is a file that receives a copy of the stdout.
+	OutputFile string `yaml:"output_file,omitempty"`
 	// Stderr is the file to write the stderr.
 	Stderr string `yaml:"stderr,omitempty"`
 	// LogOutput specifies how stdout and stderr are handled in log files for this step.
@@ -54,26 +68,39 @@ type step struct {
 	// Can be "separate" (default) for separate .out and .err files,
 	// or "merged" for a single combined .log file.
 	LogOutput types.LogOutputValue `yaml:"log_output,omitempty"`
+	// LogRotate rotates the step log files when they grow past a size.
+	LogRotate *logRotate `yaml:"log_rotate,omitempty"`
 	// Output is the variable name to store the output.
 	// Can be a string for captured stdout or an object for structured step output.
 	Output any `yaml:"output,omitempty"`
-	// Depends is the list of steps to depend on.
-	Depends types.StringOrArray `yaml:"depends,omitempty"`
+	// Depends is the list of steps to depend on. Entries may be objects with
+	// the upstream outcomes to start on.
+	Depends types.DependsValue `yaml:"depends,omitempty"`
+	// JoinPolicy starts the step once a number of dependencies succeed.
+	JoinPolicy *joinPolicy `yaml:"join_policy,omitempty"`
 	// ContinueOn is the condition to continue on.
 	// Can be a string ("skipped", "failed") or an object with detailed config.
 	ContinueOn types.ContinueOnValue `yaml:"continue_on,omitempty"`
+	// MarkFailure is the condition to fail a step that exited successfully.
+	MarkFailure *markFailure `yaml:"mark_failure,omitempty"`
 	// RetryPolicy is the retry policy.
 	RetryPolicy *retryPolicy `yaml:"retry_policy,omitempty"`
 	// RepeatPolicy is the repeat policy.
 	RepeatPolicy *repeatPolicy `yaml:"repeat_policy,omitempty"`
 	// MailOnError is the flag to send mail on error.
 	MailOnError bool `yaml:"mail_on_error,omitempty"`
+	// OnError is a shell command run when the step fails.
+	OnError string `yaml:"on_error,omitempty"`
 	// Preconditions is the condition to run the step.
 	Preconditions any `yaml:"preconditions,omitempty"`
+	// Skip skips the step when it evaluates truthy. Can be a boolean or an
+	// expression such as "${SKIP_TESTS}".
+	Skip any `yaml:"skip,omitempty"`
 	// SignalOnStop is the signal when the step is requested to stop.
 	// When it is empty, the same signal as the parent process is sent.
-	// It can be KILL when the process does not stop over the timeout.
-	SignalOnStop *string `yaml:"signal_on_stop,omitempty"`
+	// Can be a signal name (e.g., "SIGINT") or an object that escalates to
+	// another signal (e.g., SIGKILL) when the process outlives a grace period.
+	SignalOnStop types.SignalOnStopValue `yaml:"signal_on_stop,omitempty"`
 	// Call is the name of a DAG to run as a sub dag-run.
 	Call string `yaml:"call,omitempty"`
 	// Params specifies the parameters for the sub dag-run.
@@ -86,6 +113,8 @@ type step struct {
 	Parallel any `yaml:"parallel,omitempty"`
 	// WorkerSelector specifies required worker labels for execution.
 	WorkerSelector map[string]string `yaml:"worker_selector,omitempty"`
+	// Requires lists capabilities the worker running this step must advertise.
+	Requires types.StringOrArray `yaml:"requires,omitempty"`
 	// Env specifies the environment variables for the step.
 	Env types.EnvValue `yaml:"env,omitempty"`
 	// TimeoutSec specifies the maximum runtime for the step in seconds.
@@ -212,16 +241,26 @@ type approvalConfig struct {
 	RewindTo string `yaml:"rewind_to,omitempty"`
 }
 
+// logRotate defines size-based rotation of the step log files.
+type logRotate struct {
+	// MaxSizeMB is the size in megabytes after which a log file is rotated.
+	MaxSizeMB int `yaml:"max_size_mb,omitempty"`
+	// Keep is the number of rotated files to keep. Defaults to 1.
+	Keep *int `yaml:"keep,omitempty"`
+}
+
 // repeatPolicy defines the repeat policy for a step.
 type repeatPolicy struct {
-	Repeat         types.RepeatMode   `yaml:"repeat,omitempty"`           // Flag to indicate if the step should be repeated, can be bool (legacy) or string ("while" or "until")
-	IntervalSec    types.IntOrDynamic `yaml:"interval_sec,omitempty"`     // Interval in seconds to wait before repeating the step
-	Limit          types.IntOrDynamic `yaml:"limit,omitempty"`            // Maximum number of times to repeat the step
-	Condition      string             `yaml:"condition,omitempty"`        // Condition to check before repeating
-	Expected       string             `yaml:"expected,omitempty"`         // Expected output to match before repeating
-	ExitCode       []int              `yaml:"exit_code,omitempty"`        // List of exit codes to consider for repeating the step
-	Backoff        types.BackoffValue `yaml:"backoff,omitempty"`          // Accepts bool or float
-	MaxIntervalSec types.IntOrDynamic `yaml:"max_interval_sec,omitempty"` // Maximum interval in seconds
+	Repeat              types.RepeatMode   `yaml:"repeat,omitempty"`                // Flag to indicate if the step should be repeated, can be bool (legacy) or string ("while" or "until")
+	IntervalSec         types.IntOrDynamic `yaml:"interval_sec,omitempty"`          // Interval in seconds to wait before repeating the step
+	Limit               types.IntOrDynamic `yaml:"limit,omitempty"`                 // Maximum number of executions (initial run + repeats)
+	MaxRepeats          types.IntOrDynamic `yaml:"max_repeats,omitempty"`           // Maximum number of repeats after the initial run; mutually exclusive with limit
+	Condition           string             `yaml:"condition,omitempty"`             // Condition to check before repeating
+	ConditionTimeoutSec int                `yaml:"condition_timeout_sec,omitempty"` // Timeout in seconds for evaluating the condition
+	Expected            string             `yaml:"expected,omitempty"`              // Expected output to match before repeating
+	ExitCode            []int              `yaml:"exit_code,omitempty"`             // List of exit codes to consider for repeating the step
+	Backoff             types.BackoffValue `yaml:"backoff,omitempty"`               // Accepts bool or float
+	MaxIntervalSec      types.IntOrDynamic `yaml:"max_interval_sec,omitempty"`      // Maximum interval in seconds
 }
 
 // retryPolicy defines the retry policy for a step.
@@ -231,6 +270,23 @@ type retryPolicy struct {
 	ExitCode       []int `yaml:"exit_code,omitempty"`
 	Backoff        any   `yaml:"backoff,omitempty"` // Accepts bool or float
 	MaxIntervalSec int   `yaml:"max_interval_sec,omitempty"`
+	// RetryOn is the list of failure causes to retry on: "failure", "timeout".
+	RetryOn types.StringOrArray `yaml:"retry_on,omitempty"`
+}
+
+// joinPolicy defines when a step may start before all dependencies finish.
+type joinPolicy struct {
+	// Mode is "all" (default) or "any".
+	Mode string `yaml:"mode,omitempty"`
+	// Count is the number of dependencies that must succeed in "any" mode.
+	Count *int `yaml:"count,omitempty"`
+}
+
+// markFailure defines the conditions to fail a step that exited successfully.
+type markFailure struct {
+	// Output is the list of stdout patterns that mark the step as failed.
+	// Supports regex with 're:' prefix.
+	Output types.StringOrArray `yaml:"output,omitempty"`
 }
 
 // llmConfig defines the LLM configuration for a step.
@@ -407,25 +463,37 @@ var stepTransformers = []stepTransform{
 	{"description", newStepTransformer("Description", buildStepDescription)},
 	{"shell_packages", newStepTransformer("ShellPackages", buildStepShellPackages)},
 	{"script", newStepTransformer("Script", buildStepScript)},
+	{"template", newStepTransformer("Template", buildStepTemplate)},
 	{"stdout", newStepTransformer("Stdout", buildStepStdout)},
+	{"output_file", newStepTransformer("OutputFile", buildStepOutputFile)},
+	{"stdin", newStepTransformer("Stdin", buildStepStdin)},
 	{"stderr", newStepTransformer("Stderr", buildStepStderr)},
 	{"log_output", newStepTransformer("LogOutput", buildStepLogOutput)},
+	{"log_rotate", newStepTransformer("LogRotate", buildStepLogRotate)},
 	{"mail_on_error", newStepTransformer("MailOnError", buildStepMailOnError)},
+	{"on_error", newStepTransformer("OnError", buildStepOnError)},
 	{"worker_selector", newStepTransformer("WorkerSelector", buildStepWorkerSelector)},
+	{"requires", newStepTransformer("Requires", buildStepRequires)},
 	{"working_dir", newStepTransformer("Dir", buildStepWorkingDir)},
+	{"create_dir", newStepTransformer("CreateDir", buildStepCreateDir)},
 	{"shell", newStepTransformer("Shell", buildStepShell)},
 	{"shell_args", newStepTransformer("ShellArgs", buildStepShellArgs)},
 	{"timeout", newStepTransformer("Timeout", buildStepTimeout)},
 	{"depends", newStepTransformer("Depends", buildStepDepends)},
+	{"depends", newStepTransformer("DependsOn", buildStepDependsOn)},
+	{"join_policy", newStepTransformer("JoinPolicy", buildStepJoinPolicy)},
 	{"explicitly_no_deps", newStepTransformer("ExplicitlyNoDeps", buildStepExplicitlyNoDeps)},
 	{"continue_on", newStepTransformer("ContinueOn", buildStepContinueOn)},
+	{"mark_failure", newStepTransformer("MarkFailure", buildStepMarkFailure)},
 	{"retry_policy", newStepTransformer("RetryPolicy", buildStepRetryPolicy)},
 	{"repeat_policy", newStepTransformer("RepeatPolicy", buildStepRepeatPolicy)},
 	{"signal_on_stop", newStepTransformer("SignalOnStop", buildStepSignalOnStop)},
+	{"signal_on_stop", newStepTransformer("SignalEscalation", buildStepSignalEscalation)},
 	{"output", newStepTransformer("Output", buildStepOutput)},
 	{"structured_output", newStepTransformer("StructuredOutput", buildStepStructuredOutput)},
 	{"env", newStepTransformer("Env", buildStepEnvs)},
 	{"preconditions", newStepTransformer("Preconditions", buildStepPreconditions)},
+	{"skip", newStepTransformer("Skip", buildStepSkip)},
 }
 
 // runStepTransformers executes all step transformers
@@ -526,6 +594,9 @@ func (s *step) build(ctx StepBuildContext) (*core.Step, error) {
 	if err := validateAgent(result); err != nil {
 		errs = append(errs, wrapTransformError("agent", err))
 	}
+	if err := validateStepContainerResources(s, result); err != nil {
+		errs = append(errs, err)
+	}
 
 	// Validate executor config against registered schema
 	// Only validate when config has actual values (not just initialized as empty map)
@@ -539,6 +610,9 @@ func (s *step) build(ctx StepBuildContext) (*core.Step, error) {
 	if err := validateStdoutStderr(result); err != nil {
 		errs = append(errs, err)
 	}
+	if err := validateMarkFailure(result); err != nil {
+		errs = append(errs, err)
+	}
 
 	if len(errs) > 0 {
 		return nil, errs
@@ -556,6 +630,35 @@ func validateStdoutStderr(s *core.Step) error {
 	return nil
 }
 
+// validateMarkFailure rejects mark_failure combined with a
+// continue_on.mark_success condition that always holds when mark_failure
+// fires. mark_success takes precedence over mark_failure at runtime, so such a
+// combination would make mark_failure a no-op.
+func validateMarkFailure(s *core.Step) error {
+	if len(s.MarkFailure.Output) == 0 || !s.ContinueOn.MarkSuccess {
+		return nil
+	}
+	var reason string
+	switch {
+	case s.ContinueOn.Failure:
+		reason = "continue_on.failure"
+	case slices.Contains(s.ContinueOn.ExitCode, 0):
+		reason = "continue_on.exit_code 0"
+	default:
+		for _, pattern := range s.MarkFailure.Output {
+			if slices.Contains(s.ContinueOn.Output, pattern) {
+				reason = fmt.Sprintf("continue_on.output %q", pattern)
+				break
+			}
+		}
+	}
+	if reason == "" {
+		return nil
+	}
+	return core.NewValidationError("mark_failure", s.MarkFailure.Output,
+		fmt.Errorf("conflicts with continue_on.mark_success: %s would mark every mark_failure match as success", reason))
+}
+
 // Simple field builders
 
 func buildStepName(_ StepBuildContext, s *step) (string, error) {
@@ -578,10 +681,35 @@ func buildStepScript(_ StepBuildContext, s *step) (string, error) {
 	return strings.TrimSpace(s.Script), nil
 }
 
+func buildStepTemplate(_ StepBuildContext, s *step) (bool, error) {
+	return s.Template, nil
+}
+
 func buildStepStdout(_ StepBuildContext, s *step) (string, error) {
 	return strings.TrimSpace(s.Stdout), nil
 }
 
+func buildStepOutputFile(_ StepBuildContext, s *step) (string, error) {
+	return strings.TrimSpace(s.OutputFile), nil
+}
+
+func buildStepStdin(_ StepBuildContext, s *step) (*core.StepStdin, error) {
+	switch {
+	case s.Stdin == "":
+		return nil, nil
+	case strings.HasPrefix(s.Stdin, "@@"):
+		return &core.StepStdin{Content: s.Stdin[1:]}, nil
+	case strings.HasPrefix(s.Stdin, "@"):
+		file := strings.TrimSpace(s.Stdin[1:])
+		if file == "" {
+			return nil, core.NewValidationError("stdin", s.Stdin, fmt.Errorf("file path is required after '@'"))
+		}
+		return &core.StepStdin{File: file}, nil
+	default:
+		return &core.StepStdin{Content: s.Stdin}, nil
+	}
+}
+
 func buildStepStderr(_ StepBuildContext, s *step) (string, error) {
 	return strings.TrimSpace(s.Stderr), nil
 }
@@ -594,25 +722,85 @@ func buildStepLogOutput(_ StepBuildContext, s *step) (core.LogOutputMode, error)
 	return s.LogOutput.Mode(), nil
 }
 
+// defaultLogRotateKeep is the number of rotated log files kept when
+// log_rotate does not set keep.
+const defaultLogRotateKeep = 1
+
+func buildStepLogRotate(_ StepBuildContext, s *step) (*core.LogRotateConfig, error) {
+	if s.LogRotate == nil {
+		return nil, nil
+	}
+	if s.LogRotate.MaxSizeMB <= 0 {
+		return nil, core.NewValidationError("log_rotate.max_size_mb", s.LogRotate.MaxSizeMB,
+			fmt.Errorf("must be greater than 0"))
+	}
+	keep := defaultLogRotateKeep
+	if s.LogRotate.Keep != nil {
+		keep = *s.LogRotate.Keep
+	}
+	if keep < 1 {
+		return nil, core.NewValidationError("log_rotate.keep", keep, fmt.Errorf("must be at least 1"))
+	}
+	return &core.LogRotateConfig{
+		MaxSize: int64(s.LogRotate.MaxSizeMB) * 1024 * 1024,
+		Keep:    keep,
+	}, nil
+}
+
 func buildStepMailOnError(_ StepBuildContext, s *step) (bool, error) {
 	return s.MailOnError, nil
 }
 
+func buildStepOnError(_ StepBuildContext, s *step) (string, error) {
+	return strings.TrimSpace(s.OnError), nil
+}
+
 func buildStepWorkerSelector(_ StepBuildContext, s *step) (map[string]string, error) {
 	return s.WorkerSelector, nil
 }
 
+func buildStepRequires(_ StepBuildContext, s *step) ([]string, error) {
+	return parseRequires(s.Requires)
+}
+
 func buildStepWorkingDir(_ StepBuildContext, s *step) (string, error) {
 	return strings.TrimSpace(s.WorkingDir), nil
 }
 
+func buildStepCreateDir(_ StepBuildContext, s *step) (bool, error) {
+	if s.CreateDir && strings.TrimSpace(s.WorkingDir) == "" {
+		return false, core.NewValidationError("create_dir", s.CreateDir, fmt.Errorf("create_dir requires working_dir"))
+	}
+	return s.CreateDir, nil
+}
+
 // stepShellResult holds both shell and args for step
 type stepShellResult struct {
 	Shell string
 	Args  []string
 }
 
-func parseStepShellInternal(_ StepBuildContext, s *step) (*stepShellResult, error) {
+// parseStepShellInternal parses the step shell. Setting shell replaces the
+// DAG shell; setting only shell_args appends them to the DAG shell and args.
+func parseStepShellInternal(ctx StepBuildContext, s *step) (*stepShellResult, error) {
+	result, err := parseStepShellValue(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.ShellArgs) == 0 {
+		return result, nil
+	}
+	if result.Shell == "" && ctx.dag != nil && ctx.dag.Shell != "" {
+		result.Shell = ctx.dag.Shell
+		result.Args = slices.Clone(ctx.dag.ShellArgs)
+	}
+	// Without any shell the args stay on the step and extend the default
+	// shell, which is resolved at runtime.
+	result.Args = append(result.Args, s.ShellArgs...)
+	return result, nil
+}
+
+func parseStepShellValue(s *step) (*stepShellResult, error) {
 	if s.Shell.IsZero() {
 		return &stepShellResult{}, nil
 	}
@@ -667,6 +855,61 @@ func buildStepDepends(_ StepBuildContext, s *step) ([]string, error) {
 	return s.Depends.Values(), nil
 }
 
+func buildStepDependsOn(_ StepBuildContext, s *step) (map[string][]core.DependencyOutcome, error) {
+	var result map[string][]core.DependencyOutcome
+	for _, entry := range s.Depends.Entries() {
+		if len(entry.On) == 0 {
+			continue
+		}
+		outcomes := make([]core.DependencyOutcome, 0, len(entry.On))
+		for _, on := range entry.On {
+			outcome, err := core.ParseDependencyOutcome(on)
+			if err != nil {
+				return nil, core.NewValidationError("depends", s.Depends.Value(), fmt.Errorf("step %q: %w", entry.Step, err))
+			}
+			if !slices.Contains(outcomes, outcome) {
+				outcomes = append(outcomes, outcome)
+			}
+		}
+		if result == nil {
+			result = make(map[string][]core.DependencyOutcome)
+		}
+		result[entry.Step] = outcomes
+	}
+	return result, nil
+}
+
+func buildStepJoinPolicy(_ StepBuildContext, s *step) (*core.JoinPolicy, error) {
+	if s.JoinPolicy == nil {
+		return nil, nil
+	}
+
+	switch core.JoinMode(strings.ToLower(strings.TrimSpace(s.JoinPolicy.Mode))) {
+	case "", core.JoinModeAll:
+		if s.JoinPolicy.Count != nil {
+			return nil, core.NewValidationError("join_policy.count", *s.JoinPolicy.Count, fmt.Errorf("count is only supported with mode 'any'"))
+		}
+		return nil, nil
+
+	case core.JoinModeAny:
+		count := 1
+		if s.JoinPolicy.Count != nil {
+			count = *s.JoinPolicy.Count
+		}
+		deps := len(s.Depends.Values())
+		if count < 1 {
+			return nil, core.NewValidationError("join_policy.count", count, fmt.Errorf("count must be at least 1"))
+		}
+		if count > deps {
+			return nil, core.NewValidationError("join_policy.count", count, fmt.Errorf("count must not exceed the number of depends (%d)", deps))
+		}
+		return &core.JoinPolicy{Mode: core.JoinModeAny, Count: count}, nil
+
+	default:
+		return nil, core.NewValidationError("join_policy.mode", s.JoinPolicy.Mode, fmt.Errorf("mode must be 'all' or 'any'"))
+	}
+}
+
 func buildStepExplicitlyNoDeps(_ StepBuildContext, s *step) (bool, error) {
 	return !s.Depends.IsZero() && s.Depends.IsEmpty(), nil
 }
@@ -676,13 +919,30 @@ func buildStepContinueOn(_ StepBuildContext, s *step) (core.ContinueOn, error) {
 		return core.ContinueOn{}, nil
 	}
 
-	return core.ContinueOn{
+	continueOn := core.ContinueOn{
 		Skipped:     s.ContinueOn.Skipped(),
 		Failure:     s.ContinueOn.Failed(),
 		MarkSuccess: s.ContinueOn.MarkSuccess(),
 		ExitCode:    s.ContinueOn.ExitCode(),
 		Output:      s.ContinueOn.Output(),
-	}, nil
+	}
+	if err := continueOn.CompileOutput(); err != nil {
+		return core.ContinueOn{}, core.NewValidationError("continue_on.output", continueOn.Output, err)
+	}
+	return continueOn, nil
+}
+
+func buildStepMarkFailure(_ StepBuildContext, s *step) (core.MarkFailure, error) {
+	if s.MarkFailure == nil {
+		return core.MarkFailure{}, nil
+	}
+
+	output := s.MarkFailure.Output.Values()
+	if err := stringutil.ValidatePatterns(output); err != nil {
+		return core.MarkFailure{}, core.NewValidationError("mark_failure.output", output, err)
+	}
+
+	return core.MarkFailure{Output: output}, nil
 }
 
 func buildStepRetryPolicy(_ StepBuildContext, s *step) (core.RetryPolicy, error) {
@@ -720,9 +980,35 @@ func buildStepRetryPolicy(_ StepBuildContext, s *step) (core.RetryPolicy, error)
 		result.MaxInterval = time.Second * time.Duration(s.RetryPolicy.MaxIntervalSec)
 	}
 
+	result.RetryOn, err = parseRetryOn(s.RetryPolicy.RetryOn)
+	if err != nil {
+		return core.RetryPolicy{}, err
+	}
+
 	return result, nil
 }
 
+// parseRetryOn parses the failure causes a step retry policy retries on.
+func parseRetryOn(v types.StringOrArray) ([]core.RetryCause, error) {
+	if v.IsEmpty() {
+		return nil, core.NewValidationError("retry_policy.retry_on", v.Value(), fmt.Errorf("must list at least one of 'failure' or 'timeout'"))
+	}
+
+	var causes []core.RetryCause
+	for _, value := range v.Values() {
+		cause := core.RetryCause(strings.ToLower(strings.TrimSpace(value)))
+		switch cause {
+		case core.RetryOnFailure, core.RetryOnTimeout:
+		default:
+			return nil, core.NewValidationError("retry_policy.retry_on", value, fmt.Errorf("must be 'failure' or 'timeout'"))
+		}
+		if !slices.Contains(causes, cause) {
+			causes = append(causes, cause)
+		}
+	}
+	return causes, nil
+}
+
 func parseStepRetryLimit(val any) (int, string, error) {
 	switch v := val.(type) {
 	case int:
@@ -847,11 +1133,39 @@ func buildStepRepeatPolicy(_ StepBuildContext, s *step) (core.RepeatPolicy, erro
 	result.Limit = rp.Limit.Int()
 	result.LimitStr = rp.Limit.Str()
 
+	// max_repeats counts repeats only, so it maps to limit with an offset of
+	// one for the initial execution.
+	if !rp.MaxRepeats.IsZero() {
+		if !rp.Limit.IsZero() {
+			return core.RepeatPolicy{}, fmt.Errorf("repeat_policy: 'limit' and 'max_repeats' are mutually exclusive")
+		}
+		if rp.MaxRepeats.IsDynamic() {
+			result.MaxRepeatsStr = rp.MaxRepeats.Str()
+		} else {
+			if rp.MaxRepeats.Int() < 0 {
+				return core.RepeatPolicy{}, fmt.Errorf("repeat_policy: 'max_repeats' must be non-negative, got %d", rp.MaxRepeats.Int())
+			}
+			result.Limit = rp.MaxRepeats.Int() + 1
+		}
+	}
+
 	if rp.Condition != "" {
 		result.Condition = &core.Condition{
 			Condition: rp.Condition,
 			Expected:  rp.Expected,
 		}
+		if err := result.Condition.Compile(); err != nil {
+			return core.RepeatPolicy{}, fmt.Errorf("repeat_policy: %w", err)
+		}
+	}
+	if rp.ConditionTimeoutSec < 0 {
+		return core.RepeatPolicy{}, fmt.Errorf("repeat_policy: 'condition_timeout_sec' must be non-negative, got %d", rp.ConditionTimeoutSec)
+	}
+	if rp.ConditionTimeoutSec > 0 {
+		if rp.Condition == "" {
+			return core.RepeatPolicy{}, fmt.Errorf("repeat_policy: 'condition_timeout_sec' requires 'condition' to be specified")
+		}
+		result.ConditionTimeout = time.Duration(rp.ConditionTimeoutSec) * time.Second
 	}
 	result.ExitCode = rp.ExitCode
 
@@ -867,11 +1181,38 @@ func buildStepRepeatPolicy(_ StepBuildContext, s *step) (core.RepeatPolicy, erro
 	return result, nil
 }
 
+// defaultRepeatMaxInterval caps the repeat interval when backoff is enabled
+// without max_interval_sec, so that long-running polls do not back off
+// indefinitely.
+const defaultRepeatMaxInterval = time.Hour
+
+// applyDefaultRepeatMaxInterval sets MaxInterval to defaultRepeatMaxInterval
+// for steps whose repeat policy uses exponential backoff without an explicit
+// max_interval_sec, and returns a build warning for each step it changed.
+func applyDefaultRepeatMaxInterval(steps []*core.Step) []core.BuildWarning {
+	var warnings []core.BuildWarning
+	for _, step := range steps {
+		rp := &step.RepeatPolicy
+		if rp.RepeatMode == "" || rp.Backoff <= 1 || rp.MaxInterval > 0 || rp.MaxIntervalStr != "" {
+			continue
+		}
+		rp.MaxInterval = defaultRepeatMaxInterval
+		warnings = append(warnings, core.BuildWarning{
+			Code: core.WarningRepeatMaxIntervalCapped,
+			Message: fmt.Sprintf(
+				"step %q: repeat_policy.backoff is set without max_interval_sec; capping the repeat interval at %s",
+				step.Name, defaultRepeatMaxInterval,
+			),
+		})
+	}
+	return warnings
+}
+
 func buildStepSignalOnStop(_ StepBuildContext, s *step) (string, error) {
-	if s.SignalOnStop == nil {
+	if s.SignalOnStop.IsZero() {
 		return "", nil
 	}
-	sigOnStop := *s.SignalOnStop
+	sigOnStop := s.SignalOnStop.Signal()
 	sig := signal.GetSignalNum(sigOnStop, 0)
 	if sig == 0 {
 		return "", fmt.Errorf("%w: %s", ErrInvalidSignal, sigOnStop)
@@ -879,6 +1220,20 @@ func buildStepSignalOnStop(_ StepBuildContext, s *step) (string, error) {
 	return sigOnStop, nil
 }
 
+func buildStepSignalEscalation(_ StepBuildContext, s *step) (*core.SignalEscalation, error) {
+	escalateTo := s.SignalOnStop.EscalateTo()
+	if escalateTo == "" {
+		return nil, nil
+	}
+	if signal.GetSignalNum(escalateTo, 0) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSignal, escalateTo)
+	}
+	return &core.SignalEscalation{
+		Signal: escalateTo,
+		After:  time.Duration(s.SignalOnStop.EscalateAfterSec()) * time.Second,
+	}, nil
+}
+
 // outputConfig holds the parsed output configuration
 type outputConfig struct {
 	Name             string
@@ -1061,10 +1416,15 @@ func buildStepStructuredOutput(_ StepBuildContext, s *step) (map[string]core.Ste
 	return cfg.StructuredOutput, nil
 }
 
-func buildStepEnvs(_ StepBuildContext, s *step) ([]string, error) {
+func buildStepEnvs(ctx StepBuildContext, s *step) ([]string, error) {
 	if s.Env.IsZero() {
 		return nil, nil
 	}
+	var prefix string
+	if name := strings.TrimSpace(s.Name); name != "" {
+		prefix = fmt.Sprintf("step %q: ", name)
+	}
+	ctx.addWarnings(envCoercionWarnings(prefix, s.Env)...)
 	var envs []string
 	for _, entry := range s.Env.Entries() {
 		envs = append(envs, fmt.Sprintf("%s=%s", entry.Key, entry.Value))
@@ -1073,7 +1433,29 @@ func buildStepEnvs(_ StepBuildContext, s *step) ([]string, error) {
 }
 
 func buildStepPreconditions(ctx StepBuildContext, s *step) ([]*core.Condition, error) {
-	return parsePrecondition(ctx.BuildContext, s.Preconditions)
+	conds, err := parsePrecondition(ctx.BuildContext, s.Preconditions)
+	if err != nil {
+		if name := strings.TrimSpace(s.Name); name != "" {
+			return nil, fmt.Errorf("step %q: %w", name, err)
+		}
+		return nil, err
+	}
+	return conds, nil
+}
+
+// buildStepSkip parses the skip field. Expressions are kept as written and
+// evaluated when the step is about to run.
+func buildStepSkip(_ StepBuildContext, s *step) (string, error) {
+	switch v := s.Skip.(type) {
+	case nil:
+		return "", nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case string:
+		return strings.TrimSpace(v), nil
+	default:
+		return "", core.NewValidationError("skip", v, fmt.Errorf("must be a boolean or a string"))
+	}
 }
 
 // buildStepCommand parses the command field in the step definition.
@@ -1469,6 +1851,15 @@ func buildStepParamsField(ctx StepBuildContext, s *step, result *core.Step) erro
 		return nil
 	}
 
+	// Keep nested objects and arrays structured so Params.AsJSON can hand
+	// them on intact; AsStringMap still flattens them on demand.
+	if m, ok := s.Params.(map[string]any); ok && hasStructuredParamValue(m) {
+		if _, isSchema := extractParamsSchemaDeclaration(m); !isSchema {
+			result.Params = core.NewRichParams(m)
+			return nil
+		}
+	}
+
 	// Parse params using existing parseParamValue function
 	paramPairs, err := parseParamValue(ctx.BuildContext, s.Params)
 	if err != nil {
@@ -1485,6 +1876,35 @@ func buildStepParamsField(ctx StepBuildContext, s *step, result *core.Step) erro
 	return nil
 }
 
+// hasStructuredParamValue reports whether any param value is an object or array.
+func hasStructuredParamValue(m map[string]any) bool {
+	for _, v := range m {
+		switch v.(type) {
+		case map[string]any, []any:
+			return true
+		}
+	}
+	return false
+}
+
+// encodeStructuredParamValues replaces object and array values of a params map
+// with their JSON encoding, so a sub-DAG receives each as a single param it can
+// decode again. Other inputs are returned unchanged.
+func encodeStructuredParamValues(params any) any {
+	m, ok := params.(map[string]any)
+	if !ok || !hasStructuredParamValue(m) {
+		return params
+	}
+	if _, isSchema := extractParamsSchemaDeclaration(m); isSchema {
+		return params
+	}
+	encoded := make(map[string]any, len(m))
+	for k, v := range m {
+		encoded[k] = core.ParamValueString(v)
+	}
+	return encoded
+}
+
 // buildStepExecutor parses the executor configuration from step fields.
 func buildStepExecutor(ctx StepBuildContext, s *step, result *core.Step) error {
 	if err := validateStepConfigAliasStruct(s); err != nil {
@@ -1725,8 +2145,39 @@ func buildStepParallel(_ StepBuildContext, s *step, result *core.Step) error {
 				default:
 					return core.NewValidationError("parallel.max_concurrent", val, fmt.Errorf("parallel.max_concurrent must be int, got %T", val))
 				}
+
+			case "dedupe":
+				b, ok := val.(bool)
+				if !ok {
+					return core.NewValidationError("parallel.dedupe", val, fmt.Errorf("parallel.dedupe must be bool, got %T", val))
+				}
+				result.Parallel.Dedupe = b
+
+			case "sort":
+				b, ok := val.(bool)
+				if !ok {
+					return core.NewValidationError("parallel.sort", val, fmt.Errorf("parallel.sort must be bool, got %T", val))
+				}
+				result.Parallel.Sort = b
+
+			case "random_ids":
+				b, ok := val.(bool)
+				if !ok {
+					return core.NewValidationError("parallel.random_ids", val, fmt.Errorf("parallel.random_ids must be bool, got %T", val))
+				}
+				result.Parallel.RandomIDs = b
+
+			case "stable_repeat_ids":
+				b, ok := val.(bool)
+				if !ok {
+					return core.NewValidationError("parallel.stable_repeat_ids", val, fmt.Errorf("parallel.stable_repeat_ids must be bool, got %T", val))
+				}
+				result.Parallel.StableRepeatIDs = b
 			}
 		}
+		if result.Parallel.RandomIDs && result.Parallel.StableRepeatIDs {
+			return core.NewValidationError("parallel.stable_repeat_ids", true, fmt.Errorf("parallel.stable_repeat_ids cannot be used with parallel.random_ids"))
+		}
 
 	default:
 		return core.NewValidationError("parallel", v, fmt.Errorf("parallel must be string, array, or object, got %T", v))
@@ -1745,6 +2196,10 @@ func buildStepContainer(ctx StepBuildContext, s *step, result *core.Step) error
 	if err != nil {
 		return err
 	}
+	if ct != nil && ct.FallbackToLocal {
+		return core.NewValidationError("container.fallback_to_local", true,
+			fmt.Errorf("fallback_to_local is only supported on the DAG-level container"))
+	}
 
 	result.Container = ct
 	return nil
@@ -2155,7 +2610,7 @@ func buildStepSubDAG(ctx StepBuildContext, s *step, result *core.Step) error {
 		// Parse the params to convert them to string format
 		ctxCopy := ctx
 		ctxCopy.opts.Flags |= BuildFlagNoEval // Disable evaluation for params parsing
-		paramPairs, err := parseParamValue(ctxCopy.BuildContext, s.Params)
+		paramPairs, err := parseParamValue(ctxCopy.BuildContext, encodeStructuredParamValues(s.Params))
 		if err != nil {
 			return core.NewValidationError("params", s.Params, err)
 		}
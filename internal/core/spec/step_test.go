@@ -105,6 +105,21 @@ func continueOnValueMap(m map[string]any) types.ContinueOnValue {
 	return v
 }
 
+// Helper to create SignalOnStopValue from string
+func signalOnStopValue(s string) types.SignalOnStopValue {
+	var v types.SignalOnStopValue
+	_ = yaml.Unmarshal([]byte(`"`+s+`"`), &v)
+	return v
+}
+
+// Helper to create SignalOnStopValue from map
+func signalOnStopValueMap(m map[string]any) types.SignalOnStopValue {
+	var v types.SignalOnStopValue
+	data, _ := yaml.Marshal(m)
+	_ = yaml.Unmarshal(data, &v)
+	return v
+}
+
 // Helper to create EnvValue from map
 func envValueMap(m map[string]string) types.EnvValue {
 	var v types.EnvValue
@@ -314,6 +329,69 @@ func TestBuildStepMailOnError(t *testing.T) {
 	}
 }
 
+func TestBuildStepOnError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "Empty", input: "", expected: ""},
+		{name: "Command", input: "rm -f /tmp/lock", expected: "rm -f /tmp/lock"},
+		{name: "TrimsSpace", input: "  rm -f /tmp/lock\n", expected: "rm -f /tmp/lock"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &step{OnError: tt.input}
+			result, err := buildStepOnError(testStepBuildContext(), s)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestBuildStepLogRotate(t *testing.T) {
+	t.Parallel()
+
+	intPtr := func(v int) *int { return &v }
+
+	tests := []struct {
+		name     string
+		input    *logRotate
+		expected *core.LogRotateConfig
+		wantErr  bool
+	}{
+		{name: "Unset", input: nil, expected: nil},
+		{
+			name:     "DefaultKeep",
+			input:    &logRotate{MaxSizeMB: 50},
+			expected: &core.LogRotateConfig{MaxSize: 50 * 1024 * 1024, Keep: 1},
+		},
+		{
+			name:     "ExplicitKeep",
+			input:    &logRotate{MaxSizeMB: 1, Keep: intPtr(3)},
+			expected: &core.LogRotateConfig{MaxSize: 1024 * 1024, Keep: 3},
+		},
+		{name: "ZeroSize", input: &logRotate{MaxSizeMB: 0}, wantErr: true},
+		{name: "ZeroKeep", input: &logRotate{MaxSizeMB: 1, Keep: intPtr(0)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &step{LogRotate: tt.input}
+			result, err := buildStepLogRotate(testStepBuildContext(), s)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func TestBuildStepWorkerSelector(t *testing.T) {
 	t.Parallel()
 
@@ -364,19 +442,22 @@ func TestBuildStepShell(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name     string
-		shell    types.ShellValue
-		expected string
+		name      string
+		shell     types.ShellValue
+		shellArgs []string
+		expected  string
 	}{
 		{name: "SimpleShell", shell: shellValue("bash"), expected: "bash"},
 		{name: "ShellWithArgsAsString", shell: shellValue("bash -e"), expected: "bash"},
 		{name: "ShellAsArray", shell: shellValueArray([]string{"bash", "-e", "-x"}), expected: "bash"},
 		{name: "Empty", shell: types.ShellValue{}, expected: ""},
+		// The default shell is resolved at runtime.
+		{name: "OnlyShellArgs", shellArgs: []string{"-x"}, expected: ""},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := &step{Shell: tt.shell}
+			s := &step{Shell: tt.shell, ShellArgs: tt.shellArgs}
 			result, err := buildStepShell(testStepBuildContext(), s)
 			require.NoError(t, err)
 			assert.Equal(t, tt.expected, result)
@@ -388,19 +469,21 @@ func TestBuildStepShellArgs(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name     string
-		shell    types.ShellValue
-		expected []string
+		name      string
+		shell     types.ShellValue
+		shellArgs []string
+		expected  []string
 	}{
 		{name: "NoArgs", shell: shellValue("bash"), expected: []string{}},
 		{name: "ShellWithArgsAsString", shell: shellValue("bash -e"), expected: []string{"-e"}},
 		{name: "ShellAsArray", shell: shellValueArray([]string{"bash", "-e", "-x"}), expected: []string{"-e", "-x"}},
 		{name: "Empty", shell: types.ShellValue{}, expected: nil},
+		{name: "OnlyShellArgs", shellArgs: []string{"-x"}, expected: []string{"-x"}},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := &step{Shell: tt.shell}
+			s := &step{Shell: tt.shell, ShellArgs: tt.shellArgs}
 			result, err := buildStepShellArgs(testStepBuildContext(), s)
 			require.NoError(t, err)
 			assert.Equal(t, tt.expected, result)
@@ -443,12 +526,13 @@ func TestBuildStepDepends(t *testing.T) {
 
 	tests := []struct {
 		name     string
-		depends  types.StringOrArray
+		depends  types.DependsValue
 		expected []string
 	}{
-		{name: "SingleDependency", depends: stringOrArray("step1"), expected: []string{"step1"}},
-		{name: "MultipleDependencies", depends: stringOrArrayList([]string{"step1", "step2"}), expected: []string{"step1", "step2"}},
-		{name: "Empty", depends: types.StringOrArray{}, expected: nil},
+		{name: "SingleDependency", depends: dependsValue(t, `step1`), expected: []string{"step1"}},
+		{name: "MultipleDependencies", depends: dependsValue(t, `[step1, step2]`), expected: []string{"step1", "step2"}},
+		{name: "ObjectForm", depends: dependsValue(t, `[step1, {step: step2, on: [failed]}]`), expected: []string{"step1", "step2"}},
+		{name: "Empty", depends: types.DependsValue{}, expected: nil},
 	}
 
 	for _, tt := range tests {
@@ -461,17 +545,123 @@ func TestBuildStepDepends(t *testing.T) {
 	}
 }
 
+func dependsValue(t *testing.T, src string) types.DependsValue {
+	t.Helper()
+	var v types.DependsValue
+	require.NoError(t, yaml.Unmarshal([]byte(src), &v))
+	return v
+}
+
+func TestBuildStepDependsOn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		depends  string
+		expected map[string][]core.DependencyOutcome
+		wantErr  string
+	}{
+		{name: "StringFormHasNoConditions", depends: `[step1, step2]`, expected: nil},
+		{
+			name:    "ObjectForm",
+			depends: `[step1, {step: build, on: [success, Skipped, success]}, {step: test, on: failed}]`,
+			expected: map[string][]core.DependencyOutcome{
+				"build": {core.DependencySuccess, core.DependencySkipped},
+				"test":  {core.DependencyFailed},
+			},
+		},
+		{name: "InvalidOutcome", depends: `[{step: build, on: [done]}]`, wantErr: `invalid dependency outcome "done"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &step{Depends: dependsValue(t, tt.depends)}
+			result, err := buildStepDependsOn(testStepBuildContext(), s)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestBuildStepJoinPolicy(t *testing.T) {
+	t.Parallel()
+
+	intPtr := func(v int) *int { return &v }
+
+	tests := []struct {
+		name     string
+		policy   *joinPolicy
+		expected *core.JoinPolicy
+		wantErr  string
+	}{
+		{name: "NotSet", policy: nil, expected: nil},
+		{name: "AllIsDefault", policy: &joinPolicy{Mode: "all"}, expected: nil},
+		{name: "AnyDefaultsToOne", policy: &joinPolicy{Mode: "any"}, expected: &core.JoinPolicy{Mode: core.JoinModeAny, Count: 1}},
+		{name: "AnyWithCount", policy: &joinPolicy{Mode: "any", Count: intPtr(3)}, expected: &core.JoinPolicy{Mode: core.JoinModeAny, Count: 3}},
+		{name: "CountExceedsDepends", policy: &joinPolicy{Mode: "any", Count: intPtr(4)}, wantErr: "count must not exceed the number of depends (3)"},
+		{name: "CountBelowOne", policy: &joinPolicy{Mode: "any", Count: intPtr(0)}, wantErr: "count must be at least 1"},
+		{name: "CountWithAll", policy: &joinPolicy{Mode: "all", Count: intPtr(2)}, wantErr: "count is only supported with mode 'any'"},
+		{name: "InvalidMode", policy: &joinPolicy{Mode: "first"}, wantErr: "mode must be 'all' or 'any'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &step{Depends: dependsValue(t, `[a, b, c]`), JoinPolicy: tt.policy}
+			result, err := buildStepJoinPolicy(testStepBuildContext(), s)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestBuildStepSkip(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		skip     any
+		expected string
+		wantErr  string
+	}{
+		{name: "NotSet", skip: nil, expected: ""},
+		{name: "BoolTrue", skip: true, expected: "true"},
+		{name: "BoolFalse", skip: false, expected: "false"},
+		{name: "Expression", skip: " ${SKIP_TESTS} ", expected: "${SKIP_TESTS}"},
+		{name: "InvalidType", skip: 1, wantErr: "must be a boolean or a string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := buildStepSkip(testStepBuildContext(), &step{Skip: tt.skip})
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func TestBuildStepExplicitlyNoDeps(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
 		name     string
-		depends  types.StringOrArray
+		depends  types.DependsValue
 		expected bool
 	}{
-		{name: "ExplicitEmptyArray", depends: stringOrArrayList([]string{}), expected: true},
-		{name: "HasDependencies", depends: stringOrArrayList([]string{"step1"}), expected: false},
-		{name: "ZeroValue", depends: types.StringOrArray{}, expected: false},
+		{name: "ExplicitEmptyArray", depends: dependsValue(t, `[]`), expected: true},
+		{name: "HasDependencies", depends: dependsValue(t, `[step1]`), expected: false},
+		{name: "ZeroValue", depends: types.DependsValue{}, expected: false},
 	}
 
 	for _, tt := range tests {
@@ -528,6 +718,95 @@ func TestBuildStepContinueOn(t *testing.T) {
 	}
 }
 
+func TestBuildStepMarkFailure(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		markFailure *markFailure
+		expected    core.MarkFailure
+		wantErr     bool
+	}{
+		{name: "Nil", markFailure: nil, expected: core.MarkFailure{}},
+		{
+			name:        "SingleOutput",
+			markFailure: &markFailure{Output: stringOrArray("ERROR")},
+			expected:    core.MarkFailure{Output: []string{"ERROR"}},
+		},
+		{
+			name:        "LiteralAndRegex",
+			markFailure: &markFailure{Output: stringOrArrayList([]string{"ERROR", "re:panic:"})},
+			expected:    core.MarkFailure{Output: []string{"ERROR", "re:panic:"}},
+		},
+		{
+			name:        "InvalidRegex",
+			markFailure: &markFailure{Output: stringOrArrayList([]string{"re:[unclosed"})},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &step{MarkFailure: tt.markFailure}
+			result, err := buildStepMarkFailure(testStepBuildContext(), s)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestValidateMarkFailure(t *testing.T) {
+	t.Parallel()
+
+	markFailure := core.MarkFailure{Output: []string{"ERROR"}}
+	tests := []struct {
+		name       string
+		continueOn core.ContinueOn
+		wantErr    string
+	}{
+		{name: "NoContinueOn"},
+		{name: "FailureWithoutMarkSuccess", continueOn: core.ContinueOn{Failure: true}},
+		{name: "DistinctOutput", continueOn: core.ContinueOn{Output: []string{"WARN"}, MarkSuccess: true}},
+		{name: "NonZeroExitCode", continueOn: core.ContinueOn{ExitCode: []int{1}, MarkSuccess: true}},
+		{
+			name:       "FailureConflict",
+			continueOn: core.ContinueOn{Failure: true, MarkSuccess: true},
+			wantErr:    "continue_on.failure",
+		},
+		{
+			name:       "ExitCodeZeroConflict",
+			continueOn: core.ContinueOn{ExitCode: []int{1, 0}, MarkSuccess: true},
+			wantErr:    "continue_on.exit_code 0",
+		},
+		{
+			name:       "SameOutputConflict",
+			continueOn: core.ContinueOn{Output: []string{"WARN", "ERROR"}, MarkSuccess: true},
+			wantErr:    `continue_on.output "ERROR"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateMarkFailure(&core.Step{MarkFailure: markFailure, ContinueOn: tt.continueOn})
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var validationErr *core.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, "mark_failure", validationErr.Field)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
 func TestBuildStepRetryPolicy(t *testing.T) {
 	t.Parallel()
 
@@ -627,6 +906,50 @@ func TestBuildStepRetryPolicy(t *testing.T) {
 				MaxInterval: 60 * time.Second,
 			},
 		},
+		{
+			name: "PolicyWithRetryOn",
+			retryPolicy: &retryPolicy{
+				Limit:       3,
+				IntervalSec: 5,
+				RetryOn:     stringOrArrayList([]string{"timeout", "Failure", "timeout"}),
+			},
+			expected: core.RetryPolicy{
+				Limit:    3,
+				Interval: 5 * time.Second,
+				RetryOn:  []core.RetryCause{core.RetryOnTimeout, core.RetryOnFailure},
+			},
+		},
+		{
+			name: "PolicyWithRetryOnString",
+			retryPolicy: &retryPolicy{
+				Limit:       3,
+				IntervalSec: 5,
+				RetryOn:     stringOrArray("timeout"),
+			},
+			expected: core.RetryPolicy{
+				Limit:    3,
+				Interval: 5 * time.Second,
+				RetryOn:  []core.RetryCause{core.RetryOnTimeout},
+			},
+		},
+		{
+			name: "PolicyWithInvalidRetryOn",
+			retryPolicy: &retryPolicy{
+				Limit:       3,
+				IntervalSec: 5,
+				RetryOn:     stringOrArrayList([]string{"signal"}),
+			},
+			wantErr: true,
+		},
+		{
+			name: "PolicyWithEmptyRetryOn",
+			retryPolicy: &retryPolicy{
+				Limit:       3,
+				IntervalSec: 5,
+				RetryOn:     stringOrArrayList([]string{}),
+			},
+			wantErr: true,
+		},
 		{
 			name: "MissingLimit",
 			retryPolicy: &retryPolicy{
@@ -700,6 +1023,15 @@ func TestBuildStepRepeatPolicy(t *testing.T) {
 				Interval:   10 * time.Second,
 			},
 		},
+		{
+			name: "UntilModeWithInvalidExpectedRegex",
+			repeatPolicy: &repeatPolicy{
+				Repeat:    types.RepeatModeFromString("until"),
+				Condition: "cat /tmp/status",
+				Expected:  "re:[done",
+			},
+			wantErr: true,
+		},
 		{
 			name: "LegacyBooleanTrue",
 			repeatPolicy: &repeatPolicy{
@@ -838,22 +1170,26 @@ func TestBuildStepRepeatPolicy(t *testing.T) {
 func TestBuildStepSignalOnStop(t *testing.T) {
 	t.Parallel()
 
-	sigTerm := "SIGTERM"
-	sigKill := "SIGKILL"
-	sigInt := "SIGINT"
-	invalid := "INVALID"
-
 	tests := []struct {
 		name         string
-		signalOnStop *string
+		signalOnStop types.SignalOnStopValue
 		expected     string
 		wantErr      bool
 	}{
-		{name: "Nil", signalOnStop: nil, expected: ""},
-		{name: "SIGTERM", signalOnStop: &sigTerm, expected: "SIGTERM"},
-		{name: "SIGKILL", signalOnStop: &sigKill, expected: "SIGKILL"},
-		{name: "SIGINT", signalOnStop: &sigInt, expected: "SIGINT"},
-		{name: "InvalidSignal", signalOnStop: &invalid, wantErr: true},
+		{name: "Unset", signalOnStop: types.SignalOnStopValue{}, expected: ""},
+		{name: "SIGTERM", signalOnStop: signalOnStopValue("SIGTERM"), expected: "SIGTERM"},
+		{name: "SIGKILL", signalOnStop: signalOnStopValue("SIGKILL"), expected: "SIGKILL"},
+		{name: "SIGINT", signalOnStop: signalOnStopValue("SIGINT"), expected: "SIGINT"},
+		{name: "InvalidSignal", signalOnStop: signalOnStopValue("INVALID"), wantErr: true},
+		{
+			name: "ObjectForm",
+			signalOnStop: signalOnStopValueMap(map[string]any{
+				"signal":             "SIGINT",
+				"escalate_after_sec": 10,
+				"escalate_to":        "SIGKILL",
+			}),
+			expected: "SIGINT",
+		},
 	}
 
 	for _, tt := range tests {
@@ -872,6 +1208,53 @@ func TestBuildStepSignalOnStop(t *testing.T) {
 	}
 }
 
+func TestBuildStepSignalEscalation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		signalOnStop types.SignalOnStopValue
+		expected     *core.SignalEscalation
+		wantErr      bool
+	}{
+		{name: "Unset", signalOnStop: types.SignalOnStopValue{}, expected: nil},
+		{name: "StringForm", signalOnStop: signalOnStopValue("SIGINT"), expected: nil},
+		{
+			name: "ObjectForm",
+			signalOnStop: signalOnStopValueMap(map[string]any{
+				"signal":             "SIGINT",
+				"escalate_after_sec": 10,
+				"escalate_to":        "SIGKILL",
+			}),
+			expected: &core.SignalEscalation{Signal: "SIGKILL", After: 10 * time.Second},
+		},
+		{
+			name: "InvalidEscalationSignal",
+			signalOnStop: signalOnStopValueMap(map[string]any{
+				"signal":             "SIGINT",
+				"escalate_after_sec": 10,
+				"escalate_to":        "INVALID",
+			}),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &step{SignalOnStop: tt.signalOnStop}
+			result, err := buildStepSignalEscalation(testStepBuildContext(), s)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func TestBuildStepOutput(t *testing.T) {
 	t.Parallel()
 
@@ -1537,6 +1920,29 @@ func TestBuildStepParamsField(t *testing.T) {
 	}
 }
 
+func TestBuildStepParamsFieldNested(t *testing.T) {
+	t.Parallel()
+
+	s := &step{Params: map[string]any{
+		"repository": "myorg/myrepo",
+		"matrix": map[string]any{
+			"os":      []any{"linux", "darwin"},
+			"version": 3,
+		},
+	}}
+	result := &core.Step{}
+	require.NoError(t, buildStepParamsField(testStepBuildContext(), s, result))
+
+	data, err := result.Params.AsJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"repository":"myorg/myrepo","matrix":{"os":["linux","darwin"],"version":3}}`, string(data))
+
+	params, err := result.Params.AsStringMap()
+	require.NoError(t, err)
+	assert.Equal(t, "myorg/myrepo", params["repository"])
+	assert.JSONEq(t, `{"os":["linux","darwin"],"version":3}`, params["matrix"])
+}
+
 func TestBuildStepParallel(t *testing.T) {
 	t.Parallel()
 
@@ -1641,6 +2047,69 @@ func TestBuildStepParallel(t *testing.T) {
 				MaxConcurrent: 7,
 			},
 		},
+		{
+			name: "DedupeAndSort",
+			parallel: map[string]any{
+				"items":  "${ITEMS}",
+				"dedupe": true,
+				"sort":   true,
+			},
+			expected: &core.ParallelConfig{
+				Variable:      "${ITEMS}",
+				MaxConcurrent: core.DefaultMaxConcurrent,
+				Dedupe:        true,
+				Sort:          true,
+			},
+		},
+		{
+			name: "RandomIDs",
+			parallel: map[string]any{
+				"items":      "${ITEMS}",
+				"random_ids": true,
+			},
+			expected: &core.ParallelConfig{
+				Variable:      "${ITEMS}",
+				MaxConcurrent: core.DefaultMaxConcurrent,
+				RandomIDs:     true,
+			},
+		},
+		{
+			name: "StableRepeatIDs",
+			parallel: map[string]any{
+				"items":             "${ITEMS}",
+				"stable_repeat_ids": true,
+			},
+			expected: &core.ParallelConfig{
+				Variable:        "${ITEMS}",
+				MaxConcurrent:   core.DefaultMaxConcurrent,
+				StableRepeatIDs: true,
+			},
+		},
+		{
+			name: "StableRepeatIDsWithRandomIDs",
+			parallel: map[string]any{
+				"items":             "${ITEMS}",
+				"random_ids":        true,
+				"stable_repeat_ids": true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "InvalidDedupeType",
+			parallel: map[string]any{
+				"items":  "${ITEMS}",
+				"dedupe": "yes",
+			},
+			wantErr: true,
+		},
+		{
+			name: "InvalidSortType",
+			parallel: map[string]any{
+				"items": "${ITEMS}",
+				"sort":  1,
+			},
+			wantErr: true,
+		},
 		{
 			name:     "InvalidType",
 			parallel: 123,
@@ -1742,6 +2211,20 @@ func TestBuildStepSubDAG(t *testing.T) {
 			},
 			expected: &core.SubDAG{Name: "other-dag", Params: `key="value"`},
 		},
+		{
+			name: "CallWithNestedObjectParam",
+			step: &step{
+				Call: "other-dag",
+				Params: map[string]any{
+					"config": map[string]any{"region": "us-east-1", "replicas": 3},
+					"tags":   []any{"a", "b"},
+				},
+			},
+			expected: &core.SubDAG{
+				Name:   "other-dag",
+				Params: `config="{\"region\":\"us-east-1\",\"replicas\":3}" tags="[\"a\",\"b\"]"`,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -2388,6 +2871,71 @@ func TestValidateContainer(t *testing.T) {
 	}
 }
 
+func TestValidateStepContainerResources(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		executorType string
+		config       map[string]any
+		wantErr      string
+	}{
+		{
+			name:         "ValidLimits",
+			executorType: "docker",
+			config:       map[string]any{"cpus": 1.5, "memory": "512m"},
+		},
+		{
+			name:         "RuntimeExpressions",
+			executorType: "container",
+			config:       map[string]any{"cpus": "${CPUS}", "memory": "`echo 1g`"},
+		},
+		{
+			name:         "InvalidCPUs",
+			executorType: "docker",
+			config:       map[string]any{"cpus": "two"},
+			wantErr:      "with.cpus",
+		},
+		{
+			name:         "ZeroCPUs",
+			executorType: "docker",
+			config:       map[string]any{"cpus": 0},
+			wantErr:      "with.cpus",
+		},
+		{
+			name:         "InvalidMemory",
+			executorType: "container",
+			config:       map[string]any{"memory": "lots"},
+			wantErr:      "with.memory",
+		},
+		{
+			name:         "OtherExecutorIgnored",
+			executorType: "ssh",
+			config:       map[string]any{"memory": "lots"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			result := &core.Step{
+				ExecutorConfig: core.ExecutorConfig{
+					Type:   tt.executorType,
+					Config: tt.config,
+				},
+			}
+			err := validateStepContainerResources(&step{With: tt.config}, result)
+
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateSubDAG(t *testing.T) {
 	t.Parallel()
 
@@ -65,6 +65,7 @@ var builtinStepTypeNames = map[string]struct{}{
 	"gha":           {},
 	"github-action": {},
 	"github_action": {},
+	"graphql":       {},
 	"harness":       {},
 	"http":          {},
 	"jq":            {},
@@ -284,7 +285,7 @@ func resolveCustomStepTypeInputSchema(name string, schemaDecl any) (*jsonschema.
 			fmt.Errorf("input_schema must be an inline JSON Schema object"),
 		)
 	}
-	resolved, err := resolveSchemaDeclaration(schemaMap, "", "")
+	resolved, err := resolveSchemaDeclaration(schemaMap, "", "", DefaultRemoteSchemaCacheTTL)
 	if err != nil {
 		return nil, core.NewValidationError(
 			fmt.Sprintf("step_types.%s.input_schema", name),
@@ -630,11 +630,11 @@ steps:
 `))
 	require.NoError(t, err)
 	require.NotNil(t, dag.HandlerOn.Success)
-	assert.Equal(t, "onSuccess", dag.HandlerOn.Success.Name)
-	assert.Equal(t, "direct", dag.HandlerOn.Success.Shell)
-	assert.Equal(t, "greet", dag.HandlerOn.Success.ExecutorConfig.Metadata["custom_type"])
-	require.Len(t, dag.HandlerOn.Success.Commands, 1)
-	assert.Equal(t, []string{"handler-ok"}, dag.HandlerOn.Success.Commands[0].Args)
+	assert.Equal(t, "onSuccess", dag.HandlerOn.Success[0].Name)
+	assert.Equal(t, "direct", dag.HandlerOn.Success[0].Shell)
+	assert.Equal(t, "greet", dag.HandlerOn.Success[0].ExecutorConfig.Metadata["custom_type"])
+	require.Len(t, dag.HandlerOn.Success[0].Commands, 1)
+	assert.Equal(t, []string{"handler-ok"}, dag.HandlerOn.Success[0].Commands[0].Args)
 }
 
 func TestCustomStepTypes_HandlerRejectsWithAndLegacyConfig(t *testing.T) {
@@ -701,10 +701,10 @@ steps:
 `))
 	require.NoError(t, err)
 	require.NotNil(t, dag.HandlerOn.Success)
-	assert.Equal(t, "onSuccess", dag.HandlerOn.Success.Name)
-	assert.Zero(t, dag.HandlerOn.Success.Timeout)
-	assert.False(t, dag.HandlerOn.Success.MailOnError)
-	assert.Equal(t, "greet", dag.HandlerOn.Success.ExecutorConfig.Metadata["custom_type"])
+	assert.Equal(t, "onSuccess", dag.HandlerOn.Success[0].Name)
+	assert.Zero(t, dag.HandlerOn.Success[0].Timeout)
+	assert.False(t, dag.HandlerOn.Success[0].MailOnError)
+	assert.Equal(t, "greet", dag.HandlerOn.Success[0].ExecutorConfig.Metadata["custom_type"])
 }
 
 func TestCustomStepTypes_TemplateFieldsOverrideDefaults(t *testing.T) {
@@ -919,9 +919,9 @@ steps:
 `))
 	require.NoError(t, err)
 	require.NotNil(t, dag.HandlerOn.Success)
-	assert.Equal(t, "onSuccess", dag.HandlerOn.Success.Name)
-	assert.Equal(t, 15*time.Second, dag.HandlerOn.Success.Timeout)
-	assert.Equal(t, "greet", dag.HandlerOn.Success.ExecutorConfig.Metadata["custom_type"])
+	assert.Equal(t, "onSuccess", dag.HandlerOn.Success[0].Name)
+	assert.Equal(t, 15*time.Second, dag.HandlerOn.Success[0].Timeout)
+	assert.Equal(t, "greet", dag.HandlerOn.Success[0].ExecutorConfig.Metadata["custom_type"])
 }
 
 func TestStepExec_BuildsDirectCommand(t *testing.T) {
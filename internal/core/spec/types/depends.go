@@ -0,0 +1,155 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/goccy/go-yaml"
+)
+
+// DependsValue represents step dependencies specified as a step name, an
+// object with the upstream outcomes to start on, or an array mixing both.
+//
+// YAML examples:
+//
+//	depends: build
+//	depends: [build, test]
+//	depends:
+//	  - build
+//	  - step: test
+//	    on: [failed]
+type DependsValue struct {
+	raw     any            // Original value for error reporting
+	isSet   bool           // Whether the field was set in YAML
+	entries []DependsEntry // Parsed dependencies
+}
+
+// DependsEntry is a single dependency. On is empty for the string form.
+type DependsEntry struct {
+	Step string
+	On   []string
+}
+
+// UnmarshalYAML implements BytesUnmarshaler for goccy/go-yaml.
+func (d *DependsValue) UnmarshalYAML(data []byte) error {
+	d.isSet = true
+
+	var raw any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("unmarshal error: %w", err)
+	}
+	d.raw = raw
+
+	switch v := raw.(type) {
+	case nil:
+		d.isSet = false
+		return nil
+
+	case []any:
+		for i, item := range v {
+			entry, err := parseDependsEntry(item)
+			if err != nil {
+				return fmt.Errorf("depends[%d]: %w", i, err)
+			}
+			d.entries = append(d.entries, entry)
+		}
+		return nil
+
+	default:
+		entry, err := parseDependsEntry(v)
+		if err != nil {
+			return err
+		}
+		d.entries = []DependsEntry{entry}
+		return nil
+	}
+}
+
+func parseDependsEntry(v any) (DependsEntry, error) {
+	switch v := v.(type) {
+	case string:
+		// Preserve empty strings for the validation layer to handle
+		return DependsEntry{Step: v}, nil
+
+	case map[string]any:
+		var entry DependsEntry
+		for key, val := range v {
+			switch key {
+			case "step":
+				s, ok := val.(string)
+				if !ok {
+					return DependsEntry{}, fmt.Errorf("step must be a string, got %T", val)
+				}
+				entry.Step = s
+			case "on":
+				on, err := parseDependsOn(val)
+				if err != nil {
+					return DependsEntry{}, err
+				}
+				entry.On = on
+			default:
+				return DependsEntry{}, fmt.Errorf("unknown key %q (expected 'step' or 'on')", key)
+			}
+		}
+		if entry.Step == "" {
+			return DependsEntry{}, fmt.Errorf("step is required")
+		}
+		return entry, nil
+
+	default:
+		// Stringify scalar items (e.g., numeric step names) for compatibility
+		switch v.(type) {
+		case int, int64, uint64, float64, bool:
+			return DependsEntry{Step: fmt.Sprintf("%v", v)}, nil
+		}
+		return DependsEntry{}, fmt.Errorf("must be a string or an object with 'step' and 'on', got %T", v)
+	}
+}
+
+func parseDependsOn(v any) ([]string, error) {
+	switch v := v.(type) {
+	case string:
+		return []string{v}, nil
+	case []any:
+		on := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("on must contain strings, got %T", item)
+			}
+			on = append(on, s)
+		}
+		if len(on) == 0 {
+			return nil, fmt.Errorf("on must not be empty")
+		}
+		return on, nil
+	default:
+		return nil, fmt.Errorf("on must be a string or an array of strings, got %T", v)
+	}
+}
+
+// IsZero returns true if the value was not set in YAML.
+func (d DependsValue) IsZero() bool { return !d.isSet }
+
+// Value returns the original raw value for error reporting.
+func (d DependsValue) Value() any { return d.raw }
+
+// Entries returns the parsed dependencies.
+func (d DependsValue) Entries() []DependsEntry { return d.entries }
+
+// Values returns the names of the steps depended on.
+func (d DependsValue) Values() []string {
+	if len(d.entries) == 0 {
+		return nil
+	}
+	names := make([]string, len(d.entries))
+	for i, e := range d.entries {
+		names[i] = e.Step
+	}
+	return names
+}
+
+// IsEmpty returns true if set but contains no values (empty array).
+func (d DependsValue) IsEmpty() bool { return d.isSet && len(d.entries) == 0 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/goccy/go-yaml"
@@ -33,6 +34,7 @@ type EnvValue struct {
 	raw     any        // Original value for error reporting
 	isSet   bool       // Whether the field was set in YAML
 	entries []EnvEntry // Parsed entries in order
+	coerced []string   // Warnings for non-string values converted to strings
 }
 
 // EnvEntry represents a single environment variable entry.
@@ -71,8 +73,7 @@ func (e *EnvValue) UnmarshalYAML(data []byte) error {
 
 func (e *EnvValue) parseMap(m map[string]any) error {
 	for key, v := range m {
-		value := stringifyValue(v)
-		e.entries = append(e.entries, EnvEntry{Key: key, Value: value})
+		e.addEntry(key, v)
 	}
 	return nil
 }
@@ -82,8 +83,7 @@ func (e *EnvValue) parseArray(arr []any) error {
 		switch v := item.(type) {
 		case map[string]any:
 			for key, val := range v {
-				value := stringifyValue(val)
-				e.entries = append(e.entries, EnvEntry{Key: key, Value: value})
+				e.addEntry(key, val)
 			}
 		case string:
 			key, val, found := strings.Cut(v, "=")
@@ -98,6 +98,34 @@ func (e *EnvValue) parseArray(arr []any) error {
 	return nil
 }
 
+// addEntry appends a map-form entry. YAML scalars that are not strings are
+// converted to their string form, and the conversion is recorded so it can be
+// reported as a build warning.
+func (e *EnvValue) addEntry(key string, v any) {
+	value := stringifyValue(v)
+	if _, ok := v.(string); !ok {
+		e.coerced = append(e.coerced, fmt.Sprintf(
+			"env %s: %s value was converted to the string %q; quote the value to make this explicit",
+			key, scalarKind(v), value,
+		))
+	}
+	e.entries = append(e.entries, EnvEntry{Key: key, Value: value})
+}
+
+// scalarKind describes a decoded YAML value for coercion warnings.
+func scalarKind(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return "number"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
 func stringifyValue(v any) string {
 	switch val := v.(type) {
 	case string:
@@ -118,6 +146,7 @@ func (e EnvValue) Prepend(other EnvValue) EnvValue {
 	return EnvValue{
 		isSet:   true,
 		entries: combined,
+		coerced: slices.Concat(other.coerced, e.coerced),
 	}
 }
 
@@ -129,3 +158,11 @@ func (e EnvValue) Value() any { return e.raw }
 
 // Entries returns the parsed environment entries in order.
 func (e EnvValue) Entries() []EnvEntry { return e.entries }
+
+// CoercionWarnings returns a sorted message for each non-string value that
+// was converted to a string, such as DEBUG: true or PORT: 8080.
+func (e EnvValue) CoercionWarnings() []string {
+	warnings := slices.Clone(e.coerced)
+	slices.Sort(warnings)
+	return warnings
+}
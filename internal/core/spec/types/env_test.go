@@ -322,6 +322,58 @@ func TestEnvValue_AdditionalCoverage(t *testing.T) {
 	})
 }
 
+func TestEnvValue_CoercionWarnings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		warnings []string
+	}{
+		{
+			name:  "BoolAndNumbers",
+			input: "DEBUG: true\nPORT: 8080\nRATIO: 1.5",
+			warnings: []string{
+				`env DEBUG: boolean value was converted to the string "true"; quote the value to make this explicit`,
+				`env PORT: number value was converted to the string "8080"; quote the value to make this explicit`,
+				`env RATIO: number value was converted to the string "1.5"; quote the value to make this explicit`,
+			},
+		},
+		{
+			name:  "ArrayOfMaps",
+			input: "- ENABLED: false\n- NAME: app",
+			warnings: []string{
+				`env ENABLED: boolean value was converted to the string "false"; quote the value to make this explicit`,
+			},
+		},
+		{
+			name:  "QuotedStrings",
+			input: `{DEBUG: "true", PORT: "08", NAME: app}`,
+		},
+		{
+			name:  "KeyValueStrings",
+			input: "- DEBUG=true\n- PORT=8080",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var e types.EnvValue
+			require.NoError(t, yaml.Unmarshal([]byte(tt.input), &e))
+			assert.Equal(t, tt.warnings, e.CoercionWarnings())
+		})
+	}
+
+	t.Run("KeptByPrepend", func(t *testing.T) {
+		t.Parallel()
+		var base, other types.EnvValue
+		require.NoError(t, yaml.Unmarshal([]byte("A: 1"), &base))
+		require.NoError(t, yaml.Unmarshal([]byte("B: true"), &other))
+		assert.Len(t, base.Prepend(other).CoercionWarnings(), 2)
+	})
+}
+
 func TestEnvValue_Prepend(t *testing.T) {
 	t.Parallel()
 
@@ -13,7 +13,7 @@ import (
 // ScheduleValue represents a schedule configuration that can be specified as:
 // - A single cron expression string
 // - An array of cron expressions
-// - A map with start/stop/restart keys
+// - A map with start/stop/restart keys, plus an optional stop_signal
 //
 // YAML examples:
 //
@@ -23,12 +23,14 @@ import (
 //	  start: "0 8 * * *"
 //	  stop: "0 18 * * *"
 //	  restart: "0 12 * * *"
+//	  stop_signal: SIGUSR1
 type ScheduleValue struct {
 	raw      any             // Original value for error reporting
 	isSet    bool            // Whether the field was set in YAML
 	starts   []core.Schedule // Start schedules (or simple schedule expressions)
 	stops    []core.Schedule // Stop schedules
 	restarts []core.Schedule // Restart schedules
+	stopSig  string          // Signal sent by stop schedules
 }
 
 // UnmarshalYAML implements BytesUnmarshaler for goccy/go-yaml.
@@ -90,6 +92,14 @@ func (s *ScheduleValue) UnmarshalYAML(data []byte) error {
 
 func (s *ScheduleValue) parseScheduleMap(m map[string]any) error {
 	for key, v := range m {
+		if key == "stop_signal" {
+			sig, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("schedule.stop_signal: expected string, got %T", v)
+			}
+			s.stopSig = sig
+			continue
+		}
 		opts := core.ScheduleParseOptions{AllowAt: key == "start"}
 		values, err := parseScheduleEntry(v, opts)
 		if err != nil {
@@ -104,7 +114,7 @@ func (s *ScheduleValue) parseScheduleMap(m map[string]any) error {
 		case "restart":
 			s.restarts = values
 		default:
-			return fmt.Errorf("schedule: unknown key %q (expected start, stop, or restart)", key)
+			return fmt.Errorf("schedule: unknown key %q (expected start, stop, restart, or stop_signal)", key)
 		}
 	}
 	return nil
@@ -164,6 +174,9 @@ func (s ScheduleValue) Stops() []core.Schedule { return s.stops }
 // Restarts returns the restart schedules.
 func (s ScheduleValue) Restarts() []core.Schedule { return s.restarts }
 
+// StopSignal returns the signal to send when a stop schedule fires.
+func (s ScheduleValue) StopSignal() string { return s.stopSig }
+
 // HasStopSchedule returns true if stop schedules are configured.
 func (s ScheduleValue) HasStopSchedule() bool { return len(s.stops) > 0 }
 
@@ -40,6 +40,7 @@ func TestScheduleValue_UnmarshalYAML(t *testing.T) {
 		wantStarts      []string
 		wantStops       []string
 		wantRestarts    []string
+		wantStopSignal  string
 		wantHasStop     bool
 		wantHasRestart  bool
 		checkHasStop    bool
@@ -110,6 +111,21 @@ stop: "0 18 * * *"
 			wantStarts: []string{"0 8 * * *", "0 12 * * *"},
 			wantStops:  []string{"0 18 * * *"},
 		},
+		{
+			name: "MapWithStopSignal",
+			input: `
+stop: "0 2 * * *"
+stop_signal: SIGUSR1
+`,
+			wantStops:      []string{"0 2 * * *"},
+			wantStopSignal: "SIGUSR1",
+		},
+		{
+			name:        "NonStringStopSignal",
+			input:       `stop_signal: 10`,
+			wantErr:     true,
+			errContains: "schedule.stop_signal",
+		},
 		{
 			name:        "InvalidMapKey",
 			input:       `invalid: "0 * * * *"`,
@@ -150,6 +166,7 @@ start:
 			if tt.wantRestarts != nil {
 				assert.Equal(t, tt.wantRestarts, scheduleExpressions(s.Restarts()))
 			}
+			assert.Equal(t, tt.wantStopSignal, s.StopSignal())
 			if tt.checkHasStop {
 				assert.Equal(t, tt.wantHasStop, s.HasStopSchedule())
 			}
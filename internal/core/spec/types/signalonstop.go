@@ -0,0 +1,107 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+)
+
+// SignalOnStopValue represents the signal sent to a step when it is requested
+// to stop. It can be specified as:
+// - A string shorthand: the signal name
+// - A map that additionally escalates to a second signal after a grace period
+//
+// YAML examples:
+//
+//	signal_on_stop: SIGINT
+//	signal_on_stop:
+//	  signal: SIGINT
+//	  escalate_after_sec: 10
+//	  escalate_to: SIGKILL
+type SignalOnStopValue struct {
+	raw              any    // Original value for error reporting
+	isSet            bool   // Whether the field was set in YAML
+	signal           string // Signal sent on stop
+	escalateAfterSec int    // Grace period before escalating
+	escalateTo       string // Signal sent after the grace period
+}
+
+// UnmarshalYAML implements BytesUnmarshaler for goccy/go-yaml.
+func (s *SignalOnStopValue) UnmarshalYAML(data []byte) error {
+	s.isSet = true
+
+	var raw any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("signal_on_stop unmarshal error: %w", err)
+	}
+	s.raw = raw
+
+	switch v := raw.(type) {
+	case string:
+		s.signal = strings.TrimSpace(v)
+		return nil
+
+	case map[string]any:
+		return s.parseMap(v)
+
+	case nil:
+		s.isSet = false
+		return nil
+
+	default:
+		return fmt.Errorf("signal_on_stop must be string or map, got %T", v)
+	}
+}
+
+func (s *SignalOnStopValue) parseMap(m map[string]any) error {
+	for key, v := range m {
+		switch key {
+		case "signal":
+			str, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("signal_on_stop.signal: expected string, got %T", v)
+			}
+			s.signal = strings.TrimSpace(str)
+		case "escalate_after_sec":
+			n, err := parseIntValue(v)
+			if err != nil {
+				return fmt.Errorf("signal_on_stop.escalate_after_sec: %w", err)
+			}
+			if n < 0 {
+				return fmt.Errorf("signal_on_stop.escalate_after_sec: must be non-negative, got %d", n)
+			}
+			s.escalateAfterSec = n
+		case "escalate_to":
+			str, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("signal_on_stop.escalate_to: expected string, got %T", v)
+			}
+			s.escalateTo = strings.TrimSpace(str)
+		default:
+			return fmt.Errorf("signal_on_stop: unknown key %q", key)
+		}
+	}
+	if s.escalateTo != "" && s.escalateAfterSec == 0 {
+		return fmt.Errorf("signal_on_stop.escalate_after_sec is required when escalate_to is set")
+	}
+	return nil
+}
+
+// IsZero returns true if signal_on_stop was not set in YAML.
+func (s SignalOnStopValue) IsZero() bool { return !s.isSet }
+
+// Value returns the original raw value for error reporting.
+func (s SignalOnStopValue) Value() any { return s.raw }
+
+// Signal returns the signal sent on stop.
+func (s SignalOnStopValue) Signal() string { return s.signal }
+
+// EscalateAfterSec returns the grace period in seconds before escalating.
+func (s SignalOnStopValue) EscalateAfterSec() int { return s.escalateAfterSec }
+
+// EscalateTo returns the signal sent after the grace period.
+func (s SignalOnStopValue) EscalateTo() string { return s.escalateTo }
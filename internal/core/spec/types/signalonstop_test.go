@@ -0,0 +1,104 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dagucloud/dagu/internal/core/spec/types"
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignalOnStopValue_UnmarshalYAML(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                 string
+		input                string
+		wantErr              bool
+		errContains          string
+		wantSignal           string
+		wantEscalateAfterSec int
+		wantEscalateTo       string
+	}{
+		{
+			name:       "String",
+			input:      "SIGINT",
+			wantSignal: "SIGINT",
+		},
+		{
+			name: "MapWithEscalation",
+			input: `
+signal: SIGINT
+escalate_after_sec: 10
+escalate_to: SIGKILL
+`,
+			wantSignal:           "SIGINT",
+			wantEscalateAfterSec: 10,
+			wantEscalateTo:       "SIGKILL",
+		},
+		{
+			name:       "MapSignalOnly",
+			input:      "signal: SIGTERM",
+			wantSignal: "SIGTERM",
+		},
+		{
+			name: "EscalateToWithoutGracePeriod",
+			input: `
+signal: SIGINT
+escalate_to: SIGKILL
+`,
+			wantErr:     true,
+			errContains: "escalate_after_sec is required",
+		},
+		{
+			name:        "NegativeGracePeriod",
+			input:       "escalate_after_sec: -1",
+			wantErr:     true,
+			errContains: "must be non-negative",
+		},
+		{
+			name:        "UnknownKey",
+			input:       "grace: 10",
+			wantErr:     true,
+			errContains: "unknown key",
+		},
+		{
+			name:        "InvalidType",
+			input:       "[SIGINT]",
+			wantErr:     true,
+			errContains: "must be string or map",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var v types.SignalOnStopValue
+			err := yaml.Unmarshal([]byte(tt.input), &v)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.False(t, v.IsZero())
+			assert.Equal(t, tt.wantSignal, v.Signal())
+			assert.Equal(t, tt.wantEscalateAfterSec, v.EscalateAfterSec())
+			assert.Equal(t, tt.wantEscalateTo, v.EscalateTo())
+		})
+	}
+
+	t.Run("Null", func(t *testing.T) {
+		t.Parallel()
+
+		var v types.SignalOnStopValue
+		require.NoError(t, yaml.Unmarshal([]byte("null"), &v))
+		assert.True(t, v.IsZero())
+	})
+}
@@ -5,6 +5,7 @@ package spec
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -110,6 +111,9 @@ func evaluatePairs(ctx BuildContext, pairs []pair) (map[string]string, error) {
 
 			var err error
 			value, err = eval.String(scopeCtx, value, eval.WithVariables(vars), eval.WithOSExpansion())
+			if errors.Is(err, eval.ErrRequiredVariable) {
+				return nil, core.NewValidationError("env", p.val, fmt.Errorf("%w: %w", ErrInvalidEnvValue, err))
+			}
 			if err != nil {
 				return nil, core.NewValidationError("env", p.val, fmt.Errorf("%w: %s", ErrInvalidEnvValue, p.val))
 			}
@@ -3,6 +3,15 @@
 
 package core
 
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrUnknownStatus is returned when a status token is not recognized.
+var ErrUnknownStatus = errors.New("unknown status")
+
 // Status represents the canonical lifecycle phases for a DAG execution.
 type Status int
 
@@ -45,6 +54,45 @@ func (s Status) String() string {
 	}
 }
 
+// ParseStatus parses a canonical status token as returned by String.
+func ParseStatus(s string) (Status, error) {
+	for status := NotStarted; status <= Rejected; status++ {
+		if status.String() == s {
+			return status, nil
+		}
+	}
+	return NotStarted, fmt.Errorf("%w: %q", ErrUnknownStatus, s)
+}
+
+// MarshalJSON encodes the status as its canonical string token. A status
+// without a token is encoded as its numeric value so it still round-trips.
+func (s Status) MarshalJSON() ([]byte, error) {
+	if s < NotStarted || s > Rejected {
+		return json.Marshal(int(s))
+	}
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON decodes a status from its canonical string token. Numeric
+// values written by older versions are still accepted.
+func (s *Status) UnmarshalJSON(data []byte) error {
+	var token string
+	if err := json.Unmarshal(data, &token); err != nil {
+		var n int
+		if numErr := json.Unmarshal(data, &n); numErr != nil {
+			return fmt.Errorf("invalid status %s: %w", data, err)
+		}
+		*s = Status(n)
+		return nil
+	}
+	status, err := ParseStatus(token)
+	if err != nil {
+		return err
+	}
+	*s = status
+	return nil
+}
+
 // IsActive checks if the status is active (not yet completed).
 // This includes Running, Queued, and Wait (waiting for human approval).
 func (s Status) IsActive() bool {
@@ -99,6 +147,27 @@ func (s NodeStatus) IsDone() bool {
 		s == NodeRejected
 }
 
+// CanTransitionTo reports whether a node may move from s to next. Resetting a
+// node to not started is done by clearing its state, never by a transition.
+// Skipped and rejected nodes are final, and an aborted node never resumes
+// or succeeds. Finished nodes may otherwise run again, because repeat
+// policies and inline retries re-run a node after it has finished.
+func (s NodeStatus) CanTransitionTo(next NodeStatus) bool {
+	if s == next {
+		return true
+	}
+	switch {
+	case next == NodeNotStarted:
+		return false
+	case s == NodeSkipped, s == NodeRejected:
+		return false
+	case s == NodeAborted:
+		return next == NodeFailed
+	default:
+		return true
+	}
+}
+
 // String returns the canonical lowercase token for the node lifecycle phase.
 func (s NodeStatus) String() string {
 	switch s {
@@ -127,6 +196,26 @@ func (s NodeStatus) String() string {
 	}
 }
 
+// CancelReason records why a node was aborted, or why it never started,
+// when the cause lies outside the node itself. It also records that a node
+// failed because its own timeout was exceeded.
+type CancelReason string
+
+const (
+	// CancelReasonUpstreamFailed means a dependency failed or was rejected.
+	CancelReasonUpstreamFailed CancelReason = "upstream_failed"
+	// CancelReasonTimeout means the DAG-level timeout was exceeded.
+	CancelReasonTimeout CancelReason = "timeout"
+	// CancelReasonStepTimeout means the step's own timeout was exceeded.
+	// The step fails instead of being aborted.
+	CancelReasonStepTimeout CancelReason = "step_timeout"
+	// CancelReasonSignal means the DAG-run was stopped by a signal.
+	CancelReasonSignal CancelReason = "signal"
+	// CancelReasonPreconditionNotMet means a DAG-level precondition was not
+	// met, so no step ran.
+	CancelReasonPreconditionNotMet CancelReason = "dag_precondition_not_met"
+)
+
 // TriggerType represents how a DAG run was initiated.
 type TriggerType int
 
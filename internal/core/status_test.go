@@ -4,9 +4,11 @@
 package core
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestStatusConstants(t *testing.T) {
@@ -77,6 +79,52 @@ func TestStatusString(t *testing.T) {
 	}
 }
 
+func TestStatusJSON(t *testing.T) {
+	t.Run("RoundTrip", func(t *testing.T) {
+		for _, status := range []Status{
+			NotStarted, Running, Failed, Aborted, Succeeded,
+			Queued, PartiallySucceeded, Waiting, Rejected,
+		} {
+			t.Run(status.String(), func(t *testing.T) {
+				data, err := json.Marshal(status)
+				require.NoError(t, err)
+				assert.Equal(t, `"`+status.String()+`"`, string(data))
+
+				var decoded Status
+				require.NoError(t, json.Unmarshal(data, &decoded))
+				assert.Equal(t, status, decoded)
+			})
+		}
+	})
+
+	t.Run("StatusWithoutTokenRoundTrips", func(t *testing.T) {
+		data, err := json.Marshal(Status(42))
+		require.NoError(t, err)
+		assert.Equal(t, `42`, string(data))
+
+		var decoded Status
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, Status(42), decoded)
+	})
+
+	t.Run("LegacyNumericValue", func(t *testing.T) {
+		var decoded Status
+		require.NoError(t, json.Unmarshal([]byte(`6`), &decoded))
+		assert.Equal(t, PartiallySucceeded, decoded)
+	})
+
+	t.Run("UnknownToken", func(t *testing.T) {
+		var decoded Status
+		err := json.Unmarshal([]byte(`"unknown"`), &decoded)
+		require.ErrorIs(t, err, ErrUnknownStatus)
+	})
+
+	t.Run("InvalidType", func(t *testing.T) {
+		var decoded Status
+		require.Error(t, json.Unmarshal([]byte(`true`), &decoded))
+	})
+}
+
 func TestNodeStatusString(t *testing.T) {
 	tests := []struct {
 		nodeStatus NodeStatus
@@ -99,6 +147,47 @@ func TestNodeStatusString(t *testing.T) {
 	}
 }
 
+func TestNodeStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from, to NodeStatus
+		expected bool
+	}{
+		{NodeNotStarted, NodeRunning, true},
+		{NodeNotStarted, NodeSkipped, true},
+		{NodeNotStarted, NodeAborted, true},
+		{NodeRunning, NodeSucceeded, true},
+		{NodeRunning, NodeFailed, true},
+		{NodeRunning, NodeRetrying, true},
+		{NodeRunning, NodeWaiting, true},
+		{NodeRetrying, NodeRunning, true},
+		{NodeWaiting, NodeSucceeded, true},
+		{NodeWaiting, NodeRejected, true},
+		{NodeSucceeded, NodeWaiting, true},
+		{NodeSucceeded, NodeRunning, true},
+		{NodeFailed, NodeRunning, true},
+		{NodeFailed, NodeSucceeded, true},
+		{NodeAborted, NodeFailed, true},
+		{NodeSucceeded, NodeSucceeded, true},
+		{NodeSkipped, NodeSkipped, true},
+
+		{NodeRunning, NodeNotStarted, false},
+		{NodeSucceeded, NodeNotStarted, false},
+		{NodeSkipped, NodeRunning, false},
+		{NodeSkipped, NodeAborted, false},
+		{NodeRejected, NodeRunning, false},
+		{NodeRejected, NodeSucceeded, false},
+		{NodeAborted, NodeRunning, false},
+		{NodeAborted, NodeSucceeded, false},
+		{NodeAborted, NodeRetrying, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from.String()+"_to_"+tt.to.String(), func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.from.CanTransitionTo(tt.to))
+		})
+	}
+}
+
 func TestNodeStatus_IsDone(t *testing.T) {
 	tests := []struct {
 		status   NodeStatus
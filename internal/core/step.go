@@ -9,6 +9,8 @@ import (
 	"slices"
 	"strings"
 	"time"
+
+	"github.com/dagucloud/dagu/internal/cmn/stringutil"
 )
 
 // Step contains the runtime information for a step in a DAG.
@@ -29,6 +31,8 @@ type Step struct {
 	ShellArgs []string `json:"shellArgs,omitempty"`
 	// Dir is the working directory for the step.
 	Dir string `json:"dir,omitempty"`
+	// CreateDir creates Dir, including missing parents, before the step runs.
+	CreateDir bool `json:"createDir,omitempty"`
 	// ExecutorConfig contains the configuration for the executor.
 	ExecutorConfig ExecutorConfig `json:"executorConfig,omitzero"`
 	// CmdWithArgs is the command with arguments for display purposes.
@@ -44,6 +48,8 @@ type Step struct {
 	ShellCmdArgs string `json:"shellCmdArgs,omitempty"`
 	// Script is the script to be executed.
 	Script string `json:"script,omitempty"`
+	// Template renders the command and script as Go templates before execution.
+	Template bool `json:"template,omitempty"`
 	// Args contains the arguments for the command.
 	// Deprecated: Use Commands field instead. Kept for JSON backward compatibility.
 	Args []string `json:"args,omitempty"`
@@ -53,35 +59,62 @@ type Step struct {
 	Commands []CommandEntry `json:"commands,omitempty"`
 	// Stdout is the file to store the standard output.
 	Stdout string `json:"stdout,omitempty"`
+	// Stdin is the standard input fed to the command.
+	Stdin *StepStdin `json:"stdin,omitempty"`
+	// OutputFile receives a copy of the standard output of the latest attempt.
+	OutputFile string `json:"outputFile,omitempty"`
 	// Stderr is the file to store the standard error.
 	Stderr string `json:"stderr,omitempty"`
 	// LogOutput specifies how stdout and stderr are handled in log files for this step.
 	// Overrides the DAG-level LogOutput setting. Empty string means inherit from DAG.
 	LogOutput LogOutputMode `json:"logOutput,omitempty"`
+	// LogRotate rotates the step's stdout and stderr log files by size.
+	// Nil keeps each log in a single file.
+	LogRotate *LogRotateConfig `json:"logRotate,omitempty"`
 	// Output is the variable name to store captured stdout.
 	Output string `json:"output,omitempty"`
 	// StructuredOutput publishes post-processed step-scoped outputs for ${step.output.*} access.
 	StructuredOutput map[string]StepOutputEntry `json:"structuredOutput,omitempty"`
 	// Depends contains the list of step names to depend on.
 	Depends []string `json:"depends,omitempty"`
+	// DependsOn restricts, per dependency step name, which upstream outcomes
+	// allow this step to start. Dependencies without an entry keep the
+	// default behavior.
+	DependsOn map[string][]DependencyOutcome `json:"dependsOn,omitempty"`
+	// JoinPolicy, when set to any mode, starts the step once a number of
+	// dependencies have succeeded instead of waiting for all of them.
+	JoinPolicy *JoinPolicy `json:"joinPolicy,omitempty"`
 	// ExplicitlyNoDeps indicates the depends field was explicitly set to empty
 	ExplicitlyNoDeps bool `json:"-"`
 	// ContinueOn contains the conditions to continue on failure or skipped.
 	ContinueOn ContinueOn `json:"continueOn,omitzero"`
+	// MarkFailure contains the conditions to fail an otherwise successful step.
+	MarkFailure MarkFailure `json:"markFailure,omitzero"`
 	// RetryPolicy contains the retry policy for the step.
 	RetryPolicy RetryPolicy `json:"retryPolicy,omitzero"`
 	// RepeatPolicy contains the repeat policy for the step.
 	RepeatPolicy RepeatPolicy `json:"repeatPolicy,omitzero"`
 	// MailOnError is the flag to send mail on error.
 	MailOnError bool `json:"mailOnError,omitempty"`
+	// OnError is a shell command run when the step fails, before continueOn is
+	// evaluated. Its result does not change the status of the step.
+	OnError string `json:"onError,omitempty"`
 	// Preconditions contains the conditions to be met before running the step.
 	Preconditions []*Condition `json:"preconditions,omitempty"`
+	// Skip is an expression that skips the step when it evaluates truthy.
+	Skip string `json:"skip,omitempty"`
 	// SignalOnStop is the signal to send on stop.
 	SignalOnStop string `json:"signalOnStop,omitempty"`
+	// SignalEscalation escalates to another signal when the step is still
+	// running after the grace period following SignalOnStop.
+	SignalEscalation *SignalEscalation `json:"signalEscalation,omitempty"`
 	// SubDAG contains the information about a sub DAG to be executed.
 	SubDAG *SubDAG `json:"childDag,omitempty"`
 	// WorkerSelector specifies required worker labels for execution.
 	WorkerSelector map[string]string `json:"workerSelector,omitempty"`
+	// Requires lists capability requirements the worker running this step
+	// must satisfy. They are added to the requirements of the whole run.
+	Requires []string `json:"requires,omitempty"`
 	// Parallel contains the configuration for parallel execution.
 	Parallel *ParallelConfig `json:"parallel,omitempty"`
 	// Env contains environment variables for the step.
@@ -155,6 +188,15 @@ type SubDAG struct {
 	Params string `json:"params,omitempty"`
 }
 
+// StepStdin is the standard input of a step. Exactly one of Content and
+// File is set.
+type StepStdin struct {
+	// Content is literal text passed as stdin.
+	Content string `json:"content,omitempty"`
+	// File is the path of a file whose content is passed as stdin.
+	File string `json:"file,omitempty"`
+}
+
 // CommandEntry represents a single command in a multi-command step.
 // Each entry contains a parsed command with its arguments.
 type CommandEntry struct {
@@ -262,8 +304,21 @@ type RetryPolicy struct {
 	Backoff float64 `json:"backoff,omitempty"`
 	// MaxInterval is the maximum interval cap for exponential backoff.
 	MaxInterval time.Duration `json:"maxInterval,omitempty"`
+	// RetryOn is the list of failure causes to retry on. When empty, failures
+	// are retried but step timeouts are not.
+	RetryOn []RetryCause `json:"retryOn,omitempty"`
 }
 
+// RetryCause is the cause of a step failure that a retry policy can retry on.
+type RetryCause string
+
+const (
+	// RetryOnFailure retries a step that exited with an error.
+	RetryOnFailure RetryCause = "failure"
+	// RetryOnTimeout retries a step stopped by its step-level timeout.
+	RetryOnTimeout RetryCause = "timeout"
+)
+
 // RepeatMode is the type for the repeat mode.
 type RepeatMode string
 
@@ -287,6 +342,9 @@ type RepeatPolicy struct {
 	Limit int `json:"limit,omitempty"`
 	// LimitStr is the string representation of the limit for deferred evaluation.
 	LimitStr string `json:"limitStr,omitempty"`
+	// MaxRepeatsStr is the string representation of max_repeats for deferred
+	// evaluation. Once resolved it is stored in Limit with an offset of one.
+	MaxRepeatsStr string `json:"maxRepeatsStr,omitempty"`
 	// Backoff is the exponential backoff multiplier (e.g., 2.0 for doubling).
 	Backoff float64 `json:"backoff,omitempty"`
 	// MaxInterval is the maximum interval cap for exponential backoff.
@@ -295,6 +353,9 @@ type RepeatPolicy struct {
 	MaxIntervalStr string `json:"maxIntervalStr,omitempty"`
 	// Condition is the condition object to be met for the repeat.
 	Condition *Condition `json:"condition,omitempty"`
+	// ConditionTimeout bounds how long the repeat condition may take to
+	// evaluate. A timeout stops the repeat loop and fails the step.
+	ConditionTimeout time.Duration `json:"conditionTimeout,omitempty"`
 	// ExitCode is the list of exit codes that should trigger a repeat.
 	ExitCode []int `json:"exitCode,omitempty"`
 }
@@ -317,7 +378,9 @@ func (r *RepeatPolicy) UnmarshalJSON(data []byte) error {
 	r.IntervalStr = aux.IntervalStr
 	r.Limit = aux.Limit
 	r.LimitStr = aux.LimitStr
+	r.MaxRepeatsStr = aux.MaxRepeatsStr
 	r.Condition = aux.Condition
+	r.ConditionTimeout = aux.ConditionTimeout
 	r.ExitCode = aux.ExitCode
 	r.Backoff = aux.Backoff
 	r.MaxInterval = aux.MaxInterval
@@ -365,6 +428,56 @@ type ContinueOn struct {
 	ExitCode    []int    `json:"exitCode,omitempty"`    // ExitCode is the list of exit codes to continue to the next step.
 	Output      []string `json:"output,omitempty"`      // Output is the list of output (stdout/stderr) to continue to the next step.
 	MarkSuccess bool     `json:"markSuccess,omitempty"` // MarkSuccess is the flag to mark the step as success when the condition is met.
+
+	outputPatterns *stringutil.Patterns // outputPatterns caches Output compiled by CompileOutput.
+}
+
+// CompileOutput compiles the Output patterns once so that repeated
+// evaluations do not recompile them. It reports invalid "re:" patterns.
+func (c *ContinueOn) CompileOutput() error {
+	if len(c.Output) == 0 {
+		c.outputPatterns = nil
+		return nil
+	}
+	patterns, err := stringutil.CompilePatterns(c.Output)
+	if err != nil {
+		return err
+	}
+	c.outputPatterns = patterns
+	return nil
+}
+
+// OutputPatterns returns the compiled Output patterns. Patterns that were not
+// compiled at build time, such as those decoded from JSON, are compiled here.
+func (c ContinueOn) OutputPatterns() (*stringutil.Patterns, error) {
+	if c.outputPatterns != nil || len(c.Output) == 0 {
+		return c.outputPatterns, nil
+	}
+	return stringutil.CompilePatterns(c.Output)
+}
+
+// SignalEscalation describes a follow-up signal sent to a step that does not
+// stop within the grace period after receiving its stop signal.
+type SignalEscalation struct {
+	Signal string        `json:"signal"` // Signal is the signal sent after the grace period (e.g., SIGKILL).
+	After  time.Duration `json:"after"`  // After is the grace period following the first signal.
+}
+
+// MarkFailure contains the conditions to mark a step as failed even when
+// its command exits successfully. A failure raised by MarkFailure is subject
+// to ContinueOn like any other failure, so a matching ContinueOn.MarkSuccess
+// turns it back into success. Combinations where that would always happen are
+// rejected at build time.
+type MarkFailure struct {
+	Output []string `json:"output,omitempty"` // Output is the list of stdout patterns that fail the step. Supports regex with 're:' prefix.
+}
+
+// LogRotateConfig configures size-based rotation of the step log files.
+type LogRotateConfig struct {
+	// MaxSize is the size in bytes after which a log file is rotated.
+	MaxSize int64 `json:"maxSize"`
+	// Keep is the number of rotated files kept next to the log file.
+	Keep int `json:"keep"`
 }
 
 // ApprovalConfig configures the approval gate for a step.
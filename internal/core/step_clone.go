@@ -0,0 +1,233 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package core
+
+import (
+	"maps"
+	"slices"
+)
+
+// Clone returns a deep copy of the step. Slices, maps and nested pointers are
+// copied so that the clone can be modified without affecting the original.
+func (s *Step) Clone() *Step {
+	if s == nil {
+		return nil
+	}
+	clone := *s
+
+	clone.ShellPackages = slices.Clone(s.ShellPackages)
+	clone.ShellArgs = slices.Clone(s.ShellArgs)
+	clone.ExecutorConfig.Config = cloneHarnessConfigMap(s.ExecutorConfig.Config)
+	clone.ExecutorConfig.Metadata = cloneHarnessConfigMap(s.ExecutorConfig.Metadata)
+	clone.Args = slices.Clone(s.Args)
+	clone.Stdin = clonePtr(s.Stdin)
+	if s.Commands != nil {
+		clone.Commands = make([]CommandEntry, len(s.Commands))
+		for i, cmd := range s.Commands {
+			cmd.Args = slices.Clone(cmd.Args)
+			clone.Commands[i] = cmd
+		}
+	}
+	clone.LogRotate = clonePtr(s.LogRotate)
+	if s.StructuredOutput != nil {
+		clone.StructuredOutput = make(map[string]StepOutputEntry, len(s.StructuredOutput))
+		for name, entry := range s.StructuredOutput {
+			entry.Value = cloneHarnessValue(entry.Value)
+			clone.StructuredOutput[name] = entry
+		}
+	}
+	clone.Depends = slices.Clone(s.Depends)
+	if s.DependsOn != nil {
+		clone.DependsOn = make(map[string][]DependencyOutcome, len(s.DependsOn))
+		for name, outcomes := range s.DependsOn {
+			clone.DependsOn[name] = slices.Clone(outcomes)
+		}
+	}
+	clone.JoinPolicy = clonePtr(s.JoinPolicy)
+	clone.ContinueOn.ExitCode = slices.Clone(s.ContinueOn.ExitCode)
+	clone.ContinueOn.Output = slices.Clone(s.ContinueOn.Output)
+	clone.MarkFailure.Output = slices.Clone(s.MarkFailure.Output)
+	clone.RetryPolicy.ExitCodes = slices.Clone(s.RetryPolicy.ExitCodes)
+	clone.RetryPolicy.RetryOn = slices.Clone(s.RetryPolicy.RetryOn)
+	clone.RepeatPolicy.Condition = s.RepeatPolicy.Condition.Clone()
+	clone.RepeatPolicy.ExitCode = slices.Clone(s.RepeatPolicy.ExitCode)
+	if s.Preconditions != nil {
+		clone.Preconditions = make([]*Condition, len(s.Preconditions))
+		for i, cond := range s.Preconditions {
+			clone.Preconditions[i] = cond.Clone()
+		}
+	}
+	clone.SignalEscalation = clonePtr(s.SignalEscalation)
+	clone.SubDAG = clonePtr(s.SubDAG)
+	clone.WorkerSelector = maps.Clone(s.WorkerSelector)
+	clone.Requires = slices.Clone(s.Requires)
+	clone.Parallel = cloneParallelConfig(s.Parallel)
+	clone.Env = slices.Clone(s.Env)
+	clone.Params = cloneParams(s.Params)
+	clone.Container = cloneContainer(s.Container)
+	clone.LLM = cloneLLMConfig(s.LLM)
+	clone.Messages = slices.Clone(s.Messages)
+	clone.Router = cloneRouterConfig(s.Router)
+	clone.Agent = cloneAgentStepConfig(s.Agent)
+	if s.Approval != nil {
+		approval := *s.Approval
+		approval.Input = slices.Clone(s.Approval.Input)
+		approval.Required = slices.Clone(s.Approval.Required)
+		clone.Approval = &approval
+	}
+	return &clone
+}
+
+// cloneSteps returns deep copies of the given steps.
+func cloneSteps(steps []Step) []Step {
+	if steps == nil {
+		return nil
+	}
+	cloned := make([]Step, len(steps))
+	for i := range steps {
+		cloned[i] = *steps[i].Clone()
+	}
+	return cloned
+}
+
+// cloneStepPtrs returns deep copies of the given step pointers.
+func cloneStepPtrs(steps []*Step) []*Step {
+	if steps == nil {
+		return nil
+	}
+	cloned := make([]*Step, len(steps))
+	for i, step := range steps {
+		cloned[i] = step.Clone()
+	}
+	return cloned
+}
+
+// clonePtr returns a pointer to a shallow copy of *p, or nil.
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+func cloneParallelConfig(cfg *ParallelConfig) *ParallelConfig {
+	if cfg == nil {
+		return nil
+	}
+	clone := *cfg
+	if cfg.Items != nil {
+		clone.Items = make([]ParallelItem, len(cfg.Items))
+		for i, item := range cfg.Items {
+			item.Params = maps.Clone(item.Params)
+			clone.Items[i] = item
+		}
+	}
+	return &clone
+}
+
+func cloneParams(p Params) Params {
+	return Params{
+		Simple: maps.Clone(p.Simple),
+		Rich:   cloneHarnessConfigMap(p.Rich),
+		Raw:    slices.Clone(p.Raw),
+	}
+}
+
+func cloneContainer(ct *Container) *Container {
+	if ct == nil {
+		return nil
+	}
+	clone := *ct
+	clone.Build = clonePtr(ct.Build)
+	clone.Env = slices.Clone(ct.Env)
+	clone.Volumes = slices.Clone(ct.Volumes)
+	clone.Ports = slices.Clone(ct.Ports)
+	clone.Command = slices.Clone(ct.Command)
+	clone.Entrypoint = slices.Clone(ct.Entrypoint)
+	if ct.Healthcheck != nil {
+		healthcheck := *ct.Healthcheck
+		healthcheck.Test = slices.Clone(ct.Healthcheck.Test)
+		clone.Healthcheck = &healthcheck
+	}
+	clone.Shell = slices.Clone(ct.Shell)
+	return &clone
+}
+
+func cloneLLMConfig(cfg *LLMConfig) *LLMConfig {
+	if cfg == nil {
+		return nil
+	}
+	clone := *cfg
+	if cfg.Models != nil {
+		clone.Models = make([]ModelEntry, len(cfg.Models))
+		for i, model := range cfg.Models {
+			model.Temperature = clonePtr(model.Temperature)
+			model.MaxTokens = clonePtr(model.MaxTokens)
+			model.TopP = clonePtr(model.TopP)
+			clone.Models[i] = model
+		}
+	}
+	clone.Temperature = clonePtr(cfg.Temperature)
+	clone.MaxTokens = clonePtr(cfg.MaxTokens)
+	clone.TopP = clonePtr(cfg.TopP)
+	clone.Stream = clonePtr(cfg.Stream)
+	if cfg.Thinking != nil {
+		thinking := *cfg.Thinking
+		thinking.BudgetTokens = clonePtr(cfg.Thinking.BudgetTokens)
+		clone.Thinking = &thinking
+	}
+	clone.Tools = slices.Clone(cfg.Tools)
+	clone.MaxToolIterations = clonePtr(cfg.MaxToolIterations)
+	clone.WebSearch = cloneWebSearchConfig(cfg.WebSearch)
+	return &clone
+}
+
+func cloneWebSearchConfig(cfg *WebSearchConfig) *WebSearchConfig {
+	if cfg == nil {
+		return nil
+	}
+	clone := *cfg
+	clone.MaxUses = clonePtr(cfg.MaxUses)
+	clone.AllowedDomains = slices.Clone(cfg.AllowedDomains)
+	clone.BlockedDomains = slices.Clone(cfg.BlockedDomains)
+	clone.UserLocation = clonePtr(cfg.UserLocation)
+	return &clone
+}
+
+func cloneRouterConfig(cfg *RouterConfig) *RouterConfig {
+	if cfg == nil {
+		return nil
+	}
+	clone := *cfg
+	if cfg.Routes != nil {
+		clone.Routes = make([]RouteEntry, len(cfg.Routes))
+		for i, route := range cfg.Routes {
+			route.Targets = slices.Clone(route.Targets)
+			clone.Routes[i] = route
+		}
+	}
+	return &clone
+}
+
+func cloneAgentStepConfig(cfg *AgentStepConfig) *AgentStepConfig {
+	if cfg == nil {
+		return nil
+	}
+	clone := *cfg
+	if cfg.Tools != nil {
+		tools := *cfg.Tools
+		tools.Enabled = slices.Clone(cfg.Tools.Enabled)
+		if cfg.Tools.BashPolicy != nil {
+			policy := *cfg.Tools.BashPolicy
+			policy.Rules = slices.Clone(cfg.Tools.BashPolicy.Rules)
+			tools.BashPolicy = &policy
+		}
+		clone.Tools = &tools
+	}
+	clone.Skills = slices.Clone(cfg.Skills)
+	clone.Memory = clonePtr(cfg.Memory)
+	clone.WebSearch = cloneWebSearchConfig(cfg.WebSearch)
+	return &clone
+}
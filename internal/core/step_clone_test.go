@@ -0,0 +1,181 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStepClone(t *testing.T) {
+	t.Parallel()
+
+	maxTokens := 100
+	original := &Step{
+		Name:           "step",
+		Depends:        []string{"a", "b"},
+		Env:            []string{"FOO=bar"},
+		Commands:       []CommandEntry{{Command: "echo", Args: []string{"hello"}}},
+		ExecutorConfig: ExecutorConfig{Type: "http", Config: map[string]any{"headers": map[string]any{"X-Test": "1"}}},
+		ContinueOn:     ContinueOn{ExitCode: []int{1, 2}, Output: []string{"warn"}},
+		RetryPolicy:    RetryPolicy{Limit: 3, ExitCodes: []int{42}},
+		RepeatPolicy: RepeatPolicy{
+			RepeatMode: RepeatModeWhile,
+			Condition:  &Condition{Condition: "$STATUS", Expected: "pending"},
+			ExitCode:   []int{7},
+		},
+		Preconditions:  []*Condition{{Condition: "test -f file"}},
+		SubDAG:         &SubDAG{Name: "child", Params: "P=1"},
+		WorkerSelector: map[string]string{"gpu": "true"},
+		Params:         Params{Simple: map[string]string{"P": "1"}},
+		LLM:            &LLMConfig{Model: "m", MaxTokens: &maxTokens, Tools: []string{"search"}},
+	}
+
+	clone := original.Clone()
+	require.NotNil(t, clone)
+	require.Equal(t, original, clone)
+
+	clone.Depends[0] = "changed"
+	clone.Env = append(clone.Env[:0], "FOO=changed")
+	clone.Commands[0].Args[0] = "changed"
+	clone.ExecutorConfig.Config["headers"].(map[string]any)["X-Test"] = "2"
+	clone.ContinueOn.ExitCode[0] = 99
+	clone.ContinueOn.Output[0] = "changed"
+	clone.RetryPolicy.ExitCodes[0] = 99
+	clone.RepeatPolicy.Condition.Expected = "done"
+	clone.RepeatPolicy.ExitCode[0] = 99
+	clone.Preconditions[0].Condition = "changed"
+	clone.SubDAG.Name = "other"
+	clone.WorkerSelector["gpu"] = "false"
+	clone.Params.Simple["P"] = "2"
+	*clone.LLM.MaxTokens = 200
+	clone.LLM.Tools[0] = "changed"
+
+	assert.Equal(t, []string{"a", "b"}, original.Depends)
+	assert.Equal(t, []string{"FOO=bar"}, original.Env)
+	assert.Equal(t, []string{"hello"}, original.Commands[0].Args)
+	assert.Equal(t, "1", original.ExecutorConfig.Config["headers"].(map[string]any)["X-Test"])
+	assert.Equal(t, []int{1, 2}, original.ContinueOn.ExitCode)
+	assert.Equal(t, []string{"warn"}, original.ContinueOn.Output)
+	assert.Equal(t, []int{42}, original.RetryPolicy.ExitCodes)
+	assert.Equal(t, "pending", original.RepeatPolicy.Condition.Expected)
+	assert.Equal(t, []int{7}, original.RepeatPolicy.ExitCode)
+	assert.Equal(t, "test -f file", original.Preconditions[0].Condition)
+	assert.Equal(t, "child", original.SubDAG.Name)
+	assert.Equal(t, "true", original.WorkerSelector["gpu"])
+	assert.Equal(t, "1", original.Params.Simple["P"])
+	assert.Equal(t, 100, *original.LLM.MaxTokens)
+	assert.Equal(t, []string{"search"}, original.LLM.Tools)
+}
+
+func TestStepCloneNil(t *testing.T) {
+	t.Parallel()
+
+	var step *Step
+	assert.Nil(t, step.Clone())
+
+	clone := (&Step{Name: "empty"}).Clone()
+	assert.Nil(t, clone.Depends)
+	assert.Nil(t, clone.RepeatPolicy.Condition)
+	assert.Nil(t, clone.LLM)
+}
+
+// TestStepCloneDeepCopiesAllFields fills every exported pointer, slice and map
+// of a Step and fails when the clone shares one of them with the original, so
+// that new fields cannot be added without updating Step.Clone.
+func TestStepCloneDeepCopiesAllFields(t *testing.T) {
+	t.Parallel()
+
+	original := &Step{}
+	fillValue(reflect.ValueOf(original).Elem(), 0)
+
+	clone := original.Clone()
+	require.Equal(t, original, clone)
+
+	assertNoSharedReferences(t, "Step", reflect.ValueOf(*original), reflect.ValueOf(*clone))
+}
+
+// fillValue sets every exported pointer, slice and map reachable from v to a
+// non-empty value.
+func fillValue(v reflect.Value, depth int) {
+	if depth > 8 {
+		return
+	}
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		fillValue(v.Elem(), depth+1)
+	case reflect.Slice:
+		if v.Len() == 0 {
+			v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		}
+		fillValue(v.Index(0), depth+1)
+	case reflect.Map:
+		if v.Len() == 0 {
+			key := reflect.New(v.Type().Key()).Elem()
+			fillValue(key, depth+1)
+			elem := reflect.New(v.Type().Elem()).Elem()
+			fillValue(elem, depth+1)
+			v.Set(reflect.MakeMap(v.Type()))
+			v.SetMapIndex(key, elem)
+		}
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if v.Type().Field(i).IsExported() {
+				fillValue(v.Field(i), depth+1)
+			}
+		}
+	case reflect.String:
+		v.SetString("x")
+	case reflect.Interface, reflect.Func, reflect.Chan:
+		// Left nil: their contents cannot be filled generically.
+	default:
+	}
+}
+
+// assertNoSharedReferences walks a and b in parallel and fails for every
+// pointer, slice or map that b shares with a.
+func assertNoSharedReferences(t *testing.T, path string, a, b reflect.Value) {
+	t.Helper()
+
+	switch a.Kind() {
+	case reflect.Pointer:
+		if a.IsNil() || b.IsNil() {
+			return
+		}
+		assert.NotEqual(t, a.Pointer(), b.Pointer(), "%s is shared with the original; deep copy it in Step.Clone", path)
+		assertNoSharedReferences(t, path, a.Elem(), b.Elem())
+	case reflect.Slice:
+		if a.Len() == 0 || b.Len() == 0 {
+			return
+		}
+		assert.NotEqual(t, a.Pointer(), b.Pointer(), "%s is shared with the original; deep copy it in Step.Clone", path)
+		for i := range min(a.Len(), b.Len()) {
+			assertNoSharedReferences(t, fmt.Sprintf("%s[%d]", path, i), a.Index(i), b.Index(i))
+		}
+	case reflect.Map:
+		if a.Len() == 0 || b.Len() == 0 {
+			return
+		}
+		assert.NotEqual(t, a.Pointer(), b.Pointer(), "%s is shared with the original; deep copy it in Step.Clone", path)
+		for _, key := range a.MapKeys() {
+			if bv := b.MapIndex(key); bv.IsValid() {
+				assertNoSharedReferences(t, fmt.Sprintf("%s[%v]", path, key), a.MapIndex(key), bv)
+			}
+		}
+	case reflect.Struct:
+		for i := range a.NumField() {
+			if field := a.Type().Field(i); field.IsExported() {
+				assertNoSharedReferences(t, path+"."+field.Name, a.Field(i), b.Field(i))
+			}
+		}
+	default:
+	}
+}
@@ -84,6 +84,7 @@ func ValidateSteps(dag *DAG) error {
 	validateNameIDConflicts(dag, stepNames, stepIDs, &errs)
 	resolveStepDependencies(dag)
 	validateDependenciesExist(dag, stepNames, &errs)
+	validateNoDependencyCycles(dag, &errs)
 	validateApprovalRewindTargets(dag, stepNames, &errs)
 
 	for _, step := range dag.Steps {
@@ -179,6 +180,83 @@ func validateDependenciesExist(dag *DAG, stepNames map[string]struct{}, errs *Er
 	}
 }
 
+// validateNoDependencyCycles checks that the depends graph is acyclic.
+func validateNoDependencyCycles(dag *DAG, errs *ErrorList) {
+	if cycle := FindDependencyCycle(dag.Steps); len(cycle) > 0 {
+		*errs = append(*errs, NewValidationError("depends", cycle[0], NewDependencyCycleError(cycle)))
+	}
+}
+
+// validateSchedules checks that every schedule has a parseable expression or
+// timestamp. Schedules constructed with only their parsed form are accepted.
+func validateSchedules(field string, schedules []Schedule, errs *ErrorList) {
+	for _, sched := range schedules {
+		if sched.Expression == "" && sched.At == "" && (sched.Parsed != nil || !sched.AtTime.IsZero()) {
+			continue
+		}
+		if _, err := sched.normalized(); err != nil {
+			*errs = append(*errs, NewValidationError(field, sched.DisplayValue(), err))
+		}
+	}
+}
+
+// FindDependencyCycle returns the first dependency cycle found among the
+// given steps, or nil when the graph is acyclic. The returned path follows
+// the depends edges and repeats the first step at the end, e.g.
+// [a b c a] for a depending on b, b on c, and c on a. Dependencies that do
+// not reference a known step are ignored.
+func FindDependencyCycle(steps []Step) []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	depsByName := make(map[string][]string, len(steps))
+	for _, step := range steps {
+		depsByName[step.Name] = step.Depends
+	}
+
+	state := make(map[string]int, len(steps))
+	var path []string
+
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		state[name] = visiting
+		path = append(path, name)
+		for _, dep := range depsByName[name] {
+			if _, ok := depsByName[dep]; !ok {
+				continue
+			}
+			switch state[dep] {
+			case visiting:
+				for i, n := range path {
+					if n == dep {
+						return append(append([]string(nil), path[i:]...), dep)
+					}
+				}
+			case unvisited:
+				if cycle := visit(dep); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+
+	for _, step := range steps {
+		if state[step.Name] != unvisited {
+			continue
+		}
+		if cycle := visit(step.Name); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
 func validateApprovalRewindTargets(dag *DAG, stepNames map[string]struct{}, errs *ErrorList) {
 	stepByName := make(map[string]Step, len(dag.Steps))
 	for _, step := range dag.Steps {
@@ -310,14 +388,20 @@ func isReservedWord(id string) bool {
 	return reservedWords[strings.ToLower(id)]
 }
 
-// resolveStepDependencies resolves step IDs to step names in the depends field.
-func resolveStepDependencies(dag *DAG) {
+// stepIDToName maps the IDs of steps that define one to their names.
+func stepIDToName(steps []Step) map[string]string {
 	idToName := make(map[string]string)
-	for i := range dag.Steps {
-		if dag.Steps[i].ID != "" {
-			idToName[dag.Steps[i].ID] = dag.Steps[i].Name
+	for i := range steps {
+		if steps[i].ID != "" {
+			idToName[steps[i].ID] = steps[i].Name
 		}
 	}
+	return idToName
+}
+
+// resolveStepDependencies resolves step IDs to step names in the depends field.
+func resolveStepDependencies(dag *DAG) {
+	idToName := stepIDToName(dag.Steps)
 
 	for i := range dag.Steps {
 		for j, dep := range dag.Steps[i].Depends {
@@ -325,6 +409,16 @@ func resolveStepDependencies(dag *DAG) {
 				dag.Steps[i].Depends[j] = name
 			}
 		}
+		if len(dag.Steps[i].DependsOn) > 0 {
+			resolved := make(map[string][]DependencyOutcome, len(dag.Steps[i].DependsOn))
+			for dep, outcomes := range dag.Steps[i].DependsOn {
+				if name, exists := idToName[dep]; exists {
+					dep = name
+				}
+				resolved[dep] = outcomes
+			}
+			dag.Steps[i].DependsOn = resolved
+		}
 		if dag.Steps[i].Approval != nil {
 			if name, exists := idToName[dag.Steps[i].Approval.RewindTo]; exists {
 				dag.Steps[i].Approval.RewindTo = name
@@ -834,6 +834,90 @@ func TestValidateSteps_ComplexScenarios(t *testing.T) {
 	})
 }
 
+func TestValidateSteps_DependencyCycle(t *testing.T) {
+	t.Parallel()
+
+	t.Run("two node cycle", func(t *testing.T) {
+		t.Parallel()
+
+		dag := &DAG{
+			Steps: []Step{
+				{Name: "a", Depends: []string{"b"}, ExecutorConfig: testExecConfig},
+				{Name: "b", Depends: []string{"a"}, ExecutorConfig: testExecConfig},
+			},
+		}
+
+		err := ValidateSteps(dag)
+		require.ErrorIs(t, err, ErrDependencyCycle)
+		assert.Contains(t, err.Error(), "cycle detected: a -> b -> a")
+	})
+
+	t.Run("longer cycle through step IDs", func(t *testing.T) {
+		t.Parallel()
+
+		dag := &DAG{
+			Steps: []Step{
+				{Name: "start", ExecutorConfig: testExecConfig},
+				{Name: "a", ID: "step_a", Depends: []string{"start", "step_c"}, ExecutorConfig: testExecConfig},
+				{Name: "b", ID: "step_b", Depends: []string{"step_a"}, ExecutorConfig: testExecConfig},
+				{Name: "c", ID: "step_c", Depends: []string{"b"}, ExecutorConfig: testExecConfig},
+			},
+		}
+
+		err := ValidateSteps(dag)
+		require.ErrorIs(t, err, ErrDependencyCycle)
+		assert.Contains(t, err.Error(), "cycle detected: a -> c -> b -> a")
+	})
+
+	t.Run("self dependency", func(t *testing.T) {
+		t.Parallel()
+
+		dag := &DAG{
+			Steps: []Step{
+				{Name: "a", Depends: []string{"a"}, ExecutorConfig: testExecConfig},
+			},
+		}
+
+		err := ValidateSteps(dag)
+		require.ErrorIs(t, err, ErrDependencyCycle)
+		assert.Contains(t, err.Error(), "cycle detected: a -> a")
+	})
+}
+
+func TestFindDependencyCycle(t *testing.T) {
+	t.Parallel()
+
+	t.Run("diamond is acyclic", func(t *testing.T) {
+		t.Parallel()
+
+		steps := []Step{
+			{Name: "A"},
+			{Name: "B", Depends: []string{"A"}},
+			{Name: "C", Depends: []string{"A"}},
+			{Name: "D", Depends: []string{"B", "C"}},
+		}
+		assert.Nil(t, FindDependencyCycle(steps))
+	})
+
+	t.Run("ignores unknown dependencies", func(t *testing.T) {
+		t.Parallel()
+
+		steps := []Step{{Name: "A", Depends: []string{"missing"}}}
+		assert.Nil(t, FindDependencyCycle(steps))
+	})
+
+	t.Run("returns cycle path", func(t *testing.T) {
+		t.Parallel()
+
+		steps := []Step{
+			{Name: "a", Depends: []string{"b"}},
+			{Name: "b", Depends: []string{"c"}},
+			{Name: "c", Depends: []string{"a"}},
+		}
+		assert.Equal(t, []string{"a", "b", "c", "a"}, FindDependencyCycle(steps))
+	})
+}
+
 func TestValidateSteps_MultipleErrors(t *testing.T) {
 	t.Parallel()
 
@@ -197,6 +197,7 @@ func newDAGStore(cfg *config.Config, searchPaths []string, skipDirectoryCreation
 		filedag.WithWorkspaceBaseConfigDir(workspace.BaseConfigDir(cfg.Paths.DAGsDir)),
 		filedag.WithSkipExamples(cfg.Core.SkipExamples),
 		filedag.WithSkipDirectoryCreation(skipDirectoryCreation),
+		filedag.WithRemoteSchemaCacheTTL(cfg.Core.ParamsSchemaCacheTTL),
 	)
 	if s, ok := store.(*filedag.Storage); ok {
 		if err := s.Initialize(); err != nil {
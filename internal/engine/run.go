@@ -259,6 +259,7 @@ func (e *Engine) loadOptions(opts RunOptions) []spec.LoadOption {
 		spec.WithBaseConfig(e.cfg.Paths.BaseConfig),
 		spec.WithWorkspaceBaseConfigDir(workspace.BaseConfigDir(e.cfg.Paths.DAGsDir)),
 		spec.WithDAGsDir(e.cfg.Paths.DAGsDir),
+		spec.WithRemoteSchemaCacheTTL(e.cfg.Core.ParamsSchemaCacheTTL),
 	}
 	if opts.Name != "" {
 		loadOpts = append(loadOpts, spec.WithName(opts.Name))
@@ -426,6 +427,8 @@ func (e *Engine) runDistributed(ctx context.Context, dag *core.DAG, runID string
 	}
 	taskOpts := []runtimeexec.TaskOption{
 		runtimeexec.WithBaseConfig(runtimeexec.ResolveBaseConfig(dag.BaseConfigData, e.cfg.Paths.BaseConfig)),
+		runtimeexec.WithDAGTags(dag.Labels),
+		runtimeexec.WithRequires(dag.Requirements()),
 	}
 	if len(dist.WorkerSelector) > 0 {
 		taskOpts = append(taskOpts, runtimeexec.WithWorkerSelector(dist.WorkerSelector))
@@ -79,7 +79,7 @@ steps:
 `,
 			setupFunc: func(t *testing.T, dag *core.DAG) {
 				require.NotNil(t, dag.HandlerOn.Init)
-				require.Equal(t, "onInit", dag.HandlerOn.Init.Name)
+				require.Equal(t, "onInit", dag.HandlerOn.Init[0].Name)
 			},
 			runFunc: func(t *testing.T, _ context.Context, agent *test.Agent) {
 				agent.RunSuccess(t)
@@ -253,7 +253,7 @@ steps:
 `,
 			setupFunc: func(t *testing.T, dag *core.DAG) {
 				require.NotNil(t, dag.HandlerOn.Wait)
-				require.Equal(t, "onWait", dag.HandlerOn.Wait.Name)
+				require.Equal(t, "onWait", dag.HandlerOn.Wait[0].Name)
 			},
 			runFunc: func(_ *testing.T, ctx context.Context, agent *test.Agent) {
 				_ = agent.Run(ctx)
@@ -330,7 +330,7 @@ steps:
 
 	// Verify parsing: abort field maps to the canonical abort handler
 	require.NotNil(t, dag.HandlerOn.Abort)
-	require.Equal(t, "onAbort", dag.HandlerOn.Abort.Name)
+	require.Equal(t, "onAbort", dag.HandlerOn.Abort[0].Name)
 
 	dagAgent := dagAgentWithProc(t, th, dag)
 
@@ -0,0 +1,131 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package intg_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dagucloud/dagu/internal/core"
+	"github.com/dagucloud/dagu/internal/test"
+)
+
+func TestHTTPExecutor_OutputCapture(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/large":
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte(strings.Repeat("x", 4096)))
+		case "/graphql":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data":{"user":{"name":"alice"}}}`))
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"42","user":{"name":"alice"}}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	t.Run("JSONFieldFeedsLaterStep", func(t *testing.T) {
+		t.Parallel()
+
+		th := test.Setup(t)
+		dag := th.DAG(t, fmt.Sprintf(`
+steps:
+  - name: fetch
+    type: http
+    with:
+      silent: true
+    command: GET %s/user
+    output: RESP
+  - name: use
+    command: echo ${RESP.id}-${RESP.user.name}
+    output: RESULT
+`, server.URL))
+		agent := dag.Agent()
+		agent.RunSuccess(t)
+
+		dag.AssertLatestStatus(t, core.Succeeded)
+		dag.AssertOutputs(t, map[string]any{
+			"RESP":   `{"id":"42","user":{"name":"alice"}}`,
+			"RESULT": "42-alice",
+		})
+	})
+
+	t.Run("HeadersIncludedWhenNotSilent", func(t *testing.T) {
+		t.Parallel()
+
+		th := test.Setup(t)
+		dag := th.DAG(t, fmt.Sprintf(`
+steps:
+  - name: fetch
+    type: http
+    with:
+      silent: false
+    command: GET %s/user
+    output: RESP
+`, server.URL))
+		agent := dag.Agent()
+		agent.RunSuccess(t)
+
+		dag.AssertLatestStatus(t, core.Succeeded)
+		dag.AssertOutputs(t, map[string]any{
+			"RESP": test.Contains("Content-Type: application/json"),
+		})
+	})
+
+	t.Run("LargeBodyRespectsMaxOutputSize", func(t *testing.T) {
+		t.Parallel()
+
+		th := test.Setup(t)
+		dag := th.DAG(t, fmt.Sprintf(`
+max_output_size: 1024
+steps:
+  - name: fetch
+    type: http
+    with:
+      silent: true
+    command: GET %s/large
+    output: RESP
+`, server.URL))
+		agent := dag.Agent()
+		agent.RunCheckErr(t, "output exceeded maximum size limit of 1024 bytes")
+
+		dag.AssertLatestStatus(t, core.Failed)
+	})
+
+	t.Run("GraphQLDataFeedsLaterStep", func(t *testing.T) {
+		t.Parallel()
+
+		th := test.Setup(t)
+		dag := th.DAG(t, fmt.Sprintf(`
+env:
+  - USER_ID: "42"
+steps:
+  - name: query
+    type: graphql
+    with:
+      url: %s/graphql
+      query: "query($id: ID!) { user(id: $id) { name } }"
+      variables:
+        id: ${USER_ID}
+    output: RESP
+  - name: use
+    command: echo ${RESP.user.name}
+    output: RESULT
+`, server.URL))
+		agent := dag.Agent()
+		agent.RunSuccess(t)
+
+		dag.AssertLatestStatus(t, core.Succeeded)
+		dag.AssertOutputs(t, map[string]any{
+			"RESULT": "alice",
+		})
+	})
+}
@@ -192,3 +192,36 @@ steps:
 		t.Fatalf("expected file to exist: %v", err)
 	}
 }
+
+func TestJSONPathPreconditionUsesPreviousOutput(t *testing.T) {
+	t.Parallel()
+
+	th := test.Setup(t)
+	dag := th.DAG(t, `
+steps:
+  - name: fetch
+    command: echo '{"status":"ready","replicas":3}'
+    output: RESP
+  - name: when-ready
+    command: echo deployed
+    output: READY_RESULT
+    preconditions:
+      - condition: "jsonpath:$.status"
+        source: "${RESP}"
+        expected: "ready"
+  - name: when-scaled
+    command: echo scaled
+    output: SCALED_RESULT
+    preconditions:
+      - condition: "jsonpath:$.replicas"
+        source: "${RESP}"
+        expected: "5"
+`)
+	agent := dag.Agent()
+	agent.RunSuccess(t)
+
+	dag.AssertOutputs(t, map[string]any{
+		"READY_RESULT":  "deployed",
+		"SCALED_RESULT": "",
+	})
+}
@@ -45,6 +45,7 @@ type Options struct {
 	WorkspaceBaseConfigDir string                     // Optional directory containing workspace base configs
 	SkipExamples           bool                       // Skip creating example DAGs
 	SkipDirectoryCreation  bool                       // Skip creating base directory (for worker mode)
+	RemoteSchemaCacheTTL   *time.Duration             // Freshness lifetime of cached remote params schemas
 }
 
 // WithFileCache returns a DAGRepositoryOption that sets the file cache for DAG objects
@@ -99,6 +100,14 @@ func WithSkipDirectoryCreation(skip bool) Option {
 	}
 }
 
+// WithRemoteSchemaCacheTTL returns a DAGRepositoryOption that sets how long
+// remote params schemas are cached when loading DAGs from the store.
+func WithRemoteSchemaCacheTTL(ttl time.Duration) Option {
+	return func(o *Options) {
+		o.RemoteSchemaCacheTTL = &ttl
+	}
+}
+
 // New creates a new DAG store implementation using the local filesystem
 func New(baseDir string, opts ...Option) exec.DAGStore {
 	options := &Options{}
@@ -129,6 +138,7 @@ func New(baseDir string, opts ...Option) exec.DAGStore {
 		baseConfigState:        describeBaseConfigStateSet(options.BaseConfigPath, options.WorkspaceBaseConfigDir),
 		skipExamples:           options.SkipExamples,
 		skipDirectoryCreation:  options.SkipDirectoryCreation,
+		remoteSchemaCacheTTL:   options.RemoteSchemaCacheTTL,
 	}
 }
 
@@ -143,6 +153,7 @@ type Storage struct {
 	baseConfigState        string                     // Last observed base config state for cache/index invalidation
 	skipExamples           bool                       // Skip creating example DAGs
 	skipDirectoryCreation  bool                       // Skip creating base directory (for worker mode)
+	remoteSchemaCacheTTL   *time.Duration             // Freshness lifetime of cached remote params schemas
 	baseConfigMu           sync.Mutex                 // Protects base config state refresh and invalidation
 	indexMu                sync.Mutex                 // Protects index load/rebuild/invalidate
 }
@@ -172,13 +183,16 @@ func (store *Storage) readSuspendFlags(ctx context.Context) dagindex.SuspendFlag
 }
 
 func (store *Storage) defaultLoadOptions(opts ...spec.LoadOption) []spec.LoadOption {
-	loadOpts := make([]spec.LoadOption, 0, len(opts)+1)
+	loadOpts := make([]spec.LoadOption, 0, len(opts)+3)
 	if store.baseConfigPath != "" {
 		loadOpts = append(loadOpts, spec.WithBaseConfig(store.baseConfigPath))
 	}
 	if store.workspaceBaseConfigDir != "" {
 		loadOpts = append(loadOpts, spec.WithWorkspaceBaseConfigDir(store.workspaceBaseConfigDir))
 	}
+	if store.remoteSchemaCacheTTL != nil {
+		loadOpts = append(loadOpts, spec.WithRemoteSchemaCacheTTL(*store.remoteSchemaCacheTTL))
+	}
 	loadOpts = append(loadOpts, opts...)
 	return loadOpts
 }
@@ -492,14 +492,14 @@ func createTestDAG() *core.DAG {
 			},
 		},
 		HandlerOn: core.HandlerOn{
-			Success: &core.Step{
+			Success: core.HandlerSteps{{
 				Name:    "on_success",
 				Command: "echo 'success'",
-			},
-			Failure: &core.Step{
+			}},
+			Failure: core.HandlerSteps{{
 				Name:    "on_failure",
 				Command: "echo 'failure'",
-			},
+			}},
 		},
 		Params: []string{"--param1=value1", "--param2=value2"},
 	}
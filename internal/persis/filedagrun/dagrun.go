@@ -409,12 +409,7 @@ func (dr DAGRun) listLogFiles(ctx context.Context) ([]string, error) {
 		for _, n := range status.Nodes {
 			logFiles = append(logFiles, n.Stdout, n.Stderr)
 		}
-		for _, n := range []*exec.Node{
-			status.OnSuccess, status.OnExit, status.OnFailure, status.OnAbort,
-		} {
-			if n == nil {
-				continue
-			}
+		for _, n := range status.AllHandlerNodes() {
 			logFiles = append(logFiles, n.Stdout, n.Stderr)
 		}
 	}
@@ -182,6 +182,44 @@ func TestListLogFiles(t *testing.T) {
 			assert.Contains(t, logFiles, expectedFile, "should contain expected log file: %s", expectedFile)
 		}
 	})
+
+	t.Run("WithHandlerLogFiles", func(t *testing.T) {
+		root := setupTestDataRoot(t)
+		run := root.CreateTestDAGRun(t, "test-dag-run", exec.NewUTC(time.Now()))
+
+		dagRunStatus := exec.InitialStatus(&core.DAG{Name: "test-dag"})
+		dagRunStatus.DAGRunID = "test-dag-run"
+		dagRunStatus.Status = core.Succeeded
+		handlerNode := func(name string) *exec.Node {
+			return &exec.Node{
+				Step:   core.Step{Name: name},
+				Stdout: "/tmp/" + name + ".out",
+				Stderr: "/tmp/" + name + ".err",
+			}
+		}
+		dagRunStatus.OnInit = handlerNode("onInit")
+		dagRunStatus.OnWait = handlerNode("onWait")
+		dagRunStatus.OnRetry = handlerNode("onRetry")
+		dagRunStatus.OnExit = handlerNode("onExit")
+		dagRunStatus.HandlerChains = map[core.HandlerType][]*exec.Node{
+			core.HandlerOnExit: {handlerNode("onExit_2")},
+		}
+
+		ts := exec.NewUTC(time.Now())
+		att, err := run.CreateAttempt(run.Context, ts, nil, "")
+		require.NoError(t, err)
+		require.NoError(t, att.Open(run.Context))
+		require.NoError(t, att.Write(run.Context, dagRunStatus))
+		require.NoError(t, att.Close(run.Context))
+
+		logFiles, err := run.listLogFiles(run.Context)
+		require.NoError(t, err)
+
+		for _, name := range []string{"onInit", "onWait", "onRetry", "onExit", "onExit_2"} {
+			assert.Contains(t, logFiles, "/tmp/"+name+".out")
+			assert.Contains(t, logFiles, "/tmp/"+name+".err")
+		}
+	})
 }
 
 func TestRemoveLogFiles(t *testing.T) {
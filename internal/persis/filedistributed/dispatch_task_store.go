@@ -12,6 +12,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/dagucloud/dagu/internal/core"
 	"github.com/dagucloud/dagu/internal/core/exec"
 	coordinatorv1 "github.com/dagucloud/dagu/proto/coordinator/v1"
 	"github.com/google/uuid"
@@ -110,7 +111,9 @@ func (s *DispatchTaskStore) ClaimNext(_ context.Context, claim exec.DispatchTask
 			}
 			return nil, err
 		}
-		if record.Task == nil || !matchesSelector(claim.Labels, record.Task.WorkerSelector) {
+		if record.Task == nil || !matchesSelector(claim.Labels, record.Task.WorkerSelector) ||
+			!core.MatchesWorkerTags(claim.Tags, record.Task.GetTags()) ||
+			!core.CapabilitiesSatisfy(claim.Labels, record.Task.GetRequires()) {
 			continue
 		}
 
@@ -98,6 +98,55 @@ func TestDispatchTaskStore_ClaimRecycleAndSelectorFiltering(t *testing.T) {
 	assert.ErrorIs(t, err, exec.ErrDispatchTaskNotFound)
 }
 
+func TestDispatchTaskStore_TagFiltering(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := NewDispatchTaskStore(filepath.Join(t.TempDir(), "distributed"))
+
+	require.NoError(t, store.Enqueue(ctx, &coordinatorv1.Task{
+		DagRunId:   "run-plain",
+		Target:     "dag-plain",
+		AttemptKey: "attempt-key-plain",
+	}))
+	require.NoError(t, store.Enqueue(ctx, &coordinatorv1.Task{
+		DagRunId:   "run-gpu",
+		Target:     "dag-gpu",
+		AttemptKey: "attempt-key-gpu",
+		Tags:       []string{"gpu"},
+	}))
+
+	// A worker restricted to gpu skips the untagged task.
+	gpuClaim, err := store.ClaimNext(ctx, exec.DispatchTaskClaim{
+		WorkerID:     "worker-gpu",
+		PollerID:     "poller-1",
+		Tags:         []string{"gpu", "heavy"},
+		ClaimTimeout: time.Second,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, gpuClaim)
+	assert.Equal(t, "run-gpu", gpuClaim.Task.DagRunId)
+
+	gpuClaim, err = store.ClaimNext(ctx, exec.DispatchTaskClaim{
+		WorkerID:     "worker-gpu",
+		PollerID:     "poller-2",
+		Tags:         []string{"gpu"},
+		ClaimTimeout: time.Second,
+	})
+	require.NoError(t, err)
+	assert.Nil(t, gpuClaim)
+
+	// A worker without tags accepts any task.
+	anyClaim, err := store.ClaimNext(ctx, exec.DispatchTaskClaim{
+		WorkerID:     "worker-any",
+		PollerID:     "poller-3",
+		ClaimTimeout: time.Second,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, anyClaim)
+	assert.Equal(t, "run-plain", anyClaim.Task.DagRunId)
+}
+
 func TestDispatchTaskStore_ConcurrentClaimIsExclusive(t *testing.T) {
 	t.Parallel()
 
@@ -301,6 +301,72 @@ func (s *Store) ListFlat(ctx context.Context, opts agent.ListDocsOptions) (*exec
 	return &result, nil
 }
 
+type docListCursor struct {
+	Version int    `json:"v"`
+	ID      string `json:"id"`
+}
+
+// ListAfter returns up to limit docs ordered by ID, starting after the doc the
+// cursor points at. The cursor records the last returned ID rather than an
+// offset, so docs created or deleted between calls neither shift entries into
+// a later page twice nor out of it.
+func (s *Store) ListAfter(ctx context.Context, cursor string, limit int) (*exec.CursorResult[agent.DocMetadata], error) {
+	var after docListCursor
+	if cursor != "" {
+		if err := exec.DecodeSearchCursor(cursor, &after); err != nil {
+			return nil, err
+		}
+		if after.Version != docSearchCursorVersion || after.ID == "" {
+			return nil, exec.ErrInvalidCursor
+		}
+	}
+
+	exists, err := ensureSearchRoot(s.baseDir)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return &exec.CursorResult[agent.DocMetadata]{Items: []agent.DocMetadata{}}, nil
+	}
+
+	candidates, err := listSearchCandidates(ctx, s.baseDir)
+	if err != nil {
+		return nil, err
+	}
+
+	limit = max(limit, 1)
+	items := make([]agent.DocMetadata, 0, limit)
+	var hasMore bool
+	for _, candidate := range candidates {
+		if after.ID != "" && candidate.ID <= after.ID {
+			continue
+		}
+		if len(items) == limit {
+			hasMore = true
+			break
+		}
+
+		data, err := os.ReadFile(candidate.AbsPath) //nolint:gosec // path constructed from baseDir
+		if err != nil {
+			continue
+		}
+		doc, err := parseDocFile(data, candidate.ID)
+		if err != nil {
+			continue
+		}
+		items = append(items, agent.DocMetadata{ID: doc.ID, Title: doc.Title, Description: doc.Description})
+	}
+
+	result := &exec.CursorResult[agent.DocMetadata]{Items: items, HasMore: hasMore}
+	if hasMore {
+		result.NextCursor = exec.EncodeSearchCursor(docListCursor{
+			Version: docSearchCursorVersion,
+			ID:      items[len(items)-1].ID,
+		})
+	}
+	return result, nil
+}
+
 func docPathRootExcluded(id string, excludedRoots []string) bool {
 	if len(excludedRoots) == 0 {
 		return false
@@ -557,14 +623,20 @@ func (s *Store) dirPath(id string) (string, error) {
 	return s.safePath(filepath.Join(s.baseDir, id), id)
 }
 
-// Rename moves a doc (file or directory) from oldID to newID.
-func (s *Store) Rename(_ context.Context, oldID, newID string) error {
+// Rename moves a doc (file or directory) from oldID to newID. It fails with
+// agent.ErrDocAlreadyExists when newID is taken, unless opts request an
+// overwrite.
+func (s *Store) Rename(_ context.Context, oldID, newID string, opts ...agent.RenameOpts) error {
 	if err := agent.ValidateDocID(oldID); err != nil {
 		return err
 	}
 	if err := agent.ValidateDocID(newID); err != nil {
 		return err
 	}
+	var overwrite bool
+	for _, o := range opts {
+		overwrite = overwrite || o.Overwrite
+	}
 
 	// Try as file first (existing behavior).
 	oldFilePath, err := s.docFilePath(oldID)
@@ -577,8 +649,9 @@ func (s *Store) Rename(_ context.Context, oldID, newID string) error {
 	}
 
 	if _, err := os.Stat(oldFilePath); err == nil {
-		// Old file exists — rename as file.
-		if _, err := os.Stat(newFilePath); err == nil {
+		// Old file exists — rename as file. os.Rename replaces an existing
+		// target file, so an overwrite needs no extra step.
+		if _, err := os.Stat(newFilePath); err == nil && !overwrite {
 			return agent.ErrDocAlreadyExists
 		}
 		if err := os.MkdirAll(filepath.Dir(newFilePath), docDirPermissions); err != nil {
@@ -606,11 +679,21 @@ func (s *Store) Rename(_ context.Context, oldID, newID string) error {
 		return err
 	}
 	// Check that neither a directory nor a file with the target name exists.
-	if _, err := os.Stat(newDirPath); err == nil {
-		return agent.ErrDocAlreadyExists
-	}
-	if _, err := os.Stat(newFilePath); err == nil {
-		return agent.ErrDocAlreadyExists
+	for _, target := range []string{newDirPath, newFilePath} {
+		if _, err := os.Stat(target); err != nil {
+			continue
+		}
+		if !overwrite {
+			return agent.ErrDocAlreadyExists
+		}
+		// Replacing a directory that contains the source, or one inside it,
+		// would delete the docs being moved.
+		if target == newDirPath && (isWithinDir(oldDirPath, newDirPath) || isWithinDir(newDirPath, oldDirPath)) {
+			return fmt.Errorf("filedoc: cannot overwrite %s with %s: one contains the other", newID, oldID)
+		}
+		if err := os.RemoveAll(target); err != nil {
+			return fmt.Errorf("filedoc: failed to remove existing target: %w", err)
+		}
 	}
 
 	if err := os.MkdirAll(filepath.Dir(newDirPath), docDirPermissions); err != nil {
@@ -623,9 +706,80 @@ func (s *Store) Rename(_ context.Context, oldID, newID string) error {
 	return nil
 }
 
-// Search searches all docs for the given query pattern.
+// Move relocates the docs under the oldPrefix directory to newPrefix,
+// keeping their relative paths. All target IDs are validated and checked for
+// collisions before any file is moved.
+func (s *Store) Move(ctx context.Context, oldPrefix, newPrefix string) error {
+	if err := agent.ValidateDocID(oldPrefix); err != nil {
+		return err
+	}
+	if err := agent.ValidateDocID(newPrefix); err != nil {
+		return err
+	}
+	if oldPrefix == newPrefix || strings.HasPrefix(newPrefix, oldPrefix+"/") {
+		return fmt.Errorf("%w: cannot move %s into itself", agent.ErrInvalidDocID, oldPrefix)
+	}
+
+	oldDirPath, err := s.dirPath(oldPrefix)
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(oldDirPath)
+	if err != nil || !info.IsDir() {
+		return agent.ErrDocNotFound
+	}
+	candidates, err := listSearchCandidates(ctx, oldDirPath)
+	if err != nil {
+		return err
+	}
+	if len(candidates) == 0 {
+		return agent.ErrDocNotFound
+	}
+
+	type docMove struct {
+		from string
+		to   string
+	}
+	moves := make([]docMove, 0, len(candidates))
+	for _, c := range candidates {
+		newID := newPrefix + "/" + c.ID
+		if err := agent.ValidateDocID(newID); err != nil {
+			return err
+		}
+		newFilePath, err := s.docFilePath(newID)
+		if err != nil {
+			return err
+		}
+		if _, err := os.Stat(newFilePath); err == nil {
+			return fmt.Errorf("%w: %s", agent.ErrDocAlreadyExists, newID)
+		}
+		moves = append(moves, docMove{from: c.AbsPath, to: newFilePath})
+	}
+
+	for _, m := range moves {
+		if err := os.MkdirAll(filepath.Dir(m.to), docDirPermissions); err != nil {
+			return fmt.Errorf("filedoc: failed to create target directories: %w", err)
+		}
+		if err := os.Rename(m.from, m.to); err != nil {
+			return fmt.Errorf("filedoc: failed to move file: %w", err)
+		}
+		s.cleanEmptyParents(filepath.Dir(m.from))
+	}
+	return nil
+}
+
+// isWithinDir reports whether path is dir or lies inside it.
+func isWithinDir(path, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// Search searches all docs for the given query pattern. Results are ranked by
+// the number of matching lines, most first, with ties ordered by ID. Each match
+// carries its line number and a snippet of the surrounding lines.
 func (s *Store) Search(ctx context.Context, query string) ([]*agent.DocSearchResult, error) {
 	var results []*agent.DocSearchResult
+	matchCounts := make(map[string]int)
 
 	err := filepath.WalkDir(s.baseDir, func(path string, d os.DirEntry, err error) error {
 		if err != nil {
@@ -651,10 +805,11 @@ func (s *Store) Search(ctx context.Context, query string) ([]*agent.DocSearchRes
 			return nil
 		}
 
-		matches, err := grep.Grep(data, query, grep.DefaultGrepOptions)
+		matches, count, err := grep.GrepWithCount(data, query, grep.DefaultGrepOptions)
 		if err != nil {
 			return nil // no match or error — skip
 		}
+		matchCounts[id] = count
 
 		doc, parseErr := parseDocFile(data, id)
 		title := id
@@ -677,6 +832,9 @@ func (s *Store) Search(ctx context.Context, query string) ([]*agent.DocSearchRes
 	}
 
 	sort.Slice(results, func(i, j int) bool {
+		if ci, cj := matchCounts[results[i].ID], matchCounts[results[j].ID]; ci != cj {
+			return ci > cj
+		}
 		return results[i].ID < results[j].ID
 	})
 
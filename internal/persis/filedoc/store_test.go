@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/dagucloud/dagu/internal/agent"
+	"github.com/dagucloud/dagu/internal/core/exec"
 	"github.com/dagucloud/dagu/internal/persis/testutil"
 	"github.com/goccy/go-yaml"
 	"github.com/stretchr/testify/assert"
@@ -203,6 +204,37 @@ func TestRenameToExisting(t *testing.T) {
 	assert.ErrorIs(t, err, agent.ErrDocAlreadyExists)
 }
 
+func TestRenameToExistingWithOverwrite(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, store.Create(ctx, "doc-a", "content a"))
+	require.NoError(t, store.Create(ctx, "doc-b", "content b"))
+
+	err := store.Rename(ctx, "doc-a", "doc-b", agent.RenameOpts{Overwrite: true})
+	require.NoError(t, err)
+
+	doc, err := store.Get(ctx, "doc-b")
+	require.NoError(t, err)
+	assert.Equal(t, "content a", doc.Content)
+
+	_, err = store.Get(ctx, "doc-a")
+	assert.ErrorIs(t, err, agent.ErrDocNotFound)
+}
+
+func TestRenameToInvalidIDWithOverwrite(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, store.Create(ctx, "doc-a", "content a"))
+
+	err := store.Rename(ctx, "doc-a", "../escape", agent.RenameOpts{Overwrite: true})
+	assert.ErrorIs(t, err, agent.ErrInvalidDocID)
+
+	_, err = store.Get(ctx, "doc-a")
+	require.NoError(t, err)
+}
+
 func TestRenameNested(t *testing.T) {
 	store := newTestStore(t)
 	ctx := context.Background()
@@ -308,6 +340,54 @@ func TestListTreeExcludePathRootsBeforePagination(t *testing.T) {
 	assert.Equal(t, 1, result.TotalPages)
 }
 
+func TestListAfter(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	for _, id := range []string{"b", "d", "f", "h"} {
+		require.NoError(t, store.Create(ctx, id, "content "+id))
+	}
+
+	page1, err := store.ListAfter(ctx, "", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b", "d"}, docMetadataIDs(page1.Items))
+	require.True(t, page1.HasMore)
+	require.NotEmpty(t, page1.NextCursor)
+
+	// Docs created before the cursor position must not push already seen
+	// docs into the next page, and new docs after it must show up.
+	require.NoError(t, store.Create(ctx, "a", "content a"))
+	require.NoError(t, store.Create(ctx, "c", "content c"))
+	require.NoError(t, store.Create(ctx, "e", "content e"))
+	require.NoError(t, store.Delete(ctx, "b"))
+
+	page2, err := store.ListAfter(ctx, page1.NextCursor, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"e", "f"}, docMetadataIDs(page2.Items))
+	require.True(t, page2.HasMore)
+
+	page3, err := store.ListAfter(ctx, page2.NextCursor, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"h"}, docMetadataIDs(page3.Items))
+	assert.False(t, page3.HasMore)
+	assert.Empty(t, page3.NextCursor)
+}
+
+func TestListAfterInvalidCursor(t *testing.T) {
+	store := newTestStore(t)
+
+	_, err := store.ListAfter(context.Background(), "not-a-cursor", 10)
+	assert.ErrorIs(t, err, exec.ErrInvalidCursor)
+}
+
+func docMetadataIDs(items []agent.DocMetadata) []string {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
+
 func TestSearch(t *testing.T) {
 	store := newTestStore(t)
 	ctx := context.Background()
@@ -321,6 +401,43 @@ func TestSearch(t *testing.T) {
 	assert.Len(t, results, 2)
 }
 
+func TestSearchRanksByMatchCount(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, store.Create(ctx, "a-once", "deploy once"))
+	require.NoError(t, store.Create(ctx, "b-thrice", "deploy\nother\ndeploy\nmore\ndeploy"))
+	require.NoError(t, store.Create(ctx, "c-twice", "deploy\ndeploy"))
+	require.NoError(t, store.Create(ctx, "d-once", "deploy again"))
+
+	results, err := store.Search(ctx, "deploy")
+	require.NoError(t, err)
+
+	ids := make([]string, 0, len(results))
+	for _, r := range results {
+		ids = append(ids, r.ID)
+	}
+	assert.Equal(t, []string{"b-thrice", "c-twice", "a-once", "d-once"}, ids)
+}
+
+func TestSearchSnippetsIncludeContext(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	content := "line one\nline two\nthe needle is here\nline four\nline five\nline six"
+	require.NoError(t, store.Create(ctx, "doc", content))
+
+	results, err := store.Search(ctx, "needle")
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Len(t, results[0].Matches, 1)
+
+	match := results[0].Matches[0]
+	assert.Equal(t, 3, match.LineNumber)
+	assert.Equal(t, 1, match.StartLine)
+	assert.Equal(t, "line one\nline two\nthe needle is here\nline four\nline five", match.Line)
+}
+
 func TestSearchNoResults(t *testing.T) {
 	store := newTestStore(t)
 	ctx := context.Background()
@@ -1365,6 +1482,41 @@ func TestRenameDirectoryToExisting(t *testing.T) {
 	assert.Equal(t, "c1", doc.Content)
 }
 
+func TestRenameDirectoryToExistingWithOverwrite(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, store.Create(ctx, "folder1/doc", "c1"))
+	require.NoError(t, store.Create(ctx, "folder2/doc", "c2"))
+	require.NoError(t, store.Create(ctx, "folder2/other", "c3"))
+
+	err := store.Rename(ctx, "folder1", "folder2", agent.RenameOpts{Overwrite: true})
+	require.NoError(t, err)
+
+	doc, err := store.Get(ctx, "folder2/doc")
+	require.NoError(t, err)
+	assert.Equal(t, "c1", doc.Content)
+
+	_, err = store.Get(ctx, "folder2/other")
+	assert.ErrorIs(t, err, agent.ErrDocNotFound)
+	_, err = store.Get(ctx, "folder1/doc")
+	assert.ErrorIs(t, err, agent.ErrDocNotFound)
+}
+
+func TestRenameDirectoryOverwriteParentRejected(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, store.Create(ctx, "parent/child/doc", "content"))
+
+	err := store.Rename(ctx, "parent/child", "parent", agent.RenameOpts{Overwrite: true})
+	require.Error(t, err)
+
+	doc, err := store.Get(ctx, "parent/child/doc")
+	require.NoError(t, err)
+	assert.Equal(t, "content", doc.Content)
+}
+
 func TestRenameDirectoryNotFound(t *testing.T) {
 	store := newTestStore(t)
 	ctx := context.Background()
@@ -1502,6 +1654,93 @@ func TestRenameOsRenameError(t *testing.T) {
 	assert.Error(t, err)
 }
 
+// ---------------------------------------------------------------------------
+// Move Tests
+// ---------------------------------------------------------------------------
+
+func TestMoveNestedDirectory(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, store.Create(ctx, "a/top", "top"))
+	require.NoError(t, store.Create(ctx, "a/x/mid", "mid"))
+	require.NoError(t, store.Create(ctx, "a/x/y/leaf", "leaf"))
+	require.NoError(t, store.Create(ctx, "b/existing", "existing"))
+
+	require.NoError(t, store.Move(ctx, "a", "b/moved"))
+
+	doc, err := store.Get(ctx, "b/moved/x/y/leaf")
+	require.NoError(t, err)
+	assert.Equal(t, "leaf", doc.Content)
+	_, err = store.Get(ctx, "a/top")
+	assert.ErrorIs(t, err, agent.ErrDocNotFound)
+
+	result, err := store.List(ctx, defaultListOpts(1, 100))
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+	b := result.Items[0]
+	assert.Equal(t, "b", b.ID)
+	assert.Equal(t, "directory", b.Type)
+	require.Len(t, b.Children, 2)
+
+	moved := b.Children[0]
+	assert.Equal(t, "b/moved", moved.ID)
+	require.Len(t, moved.Children, 2)
+	assert.Equal(t, "b/moved/x", moved.Children[0].ID)
+	assert.Equal(t, "b/moved/top", moved.Children[1].ID)
+	require.Len(t, moved.Children[0].Children, 2)
+	assert.Equal(t, "b/moved/x/y", moved.Children[0].Children[0].ID)
+	assert.Equal(t, "b/moved/x/y/leaf", moved.Children[0].Children[0].Children[0].ID)
+	assert.Equal(t, "b/moved/x/mid", moved.Children[0].Children[1].ID)
+	assert.Equal(t, "b/existing", b.Children[1].ID)
+
+	_, err = os.Stat(filepath.Join(store.baseDir, "a"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestMoveTargetExistsMovesNothing(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, store.Create(ctx, "a/one", "1"))
+	require.NoError(t, store.Create(ctx, "a/two", "2"))
+	require.NoError(t, store.Create(ctx, "b/two", "taken"))
+
+	err := store.Move(ctx, "a", "b")
+	assert.ErrorIs(t, err, agent.ErrDocAlreadyExists)
+
+	_, err = store.Get(ctx, "a/one")
+	assert.NoError(t, err)
+	_, err = store.Get(ctx, "b/one")
+	assert.ErrorIs(t, err, agent.ErrDocNotFound)
+}
+
+func TestMoveInvalidTargetIDMovesNothing(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, store.Create(ctx, "a/short", "s"))
+	require.NoError(t, store.Create(ctx, "a/"+strings.Repeat("x", 200), "long"))
+
+	err := store.Move(ctx, "a", strings.Repeat("p", 60))
+	assert.ErrorIs(t, err, agent.ErrInvalidDocID)
+
+	_, err = store.Get(ctx, "a/short")
+	assert.NoError(t, err)
+}
+
+func TestMoveRejectsInvalidPrefixes(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, store.Create(ctx, "a/doc", "content"))
+
+	assert.ErrorIs(t, store.Move(ctx, "a", "a/sub"), agent.ErrInvalidDocID)
+	assert.ErrorIs(t, store.Move(ctx, "a", "a"), agent.ErrInvalidDocID)
+	assert.ErrorIs(t, store.Move(ctx, "../a", "b"), agent.ErrInvalidDocID)
+	assert.ErrorIs(t, store.Move(ctx, "missing", "b"), agent.ErrDocNotFound)
+}
+
 // ---------------------------------------------------------------------------
 // Directory Delete Tests
 // ---------------------------------------------------------------------------
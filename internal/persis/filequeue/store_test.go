@@ -239,3 +239,38 @@ func TestStore_All(t *testing.T) {
 	require.Equal(t, "test-name", data2.Name, "expected job name to be 'test-name'")
 	require.Equal(t, "test-dag", data2.ID, "expected job ID to be 'test-dag'")
 }
+
+func TestStore_DequeueOrderByPriority(t *testing.T) {
+	t.Parallel()
+
+	th := test.Setup(t)
+	store := filequeue.New(th.Config.Paths.QueueDir)
+
+	// Mixed priorities: high-priority items are dequeued first, and items of
+	// the same priority keep their enqueue (FIFO) order.
+	enqueued := []struct {
+		id       string
+		priority exec.QueuePriority
+	}{
+		{"low-1", exec.QueuePriorityLow},
+		{"high-1", exec.QueuePriorityHigh},
+		{"low-2", exec.QueuePriorityLow},
+		{"high-2", exec.QueuePriorityHigh},
+	}
+	for _, item := range enqueued {
+		require.NoError(t, store.Enqueue(th.Context, "batch", item.priority, exec.DAGRunRef{
+			Name: "batch-dag",
+			ID:   item.id,
+		}))
+	}
+
+	for _, want := range []string{"high-1", "high-2", "low-1", "low-2"} {
+		job, err := store.DequeueByName(th.Context, "batch")
+		require.NoError(t, err)
+		require.Contains(t, job.ID(), want)
+	}
+
+	length, err := store.Len(th.Context, "batch")
+	require.NoError(t, err)
+	require.Equal(t, 0, length)
+}
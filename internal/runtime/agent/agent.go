@@ -144,6 +144,9 @@ type Agent struct {
 	// stepRetry is the name of the step to retry, if specified.
 	stepRetry string
 
+	// stepRetryDownstream also retries the steps depending on stepRetry.
+	stepRetryDownstream bool
+
 	// workerID is the identifier of the worker executing this DAG run.
 	workerID string
 
@@ -238,6 +241,9 @@ type Options struct {
 	ExtraEnvs []string
 	// StepRetry is the name of the step to retry, if specified.
 	StepRetry string
+	// StepRetryDownstream also resets every step that transitively depends
+	// on StepRetry, so the step's whole subtree re-runs.
+	StepRetryDownstream bool
 	// WorkerID is the identifier of the worker executing this DAG run.
 	// For distributed execution, this is set to the worker's ID.
 	// For local execution, this defaults to "local".
@@ -319,6 +325,7 @@ func New(
 		registry:                   opts.ServiceRegistry,
 		extraEnvs:                  append([]string{}, opts.ExtraEnvs...),
 		stepRetry:                  opts.StepRetry,
+		stepRetryDownstream:        opts.StepRetryDownstream,
 		peerConfig:                 opts.PeerConfig,
 		workerID:                   opts.WorkerID,
 		statusPusher:               opts.StatusPusher,
@@ -633,7 +640,10 @@ func (a *Agent) Run(ctx context.Context) error {
 	// through the runtime context and set per-command working directories.
 
 	// Create a new container if the DAG has a container configuration.
-	if a.dag.Container != nil {
+	// With fallback_to_local, steps run on the host when Docker is unreachable.
+	if a.dag.Container != nil && docker.ShouldFallbackToLocal(ctx, *a.dag.Container) {
+		ctx = docker.WithLocalFallback(ctx)
+	} else if a.dag.Container != nil {
 		// Expand environment variables in container fields
 		expandedContainer, err := docker.EvalContainerFields(ctx, *a.dag.Container)
 		if err != nil {
@@ -695,6 +705,14 @@ func (a *Agent) Run(ctx context.Context) error {
 				Password: a.dag.SSH.Bastion.Password,
 			}
 		}
+		var proxyJump []ssh.BastionConfig
+		for _, jump := range a.dag.SSH.ProxyJump {
+			proxyJump = append(proxyJump, ssh.BastionConfig{
+				Host: jump.Host,
+				Port: jump.Port,
+				User: jump.User,
+			})
+		}
 
 		sshConfig, err := eval.Object(ctx, ssh.Config{
 			User:          a.dag.SSH.User,
@@ -708,6 +726,7 @@ func (a *Agent) Run(ctx context.Context) error {
 			ShellArgs:     a.dag.SSH.ShellArgs,
 			Timeout:       sshTimeout,
 			Bastion:       bastionCfg,
+			ProxyJump:     proxyJump,
 		}, runtime.GetEnv(ctx).UserEnvsMap())
 		if err != nil {
 			initErr = fmt.Errorf("failed to evaluate ssh config: %w", err)
@@ -1162,9 +1181,19 @@ func (a *Agent) Status(ctx context.Context) exec.DAGRunStatus {
 		transform.WithOnFailureNode(a.runner.HandlerNode(core.HandlerOnFailure)),
 		transform.WithOnAbortNode(a.runner.HandlerNode(core.HandlerOnAbort)),
 		transform.WithOnWaitNode(a.runner.HandlerNode(core.HandlerOnWait)),
+		transform.WithOnRetryNode(a.runner.HandlerNode(core.HandlerOnRetry)),
+		transform.WithHandlerChainNodes(core.HandlerOnInit, a.runner.HandlerNodes(core.HandlerOnInit)),
+		transform.WithHandlerChainNodes(core.HandlerOnExit, a.runner.HandlerNodes(core.HandlerOnExit)),
+		transform.WithHandlerChainNodes(core.HandlerOnSuccess, a.runner.HandlerNodes(core.HandlerOnSuccess)),
+		transform.WithHandlerChainNodes(core.HandlerOnFailure, a.runner.HandlerNodes(core.HandlerOnFailure)),
+		transform.WithHandlerChainNodes(core.HandlerOnAbort, a.runner.HandlerNodes(core.HandlerOnAbort)),
+		transform.WithHandlerChainNodes(core.HandlerOnWait, a.runner.HandlerNodes(core.HandlerOnWait)),
+		transform.WithHandlerChainNodes(core.HandlerOnRetry, a.runner.HandlerNodes(core.HandlerOnRetry)),
 		transform.WithAttemptID(a.dagRunAttemptID),
 		transform.WithHierarchyRefs(a.rootDAGRun, a.parentDAGRun),
 		transform.WithPreconditions(a.dag.Preconditions),
+		transform.WithFailedPrecondition(a.runner.FailedPrecondition()),
+		transform.WithCancelReason(a.runner.CancelReason()),
 		transform.WithWorkerID(a.workerID),
 		transform.WithTriggerType(a.triggerType),
 		transform.WithAutoRetryCount(a.currentAutoRetryCount()),
@@ -1330,12 +1359,23 @@ func (a *Agent) HandleHTTP(ctx context.Context) sock.HTTPHandlerFunc {
 			w.WriteHeader(http.StatusOK)
 			_, _ = w.Write(statusJSON)
 		case r.Method == http.MethodPost && stopRe.MatchString(r.URL.Path):
-			// Handle Stop request for the dag-run.
+			// Handle Stop request for the dag-run. A signal query parameter
+			// (e.g. from a stop schedule's stop_signal) replaces SIGTERM and
+			// takes precedence over the steps' signal_on_stop.
+			sig, allowOverride := os.Signal(syscall.SIGTERM), true
+			if name := r.URL.Query().Get("signal"); name != "" {
+				num := signal.GetSignalNum(name, 0)
+				if num == 0 {
+					encodeError(w, &httpError{Code: http.StatusBadRequest, Message: fmt.Sprintf("invalid signal: %s", name)})
+					return
+				}
+				sig, allowOverride = syscall.Signal(num), false
+			}
 			w.WriteHeader(http.StatusOK)
 			_, _ = w.Write([]byte("OK"))
 			go func() {
-				logger.Info(ctx, "Stop request received")
-				a.signal(ctx, syscall.SIGTERM, true)
+				logger.Info(ctx, "Stop request received", tag.Signal(sig.String()))
+				a.signal(ctx, sig, allowOverride)
 			}()
 		default:
 			// Unknown request
@@ -1397,6 +1437,7 @@ func (a *Agent) newRunner(attempt exec.DAGRunAttempt) *runtime.Runner {
 		OnFailure:       a.dag.HandlerOn.Failure,
 		OnAbort:         a.dag.HandlerOn.Abort,
 		OnWait:          a.dag.HandlerOn.Wait,
+		OnRetry:         a.dag.HandlerOn.Retry,
 	}
 
 	return runtime.New(cfg)
@@ -1450,12 +1491,7 @@ func (a *Agent) resolveSecrets(ctx context.Context) ([]string, error) {
 // buildEnvScopeForSecrets creates an EnvScope with DAG env vars for secret resolution.
 func (a *Agent) buildEnvScopeForSecrets() *eval.EnvScope {
 	envScope := eval.NewEnvScope(nil, true)
-	dagEnvs := make(map[string]string)
-	for _, env := range a.dag.Env {
-		if key, value, found := strings.Cut(env, "="); found {
-			dagEnvs[key] = value
-		}
-	}
+	dagEnvs := a.dag.EnvMap()
 	if len(dagEnvs) > 0 {
 		envScope = envScope.WithEntries(dagEnvs, eval.EnvSourceDAGEnv)
 	}
@@ -1729,9 +1765,14 @@ func (a *Agent) retryNodes() []*runtime.Node {
 	return nodes
 }
 
-// setupStepRetryPlan sets up the plan for retrying a specific step.
+// setupStepRetryPlan sets up the plan for retrying a specific step, and its
+// dependants when stepRetryDownstream is set.
 func (a *Agent) setupStepRetryPlan(nodes []*runtime.Node) error {
-	plan, err := runtime.CreateStepRetryPlan(a.dag, nodes, a.stepRetry)
+	createPlan := runtime.CreateStepRetryPlan
+	if a.stepRetryDownstream {
+		createPlan = runtime.CreateStepRetryPlanWithDescendants
+	}
+	plan, err := createPlan(a.dag, nodes, a.stepRetry)
 	if err != nil {
 		return err
 	}
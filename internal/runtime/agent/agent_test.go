@@ -254,6 +254,11 @@ func TestAgent_Run(t *testing.T) {
 		require.Equal(t, core.Aborted.String(), dagRunStatus.Status.String())
 		require.Equal(t, core.NodeNotStarted.String(), dagRunStatus.Nodes[0].Status.String())
 		require.Equal(t, core.NodeNotStarted.String(), dagRunStatus.Nodes[1].Status.String())
+
+		// The failing precondition is recorded on the status
+		require.NotNil(t, dagRunStatus.FailedPrecondition)
+		require.Equal(t, "0", dagRunStatus.FailedPrecondition.Expected)
+		require.Contains(t, dagRunStatus.FailedPrecondition.GetErrorMessage(), `expected "0", got "1"`)
 	})
 	t.Run("FinishWithError", func(t *testing.T) {
 		th := test.Setup(t)
@@ -319,7 +324,9 @@ steps:
 		dagAgent.RunError(t)
 
 		// Check if the status is saved correctly
-		require.Equal(t, core.Failed, dagAgent.Status(th.Context).Status)
+		dagRunStatus := dagAgent.Status(th.Context)
+		require.Equal(t, core.Failed, dagRunStatus.Status)
+		require.Equal(t, core.CancelReasonTimeout, dagRunStatus.CancelReason)
 	})
 	t.Run("ReceiveSignal", func(t *testing.T) {
 		th := test.Setup(t)
@@ -377,6 +384,28 @@ steps:
 		// Check if the exit handler is executed
 		require.Equal(t, core.NodeSucceeded.String(), dagRunStatus.OnExit.Status.String())
 	})
+	t.Run("RetryHandler", func(t *testing.T) {
+		th := test.Setup(t)
+		dag := th.DAG(t, `handler_on:
+  retry:
+    - command: "true"
+    - command: "true"
+steps:
+  - command: exit 1
+    retry_policy:
+      limit: 1
+      interval_sec: 0
+`)
+		dagAgent := dag.Agent()
+		dagAgent.RunError(t)
+
+		// The retry handler nodes are part of the saved status
+		dagRunStatus := dagAgent.Status(th.Context)
+		require.NotNil(t, dagRunStatus.OnRetry)
+		require.Equal(t, core.NodeSucceeded.String(), dagRunStatus.OnRetry.Status.String())
+		require.Len(t, dagRunStatus.HandlerChains[core.HandlerOnRetry], 1)
+		require.Equal(t, core.NodeSucceeded.String(), dagRunStatus.HandlerChains[core.HandlerOnRetry][0].Status.String())
+	})
 }
 
 func TestAgent_WorkingDirExpansion(t *testing.T) {
@@ -729,6 +758,14 @@ func TestAgent_HandleHTTP(t *testing.T) {
 		})
 		require.Equal(t, http.StatusNotFound, rw.status)
 
+		// Stop request with an unknown signal
+		rw = mockResponseWriter{}
+		dagAgent.HandleHTTP(th.Context)(&rw, &http.Request{
+			Method: "POST",
+			URL:    &url.URL{Path: "/stop", RawQuery: "signal=SIGBOGUS"},
+		})
+		require.Equal(t, http.StatusBadRequest, rw.status)
+
 		// Stop the DAG
 		dagAgent.Abort()
 		waitForCancel(t, done, 30*time.Second)
@@ -28,6 +28,7 @@ var errNoCommandSpecified = fmt.Errorf("no command specified")
 
 var _ executor.Executor = (*commandExecutor)(nil)
 var _ executor.ExitCoder = (*commandExecutor)(nil)
+var _ executor.StdinAware = (*commandExecutor)(nil)
 
 type commandExecutor struct {
 	mu         sync.Mutex
@@ -131,6 +132,10 @@ func (e *commandExecutor) SetStderr(out io.Writer) {
 	e.config.Stderr = out
 }
 
+func (e *commandExecutor) SetStdin(in io.Reader) {
+	e.config.Stdin = in
+}
+
 func (e *commandExecutor) Kill(sig os.Signal) error {
 	e.mu.Lock()
 	defer e.mu.Unlock()
@@ -209,6 +214,7 @@ type commandConfig struct {
 	Shell              []string // Shell command and arguments, e.g., ["/bin/sh", "-e"]
 	ShellCommandArgs   string   // The command string to execute via shell -c
 	ShellPackages      []string // Packages for nix-shell
+	Stdin              io.Reader
 	Stdout             io.Writer
 	Stderr             io.Writer
 	UserSpecifiedShell bool
@@ -296,6 +302,7 @@ func (cfg *commandConfig) newCmd(ctx context.Context, scriptFile string) (*exec.
 
 	cmd.Env = append(cmd.Env, runtime.AllEnvs(ctx)...)
 	cmd.Dir = cfg.Dir
+	cmd.Stdin = cfg.Stdin
 	cmd.Stdout = cfg.Stdout
 	cmd.Stderr = cfg.Stderr
 	cmdutil.SetupCommand(cmd)
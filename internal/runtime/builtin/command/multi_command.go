@@ -17,6 +17,7 @@ import (
 
 var _ executor.Executor = (*multiCommandExecutor)(nil)
 var _ executor.ExitCoder = (*multiCommandExecutor)(nil)
+var _ executor.StdinAware = (*multiCommandExecutor)(nil)
 
 // multiCommandExecutor executes multiple commands sequentially.
 // It stops on the first command that fails (non-zero exit code).
@@ -75,6 +76,16 @@ func (e *multiCommandExecutor) SetStderr(out io.Writer) {
 	}
 }
 
+// SetStdin feeds stdin to the first command; later commands would only see
+// an already drained reader.
+func (e *multiCommandExecutor) SetStdin(in io.Reader) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.configs) > 0 {
+		e.configs[0].Stdin = in
+	}
+}
+
 func (e *multiCommandExecutor) Kill(sig os.Signal) error {
 	e.mu.Lock()
 	current := e.current
@@ -43,6 +43,10 @@ type parallelExecutor struct {
 	errors   []error                             // Collects errors from failed executions
 	children map[string]*executor.SubDAGExecutor // Active child executors keyed by attempt
 
+	// runAttemptFn replaces runAttempt when set, so that the scheduling of
+	// attempts can be exercised without starting sub DAG-runs.
+	runAttemptFn func(context.Context, scheduledAttempt) (*exec1.RunStatus, error)
+
 	runCancel  context.CancelFunc
 	isCanceled atomic.Bool
 	cancel     chan struct{}
@@ -135,6 +139,21 @@ func (e *parallelExecutor) Run(ctx context.Context) error {
 
 	resultCh := make(chan attemptResult, len(e.runParamsList))
 	inFlight := 0
+	runAttempt := e.runAttempt
+	if e.runAttemptFn != nil {
+		runAttempt = e.runAttemptFn
+	}
+
+	// The node already holds one slot of the DAG-wide step budget; every
+	// additional concurrent attempt must take another, so the effective
+	// concurrency is min(maxConcurrent, remaining maxActiveSteps budget).
+	slots := runtime.GetStepSlots(ctx)
+	extraSlots := 0
+	defer func() {
+		for ; extraSlots > 0; extraSlots-- {
+			slots.Release()
+		}
+	}()
 
 	for len(pending) > 0 || inFlight > 0 {
 		if e.cancelled() {
@@ -142,6 +161,7 @@ func (e *parallelExecutor) Run(ctx context.Context) error {
 		}
 
 		now := time.Now()
+		slotReleased := slots.Released()
 
 		for e.maxConcurrent == 0 || inFlight < e.maxConcurrent {
 			if e.cancelled() {
@@ -152,6 +172,12 @@ func (e *parallelExecutor) Run(ctx context.Context) error {
 			if idx < 0 {
 				break
 			}
+			if inFlight > 0 {
+				if !slots.TryAcquire() {
+					break
+				}
+				extraSlots++
+			}
 
 			attempt := pending[idx]
 			pending = append(pending[:idx], pending[idx+1:]...)
@@ -160,7 +186,7 @@ func (e *parallelExecutor) Run(ctx context.Context) error {
 			inFlight++
 
 			go func(a scheduledAttempt) {
-				res, err := e.runAttempt(runCtx, a)
+				res, err := runAttempt(runCtx, a)
 				select {
 				case resultCh <- attemptResult{attempt: a, result: res, err: err}:
 				case <-runCtx.Done():
@@ -184,6 +210,10 @@ func (e *parallelExecutor) Run(ctx context.Context) error {
 		select {
 		case res := <-resultCh:
 			inFlight--
+			if extraSlots > 0 {
+				slots.Release()
+				extraSlots--
+			}
 			delete(busyRuns, res.attempt.runParams.RunID)
 
 			if res.result != nil {
@@ -238,6 +268,8 @@ func (e *parallelExecutor) Run(ctx context.Context) error {
 			return runCtx.Err()
 
 		case <-timerCh:
+
+		case <-slotReleased:
 		}
 
 		if timer != nil {
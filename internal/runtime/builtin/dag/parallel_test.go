@@ -0,0 +1,167 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dag
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dagucloud/dagu/internal/core"
+	exec1 "github.com/dagucloud/dagu/internal/core/exec"
+	"github.com/dagucloud/dagu/internal/runtime"
+	"github.com/dagucloud/dagu/internal/runtime/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParallelExecutor_OutputResults(t *testing.T) {
+	t.Parallel()
+
+	var stdout bytes.Buffer
+	e := &parallelExecutor{
+		stdout: &stdout,
+		runParamsList: []executor.RunParams{
+			{RunID: "run-1", Params: "ITEM=a", DAGName: "child"},
+			{RunID: "run-2", Params: "ITEM=b", DAGName: "child"},
+		},
+		// The second child finishes first; the aggregated outputs still
+		// follow the order of the items.
+		results: map[string]*exec1.RunStatus{
+			"run-2": {Name: "child", DAGRunID: "run-2", Status: core.Succeeded, Outputs: map[string]string{"OUT": "second"}},
+			"run-1": {Name: "child", DAGRunID: "run-1", Status: core.Succeeded, Outputs: map[string]string{"OUT": "first"}},
+		},
+	}
+
+	require.NoError(t, e.outputResults())
+
+	var got struct {
+		Summary struct {
+			Total     int `json:"total"`
+			Succeeded int `json:"succeeded"`
+			Failed    int `json:"failed"`
+		} `json:"summary"`
+		Outputs []map[string]string `json:"outputs"`
+	}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &got))
+
+	assert.Equal(t, 2, got.Summary.Total)
+	assert.Equal(t, 2, got.Summary.Succeeded)
+	assert.Equal(t, 0, got.Summary.Failed)
+	assert.Equal(t, []map[string]string{
+		{"OUT": "first"},
+		{"OUT": "second"},
+	}, got.Outputs)
+}
+
+func TestParallelExecutor_StepSlots(t *testing.T) {
+	t.Parallel()
+
+	// newExecutor returns a parallel executor over units sub DAG-runs whose
+	// attempts only record how many of them run at the same time.
+	newExecutor := func(units, maxConcurrent int) (*parallelExecutor, func() int) {
+		var mu sync.Mutex
+		active, peak := 0, 0
+		e := &parallelExecutor{
+			step:          core.Step{Name: "fanout", SubDAG: &core.SubDAG{Name: "child"}},
+			stdout:        &bytes.Buffer{},
+			maxConcurrent: maxConcurrent,
+			results:       make(map[string]*exec1.RunStatus),
+			children:      make(map[string]*executor.SubDAGExecutor),
+			cancel:        make(chan struct{}),
+			runAttemptFn: func(_ context.Context, a scheduledAttempt) (*exec1.RunStatus, error) {
+				mu.Lock()
+				active++
+				peak = max(peak, active)
+				mu.Unlock()
+				time.Sleep(30 * time.Millisecond)
+				mu.Lock()
+				active--
+				mu.Unlock()
+				return &exec1.RunStatus{Name: "child", DAGRunID: a.runParams.RunID, Status: core.Succeeded}, nil
+			},
+		}
+		for i := range units {
+			e.runParamsList = append(e.runParamsList, executor.RunParams{RunID: fmt.Sprintf("run-%d", i), DAGName: "child"})
+		}
+		return e, func() int {
+			mu.Lock()
+			defer mu.Unlock()
+			return peak
+		}
+	}
+
+	// freeSlots drains the budget and reports how many slots were free.
+	freeSlots := func(slots *runtime.StepSlots) int {
+		n := 0
+		for slots.TryAcquire() {
+			n++
+		}
+		return n
+	}
+
+	t.Run("BoundedByStepBudget", func(t *testing.T) {
+		t.Parallel()
+
+		slots := runtime.NewStepSlots(3)
+		require.True(t, slots.TryAcquire()) // another running step
+		require.True(t, slots.TryAcquire()) // the parallel step itself
+		ctx := runtime.WithStepSlots(context.Background(), slots)
+
+		e, peak := newExecutor(8, 5)
+		require.NoError(t, e.Run(ctx))
+
+		assert.Equal(t, 2, peak(), "the parallel step may only use the one remaining slot")
+		assert.Len(t, e.results, 8)
+		assert.Equal(t, 1, freeSlots(slots), "the extra slots are returned to the budget")
+	})
+
+	t.Run("BoundedByMaxConcurrent", func(t *testing.T) {
+		t.Parallel()
+
+		slots := runtime.NewStepSlots(10)
+		require.True(t, slots.TryAcquire()) // the parallel step itself
+		ctx := runtime.WithStepSlots(context.Background(), slots)
+
+		e, peak := newExecutor(8, 2)
+		require.NoError(t, e.Run(ctx))
+
+		assert.Equal(t, 2, peak())
+		assert.Equal(t, 9, freeSlots(slots))
+	})
+
+	t.Run("UsesReleasedSlots", func(t *testing.T) {
+		t.Parallel()
+
+		slots := runtime.NewStepSlots(2)
+		require.True(t, slots.TryAcquire()) // another running step
+		require.True(t, slots.TryAcquire()) // the parallel step itself
+		ctx := runtime.WithStepSlots(context.Background(), slots)
+
+		// The other step finishes while the fan-out is running.
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			slots.Release()
+		}()
+
+		e, peak := newExecutor(8, 5)
+		require.NoError(t, e.Run(ctx))
+
+		assert.Equal(t, 2, peak(), "the released slot is picked up by the fan-out")
+		assert.Equal(t, 1, freeSlots(slots))
+	})
+
+	t.Run("UnlimitedBudget", func(t *testing.T) {
+		t.Parallel()
+
+		e, peak := newExecutor(6, 3)
+		require.NoError(t, e.Run(context.Background()))
+
+		assert.Equal(t, 3, peak())
+	})
+}
@@ -0,0 +1,155 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package docker
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dagucloud/dagu/internal/cmn/logger"
+	"github.com/moby/moby/client"
+)
+
+// BuildConfig describes how to build the container image from a local context.
+type BuildConfig struct {
+	// Context is the absolute path of the build context directory.
+	Context string
+	// Dockerfile is the Dockerfile path relative to Context. Empty uses Docker's default.
+	Dockerfile string
+}
+
+// buildImage builds c.cfg.Image from the configured build context.
+func (c *Client) buildImage(ctx context.Context, cli *client.Client) error {
+	build := c.cfg.Build
+	logger.Info(ctx, "Building the image",
+		slog.String("image", c.cfg.Image),
+		slog.String("context", build.Context),
+		slog.String("dockerfile", build.Dockerfile),
+	)
+
+	info, err := os.Stat(build.Context)
+	if err != nil {
+		return fmt.Errorf("failed to access build context: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("build context %s is not a directory", build.Context)
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		_ = pw.CloseWithError(tarBuildContext(build.Context, pw))
+	}()
+	defer func() { _ = pr.Close() }()
+
+	resp, err := cli.ImageBuild(ctx, pr, client.ImageBuildOptions{
+		Tags:       []string{c.cfg.Image},
+		Dockerfile: build.Dockerfile,
+		Remove:     true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build image %s: %w", c.cfg.Image, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := readBuildOutput(ctx, resp.Body); err != nil {
+		return fmt.Errorf("failed to build image %s: %w", c.cfg.Image, err)
+	}
+	logger.Infof(ctx, "Successfully built the image %q", c.cfg.Image)
+	return nil
+}
+
+// buildMessage is one entry of the JSON stream returned by the build API.
+type buildMessage struct {
+	Stream      string `json:"stream"`
+	Error       string `json:"error"`
+	ErrorDetail *struct {
+		Message string `json:"message"`
+	} `json:"errorDetail"`
+}
+
+// readBuildOutput drains the build output stream, logging progress and
+// returning the first error reported by the daemon.
+func readBuildOutput(ctx context.Context, r io.Reader) error {
+	dec := json.NewDecoder(r)
+	for {
+		var msg buildMessage
+		if err := dec.Decode(&msg); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("failed to read build output: %w", err)
+		}
+		if msg.ErrorDetail != nil && msg.ErrorDetail.Message != "" {
+			return errors.New(msg.ErrorDetail.Message)
+		}
+		if msg.Error != "" {
+			return errors.New(msg.Error)
+		}
+		if line := strings.TrimSpace(msg.Stream); line != "" {
+			logger.Debug(ctx, "Docker: build output", slog.String("line", line))
+		}
+	}
+}
+
+// tarBuildContext writes the regular files and directories under dir to w as
+// a tar archive.
+func tarBuildContext(dir string, w io.Writer) error {
+	tw := tar.NewWriter(w)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		link := ""
+		if info.Mode()&fs.ModeSymlink != 0 {
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		} else if !info.Mode().IsRegular() && !info.IsDir() {
+			// Skip sockets, devices, and other special files
+			return nil
+		}
+		hdr, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(path) //nolint:gosec
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(tw, f)
+		_ = f.Close()
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to archive build context: %w", err)
+	}
+	return tw.Close()
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package docker
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTarBuildContext(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Dockerfile"), []byte("FROM alpine\n"), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "src"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "src", "main.sh"), []byte("echo hi\n"), 0o600))
+
+	var buf bytes.Buffer
+	require.NoError(t, tarBuildContext(dir, &buf))
+
+	files := map[string]string{}
+	tr := tar.NewReader(&buf)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[hdr.Name] = string(data)
+	}
+	require.Equal(t, map[string]string{
+		"Dockerfile":  "FROM alpine\n",
+		"src":         "",
+		"src/main.sh": "echo hi\n",
+	}, files)
+}
+
+func TestReadBuildOutput(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		out := `{"stream":"Step 1/1 : FROM alpine\n"}{"stream":"Successfully built abc\n"}`
+		require.NoError(t, readBuildOutput(context.Background(), strings.NewReader(out)))
+	})
+
+	t.Run("ErrorDetail", func(t *testing.T) {
+		out := `{"stream":"Step 1/2 : FROM alpine\n"}
+{"errorDetail":{"message":"The command '/bin/sh -c false' returned a non-zero code: 1"},"error":"The command '/bin/sh -c false' returned a non-zero code: 1"}`
+		err := readBuildOutput(context.Background(), strings.NewReader(out))
+		require.EqualError(t, err, "The command '/bin/sh -c false' returned a non-zero code: 1")
+	})
+
+	t.Run("MalformedStream", func(t *testing.T) {
+		err := readBuildOutput(context.Background(), strings.NewReader(`{"stream":`))
+		require.ErrorContains(t, err, "failed to read build output")
+	})
+}
@@ -333,6 +333,7 @@ func (c *Client) CreateContainerKeepAlive(ctx context.Context) error {
 
 	// Choose startup mode and command
 	var cmd []string
+	clearEntrypoint := true
 	mode := c.cfg.Startup
 	if mode == "" {
 		mode = "keepalive"
@@ -359,6 +360,8 @@ func (c *Client) CreateContainerKeepAlive(ctx context.Context) error {
 			return fmt.Errorf("startup 'command' requires non-empty command array")
 		}
 		cmd = append([]string{}, c.cfg.StartCmd...)
+		// Run the command through a user-specified entrypoint
+		clearEntrypoint = len(c.cfg.Container.Entrypoint) == 0
 	default:
 		return fmt.Errorf("invalid startup mode: %s", mode)
 	}
@@ -371,7 +374,7 @@ func (c *Client) CreateContainerKeepAlive(ctx context.Context) error {
 	c.cancel = cancel
 	c.cancelMu.Unlock()
 
-	ctID, err := c.startNewContainer(ctx, c.cfg.ContainerName, c.cli, cmd, true)
+	ctID, err := c.startNewContainer(ctx, c.cfg.ContainerName, c.cli, cmd, clearEntrypoint)
 	if err != nil {
 		return fmt.Errorf("failed to start a new container: %w", err)
 	}
@@ -382,8 +385,11 @@ func (c *Client) CreateContainerKeepAlive(ctx context.Context) error {
 	if waitMode == "" {
 		waitMode = "running"
 	}
-	// Default timeout for readiness
-	readyCtx, cancel := context.WithTimeout(ctx, defaultReadinessTimeout)
+	readyTimeout := c.cfg.ReadinessTimeout
+	if readyTimeout <= 0 {
+		readyTimeout = defaultReadinessTimeout
+	}
+	readyCtx, cancel := context.WithTimeout(ctx, readyTimeout)
 	defer cancel()
 
 	switch waitMode {
@@ -694,7 +700,12 @@ func (c *Client) startNewContainer(ctx context.Context, name string, cli *client
 		slog.Bool("should-pull", pull),
 	)
 
-	if pull {
+	if pull && c.cfg.Build != nil {
+		if err := c.buildImage(ctx, cli); err != nil {
+			logger.Error(ctx, "Docker: startNewContainer image build failed", tag.Error(err))
+			return "", err
+		}
+	} else if pull {
 		logger.Infof(ctx, "Pulling the image %q", c.cfg.Image)
 		logger.Debug(ctx, "Docker: startNewContainer beginning image pull")
 
@@ -702,7 +713,7 @@ func (c *Client) startNewContainer(ctx context.Context, name string, cli *client
 		var pullOpts client.ImagePullOptions
 		if c.authManager != nil {
 			var err error
-			pullOpts, err = c.authManager.GetPullOptions(c.cfg.Image, c.platform)
+			pullOpts, err = c.authManager.GetPullOptions(ctx, c.cfg.Image, c.platform)
 			if err != nil {
 				logger.Error(ctx, "Docker: startNewContainer failed to get pull options", tag.Error(err))
 				return "", fmt.Errorf("failed to get pull options: %w", err)
@@ -1152,7 +1163,42 @@ func (c *Client) hasHealthcheck(ctx context.Context, cli *client.Client, id stri
 
 // waitHealthy waits until the container health status is healthy.
 func (c *Client) waitHealthy(ctx context.Context, cli *client.Client, id string) error {
-	ticker := time.NewTicker(defaultPollInterval)
+	probe := func(ctx context.Context) (healthState, error) {
+		info, err := inspectContainer(ctx, cli, id)
+		if err != nil {
+			return healthState{}, fmt.Errorf("failed to inspect container %s: %w", id, err)
+		}
+		var st healthState
+		if info.State != nil {
+			st.Status = strings.ToLower(string(info.State.Status))
+			st.ExitCode = info.State.ExitCode
+			if info.State.Health != nil {
+				st.Health = strings.ToLower(string(info.State.Health.Status))
+				st.FailingStreak = info.State.Health.FailingStreak
+			}
+		}
+		return st, nil
+	}
+	if err := pollUntilHealthy(ctx, defaultPollInterval, probe); err != nil {
+		return fmt.Errorf("container %s: %w", id, err)
+	}
+	logger.Info(ctx, "Container ready (healthy)", slog.String("id", id))
+	return nil
+}
+
+// healthState is a snapshot of a container's status and health.
+type healthState struct {
+	Status        string
+	Health        string
+	FailingStreak int
+	ExitCode      int
+}
+
+// pollUntilHealthy calls probe every interval until it reports a healthy
+// container. It fails as soon as Docker marks the container unhealthy (its
+// healthcheck exhausted the configured retries) or the container stops.
+func pollUntilHealthy(ctx context.Context, interval time.Duration, probe func(context.Context) (healthState, error)) error {
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 	var last string
 	for {
@@ -1160,20 +1206,19 @@ func (c *Client) waitHealthy(ctx context.Context, cli *client.Client, id string)
 		case <-ctx.Done():
 			return fmt.Errorf("readiness timeout waiting for healthy; last health=%s: %w", last, ctx.Err())
 		case <-ticker.C:
-			info, err := inspectContainer(ctx, cli, id)
+			st, err := probe(ctx)
 			if err != nil {
-				return fmt.Errorf("failed to inspect container %s: %w", id, err)
+				return err
 			}
-			if info.State != nil && info.State.Health != nil {
-				status := string(info.State.Health.Status)
-				last = status
-				if strings.ToLower(status) == "healthy" {
-					logger.Info(ctx, "Container ready (healthy)",
-						slog.String("id", id),
-					)
-					return nil
-				}
+			switch {
+			case st.Health == "healthy":
+				return nil
+			case st.Health == "unhealthy":
+				return fmt.Errorf("healthcheck failed after %d consecutive attempts", st.FailingStreak)
+			case slices.Contains(terminalContainerStatuses, st.Status):
+				return fmt.Errorf("container stopped before becoming healthy; status=%s, exitCode=%d", st.Status, st.ExitCode)
 			}
+			last = st.Health
 		}
 	}
 }
@@ -4,12 +4,14 @@
 package docker
 
 import (
+	"context"
 	"net/netip"
 	"os"
 	"path/filepath"
 	"runtime"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/dagucloud/dagu/internal/core"
 	"github.com/moby/moby/api/types/container"
@@ -719,6 +721,53 @@ func TestLoadConfigFromMap(t *testing.T) {
 				ExecOptions: &client.ExecCreateOptions{},
 			},
 		},
+		{
+			name: "ResourceShortcutsSetHostResources",
+			input: map[string]any{
+				"image":  "alpine",
+				"cpus":   1.5,
+				"memory": "512m",
+			},
+			expected: &Config{
+				Image:     "alpine",
+				Pull:      core.PullPolicyMissing,
+				Container: &container.Config{},
+				Host: &container.HostConfig{
+					Resources: container.Resources{NanoCPUs: 1_500_000_000, Memory: 512 * 1024 * 1024},
+				},
+				Network:     &network.NetworkingConfig{},
+				ExecOptions: &client.ExecCreateOptions{},
+			},
+		},
+		{
+			name: "ResourceShortcutsDoNotOverrideHost",
+			input: map[string]any{
+				"image":  "alpine",
+				"memory": "512m",
+				"host": map[string]any{
+					"Resources": map[string]any{"Memory": 1024},
+				},
+			},
+			expected: &Config{
+				Image:     "alpine",
+				Pull:      core.PullPolicyMissing,
+				Container: &container.Config{},
+				Host: &container.HostConfig{
+					Resources: container.Resources{Memory: 1024},
+				},
+				Network:     &network.NetworkingConfig{},
+				ExecOptions: &client.ExecCreateOptions{},
+			},
+		},
+		{
+			name: "InvalidMemoryShortcut",
+			input: map[string]any{
+				"image":  "alpine",
+				"memory": "lots",
+			},
+			expectError: true,
+			errorMsg:    "failed to parse memory",
+		},
 	}
 
 	for _, tt := range tests {
@@ -747,6 +796,8 @@ func TestLoadConfigFromMap(t *testing.T) {
 			assert.Equal(t, tt.expected.Host.AutoRemove, result.Host.AutoRemove)
 			assert.Equal(t, tt.expected.Host.Privileged, result.Host.Privileged)
 			assert.Equal(t, tt.expected.Host.Binds, result.Host.Binds)
+			assert.Equal(t, tt.expected.Host.NanoCPUs, result.Host.NanoCPUs)
+			assert.Equal(t, tt.expected.Host.Memory, result.Host.Memory)
 
 			// Compare exec options
 			assert.Equal(t, tt.expected.ExecOptions.User, result.ExecOptions.User)
@@ -1528,3 +1579,48 @@ func TestDockerClientRespectsDockerHostEnv(t *testing.T) {
 
 	assert.Equal(t, "tcp://test-host:2375", cli.DaemonHost())
 }
+
+func TestPollUntilHealthy(t *testing.T) {
+	// sequence returns a probe that reports the given states in order and
+	// repeats the last one.
+	sequence := func(states ...healthState) (func(context.Context) (healthState, error), *int) {
+		calls := 0
+		return func(context.Context) (healthState, error) {
+			st := states[min(calls, len(states)-1)]
+			calls++
+			return st, nil
+		}, &calls
+	}
+	starting := healthState{Status: "running", Health: "starting"}
+
+	t.Run("HealthyAfterRetries", func(t *testing.T) {
+		probe, calls := sequence(starting, starting, healthState{Status: "running", Health: "healthy"})
+		err := pollUntilHealthy(context.Background(), time.Millisecond, probe)
+		require.NoError(t, err)
+		assert.Equal(t, 3, *calls)
+	})
+
+	t.Run("UnhealthyFailsFast", func(t *testing.T) {
+		probe, calls := sequence(starting, healthState{Status: "running", Health: "unhealthy", FailingStreak: 3})
+		err := pollUntilHealthy(context.Background(), time.Millisecond, probe)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "healthcheck failed after 3 consecutive attempts")
+		assert.Equal(t, 2, *calls)
+	})
+
+	t.Run("ContainerExited", func(t *testing.T) {
+		probe, _ := sequence(starting, healthState{Status: "exited", Health: "starting", ExitCode: 1})
+		err := pollUntilHealthy(context.Background(), time.Millisecond, probe)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "status=exited, exitCode=1")
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		probe, _ := sequence(starting)
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		err := pollUntilHealthy(ctx, time.Millisecond, probe)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Contains(t, err.Error(), "last health=starting")
+	})
+}
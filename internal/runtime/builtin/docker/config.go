@@ -4,11 +4,15 @@
 package docker
 
 import (
+	"cmp"
 	"fmt"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/dagucloud/dagu/internal/cmn/stringutil"
 	"github.com/dagucloud/dagu/internal/core"
+	"github.com/dagucloud/dagu/internal/core/spec"
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/moby/moby/api/types/container"
@@ -42,11 +46,15 @@ type Config struct {
 	AutoRemove bool
 	// AuthManager is responsible for managing registry authentication.
 	AuthManager *RegistryAuthManager
+	// Build builds Image from a local context instead of pulling it.
+	Build *BuildConfig
 
 	// Startup mode for DAG-level container: "keepalive" (default) | "entrypoint" | "command"
 	Startup string
 	// WaitFor readiness gate: "running" (default) | "healthy"
 	WaitFor string
+	// ReadinessTimeout bounds the readiness wait. Zero uses the default.
+	ReadinessTimeout time.Duration
 	// StartCmd command for startup when startup == "command"
 	StartCmd []string
 	// LogPattern optional regex to wait for in logs before proceeding (if empty, no wait)
@@ -73,6 +81,8 @@ func LoadConfigFromMap(data map[string]any, registryAuths map[string]*core.AuthC
 		WorkingDir string   `mapstructure:"working_dir"`
 		Volumes    []string `mapstructure:"volumes"`
 		Shell      []string `mapstructure:"shell"`
+		CPUs       string   `mapstructure:"cpus"`
+		Memory     string   `mapstructure:"memory"`
 	}{}
 
 	md, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
@@ -151,6 +161,11 @@ func LoadConfigFromMap(data map[string]any, registryAuths map[string]*core.AuthC
 		ret.Host.Binds = append(ret.Host.Binds, ret.Volumes...)
 	}
 
+	// cpus/memory -> host resource limits (only if not already set)
+	if err := applyResourceLimits(&ret.Host.Resources, ret.CPUs, ret.Memory); err != nil {
+		return nil, err
+	}
+
 	// Set up registry authentication if provided
 	var authManager *RegistryAuthManager
 	if len(registryAuths) > 0 {
@@ -200,6 +215,9 @@ func LoadConfig(workDir string, ct core.Container, registryAuths map[string]*cor
 		User:       ct.User,
 		WorkingDir: ct.GetWorkingDir(),
 	}
+	if len(ct.Entrypoint) > 0 {
+		containerConfig.Entrypoint = append([]string{}, ct.Entrypoint...)
+	}
 
 	// Convert healthcheck if provided
 	if ct.Healthcheck != nil {
@@ -265,6 +283,30 @@ func LoadConfig(workDir string, ct core.Container, registryAuths map[string]*cor
 		hostConfig.RestartPolicy = rp
 	}
 
+	if err := applyResourceLimits(&hostConfig.Resources, ct.CPUs, ct.Memory); err != nil {
+		return nil, err
+	}
+
+	// A custom healthcheck gates steps on the container becoming healthy
+	// unless a readiness mode was chosen explicitly.
+	waitFor := strings.ToLower(strings.TrimSpace(string(ct.WaitFor)))
+	var readinessTimeout time.Duration
+	if hc := ct.Healthcheck; hc != nil && len(hc.Test) > 0 && hc.Test[0] != "NONE" {
+		if waitFor == "" {
+			waitFor = "healthy"
+		}
+		readinessTimeout = healthcheckReadinessTimeout(hc)
+	}
+
+	var build *BuildConfig
+	if ct.Build != nil {
+		buildContext := ct.Build.Context
+		if !filepath.IsAbs(buildContext) {
+			buildContext = filepath.Join(workDir, buildContext)
+		}
+		build = &BuildConfig{Context: buildContext, Dockerfile: ct.Build.Dockerfile}
+	}
+
 	// Set up registry authentication if provided
 	var authManager *RegistryAuthManager
 	if len(registryAuths) > 0 {
@@ -272,24 +314,64 @@ func LoadConfig(workDir string, ct core.Container, registryAuths map[string]*cor
 	}
 
 	return loadDefaults(&Config{
-		ContainerName: ct.Name,
-		Image:         ct.Image,
-		Platform:      ct.Platform,
-		Pull:          ct.PullPolicy,
-		AutoRemove:    autoRemove,
-		Container:     containerConfig,
-		Host:          hostConfig,
-		Network:       networkConfig,
-		ExecOptions:   execOptions,
-		Startup:       strings.ToLower(strings.TrimSpace(string(ct.Startup))),
-		WaitFor:       strings.ToLower(strings.TrimSpace(string(ct.WaitFor))),
-		LogPattern:    ct.LogPattern,
-		StartCmd:      append([]string{}, ct.Command...),
-		AuthManager:   authManager,
-		Shell:         append([]string{}, ct.Shell...),
+		ContainerName:    ct.Name,
+		Image:            ct.Image,
+		Platform:         ct.Platform,
+		Pull:             ct.PullPolicy,
+		AutoRemove:       autoRemove,
+		Container:        containerConfig,
+		Host:             hostConfig,
+		Network:          networkConfig,
+		ExecOptions:      execOptions,
+		Startup:          strings.ToLower(strings.TrimSpace(string(ct.Startup))),
+		WaitFor:          waitFor,
+		ReadinessTimeout: readinessTimeout,
+		LogPattern:       ct.LogPattern,
+		StartCmd:         append([]string{}, ct.Command...),
+		AuthManager:      authManager,
+		Build:            build,
+		Shell:            append([]string{}, ct.Shell...),
 	}), nil
 }
 
+// applyResourceLimits sets the CPU and memory limits on resources unless they
+// are already set.
+func applyResourceLimits(resources *container.Resources, cpus, memory string) error {
+	nanoCPUs, err := spec.ParseContainerCPUs(cpus)
+	if err != nil {
+		return fmt.Errorf("failed to parse cpus: %w", err)
+	}
+	if nanoCPUs > 0 && resources.NanoCPUs == 0 {
+		resources.NanoCPUs = nanoCPUs
+	}
+	memBytes, err := spec.ParseContainerMemory(memory)
+	if err != nil {
+		return fmt.Errorf("failed to parse memory: %w", err)
+	}
+	if memBytes > 0 && resources.Memory == 0 {
+		resources.Memory = memBytes
+	}
+	return nil
+}
+
+// Docker's defaults for healthcheck fields left unset.
+const (
+	dockerHealthcheckInterval = 30 * time.Second
+	dockerHealthcheckTimeout  = 30 * time.Second
+	dockerHealthcheckRetries  = 3
+)
+
+// healthcheckReadinessTimeout returns how long to wait for a container to
+// become healthy: long enough for Docker to run every retry after the start
+// period, and never shorter than the default readiness timeout.
+func healthcheckReadinessTimeout(hc *core.Healthcheck) time.Duration {
+	interval := cmp.Or(hc.Interval, dockerHealthcheckInterval)
+	timeout := cmp.Or(hc.Timeout, dockerHealthcheckTimeout)
+	retries := cmp.Or(hc.Retries, dockerHealthcheckRetries)
+	budget := hc.StartPeriod + time.Duration(retries+1)*(interval+timeout)
+	return max(budget, defaultReadinessTimeout)
+}
+
 func loadDefaults(cfg *Config) *Config {
 	if cfg.Startup == "" {
 		cfg.Startup = "keepalive"
@@ -323,6 +405,8 @@ var configSchema = &jsonschema.Schema{
 		"network":        {Type: "object", AdditionalProperties: &jsonschema.Schema{}},
 		"exec":           {Type: "object", AdditionalProperties: &jsonschema.Schema{}},
 		"shell":          {Type: "array", Items: &jsonschema.Schema{Type: "string"}, Description: "Shell wrapper for step commands (e.g., [\"/bin/bash\", \"-c\"])"},
+		"cpus":           {Types: []string{"number", "string"}, Description: "CPU limit for new containers (e.g., 1.5)"},
+		"memory":         {Types: []string{"integer", "string"}, Description: "Memory limit for new containers (e.g., 512m, 2g)"},
 	},
 	AllOf: []*jsonschema.Schema{
 		// Require at least one of image or container_name
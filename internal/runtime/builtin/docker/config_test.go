@@ -4,6 +4,7 @@
 package docker
 
 import (
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -42,6 +43,11 @@ func TestDockerConfigSchema(t *testing.T) {
 			config:  map[string]any{"container_name": "my-container", "exec": map[string]any{"user": "root"}},
 			wantErr: false,
 		},
+		{
+			name:    "resource limits",
+			config:  map[string]any{"image": "alpine", "cpus": 1.5, "memory": "512m"},
+			wantErr: false,
+		},
 		{
 			name:    "empty config",
 			config:  map[string]any{},
@@ -139,3 +145,96 @@ func TestDockerConfig_Healthcheck_DurationsPreserved(t *testing.T) {
 	require.Equal(t, 10*time.Second, cfg.Container.Healthcheck.StartPeriod)
 	require.Equal(t, 5, cfg.Container.Healthcheck.Retries)
 }
+
+func TestDockerConfig_HealthcheckReadiness(t *testing.T) {
+	hc := &core.Healthcheck{
+		Test:     []string{"CMD", "pg_isready"},
+		Interval: 5 * time.Second,
+		Timeout:  3 * time.Second,
+		Retries:  5,
+	}
+
+	t.Run("HealthcheckImpliesHealthy", func(t *testing.T) {
+		cfg, err := LoadConfig("", core.Container{Image: "postgres:alpine", Healthcheck: hc}, nil)
+		require.NoError(t, err)
+		require.Equal(t, "healthy", cfg.WaitFor)
+		require.Equal(t, defaultReadinessTimeout, cfg.ReadinessTimeout)
+	})
+
+	t.Run("ExplicitWaitForKept", func(t *testing.T) {
+		cfg, err := LoadConfig("", core.Container{Image: "postgres:alpine", Healthcheck: hc, WaitFor: core.WaitForRunning}, nil)
+		require.NoError(t, err)
+		require.Equal(t, "running", cfg.WaitFor)
+	})
+
+	t.Run("DisabledHealthcheck", func(t *testing.T) {
+		cfg, err := LoadConfig("", core.Container{
+			Image:       "postgres:alpine",
+			Healthcheck: &core.Healthcheck{Test: []string{"NONE"}},
+		}, nil)
+		require.NoError(t, err)
+		require.Equal(t, "running", cfg.WaitFor)
+		require.Zero(t, cfg.ReadinessTimeout)
+	})
+
+	t.Run("TimeoutCoversAllRetries", func(t *testing.T) {
+		cfg, err := LoadConfig("", core.Container{
+			Image: "postgres:alpine",
+			Healthcheck: &core.Healthcheck{
+				Test:        []string{"CMD", "pg_isready"},
+				Interval:    30 * time.Second,
+				Timeout:     10 * time.Second,
+				StartPeriod: time.Minute,
+				Retries:     4,
+			},
+		}, nil)
+		require.NoError(t, err)
+		// start_period + (retries+1) * (interval+timeout)
+		require.Equal(t, 260*time.Second, cfg.ReadinessTimeout)
+	})
+}
+
+func TestDockerConfig_Entrypoint(t *testing.T) {
+	cfg, err := LoadConfig("", core.Container{
+		Image:      "alpine:3",
+		Entrypoint: []string{"/bin/sh", "-c"},
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/bin/sh", "-c"}, []string(cfg.Container.Entrypoint))
+
+	cfg, err = LoadConfig("", core.Container{Image: "alpine:3"}, nil)
+	require.NoError(t, err)
+	require.Empty(t, cfg.Container.Entrypoint)
+}
+
+func TestDockerConfig_ResourceLimits(t *testing.T) {
+	cfg, err := LoadConfig("", core.Container{
+		Image:  "alpine:3",
+		CPUs:   "1.5",
+		Memory: "2g",
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(1_500_000_000), cfg.Host.NanoCPUs)
+	require.Equal(t, int64(2*1024*1024*1024), cfg.Host.Memory)
+
+	cfg, err = LoadConfig("", core.Container{Image: "alpine:3"}, nil)
+	require.NoError(t, err)
+	require.Zero(t, cfg.Host.NanoCPUs)
+	require.Zero(t, cfg.Host.Memory)
+
+	_, err = LoadConfig("", core.Container{Image: "alpine:3", CPUs: "-1"}, nil)
+	require.ErrorContains(t, err, "failed to parse cpus")
+}
+
+func TestDockerConfig_Build(t *testing.T) {
+	workDir := t.TempDir()
+	cfg, err := LoadConfig(workDir, core.Container{
+		Image: "myapp:dev",
+		Build: &core.ContainerBuild{Context: "app", Dockerfile: "Dockerfile.dev", Tag: "myapp:dev"},
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "myapp:dev", cfg.Image)
+	require.NotNil(t, cfg.Build)
+	require.Equal(t, filepath.Join(workDir, "app"), cfg.Build.Context)
+	require.Equal(t, "Dockerfile.dev", cfg.Build.Dockerfile)
+}
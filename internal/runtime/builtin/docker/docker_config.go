@@ -0,0 +1,151 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package docker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dagucloud/dagu/internal/cmn/logger"
+	"github.com/dagucloud/dagu/internal/cmn/logger/tag"
+	"github.com/moby/moby/api/types/registry"
+)
+
+// dockerHubServerAddress is the key the Docker CLI uses for Docker Hub
+// credentials in config.json and credential helpers.
+const dockerHubServerAddress = "https://index.docker.io/v1/"
+
+// credentialHelperTimeout bounds how long a credential helper may run, since
+// some helpers prompt or hang when their backing store is locked.
+const credentialHelperTimeout = 30 * time.Second
+
+// dockerCLIConfig is the subset of ~/.docker/config.json used for auth.
+type dockerCLIConfig struct {
+	Auths       map[string]registry.AuthConfig `json:"auths"`
+	CredsStore  string                         `json:"credsStore"`
+	CredHelpers map[string]string              `json:"credHelpers"`
+}
+
+// dockerConfigPath returns the path of the Docker CLI config file, honoring
+// DOCKER_CONFIG like the docker CLI does.
+func dockerConfigPath() (string, error) {
+	if dir := os.Getenv("DOCKER_CONFIG"); dir != "" {
+		return filepath.Join(dir, "config.json"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve home directory: %w", err)
+	}
+	return filepath.Join(home, ".docker", "config.json"), nil
+}
+
+// getAuthFromDockerConfigFile resolves credentials for the image from the
+// Docker CLI config file. Registry-specific credential helpers take
+// precedence over the default credential store, which takes precedence over
+// credentials stored inline in the file. A helper that fails or has no
+// credentials for the registry falls back to the inline credentials. A missing
+// config file means no auth.
+func getAuthFromDockerConfigFile(ctx context.Context, imageName string) (*registry.AuthConfig, error) {
+	path, err := dockerConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path) //nolint:gosec
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read docker config %s: %w", path, err)
+	}
+
+	var config dockerCLIConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("invalid docker config %s: %w", path, err)
+	}
+
+	registryHost := extractRegistry(imageName)
+	candidates := []string{registryHost, "https://" + registryHost}
+	if registryHost == "docker.io" {
+		candidates = append([]string{dockerHubServerAddress}, candidates...)
+	}
+
+	helper := config.CredsStore
+	for _, key := range candidates {
+		if h, ok := config.CredHelpers[key]; ok {
+			helper = h
+			break
+		}
+	}
+	if helper != "" {
+		// Helpers exit non-zero both when they fail and when they simply have
+		// no credentials for the server, so any error falls back to auths.
+		auth, err := credentialsFromHelper(ctx, helper, candidates[0])
+		if err == nil {
+			auth.ServerAddress = registryHost
+			return auth, nil
+		}
+		logger.Warn(ctx, "Docker: credential helper failed, falling back to inline auths",
+			slog.String("helper", helper),
+			slog.String("registry", registryHost),
+			tag.Error(err))
+	}
+
+	for _, key := range candidates {
+		if auth, ok := config.Auths[key]; ok && (auth.Auth != "" || auth.IdentityToken != "" || auth.Username != "") {
+			auth.ServerAddress = registryHost
+			return &auth, nil
+		}
+	}
+	return nil, nil
+}
+
+// runCredentialHelper runs "docker-credential-<helper> get" with the server
+// address on stdin, killing it after credentialHelperTimeout. It is a variable
+// so tests can replace it.
+var runCredentialHelper = func(ctx context.Context, helper, serverAddress string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, credentialHelperTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "docker-credential-"+helper, "get") //nolint:gosec
+	cmd.Stdin = strings.NewReader(serverAddress)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		// Helpers report unknown servers on stdout with a non-zero exit.
+		msg := strings.TrimSpace(string(out) + stderr.String())
+		return nil, fmt.Errorf("%w: %s", err, msg)
+	}
+	return out, nil
+}
+
+// credentialsFromHelper returns the credentials a helper stores for the
+// server.
+func credentialsFromHelper(ctx context.Context, helper, serverAddress string) (*registry.AuthConfig, error) {
+	out, err := runCredentialHelper(ctx, helper, serverAddress)
+	if err != nil {
+		return nil, fmt.Errorf("credential helper %q failed: %w", helper, err)
+	}
+
+	var creds struct {
+		Username string `json:"Username"`
+		Secret   string `json:"Secret"`
+	}
+	if err := json.Unmarshal(out, &creds); err != nil {
+		return nil, fmt.Errorf("credential helper %q returned invalid output: %w", helper, err)
+	}
+	if creds.Username == "<token>" {
+		return &registry.AuthConfig{IdentityToken: creds.Secret}, nil
+	}
+	return &registry.AuthConfig{Username: creds.Username, Password: creds.Secret}, nil
+}
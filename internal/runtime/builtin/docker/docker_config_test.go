@@ -0,0 +1,146 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dagucloud/dagu/internal/core"
+	"github.com/moby/moby/api/pkg/authconfig"
+	"github.com/moby/moby/api/types/registry"
+	"github.com/stretchr/testify/require"
+)
+
+func writeDockerConfig(t *testing.T, content string) {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.json"), []byte(content), 0o600))
+	t.Setenv("DOCKER_CONFIG", dir)
+}
+
+func stubCredentialHelper(t *testing.T, fn func(ctx context.Context, helper, serverAddress string) ([]byte, error)) {
+	t.Helper()
+	orig := runCredentialHelper
+	runCredentialHelper = fn
+	t.Cleanup(func() { runCredentialHelper = orig })
+}
+
+func TestGetAuthFromDockerConfigFile(t *testing.T) {
+	t.Run("InlineAuths", func(t *testing.T) {
+		writeDockerConfig(t, `{"auths":{
+			"https://index.docker.io/v1/":{"auth":"aHViOnNlY3JldA=="},
+			"ghcr.io":{"auth":"Z2g6dG9rZW4="}
+		}}`)
+
+		auth, err := getAuthFromDockerConfigFile(context.Background(), "ubuntu:22.04")
+		require.NoError(t, err)
+		require.Equal(t, &registry.AuthConfig{Auth: "aHViOnNlY3JldA==", ServerAddress: "docker.io"}, auth)
+
+		auth, err = getAuthFromDockerConfigFile(context.Background(), "ghcr.io/org/app:1")
+		require.NoError(t, err)
+		require.Equal(t, &registry.AuthConfig{Auth: "Z2g6dG9rZW4=", ServerAddress: "ghcr.io"}, auth)
+
+		auth, err = getAuthFromDockerConfigFile(context.Background(), "quay.io/org/app:1")
+		require.NoError(t, err)
+		require.Nil(t, auth)
+	})
+
+	t.Run("CredentialHelper", func(t *testing.T) {
+		writeDockerConfig(t, `{"credsStore":"desktop","credHelpers":{"123.dkr.ecr.us-east-1.amazonaws.com":"ecr-login"}}`)
+
+		var calls []string
+		stubCredentialHelper(t, func(_ context.Context, helper, serverAddress string) ([]byte, error) {
+			calls = append(calls, helper+" "+serverAddress)
+			if helper == "ecr-login" {
+				return json.Marshal(map[string]string{"Username": "AWS", "Secret": "ecr-password"})
+			}
+			return json.Marshal(map[string]string{"Username": "<token>", "Secret": "identity-token"})
+		})
+
+		auth, err := getAuthFromDockerConfigFile(context.Background(), "123.dkr.ecr.us-east-1.amazonaws.com/app:1")
+		require.NoError(t, err)
+		require.Equal(t, "AWS", auth.Username)
+		require.Equal(t, "ecr-password", auth.Password)
+
+		auth, err = getAuthFromDockerConfigFile(context.Background(), "alpine")
+		require.NoError(t, err)
+		require.Equal(t, "identity-token", auth.IdentityToken)
+
+		require.Equal(t, []string{
+			"ecr-login 123.dkr.ecr.us-east-1.amazonaws.com",
+			"desktop https://index.docker.io/v1/",
+		}, calls)
+	})
+
+	t.Run("HelperWithoutCredentialsFallsBackToAuths", func(t *testing.T) {
+		writeDockerConfig(t, `{"credsStore":"desktop","auths":{"ghcr.io":{"auth":"Z2g6dG9rZW4="}}}`)
+		stubCredentialHelper(t, func(context.Context, string, string) ([]byte, error) {
+			return nil, errors.New("exit status 1: credentials not found in native keychain")
+		})
+
+		auth, err := getAuthFromDockerConfigFile(context.Background(), "ghcr.io/org/app:1")
+		require.NoError(t, err)
+		require.Equal(t, "Z2g6dG9rZW4=", auth.Auth)
+	})
+
+	t.Run("HelperFailureFallsBackToAuths", func(t *testing.T) {
+		writeDockerConfig(t, `{"credsStore":"desktop","auths":{"ghcr.io":{"auth":"Z2g6dG9rZW4="}}}`)
+		stubCredentialHelper(t, func(context.Context, string, string) ([]byte, error) {
+			return nil, errors.New("signal: killed")
+		})
+
+		auth, err := getAuthFromDockerConfigFile(context.Background(), "ghcr.io/org/app:1")
+		require.NoError(t, err)
+		require.Equal(t, "Z2g6dG9rZW4=", auth.Auth)
+	})
+
+	t.Run("HelperFailureWithoutAuths", func(t *testing.T) {
+		writeDockerConfig(t, `{"credsStore":"desktop"}`)
+		stubCredentialHelper(t, func(context.Context, string, string) ([]byte, error) {
+			return nil, errors.New("exec: \"docker-credential-desktop\": executable file not found in $PATH")
+		})
+
+		auth, err := getAuthFromDockerConfigFile(context.Background(), "alpine")
+		require.NoError(t, err)
+		require.Nil(t, auth)
+	})
+
+	t.Run("HelperInvalidOutputFallsBackToAuths", func(t *testing.T) {
+		writeDockerConfig(t, `{"credsStore":"desktop","auths":{"ghcr.io":{"auth":"Z2g6dG9rZW4="}}}`)
+		stubCredentialHelper(t, func(context.Context, string, string) ([]byte, error) {
+			return []byte("not json"), nil
+		})
+
+		auth, err := getAuthFromDockerConfigFile(context.Background(), "ghcr.io/org/app:1")
+		require.NoError(t, err)
+		require.Equal(t, "Z2g6dG9rZW4=", auth.Auth)
+	})
+
+	t.Run("MissingConfigFile", func(t *testing.T) {
+		t.Setenv("DOCKER_CONFIG", t.TempDir())
+		auth, err := getAuthFromDockerConfigFile(context.Background(), "alpine")
+		require.NoError(t, err)
+		require.Nil(t, auth)
+	})
+}
+
+func TestRegistryAuthManager_DockerConfigDirective(t *testing.T) {
+	writeDockerConfig(t, `{"auths":{"registry.example.com":{"auth":"dXNlcjpwYXNz"}}}`)
+	t.Setenv("DOCKER_AUTH_CONFIG", "")
+
+	manager := NewRegistryAuthManager(map[string]*core.AuthConfig{"_docker_config": {}})
+	header, err := manager.GetAuthHeader(context.Background(), "registry.example.com/app:1")
+	require.NoError(t, err)
+	require.NotEmpty(t, header)
+
+	auth, err := authconfig.Decode(header)
+	require.NoError(t, err)
+	require.Equal(t, "dXNlcjpwYXNz", auth.Auth)
+	require.Equal(t, "registry.example.com", auth.ServerAddress)
+}
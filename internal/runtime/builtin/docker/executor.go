@@ -391,6 +391,11 @@ func newDocker(ctx context.Context, step core.Step) (executor.Executor, error) {
 		// if it does not exist or is stopped.
 		c.ShouldStart = true
 		cfg = c
+	} else if isLocalFallback(ctx) {
+		// The DAG-level container was skipped because Docker is unavailable;
+		// run the step on the host shell instead.
+		step.ExecutorConfig.Type = "command"
+		return executor.NewExecutor(ctx, step)
 	}
 
 	return &docker{
@@ -467,6 +472,17 @@ func EvalContainerFields(ctx context.Context, ct core.Container) (core.Container
 		return ct, fmt.Errorf("failed to evaluate network: %w", err)
 	}
 
+	if ct.Build != nil {
+		build := *ct.Build // Copy to avoid mutating the DAG definition
+		if build.Context, err = runtime.EvalStepString(ctx, build.Context); err != nil {
+			return ct, fmt.Errorf("failed to evaluate build.context: %w", err)
+		}
+		if build.Dockerfile, err = runtime.EvalStepString(ctx, build.Dockerfile); err != nil {
+			return ct, fmt.Errorf("failed to evaluate build.dockerfile: %w", err)
+		}
+		ct.Build = &build
+	}
+
 	// Evaluate slice fields
 	if ct.Volumes, err = evalStringSlice(ctx, ct.Volumes); err != nil {
 		return ct, fmt.Errorf("failed to evaluate volumes: %w", err)
@@ -480,6 +496,9 @@ func EvalContainerFields(ctx context.Context, ct core.Container) (core.Container
 	if ct.Command, err = evalStringSlice(ctx, ct.Command); err != nil {
 		return ct, fmt.Errorf("failed to evaluate command: %w", err)
 	}
+	if ct.Entrypoint, err = evalStringSlice(ctx, ct.Entrypoint); err != nil {
+		return ct, fmt.Errorf("failed to evaluate entrypoint: %w", err)
+	}
 	if ct.Shell, err = evalStringSlice(ctx, ct.Shell); err != nil {
 		return ct, fmt.Errorf("failed to evaluate shell: %w", err)
 	}
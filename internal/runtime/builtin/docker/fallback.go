@@ -0,0 +1,66 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package docker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/dagucloud/dagu/internal/cmn/logger"
+	"github.com/dagucloud/dagu/internal/cmn/logger/tag"
+	"github.com/dagucloud/dagu/internal/core"
+	"github.com/moby/moby/client"
+)
+
+// daemonPingTimeout bounds the reachability check done at run start.
+const daemonPingTimeout = 5 * time.Second
+
+type localFallbackCtxKey struct{}
+
+// WithLocalFallback marks the context so that steps relying on the DAG-level
+// container run on the host instead.
+func WithLocalFallback(ctx context.Context) context.Context {
+	return context.WithValue(ctx, localFallbackCtxKey{}, true)
+}
+
+// isLocalFallback reports whether the DAG-level container was replaced by
+// local execution for this run.
+func isLocalFallback(ctx context.Context) bool {
+	v, _ := ctx.Value(localFallbackCtxKey{}).(bool)
+	return v
+}
+
+// pingDaemon checks that the Docker daemon is reachable. It is a variable so
+// tests can simulate an unavailable daemon.
+var pingDaemon = func(ctx context.Context) error {
+	cli, err := client.New(client.FromEnv)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = cli.Close() }()
+
+	ctx, cancel := context.WithTimeout(ctx, daemonPingTimeout)
+	defer cancel()
+	_, err = cli.Ping(ctx, client.PingOptions{})
+	return err
+}
+
+// ShouldFallbackToLocal reports whether steps should run locally because the
+// container opts into fallback_to_local and the Docker daemon is unreachable.
+// It logs a warning when the fallback is taken.
+func ShouldFallbackToLocal(ctx context.Context, ct core.Container) bool {
+	if !ct.FallbackToLocal {
+		return false
+	}
+	err := pingDaemon(ctx)
+	if err == nil {
+		return false
+	}
+	logger.Warn(ctx, "Docker daemon is unavailable; running steps locally instead of in the container (fallback_to_local)",
+		slog.String("image", ct.Image),
+		tag.Error(err),
+	)
+	return true
+}
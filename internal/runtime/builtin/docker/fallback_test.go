@@ -0,0 +1,96 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package docker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dagucloud/dagu/internal/core"
+	"github.com/dagucloud/dagu/internal/runtime"
+	_ "github.com/dagucloud/dagu/internal/runtime/builtin/command"
+	"github.com/stretchr/testify/require"
+)
+
+func stubPingDaemon(t *testing.T, fn func(context.Context) error) *int {
+	t.Helper()
+	var calls int
+	orig := pingDaemon
+	pingDaemon = func(ctx context.Context) error {
+		calls++
+		return fn(ctx)
+	}
+	t.Cleanup(func() { pingDaemon = orig })
+	return &calls
+}
+
+func TestShouldFallbackToLocal(t *testing.T) {
+	unreachable := func(context.Context) error {
+		return errors.New("Cannot connect to the Docker daemon at unix:///var/run/docker.sock")
+	}
+
+	t.Run("DaemonUnreachable", func(t *testing.T) {
+		calls := stubPingDaemon(t, unreachable)
+		ct := core.Container{Image: "alpine", FallbackToLocal: true}
+		require.True(t, ShouldFallbackToLocal(t.Context(), ct))
+		require.Equal(t, 1, *calls)
+	})
+
+	t.Run("DaemonReachable", func(t *testing.T) {
+		stubPingDaemon(t, func(context.Context) error { return nil })
+		ct := core.Container{Image: "alpine", FallbackToLocal: true}
+		require.False(t, ShouldFallbackToLocal(t.Context(), ct))
+	})
+
+	t.Run("NotEnabled", func(t *testing.T) {
+		calls := stubPingDaemon(t, unreachable)
+		require.False(t, ShouldFallbackToLocal(t.Context(), core.Container{Image: "alpine"}))
+		require.Zero(t, *calls)
+	})
+}
+
+func TestNewDocker_LocalFallback(t *testing.T) {
+	dag := &core.DAG{
+		Name:      "test-dag",
+		Container: &core.Container{Image: "alpine", FallbackToLocal: true},
+	}
+	ctx := runtime.NewContextForTest(t.Context(), dag, "run-1", "log.txt")
+	step := core.Step{
+		Name:           "hello",
+		ExecutorConfig: core.ExecutorConfig{Type: "container"},
+		Commands:       []core.CommandEntry{{Command: "echo", Args: []string{"hello"}, CmdWithArgs: "echo hello"}},
+	}
+
+	t.Run("RunsLocally", func(t *testing.T) {
+		exec, err := newDocker(WithLocalFallback(ctx), step)
+		require.NoError(t, err)
+		_, isDocker := exec.(*docker)
+		require.False(t, isDocker)
+
+		var stdout bytes.Buffer
+		exec.SetStdout(&stdout)
+		exec.SetStderr(&bytes.Buffer{})
+		require.NoError(t, exec.Run(ctx))
+		require.Equal(t, "hello", strings.TrimSpace(stdout.String()))
+	})
+
+	t.Run("StepContainerIsNotReplaced", func(t *testing.T) {
+		stepWithContainer := step
+		stepWithContainer.Container = &core.Container{Image: "alpine"}
+		exec, err := newDocker(WithLocalFallback(ctx), stepWithContainer)
+		require.NoError(t, err)
+		_, isDocker := exec.(*docker)
+		require.True(t, isDocker)
+	})
+
+	t.Run("WithoutFallback", func(t *testing.T) {
+		exec, err := newDocker(ctx, step)
+		require.NoError(t, err)
+		_, isDocker := exec.(*docker)
+		require.True(t, isDocker)
+	})
+}
@@ -4,6 +4,7 @@
 package docker
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -30,13 +31,13 @@ func NewRegistryAuthManager(auths map[string]*core.AuthConfig) *RegistryAuthMana
 }
 
 // GetPullOptions returns ImagePullOptions with authentication for the given image.
-func (r *RegistryAuthManager) GetPullOptions(imageName string, platform specs.Platform) (client.ImagePullOptions, error) {
+func (r *RegistryAuthManager) GetPullOptions(ctx context.Context, imageName string, platform specs.Platform) (client.ImagePullOptions, error) {
 	opts := client.ImagePullOptions{}
 	if platform.OS != "" {
 		opts.Platforms = []specs.Platform{platform}
 	}
 
-	authHeader, err := r.GetAuthHeader(imageName)
+	authHeader, err := r.GetAuthHeader(ctx, imageName)
 	if err != nil {
 		return opts, fmt.Errorf("failed to get auth header: %w", err)
 	}
@@ -49,9 +50,9 @@ func (r *RegistryAuthManager) GetPullOptions(imageName string, platform specs.Pl
 }
 
 // GetAuthHeader returns the X-Registry-Auth header value for the given image
-func (r *RegistryAuthManager) GetAuthHeader(imageName string) (string, error) {
+func (r *RegistryAuthManager) GetAuthHeader(ctx context.Context, imageName string) (string, error) {
 	// First check if we have DAG-level auth
-	authConfig, err := r.getAuthConfig(imageName)
+	authConfig, err := r.getAuthConfig(ctx, imageName)
 	if err != nil {
 		return "", err
 	}
@@ -77,7 +78,7 @@ func (r *RegistryAuthManager) GetAuthHeader(imageName string) (string, error) {
 }
 
 // getAuthConfig returns the authentication config for the given image
-func (r *RegistryAuthManager) getAuthConfig(imageName string) (*registry.AuthConfig, error) {
+func (r *RegistryAuthManager) getAuthConfig(ctx context.Context, imageName string) (*registry.AuthConfig, error) {
 	if len(r.auths) == 0 {
 		return nil, nil
 	}
@@ -87,6 +88,11 @@ func (r *RegistryAuthManager) getAuthConfig(imageName string) (*registry.AuthCon
 		return getAuthFromDockerConfig(jsonAuth.Auth, imageName)
 	}
 
+	// Check if credentials should come from the Docker CLI config file
+	if _, ok := r.auths["_docker_config"]; ok {
+		return getAuthFromDockerConfigFile(ctx, imageName)
+	}
+
 	// Extract registry from image name
 	registryHost := extractRegistry(imageName)
 
@@ -4,6 +4,7 @@
 package docker
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"os"
@@ -226,7 +227,7 @@ func TestRegistryAuthManager_GetAuthHeader(t *testing.T) {
 			},
 		})
 
-		header, err := manager.GetAuthHeader("alpine:latest")
+		header, err := manager.GetAuthHeader(context.Background(), "alpine:latest")
 		require.NoError(t, err)
 		assert.NotEmpty(t, header)
 
@@ -250,7 +251,7 @@ func TestRegistryAuthManager_GetAuthHeader(t *testing.T) {
 
 		manager := NewRegistryAuthManager(nil)
 
-		header, err := manager.GetAuthHeader("gcr.io/project/image:latest")
+		header, err := manager.GetAuthHeader(context.Background(), "gcr.io/project/image:latest")
 		require.NoError(t, err)
 		assert.NotEmpty(t, header)
 
@@ -272,7 +273,7 @@ func TestRegistryAuthManager_GetAuthHeader(t *testing.T) {
 			},
 		})
 
-		header, err := manager.GetAuthHeader("ghcr.io/owner/repo:latest")
+		header, err := manager.GetAuthHeader(context.Background(), "ghcr.io/owner/repo:latest")
 		require.NoError(t, err)
 		assert.NotEmpty(t, header)
 
@@ -293,7 +294,7 @@ func TestRegistryAuthManager_GetAuthHeader(t *testing.T) {
 
 		manager := NewRegistryAuthManager(nil)
 
-		header, err := manager.GetAuthHeader("alpine:latest")
+		header, err := manager.GetAuthHeader(context.Background(), "alpine:latest")
 		require.NoError(t, err)
 		assert.Empty(t, header)
 	})
@@ -318,7 +319,7 @@ func TestRegistryAuthManager_GetPullOptions(t *testing.T) {
 		},
 	})
 
-	opts, err := manager.GetPullOptions("alpine:latest", specs.Platform{OS: "linux", Architecture: "amd64"})
+	opts, err := manager.GetPullOptions(context.Background(), "alpine:latest", specs.Platform{OS: "linux", Architecture: "amd64"})
 	require.NoError(t, err)
 	assert.Equal(t, client.ImagePullOptions{
 		Platforms:    []specs.Platform{{OS: "linux", Architecture: "amd64"}},
@@ -355,7 +356,7 @@ func TestRegistryAuthManager_ComplexJSONAuth(t *testing.T) {
 		},
 	})
 
-	header, err := manager.GetAuthHeader("myregistry.com/myimage:latest")
+	header, err := manager.GetAuthHeader(context.Background(), "myregistry.com/myimage:latest")
 	require.NoError(t, err)
 	assert.NotEmpty(t, header)
 
@@ -27,9 +27,40 @@ var configSchema = &jsonschema.Schema{
 		"debug":           {Type: "boolean", Description: "Enable debug mode"},
 		"json":            {Type: "boolean", Description: "Format output as JSON"},
 		"skip_tls_verify": {Type: "boolean", Description: "Skip TLS certificate verification"},
+		"retry": {
+			Type: "object",
+			Properties: map[string]*jsonschema.Schema{
+				"max": {Type: "integer", Description: "Maximum number of retries"},
+				"on": {
+					Type:        "array",
+					Items:       &jsonschema.Schema{Type: "integer"},
+					Description: "Status codes to retry (default: 429, 503)",
+				},
+			},
+			Description: "Retry responses with the given status codes, honoring Retry-After",
+		},
+	},
+}
+
+var graphqlConfigSchema = &jsonschema.Schema{
+	Type:     "object",
+	Required: []string{"url", "query"},
+	Properties: map[string]*jsonschema.Schema{
+		"url":       {Type: "string", Description: "GraphQL endpoint URL"},
+		"query":     {Type: "string", Description: "GraphQL query or mutation document"},
+		"variables": {Type: "object", Description: "Variables for the operation"},
+		"headers": {
+			Type:                 "object",
+			AdditionalProperties: &jsonschema.Schema{Type: "string"},
+			Description:          "HTTP headers to send",
+		},
+		"timeout":         {Type: "integer", Description: "Request timeout in seconds"},
+		"debug":           {Type: "boolean", Description: "Enable debug mode"},
+		"skip_tls_verify": {Type: "boolean", Description: "Skip TLS certificate verification"},
 	},
 }
 
 func init() {
 	core.RegisterExecutorConfigSchema("http", configSchema)
+	core.RegisterExecutorConfigSchema(graphqlExecutorType, graphqlConfigSchema)
 }
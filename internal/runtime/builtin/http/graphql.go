@@ -0,0 +1,166 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/dagucloud/dagu/internal/core"
+	"github.com/dagucloud/dagu/internal/runtime/executor"
+	"github.com/go-resty/resty/v2"
+	"github.com/go-viper/mapstructure/v2"
+)
+
+const graphqlExecutorType = "graphql"
+
+var _ executor.Executor = (*graphql)(nil)
+
+// graphql sends a GraphQL operation as an HTTP POST and writes the response
+// data to stdout.
+type graphql struct {
+	stdout    io.Writer
+	stderr    io.Writer
+	req       *resty.Request
+	reqCancel context.CancelFunc
+	url       string
+}
+
+type graphqlConfig struct {
+	URL           string            `mapstructure:"url"`
+	Query         string            `mapstructure:"query"`
+	Variables     map[string]any    `mapstructure:"variables"`
+	Headers       map[string]string `mapstructure:"headers"`
+	Timeout       int               `mapstructure:"timeout"`
+	Debug         bool              `mapstructure:"debug"`
+	SkipTLSVerify bool              `mapstructure:"skip_tls_verify"`
+}
+
+type graphqlRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+type graphqlResponse struct {
+	Data   json.RawMessage   `json:"data"`
+	Errors []json.RawMessage `json:"errors"`
+}
+
+var errGraphQLResponse = errors.New("graphql response contains errors")
+
+func newGraphQL(ctx context.Context, step core.Step) (executor.Executor, error) {
+	cfg, err := parseGraphQLConfig(step.ExecutorConfig.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(graphqlRequest{Query: cfg.Query, Variables: cfg.Variables})
+	if err != nil {
+		return nil, fmt.Errorf("graphql: failed to encode request: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	client := newRestyClient(cfg.Timeout, cfg.Debug, cfg.SkipTLSVerify)
+	req := client.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetHeader("Accept", "application/json").
+		SetHeaders(cfg.Headers).
+		SetBody(body)
+
+	return &graphql{
+		stdout:    os.Stdout,
+		stderr:    os.Stderr,
+		req:       req,
+		reqCancel: cancel,
+		url:       cfg.URL,
+	}, nil
+}
+
+func parseGraphQLConfig(config map[string]any) (*graphqlConfig, error) {
+	var cfg graphqlConfig
+	md, _ := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		WeaklyTypedInput: true,
+		Result:           &cfg,
+	})
+	if err := md.Decode(config); err != nil {
+		return nil, fmt.Errorf("graphql: invalid config: %w", err)
+	}
+	if strings.TrimSpace(cfg.URL) == "" {
+		return nil, fmt.Errorf("graphql: url is required")
+	}
+	if strings.TrimSpace(cfg.Query) == "" {
+		return nil, fmt.Errorf("graphql: query is required")
+	}
+	return &cfg, nil
+}
+
+func (e *graphql) SetStdout(out io.Writer) {
+	e.stdout = out
+}
+
+func (e *graphql) SetStderr(out io.Writer) {
+	e.stderr = out
+}
+
+func (e *graphql) Kill(_ os.Signal) error {
+	e.reqCancel()
+	return nil
+}
+
+func (e *graphql) Run(_ context.Context) error {
+	rsp, err := e.req.Post(e.url)
+	if err != nil {
+		return err
+	}
+	if !rsp.IsSuccess() {
+		_, _ = e.stderr.Write(rsp.Body())
+		return fmt.Errorf("%w: %d", errHTTPStatusCode, rsp.StatusCode())
+	}
+
+	var result graphqlResponse
+	if err := json.Unmarshal(rsp.Body(), &result); err != nil {
+		return fmt.Errorf("graphql: invalid response: %w", err)
+	}
+	if len(result.Errors) > 0 {
+		var messages []string
+		for _, raw := range result.Errors {
+			var gqlErr struct {
+				Message string `json:"message"`
+			}
+			if json.Unmarshal(raw, &gqlErr) == nil && gqlErr.Message != "" {
+				messages = append(messages, gqlErr.Message)
+			}
+			_, _ = e.stderr.Write(append(bytes.Clone(raw), '\n'))
+		}
+		if len(messages) == 0 {
+			return errGraphQLResponse
+		}
+		return fmt.Errorf("%w: %s", errGraphQLResponse, strings.Join(messages, "; "))
+	}
+
+	data := result.Data
+	if len(data) == 0 {
+		data = json.RawMessage("null")
+	}
+	if _, err := e.stdout.Write(append(bytes.Clone(data), '\n')); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateGraphQLStep(step core.Step) error {
+	_, err := parseGraphQLConfig(step.ExecutorConfig.Config)
+	return err
+}
+
+func init() {
+	executor.RegisterExecutor(graphqlExecutorType, newGraphQL, validateGraphQLStep, core.ExecutorCapabilities{})
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dagucloud/dagu/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newGraphQLStep(config map[string]any) core.Step {
+	return core.Step{
+		Name: "gql",
+		ExecutorConfig: core.ExecutorConfig{
+			Type:   graphqlExecutorType,
+			Config: config,
+		},
+	}
+}
+
+func TestGraphQLExecutor(t *testing.T) {
+	t.Run("SendsQueryAndWritesData", func(t *testing.T) {
+		var gotBody map[string]any
+		var gotHeaders nethttp.Header
+		server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			assert.Equal(t, nethttp.MethodPost, r.Method)
+			gotHeaders = r.Header.Clone()
+			body, _ := io.ReadAll(r.Body)
+			assert.NoError(t, json.Unmarshal(body, &gotBody))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data":{"user":{"name":"alice"}}}`))
+		}))
+		defer server.Close()
+
+		exec, err := newGraphQL(context.Background(), newGraphQLStep(map[string]any{
+			"url":       server.URL,
+			"query":     "query($id: ID!) { user(id: $id) { name } }",
+			"variables": map[string]any{"id": "42", "limit": 10},
+			"headers":   map[string]any{"Authorization": "Bearer token"},
+		}))
+		require.NoError(t, err)
+
+		stdout := &testWriter{}
+		exec.SetStdout(stdout)
+		exec.SetStderr(&testWriter{})
+		require.NoError(t, exec.Run(context.Background()))
+
+		assert.Equal(t, map[string]any{
+			"query":     "query($id: ID!) { user(id: $id) { name } }",
+			"variables": map[string]any{"id": "42", "limit": float64(10)},
+		}, gotBody)
+		assert.Equal(t, "application/json", gotHeaders.Get("Content-Type"))
+		assert.Equal(t, "Bearer token", gotHeaders.Get("Authorization"))
+		assert.JSONEq(t, `{"user":{"name":"alice"}}`, stdout.String())
+	})
+
+	t.Run("FailsOnErrors", func(t *testing.T) {
+		server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, _ *nethttp.Request) {
+			_, _ = w.Write([]byte(`{"data":null,"errors":[{"message":"field 'usr' not found"},{"message":"unauthorized"}]}`))
+		}))
+		defer server.Close()
+
+		exec, err := newGraphQL(context.Background(), newGraphQLStep(map[string]any{
+			"url":   server.URL,
+			"query": "{ usr { name } }",
+		}))
+		require.NoError(t, err)
+
+		stdout, stderr := &testWriter{}, &testWriter{}
+		exec.SetStdout(stdout)
+		exec.SetStderr(stderr)
+		err = exec.Run(context.Background())
+		require.ErrorIs(t, err, errGraphQLResponse)
+		assert.Contains(t, err.Error(), "field 'usr' not found; unauthorized")
+		assert.Empty(t, stdout.String())
+		assert.Contains(t, stderr.String(), "unauthorized")
+	})
+
+	t.Run("FailsOnHTTPStatus", func(t *testing.T) {
+		server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, _ *nethttp.Request) {
+			w.WriteHeader(nethttp.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		exec, err := newGraphQL(context.Background(), newGraphQLStep(map[string]any{
+			"url":   server.URL,
+			"query": "{ viewer { login } }",
+		}))
+		require.NoError(t, err)
+		exec.SetStdout(&testWriter{})
+		exec.SetStderr(&testWriter{})
+		require.ErrorIs(t, exec.Run(context.Background()), errHTTPStatusCode)
+	})
+
+	t.Run("ValidateRequiresURLAndQuery", func(t *testing.T) {
+		require.ErrorContains(t, validateGraphQLStep(newGraphQLStep(map[string]any{"query": "{ a }"})), "url is required")
+		require.ErrorContains(t, validateGraphQLStep(newGraphQLStep(map[string]any{"url": "http://x"})), "query is required")
+		require.NoError(t, validateGraphQLStep(newGraphQLStep(map[string]any{"url": "http://x", "query": "{ a }"})))
+	})
+}
@@ -10,10 +10,15 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
+	nethttp "net/http"
 	"os"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/dagucloud/dagu/internal/cmn/logger"
 	"github.com/dagucloud/dagu/internal/core"
 	"github.com/dagucloud/dagu/internal/runtime/executor"
 	"github.com/go-resty/resty/v2"
@@ -42,8 +47,26 @@ type httpConfig struct {
 	Format        string            `json:"format" mapstructure:"format"`
 	JSON          bool              `json:"json" mapstructure:"json"`
 	SkipTLSVerify bool              `json:"skip_tls_verify" mapstructure:"skip_tls_verify"`
+	Retry         *httpRetryConfig  `json:"retry" mapstructure:"retry"`
 }
 
+// httpRetryConfig controls retries of responses with retryable status codes.
+type httpRetryConfig struct {
+	// Max is the maximum number of retries after the first attempt.
+	Max int `json:"max" mapstructure:"max"`
+	// On lists the status codes to retry. Defaults to 429 and 503.
+	On []int `json:"on" mapstructure:"on"`
+}
+
+var defaultRetryStatusCodes = []int{nethttp.StatusTooManyRequests, nethttp.StatusServiceUnavailable}
+
+const (
+	// defaultRetryBackoff is the first wait when no Retry-After header is
+	// present; it doubles on each retry up to maxRetryBackoff.
+	defaultRetryBackoff = time.Second
+	maxRetryBackoff     = 30 * time.Second
+)
+
 type httpJSONResult struct {
 	StatusCode int                 `json:"status_code"`
 	Headers    map[string][]string `json:"headers"`
@@ -64,6 +87,15 @@ func newHTTP(ctx context.Context, step core.Step) (executor.Executor, error) {
 		}
 	}
 
+	if reqCfg.Retry != nil {
+		if reqCfg.Retry.Max < 0 {
+			return nil, fmt.Errorf("retry.max must be non-negative, got %d", reqCfg.Retry.Max)
+		}
+		if len(reqCfg.Retry.On) == 0 {
+			reqCfg.Retry.On = defaultRetryStatusCodes
+		}
+	}
+
 	// Extract method and url from Commands field.
 	// Prefer CmdWithArgs (fully expanded) over Command/Args (not expanded)
 	// so that parameter variables in the method position are resolved.
@@ -88,18 +120,7 @@ func newHTTP(ctx context.Context, step core.Step) (executor.Executor, error) {
 
 	ctx, cancel := context.WithCancel(ctx)
 
-	client := resty.New()
-	if reqCfg.Debug {
-		client.SetDebug(true)
-	}
-	if reqCfg.Timeout > 0 {
-		client.SetTimeout(time.Second * time.Duration(reqCfg.Timeout))
-	}
-	if reqCfg.SkipTLSVerify {
-		client.SetTLSClientConfig(&tls.Config{
-			InsecureSkipVerify: true, // nolint:gosec
-		})
-	}
+	client := newRestyClient(reqCfg.Timeout, reqCfg.Debug, reqCfg.SkipTLSVerify)
 	req := client.R().SetContext(ctx)
 	if len(reqCfg.Headers) > 0 {
 		req = req.SetHeaders(reqCfg.Headers)
@@ -120,6 +141,24 @@ func newHTTP(ctx context.Context, step core.Step) (executor.Executor, error) {
 	}, nil
 }
 
+// newRestyClient creates a client with the shared timeout, debug, and TLS
+// settings. A timeout of zero means no timeout.
+func newRestyClient(timeout int, debug, skipTLSVerify bool) *resty.Client {
+	client := resty.New()
+	if debug {
+		client.SetDebug(true)
+	}
+	if timeout > 0 {
+		client.SetTimeout(time.Second * time.Duration(timeout))
+	}
+	if skipTLSVerify {
+		client.SetTLSClientConfig(&tls.Config{
+			InsecureSkipVerify: true, // nolint:gosec
+		})
+	}
+	return client
+}
+
 func (e *http) SetStdout(out io.Writer) {
 	e.stdout = out
 }
@@ -183,8 +222,8 @@ func (e *http) isJSONFormat() bool {
 	return e.cfg.Format == "json" || e.cfg.JSON
 }
 
-func (e *http) Run(_ context.Context) error {
-	rsp, err := e.req.Execute(strings.ToUpper(e.method), e.url)
+func (e *http) Run(ctx context.Context) error {
+	rsp, err := e.execute(ctx)
 	if err != nil {
 		return err
 	}
@@ -207,6 +246,54 @@ func (e *http) Run(_ context.Context) error {
 	return nil
 }
 
+// execute sends the request, retrying retryable status codes as configured.
+// Only the final response is returned.
+func (e *http) execute(ctx context.Context) (*resty.Response, error) {
+	method := strings.ToUpper(e.method)
+	for attempt := 0; ; attempt++ {
+		rsp, err := e.req.Execute(method, e.url)
+		if err != nil {
+			return nil, err
+		}
+		retry := e.cfg.Retry
+		if retry == nil || attempt >= retry.Max || !slices.Contains(retry.On, rsp.StatusCode()) {
+			return rsp, nil
+		}
+
+		wait := retryDelay(rsp.Header().Get("Retry-After"), attempt, time.Now())
+		logger.Info(ctx, "Retrying HTTP request",
+			slog.Int("status", rsp.StatusCode()),
+			slog.Int("retry", attempt+1),
+			slog.Int("maxRetries", retry.Max),
+			slog.Duration("wait", wait),
+		)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-e.req.Context().Done():
+			timer.Stop()
+			return nil, e.req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryDelay returns how long to wait before the next attempt. A Retry-After
+// header in delay-seconds or HTTP-date form takes precedence; otherwise the
+// wait backs off exponentially from defaultRetryBackoff.
+func retryDelay(retryAfter string, attempt int, now time.Time) time.Duration {
+	retryAfter = strings.TrimSpace(retryAfter)
+	if secs, err := strconv.Atoi(retryAfter); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if at, err := nethttp.ParseTime(retryAfter); err == nil {
+		return max(at.Sub(now), 0)
+	}
+	return min(defaultRetryBackoff<<min(attempt, 5), maxRetryBackoff)
+}
+
 func decodeHTTPConfig(dat map[string]any, cfg *httpConfig) error {
 	md, _ := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
 		WeaklyTypedInput: true,
@@ -9,7 +9,9 @@ import (
 	nethttp "net/http"
 	"net/http/httptest"
 	"runtime"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/dagucloud/dagu/internal/core"
 	"github.com/stretchr/testify/assert"
@@ -152,6 +154,125 @@ func TestHTTPExecutor_StandardFeatures(t *testing.T) {
 	})
 }
 
+func TestHTTPExecutor_Retry(t *testing.T) {
+	newRetryServer := func(t *testing.T, failures int32) (*httptest.Server, *atomic.Int32) {
+		t.Helper()
+		var hits atomic.Int32
+		server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, _ *nethttp.Request) {
+			if hits.Add(1) <= failures {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(nethttp.StatusServiceUnavailable)
+				_, _ = w.Write([]byte("unavailable"))
+				return
+			}
+			w.WriteHeader(nethttp.StatusOK)
+			_, _ = w.Write([]byte("ok"))
+		}))
+		t.Cleanup(server.Close)
+		return server, &hits
+	}
+
+	runStep := func(t *testing.T, url string, cfg map[string]any) (string, error) {
+		t.Helper()
+		step := core.Step{
+			Commands: []core.CommandEntry{{Command: "GET", Args: []string{url}}},
+			ExecutorConfig: core.ExecutorConfig{
+				Type:   "http",
+				Config: cfg,
+			},
+		}
+		executor, err := newHTTP(context.Background(), step)
+		require.NoError(t, err)
+		out := &testWriter{}
+		executor.SetStdout(out)
+		executor.SetStderr(&testWriter{})
+		err = executor.Run(context.Background())
+		return out.String(), err
+	}
+
+	t.Run("SucceedsAfterRetries", func(t *testing.T) {
+		server, hits := newRetryServer(t, 2)
+		out, err := runStep(t, server.URL, map[string]any{
+			"silent": true,
+			"retry":  map[string]any{"max": 3, "on": []any{429, 503}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "ok", out)
+		assert.Equal(t, int32(3), hits.Load())
+	})
+
+	t.Run("GivesUpAfterMax", func(t *testing.T) {
+		server, hits := newRetryServer(t, 5)
+		out, err := runStep(t, server.URL, map[string]any{
+			"silent": true,
+			"retry":  map[string]any{"max": 1},
+		})
+		require.ErrorIs(t, err, errHTTPStatusCode)
+		assert.Contains(t, out, "unavailable")
+		assert.Equal(t, int32(2), hits.Load())
+	})
+
+	t.Run("StatusNotListed", func(t *testing.T) {
+		server, hits := newRetryServer(t, 5)
+		_, err := runStep(t, server.URL, map[string]any{
+			"retry": map[string]any{"max": 3, "on": []any{429}},
+		})
+		require.ErrorIs(t, err, errHTTPStatusCode)
+		assert.Equal(t, int32(1), hits.Load())
+	})
+
+	t.Run("RunContextCancelsBackoff", func(t *testing.T) {
+		var hits atomic.Int32
+		server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, _ *nethttp.Request) {
+			hits.Add(1)
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(nethttp.StatusServiceUnavailable)
+		}))
+		t.Cleanup(server.Close)
+
+		executor, err := newHTTP(context.Background(), core.Step{
+			Commands: []core.CommandEntry{{Command: "GET", Args: []string{server.URL}}},
+			ExecutorConfig: core.ExecutorConfig{
+				Type:   "http",
+				Config: map[string]any{"silent": true, "retry": map[string]any{"max": 3}},
+			},
+		})
+		require.NoError(t, err)
+		executor.SetStdout(&testWriter{})
+		executor.SetStderr(&testWriter{})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		err = executor.Run(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), 10*time.Second)
+		assert.Equal(t, int32(1), hits.Load())
+	})
+
+	t.Run("NegativeMax", func(t *testing.T) {
+		_, err := newHTTP(context.Background(), core.Step{
+			Commands: []core.CommandEntry{{Command: "GET", Args: []string{"http://localhost"}}},
+			ExecutorConfig: core.ExecutorConfig{
+				Type:   "http",
+				Config: map[string]any{"retry": map[string]any{"max": -1}},
+			},
+		})
+		require.ErrorContains(t, err, "retry.max must be non-negative")
+	})
+}
+
+func TestRetryDelay(t *testing.T) {
+	now := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	assert.Equal(t, 7*time.Second, retryDelay("7", 0, now))
+	assert.Equal(t, 10*time.Second, retryDelay(now.Add(10*time.Second).Format(nethttp.TimeFormat), 0, now))
+	assert.Equal(t, time.Duration(0), retryDelay(now.Add(-time.Minute).Format(nethttp.TimeFormat), 0, now))
+	assert.Equal(t, defaultRetryBackoff, retryDelay("", 0, now))
+	assert.Equal(t, 4*defaultRetryBackoff, retryDelay("soon", 2, now))
+	assert.Equal(t, maxRetryBackoff, retryDelay("", 10, now))
+}
+
 // TestHTTPExecutor_CrossPlatform tests behavior across different platforms
 func TestHTTPExecutor_CrossPlatform(t *testing.T) {
 	t.Run("BehaviorConsistencyAcrossPlatforms", func(t *testing.T) {
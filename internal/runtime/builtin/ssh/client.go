@@ -23,6 +23,7 @@ type Client struct {
 	Shell      string   // Shell for remote command execution
 	ShellArgs  []string // Shell arguments for remote command execution
 	bastionCfg *bastionClientConfig
+	proxyJumps []*bastionClientConfig
 }
 
 // bastionClientConfig holds bastion connection configuration
@@ -32,7 +33,7 @@ type bastionClientConfig struct {
 }
 
 func NewClient(cfg *Config) (*Client, error) {
-	authMethod, err := selectSSHAuthMethod(cfg)
+	authMethods, err := selectSSHAuthMethods(cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -54,17 +55,32 @@ func NewClient(cfg *Config) (*Client, error) {
 		}
 	}
 
+	var proxyJumps []*bastionClientConfig
+	for i, jump := range cfg.ProxyJump {
+		// Jump hosts fall back to the target's credentials
+		jump.User = coalesce(jump.User, cfg.User)
+		if jump.Key == "" && jump.Password == "" {
+			jump.Key, jump.Password = cfg.Key, cfg.Password
+		}
+		jumpCfg, err := newBastionClientConfig(&jump, timeout, hostKeyCallback)
+		if err != nil {
+			return nil, fmt.Errorf("failed to setup jump host %d (%s): %w", i+1, jump.Host, err)
+		}
+		proxyJumps = append(proxyJumps, jumpCfg)
+	}
+
 	return &Client{
 		hostPort: net.JoinHostPort(cfg.Host, port),
 		cfg: &ssh.ClientConfig{
 			User:            cfg.User,
-			Auth:            []ssh.AuthMethod{authMethod},
+			Auth:            authMethods,
 			HostKeyCallback: hostKeyCallback,
 			Timeout:         timeout,
 		},
 		Shell:      cfg.Shell,
 		ShellArgs:  slices.Clone(cfg.ShellArgs),
 		bastionCfg: bastionCfg,
+		proxyJumps: proxyJumps,
 	}, nil
 }
 
@@ -99,64 +115,102 @@ func (c *Client) NewSession() (*ssh.Client, *ssh.Session, error) {
 	return conn, session, nil
 }
 
-// dial establishes an SSH connection either directly or via bastion host.
+// dial establishes an SSH connection either directly or via jump hosts.
 func (c *Client) dial() (*ssh.Client, error) {
-	if c.bastionCfg != nil {
-		return c.dialViaBastion()
+	switch {
+	case len(c.proxyJumps) > 0:
+		return dialViaJumps(c.proxyJumps, c.hostPort, c.cfg)
+	case c.bastionCfg != nil:
+		return dialViaJumps([]*bastionClientConfig{c.bastionCfg}, c.hostPort, c.cfg)
+	default:
+		return ssh.Dial("tcp", c.hostPort, c.cfg)
 	}
-	return ssh.Dial("tcp", c.hostPort, c.cfg)
 }
 
-// dialViaBastion establishes an SSH connection through a bastion/jump host.
-func (c *Client) dialViaBastion() (*ssh.Client, error) {
-	// Connect to bastion host first
-	bastionConn, err := ssh.Dial("tcp", c.bastionCfg.hostPort, c.bastionCfg.cfg)
+// dialViaJumps establishes an SSH connection to hostPort by tunneling through
+// each jump host in order. Each hop is reached over the previous hop's
+// connection, as OpenSSH does for ProxyJump.
+func dialViaJumps(jumps []*bastionClientConfig, hostPort string, cfg *ssh.ClientConfig) (*ssh.Client, error) {
+	// Connect to the first jump host directly
+	conn, err := ssh.Dial("tcp", jumps[0].hostPort, jumps[0].cfg)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to bastion host: %w", err)
+		return nil, fmt.Errorf("failed to connect to bastion host %s: %w", jumps[0].hostPort, err)
+	}
+	hops := []*ssh.Client{conn}
+	closeHops := func() {
+		for i := len(hops) - 1; i >= 0; i-- {
+			_ = hops[i].Close()
+		}
 	}
 
-	// Create a tunnel through bastion to the target host
-	targetConn, err := bastionConn.Dial("tcp", c.hostPort)
+	// Tunnel through each remaining jump host
+	for _, jump := range jumps[1:] {
+		next, err := dialThrough(hops[len(hops)-1], jump.hostPort, jump.cfg)
+		if err != nil {
+			closeHops()
+			return nil, fmt.Errorf("failed to connect to jump host %s: %w", jump.hostPort, err)
+		}
+		hops = append(hops, next)
+	}
+
+	// Create a tunnel through the last hop to the target host
+	targetConn, err := hops[len(hops)-1].Dial("tcp", hostPort)
 	if err != nil {
-		bastionConn.Close()
+		closeHops()
 		return nil, fmt.Errorf("failed to dial target through bastion: %w", err)
 	}
 
 	// Perform SSH handshake over the tunnel
-	ncc, chans, reqs, err := ssh.NewClientConn(targetConn, c.hostPort, c.cfg)
+	ncc, chans, reqs, err := ssh.NewClientConn(targetConn, hostPort, cfg)
 	if err != nil {
-		targetConn.Close()
-		bastionConn.Close()
+		_ = targetConn.Close()
+		closeHops()
 		return nil, fmt.Errorf("failed to establish SSH connection through bastion: %w", err)
 	}
 
-	// Wrap the connection to ensure bastion is closed when target is closed
+	// Wrap the connection to ensure the jump hosts are closed when target is closed
 	wrappedConn := &bastionWrappedConn{
-		Conn:    ncc,
-		bastion: bastionConn,
+		Conn: ncc,
+		hops: hops,
 	}
 
 	return ssh.NewClient(wrappedConn, chans, reqs), nil
 }
 
-// bastionWrappedConn wraps an SSH client connection and ensures the bastion
-// connection is closed when the target connection is closed.
+// dialThrough opens an SSH client to hostPort over a tunnel through via.
+func dialThrough(via *ssh.Client, hostPort string, cfg *ssh.ClientConfig) (*ssh.Client, error) {
+	conn, err := via.Dial("tcp", hostPort)
+	if err != nil {
+		return nil, err
+	}
+	ncc, chans, reqs, err := ssh.NewClientConn(conn, hostPort, cfg)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return ssh.NewClient(ncc, chans, reqs), nil
+}
+
+// bastionWrappedConn wraps an SSH client connection and ensures the jump host
+// connections are closed when the target connection is closed.
 type bastionWrappedConn struct {
 	ssh.Conn
-	bastion *ssh.Client
+	hops []*ssh.Client
 }
 
-// Close closes both the target connection and the bastion connection.
+// Close closes the target connection and then each jump host, innermost first.
 func (c *bastionWrappedConn) Close() error {
-	targetErr := c.Conn.Close()
-	bastionErr := c.bastion.Close()
-	return errors.Join(targetErr, bastionErr)
+	errs := []error{c.Conn.Close()}
+	for i := len(c.hops) - 1; i >= 0; i-- {
+		errs = append(errs, c.hops[i].Close())
+	}
+	return errors.Join(errs...)
 }
 
 // newBastionClientConfig creates the bastion client configuration.
 // It inherits the host key callback from the main SSH config for security.
 func newBastionClientConfig(bastion *BastionConfig, timeout time.Duration, hostKeyCallback ssh.HostKeyCallback) (*bastionClientConfig, error) {
-	authMethod, err := selectBastionAuthMethod(bastion)
+	authMethods, err := selectBastionAuthMethods(bastion)
 	if err != nil {
 		return nil, err
 	}
@@ -165,15 +219,16 @@ func newBastionClientConfig(bastion *BastionConfig, timeout time.Duration, hostK
 		hostPort: net.JoinHostPort(bastion.Host, defaultIfEmpty(bastion.Port, "22")),
 		cfg: &ssh.ClientConfig{
 			User:            bastion.User,
-			Auth:            []ssh.AuthMethod{authMethod},
+			Auth:            authMethods,
 			HostKeyCallback: hostKeyCallback,
 			Timeout:         timeout,
 		},
 	}, nil
 }
 
-// selectBastionAuthMethod determines the authentication method for bastion host.
-func selectBastionAuthMethod(bastion *BastionConfig) (ssh.AuthMethod, error) {
+// selectBastionAuthMethods determines the authentication methods for bastion host.
+func selectBastionAuthMethods(bastion *BastionConfig) ([]ssh.AuthMethod, error) {
+	var methods []ssh.AuthMethod
 	if bastion.Key != "" {
 		keyPath, err := fileutil.ResolvePath(bastion.Key)
 		if err != nil {
@@ -183,11 +238,14 @@ func selectBastionAuthMethod(bastion *BastionConfig) (ssh.AuthMethod, error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed to load bastion SSH key: %w", err)
 		}
-		return ssh.PublicKeys(signer), nil
+		methods = append(methods, ssh.PublicKeys(signer))
 	}
 
 	if bastion.Password != "" {
-		return ssh.Password(bastion.Password), nil
+		return append(methods, passwordAuthMethods(bastion.Password)...), nil
+	}
+	if len(methods) > 0 {
+		return methods, nil
 	}
 
 	// Try default keys for bastion
@@ -195,7 +253,7 @@ func selectBastionAuthMethod(bastion *BastionConfig) (ssh.AuthMethod, error) {
 		if _, err := os.Stat(defaultKey); err == nil {
 			signer, err := getPublicKeySigner(defaultKey)
 			if err == nil {
-				return ssh.PublicKeys(signer), nil
+				return []ssh.AuthMethod{ssh.PublicKeys(signer)}, nil
 			}
 		}
 	}
@@ -244,21 +302,50 @@ func getDefaultSSHKeys() []string {
 	}
 }
 
-// selectSSHAuthMethod selects the authentication method based on the configuration.
-// Priority: explicit key > default keys > password.
-func selectSSHAuthMethod(cfg *Config) (ssh.AuthMethod, error) {
+// selectSSHAuthMethods selects the authentication methods based on the
+// configuration. An explicit key is offered first, followed by the password
+// when one is set. Default keys are only tried when neither is configured.
+func selectSSHAuthMethods(cfg *Config) ([]ssh.AuthMethod, error) {
+	var methods []ssh.AuthMethod
 	if cfg.Key != "" {
-		return loadKeyAuth(cfg.Key)
+		keyAuth, err := loadKeyAuth(cfg.Key)
+		if err != nil {
+			return nil, err
+		}
+		methods = append(methods, keyAuth)
 	}
 
-	if cfg.Password == "" {
-		if keyPath := findDefaultSSHKey(); keyPath != "" {
-			return loadKeyAuth(keyPath)
+	if cfg.Password != "" {
+		return append(methods, passwordAuthMethods(cfg.Password)...), nil
+	}
+	if len(methods) > 0 {
+		return methods, nil
+	}
+
+	if keyPath := findDefaultSSHKey(); keyPath != "" {
+		keyAuth, err := loadKeyAuth(keyPath)
+		if err != nil {
+			return nil, err
 		}
-		return nil, fmt.Errorf("no SSH key specified and no default keys found (~/.ssh/id_rsa, id_ecdsa, id_ed25519, or id_dsa)")
+		return []ssh.AuthMethod{keyAuth}, nil
 	}
+	return nil, fmt.Errorf("no SSH key specified and no default keys found (~/.ssh/id_rsa, id_ecdsa, id_ed25519, or id_dsa); set 'key' or 'password'")
+}
 
-	return ssh.Password(cfg.Password), nil
+// passwordAuthMethods returns password authentication along with a
+// keyboard-interactive fallback that answers every prompt with the password,
+// which some servers require instead of plain password auth.
+func passwordAuthMethods(password string) []ssh.AuthMethod {
+	return []ssh.AuthMethod{
+		ssh.Password(password),
+		ssh.KeyboardInteractive(func(_, _ string, questions []string, _ []bool) ([]string, error) {
+			answers := make([]string, len(questions))
+			for i := range answers {
+				answers[i] = password
+			}
+			return answers, nil
+		}),
+	}
 }
 
 // loadKeyAuth loads an SSH key and returns a PublicKeys auth method.
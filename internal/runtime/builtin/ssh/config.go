@@ -6,6 +6,7 @@ package ssh
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"slices"
 	"strings"
 	"time"
@@ -31,6 +32,9 @@ type Config struct {
 	ShellArgs     []string       // Additional shell arguments (e.g., -e, -o pipefail)
 	Timeout       time.Duration  // Connection timeout (defaults to 30s)
 	Bastion       *BastionConfig // Optional bastion/jump host configuration
+	// ProxyJump is an optional chain of jump hosts, in connection order.
+	// Jump hosts without credentials use the target's user, key, and password.
+	ProxyJump []BastionConfig
 }
 
 // BastionConfig represents bastion/jump host connection info
@@ -42,6 +46,33 @@ type BastionConfig struct {
 	Password string
 }
 
+// LogValue implements slog.LogValuer so the password is never logged.
+func (c Config) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("user", c.User),
+		slog.String("host", c.Host),
+		slog.String("port", c.Port),
+		slog.String("key", c.Key),
+		slog.Bool("password", c.Password != ""),
+		slog.Any("bastion", c.Bastion),
+		slog.Int("proxyJumps", len(c.ProxyJump)),
+	)
+}
+
+// LogValue implements slog.LogValuer so the password is never logged.
+func (c *BastionConfig) LogValue() slog.Value {
+	if c == nil {
+		return slog.Value{}
+	}
+	return slog.GroupValue(
+		slog.String("user", c.User),
+		slog.String("host", c.Host),
+		slog.String("port", c.Port),
+		slog.String("key", c.Key),
+		slog.Bool("password", c.Password != ""),
+	)
+}
+
 // sshMapConfig is the structure for decoding SSH config from a map.
 type sshMapConfig struct {
 	User          string
@@ -62,6 +93,7 @@ type sshMapConfig struct {
 		Key      string
 		Password string
 	}
+	ProxyJump string `mapstructure:"proxy_jump"`
 }
 
 func FromMapConfig(_ context.Context, mapCfg map[string]any) (*Client, error) {
@@ -87,6 +119,14 @@ func FromMapConfig(_ context.Context, mapCfg map[string]any) (*Client, error) {
 		return nil, err
 	}
 
+	proxyJump, err := parseProxyJump(def.ProxyJump)
+	if err != nil {
+		return nil, err
+	}
+	if len(proxyJump) > 0 && def.Bastion != nil {
+		return nil, fmt.Errorf("'bastion' and 'proxy_jump' are mutually exclusive")
+	}
+
 	cfg := &Config{
 		User:          def.User,
 		Host:          coalesce(def.Host, def.IP),
@@ -99,6 +139,7 @@ func FromMapConfig(_ context.Context, mapCfg map[string]any) (*Client, error) {
 		ShellArgs:     shellArgs,
 		Timeout:       timeout,
 		Bastion:       buildBastionFromMap(def.Bastion),
+		ProxyJump:     proxyJump,
 	}
 
 	return NewClient(cfg)
@@ -116,6 +157,19 @@ func parseTimeout(s string) (time.Duration, error) {
 	return d, nil
 }
 
+// parseProxyJump parses a ProxyJump chain into jump host configurations.
+func parseProxyJump(s string) ([]BastionConfig, error) {
+	jumps, err := core.ParseProxyJump(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy_jump: %w", err)
+	}
+	ret := make([]BastionConfig, 0, len(jumps))
+	for _, jump := range jumps {
+		ret = append(ret, BastionConfig{Host: jump.Host, Port: jump.Port, User: jump.User})
+	}
+	return ret, nil
+}
+
 // buildBastionFromMap converts a bastion map config to BastionConfig.
 func buildBastionFromMap(b *struct {
 	Host     string
@@ -192,6 +246,7 @@ var configSchema = &jsonschema.Schema{
 		"shell":           {Type: "string", Description: "Shell for remote execution"},
 		"shell_args":      {Type: "array", Items: &jsonschema.Schema{Type: "string"}, Description: "Additional shell arguments"},
 		"timeout":         {Type: "string", Description: "Connection timeout (e.g., '30s', '1m')"},
+		"proxy_jump":      {Type: "string", Description: "Comma-separated jump hosts ([user@]host[:port]), like OpenSSH ProxyJump"},
 		"bastion": {
 			Type:        "object",
 			Description: "Bastion/jump host configuration",
@@ -221,6 +276,7 @@ var sftpConfigSchema = &jsonschema.Schema{
 		"direction":       {Type: "string", Description: "Transfer direction: 'upload' or 'download'"},
 		"source":          {Type: "string", Description: "Source path (local for upload, remote for download)"},
 		"destination":     {Type: "string", Description: "Destination path (remote for upload, local for download)"},
+		"proxy_jump":      {Type: "string", Description: "Comma-separated jump hosts ([user@]host[:port]), like OpenSSH ProxyJump"},
 		"bastion": {
 			Type:        "object",
 			Description: "Bastion/jump host configuration",
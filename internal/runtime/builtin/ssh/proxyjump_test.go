@@ -0,0 +1,260 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package ssh
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+// testSSHServer is a minimal in-process SSH server. Jump servers forward
+// direct-tcpip channels; the target accepts sessions and exits 0 on exec.
+type testSSHServer struct {
+	addr  string
+	mu    sync.Mutex
+	users []string
+}
+
+func (s *testSSHServer) Users() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.users...)
+}
+
+func startTestSSHServer(t *testing.T, password string) *testSSHServer {
+	t.Helper()
+	return startTestSSHServerWithAuth(t, password, false)
+}
+
+// startTestSSHServerWithAuth starts a test server that accepts password auth,
+// or only keyboard-interactive auth when keyboardInteractive is true.
+func startTestSSHServerWithAuth(t *testing.T, password string, keyboardInteractive bool) *testSSHServer {
+	t.Helper()
+
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer, err := ssh.NewSignerFromKey(priv)
+	require.NoError(t, err)
+
+	srv := &testSSHServer{}
+	check := func(meta ssh.ConnMetadata, pass string) (*ssh.Permissions, error) {
+		srv.mu.Lock()
+		srv.users = append(srv.users, meta.User())
+		srv.mu.Unlock()
+		if pass != password {
+			return nil, assert.AnError
+		}
+		return nil, nil
+	}
+	cfg := &ssh.ServerConfig{}
+	if keyboardInteractive {
+		cfg.KeyboardInteractiveCallback = func(meta ssh.ConnMetadata, challenge ssh.KeyboardInteractiveChallenge) (*ssh.Permissions, error) {
+			answers, err := challenge(meta.User(), "", []string{"Password: "}, []bool{false})
+			if err != nil || len(answers) != 1 {
+				return nil, assert.AnError
+			}
+			return check(meta, answers[0])
+		}
+	} else {
+		cfg.PasswordCallback = func(meta ssh.ConnMetadata, pass []byte) (*ssh.Permissions, error) {
+			return check(meta, string(pass))
+		}
+	}
+	cfg.AddHostKey(signer)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+	srv.addr = ln.Addr().String()
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveTestSSHConn(conn, cfg)
+		}
+	}()
+	return srv
+}
+
+func serveTestSSHConn(conn net.Conn, cfg *ssh.ServerConfig) {
+	sconn, chans, reqs, err := ssh.NewServerConn(conn, cfg)
+	if err != nil {
+		_ = conn.Close()
+		return
+	}
+	defer func() { _ = sconn.Close() }()
+	go ssh.DiscardRequests(reqs)
+
+	for newCh := range chans {
+		switch newCh.ChannelType() {
+		case "direct-tcpip":
+			go forwardTestChannel(newCh)
+		case "session":
+			go serveTestSession(newCh)
+		default:
+			_ = newCh.Reject(ssh.UnknownChannelType, "unsupported")
+		}
+	}
+}
+
+func forwardTestChannel(newCh ssh.NewChannel) {
+	var payload struct {
+		Host       string
+		Port       uint32
+		OriginHost string
+		OriginPort uint32
+	}
+	if err := ssh.Unmarshal(newCh.ExtraData(), &payload); err != nil {
+		_ = newCh.Reject(ssh.ConnectionFailed, err.Error())
+		return
+	}
+	target, err := net.Dial("tcp", net.JoinHostPort(payload.Host, strconv.Itoa(int(payload.Port))))
+	if err != nil {
+		_ = newCh.Reject(ssh.ConnectionFailed, err.Error())
+		return
+	}
+	ch, reqs, err := newCh.Accept()
+	if err != nil {
+		_ = target.Close()
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+	go func() {
+		_, _ = io.Copy(target, ch)
+		_ = target.Close()
+	}()
+	_, _ = io.Copy(ch, target)
+	_ = ch.Close()
+}
+
+func serveTestSession(newCh ssh.NewChannel) {
+	ch, reqs, err := newCh.Accept()
+	if err != nil {
+		return
+	}
+	defer func() { _ = ch.Close() }()
+	for req := range reqs {
+		if req.Type != "exec" {
+			_ = req.Reply(false, nil)
+			continue
+		}
+		_ = req.Reply(true, nil)
+		_, _ = ch.Write([]byte("ok\n"))
+		status := make([]byte, 4)
+		binary.BigEndian.PutUint32(status, 0)
+		_, _ = ch.SendRequest("exit-status", false, status)
+		return
+	}
+}
+
+func TestClient_ProxyJumpChain(t *testing.T) {
+	t.Parallel()
+
+	jump1 := startTestSSHServer(t, "secret")
+	jump2 := startTestSSHServer(t, "secret")
+	target := startTestSSHServer(t, "secret")
+
+	splitHostPort := func(addr string) (string, string) {
+		host, port, err := net.SplitHostPort(addr)
+		require.NoError(t, err)
+		return host, port
+	}
+	j1Host, j1Port := splitHostPort(jump1.addr)
+	j2Host, j2Port := splitHostPort(jump2.addr)
+	tHost, tPort := splitHostPort(target.addr)
+
+	client, err := NewClient(&Config{
+		User:     "deploy",
+		Host:     tHost,
+		Port:     tPort,
+		Password: "secret",
+		ProxyJump: []BastionConfig{
+			{Host: j1Host, Port: j1Port, User: "ops"},
+			{Host: j2Host, Port: j2Port},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, client.proxyJumps, 2)
+
+	conn, session, err := client.NewSession()
+	require.NoError(t, err)
+	out, err := session.Output("uptime")
+	require.NoError(t, err)
+	assert.Equal(t, "ok\n", string(out))
+	_ = session.Close()
+	require.NoError(t, conn.Close())
+
+	// The first hop uses its own user; the second falls back to the target's.
+	assert.Equal(t, []string{"ops"}, jump1.Users())
+	assert.Equal(t, []string{"deploy"}, jump2.Users())
+	assert.Equal(t, []string{"deploy"}, target.Users())
+}
+
+func TestClient_ProxyJumpUnreachable(t *testing.T) {
+	t.Parallel()
+
+	target := startTestSSHServer(t, "secret")
+	host, port, err := net.SplitHostPort(target.addr)
+	require.NoError(t, err)
+
+	// Reserve a port and close it so the jump host refuses connections.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedAddr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+	jHost, jPort, err := net.SplitHostPort(closedAddr)
+	require.NoError(t, err)
+
+	client, err := NewClient(&Config{
+		User:      "deploy",
+		Host:      host,
+		Port:      port,
+		Password:  "secret",
+		ProxyJump: []BastionConfig{{Host: jHost, Port: jPort}},
+	})
+	require.NoError(t, err)
+
+	_, _, err = client.NewSession()
+	require.ErrorContains(t, err, "failed to connect to bastion host "+closedAddr)
+	assert.Empty(t, target.Users())
+}
+
+func TestFromMapConfig_ProxyJump(t *testing.T) {
+	t.Parallel()
+
+	client, err := FromMapConfig(t.Context(), map[string]any{
+		"user":       "deploy",
+		"host":       "target.example.com",
+		"password":   "secret",
+		"proxy_jump": "ops@bastion.example.com:2222,jump.internal",
+	})
+	require.NoError(t, err)
+	require.Len(t, client.proxyJumps, 2)
+	assert.Equal(t, "bastion.example.com:2222", client.proxyJumps[0].hostPort)
+	assert.Equal(t, "ops", client.proxyJumps[0].cfg.User)
+	assert.Equal(t, "jump.internal:22", client.proxyJumps[1].hostPort)
+	assert.Equal(t, "deploy", client.proxyJumps[1].cfg.User)
+
+	_, err = FromMapConfig(t.Context(), map[string]any{
+		"user":       "deploy",
+		"host":       "target.example.com",
+		"password":   "secret",
+		"proxy_jump": "bastion.example.com",
+		"bastion":    map[string]any{"host": "other.example.com", "password": "x"},
+	})
+	require.ErrorContains(t, err, "mutually exclusive")
+}
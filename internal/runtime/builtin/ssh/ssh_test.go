@@ -6,6 +6,8 @@ package ssh
 import (
 	"bytes"
 	"context"
+	"log/slog"
+	"net"
 	"strings"
 	"testing"
 	"time"
@@ -1036,9 +1038,9 @@ func TestSelectSSHAuthMethod_Password(t *testing.T) {
 		Password: "testpassword",
 	}
 
-	authMethod, err := selectSSHAuthMethod(cfg)
+	authMethods, err := selectSSHAuthMethods(cfg)
 	require.NoError(t, err)
-	require.NotNil(t, authMethod)
+	require.Len(t, authMethods, 2)
 }
 
 func TestSelectSSHAuthMethod_NoAuth(t *testing.T) {
@@ -1054,7 +1056,7 @@ func TestSelectSSHAuthMethod_NoAuth(t *testing.T) {
 		// Empty - no auth method specified
 	}
 
-	_, err := selectSSHAuthMethod(cfg)
+	_, err := selectSSHAuthMethods(cfg)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "no SSH key specified")
 }
@@ -1068,9 +1070,9 @@ func TestSelectBastionAuthMethod_Password(t *testing.T) {
 		Password: "bastionpass",
 	}
 
-	authMethod, err := selectBastionAuthMethod(bastion)
+	authMethods, err := selectBastionAuthMethods(bastion)
 	require.NoError(t, err)
-	require.NotNil(t, authMethod)
+	require.Len(t, authMethods, 2)
 }
 
 func TestSelectBastionAuthMethod_NoAuth(t *testing.T) {
@@ -1087,7 +1089,63 @@ func TestSelectBastionAuthMethod_NoAuth(t *testing.T) {
 		// No key, no password
 	}
 
-	_, err := selectBastionAuthMethod(bastion)
+	_, err := selectBastionAuthMethods(bastion)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "no authentication method available for bastion")
 }
+
+func TestClient_PasswordAuth(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name                string
+		keyboardInteractive bool
+	}{
+		{name: "Password", keyboardInteractive: false},
+		{name: "KeyboardInteractive", keyboardInteractive: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := startTestSSHServerWithAuth(t, "s3cret", tt.keyboardInteractive)
+			host, port, err := net.SplitHostPort(srv.addr)
+			require.NoError(t, err)
+
+			// The password is expanded at runtime, as the agent does for DAG-level SSH.
+			cfg, err := eval.Object(context.Background(), Config{
+				User:     "legacy",
+				Host:     host,
+				Port:     port,
+				Password: "${SSH_PASS}",
+			}, map[string]string{"SSH_PASS": "s3cret"})
+			require.NoError(t, err)
+			require.Equal(t, "s3cret", cfg.Password)
+
+			client, err := NewClient(&cfg)
+			require.NoError(t, err)
+			conn, session, err := client.NewSession()
+			require.NoError(t, err)
+			_ = session.Close()
+			require.NoError(t, conn.Close())
+			assert.Equal(t, []string{"legacy"}, srv.Users())
+		})
+	}
+}
+
+func TestConfig_LogValueRedactsPassword(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	log := slog.New(slog.NewJSONHandler(&buf, nil))
+	log.Info("ssh", slog.Any("config", Config{
+		User:     "legacy",
+		Host:     "legacy.example.com",
+		Password: "s3cret",
+		Bastion:  &BastionConfig{Host: "bastion.example.com", Password: "b4stion"},
+	}))
+
+	out := buf.String()
+	assert.Contains(t, out, "legacy.example.com")
+	assert.Contains(t, out, "bastion.example.com")
+	assert.NotContains(t, out, "s3cret")
+	assert.NotContains(t, out, "b4stion")
+}
@@ -5,11 +5,19 @@ package runtime
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"os/exec"
+	"regexp"
 	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/dagucloud/dagu/internal/cmn/cmdutil"
+	"github.com/dagucloud/dagu/internal/cmn/eval"
 	"github.com/dagucloud/dagu/internal/cmn/stringutil"
 	"github.com/dagucloud/dagu/internal/core"
 )
@@ -17,11 +25,56 @@ import (
 // Errors for condition evaluation
 var (
 	ErrConditionNotMet = fmt.Errorf("condition was not met")
+
+	errConditionTimedOut = errors.New("condition command timed out")
 )
 
 // Error message for when not all conditions are met
 const ErrMsgOtherConditionNotMet = "other condition was not met"
 
+// conditionWaitDelay bounds how long a timed out condition command may keep
+// its output pipes open (e.g. through orphaned child processes).
+const conditionWaitDelay = time.Second
+
+// Context key marking condition commands that run under a deadline
+type boundedConditionCtxKey struct{}
+
+// withConditionTimeout returns a context that bounds condition commands to
+// timeout. Such commands run in their own process group, which is killed as
+// a whole when the deadline passes, and a command cut short by it fails with
+// errConditionTimedOut instead of ErrConditionNotMet.
+func withConditionTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithValue(ctx, boundedConditionCtxKey{}, true), timeout)
+}
+
+func isBoundedCondition(ctx context.Context) bool {
+	bounded, _ := ctx.Value(boundedConditionCtxKey{}).(bool)
+	return bounded
+}
+
+// reUnresolvedVar matches a value that is still a bare variable reference
+// after evaluation, i.e. the variable is not set.
+var reUnresolvedVar = regexp.MustCompile(`^\$(\{[A-Za-z_][A-Za-z0-9_]*\}|[A-Za-z_][A-Za-z0-9_]*)$`)
+
+// EvalSkip evaluates a skip expression and reports whether it is truthy.
+// Empty values, unset variables and "false", "0", "no" or "off" are falsy.
+func EvalSkip(ctx context.Context, expr string) (bool, error) {
+	value, err := EvalString(ctx, expr)
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate skip expression: %w", err)
+	}
+	value = strings.TrimSpace(value)
+	if reUnresolvedVar.MatchString(value) {
+		return false, nil
+	}
+	switch strings.ToLower(value) {
+	case "", "false", "0", "no", "off":
+		return false, nil
+	default:
+		return true, nil
+	}
+}
+
 // EvalConditions evaluates a list of conditions and checks the results.
 // It returns an error if any of the conditions were not met.
 func EvalConditions(ctx context.Context, shell []string, cond []*core.Condition) error {
@@ -47,6 +100,17 @@ func EvalConditions(ctx context.Context, shell []string, cond []*core.Condition)
 	return lastErr
 }
 
+// failedCondition returns a copy of the first condition that was not met on
+// its own in the last EvalConditions call, or nil if every condition was met.
+func failedCondition(cond []*core.Condition) *core.Condition {
+	for _, c := range cond {
+		if msg := c.GetErrorMessage(); msg != "" && msg != ErrMsgOtherConditionNotMet {
+			return c.Clone()
+		}
+	}
+	return nil
+}
+
 // EvalCondition evaluates the condition and returns the actual value.
 // It returns an error if the evaluation failed or the condition is invalid.
 // If c.Negate is true, the result is inverted: the condition passes when it
@@ -54,6 +118,12 @@ func EvalConditions(ctx context.Context, shell []string, cond []*core.Condition)
 func EvalCondition(ctx context.Context, shell []string, c *core.Condition) error {
 	var err error
 	switch {
+	case c.IsAnyGroup():
+		err = matchAnyCondition(ctx, shell, c)
+
+	case c.IsJSONPath():
+		err = matchJSONPathCondition(ctx, c)
+
 	case c.Condition != "" && c.Expected != "":
 		err = matchCondition(ctx, c)
 
@@ -77,6 +147,27 @@ func EvalCondition(ctx context.Context, shell []string, c *core.Condition) error
 	return err
 }
 
+// matchAnyCondition evaluates the alternatives of an any group in order and
+// stops at the first one that is met. When none is met, an evaluation error
+// of an alternative is returned in preference to a plain "not met".
+func matchAnyCondition(ctx context.Context, shell []string, c *core.Condition) error {
+	var evalErr error
+	for _, alt := range c.Any {
+		err := EvalCondition(ctx, shell, alt)
+		if err == nil {
+			return nil
+		}
+		alt.SetErrorMessage(err.Error())
+		if !errors.Is(err, ErrConditionNotMet) && evalErr == nil {
+			evalErr = err
+		}
+	}
+	if evalErr != nil {
+		return evalErr
+	}
+	return fmt.Errorf("%w: none of the %d alternatives was met", ErrConditionNotMet, len(c.Any))
+}
+
 // matchCondition evaluates the condition and checks if it matches the expected value.
 // It returns an error if the condition was not met.
 func matchCondition(ctx context.Context, c *core.Condition) error {
@@ -84,7 +175,36 @@ func matchCondition(ctx context.Context, c *core.Condition) error {
 	if err != nil {
 		return fmt.Errorf("failed to evaluate the value: Error=%v", err)
 	}
+	return matchExpected(ctx, c, evaluatedVal)
+}
+
+// matchJSONPathCondition extracts the condition's JSON path from the evaluated
+// source and checks it against the expected value. A path that resolves to
+// nothing or null does not meet the condition.
+func matchJSONPathCondition(ctx context.Context, c *core.Condition) error {
+	source, err := EvalString(ctx, c.Source)
+	if err != nil {
+		return fmt.Errorf("failed to evaluate the source: Error=%v", err)
+	}
+	var data any
+	if err := json.Unmarshal([]byte(source), &data); err != nil {
+		return fmt.Errorf("%w: source is not valid JSON: %v", ErrConditionNotMet, err)
+	}
 
+	path := c.JSONPath()
+	query, err := c.JSONPathQuery()
+	if err != nil {
+		return err
+	}
+	value, ok := eval.RunDataPath(ctx, "source", data, path, query)
+	if !ok || value == nil {
+		return fmt.Errorf("%w: path %s not found in source", ErrConditionNotMet, path)
+	}
+	return matchExpected(ctx, c, eval.StringifyResolvedValue(value))
+}
+
+// matchExpected checks the value against the condition's expected pattern.
+func matchExpected(ctx context.Context, c *core.Condition, evaluatedVal string) error {
 	// Get maxOutputSize from DAG configuration
 	var maxOutputSize = defaultMaxOutputSizeBytes
 	if rCtx := GetDAGContext(ctx); rCtx.DAG != nil && rCtx.DAG.MaxOutputSize > 0 {
@@ -96,13 +216,60 @@ func matchCondition(ctx context.Context, c *core.Condition) error {
 		stringutil.WithMaxBufferSize(maxOutputSize),
 	}
 
-	if stringutil.MatchPattern(ctx, evaluatedVal, []string{c.Expected}, matchOpts...) {
+	matched, ok := compareNumbers(c.Expected, evaluatedVal)
+	if !ok {
+		expected, err := c.ExpectedPatterns()
+		if err != nil {
+			return err
+		}
+		matched = expected.Match(ctx, evaluatedVal, matchOpts...)
+	}
+	if matched {
 		return nil
 	}
 	// Return an helpful error message if the condition is not met
 	return fmt.Errorf("%w: expected %q, got %q", ErrConditionNotMet, c.Expected, evaluatedVal)
 }
 
+// numericOperators lists the comparison operators accepted at the start of an
+// expected value. Two-character operators come first so that ">=" is not
+// read as ">" followed by "=".
+var numericOperators = []string{">=", "<=", "!=", ">", "<"}
+
+// compareNumbers compares value against an expected value of the form
+// "<operator><number>", such as ">3" or "<=10". It reports ok=false when the
+// expected value has no operator or either side is not a number, in which
+// case the caller falls back to string matching.
+func compareNumbers(expected, value string) (matched, ok bool) {
+	for _, op := range numericOperators {
+		operand, found := strings.CutPrefix(expected, op)
+		if !found {
+			continue
+		}
+		want, err := strconv.ParseFloat(strings.TrimSpace(operand), 64)
+		if err != nil {
+			return false, false
+		}
+		got, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return false, false
+		}
+		switch op {
+		case ">=":
+			return got >= want, true
+		case "<=":
+			return got <= want, true
+		case "!=":
+			return got != want, true
+		case ">":
+			return got > want, true
+		default:
+			return got < want, true
+		}
+	}
+	return false, false
+}
+
 func evalCommand(ctx context.Context, shell []string, c *core.Condition) error {
 	commandToRun, err := EvalString(ctx, c.Condition, CommandEvalOptions(shell)...)
 	if err != nil {
@@ -115,6 +282,16 @@ func evalCommand(ctx context.Context, shell []string, c *core.Condition) error {
 }
 
 func runShellCommand(ctx context.Context, shell []string, commandToRun string) error {
+	return runConditionCommand(ctx, newShellCommand(ctx, shell, commandToRun))
+}
+
+func runDirectCommand(ctx context.Context, commandToRun string) error {
+	return runConditionCommand(ctx, newDirectCommand(ctx, commandToRun))
+}
+
+// newShellCommand returns a command that runs commandToRun with the shell
+// and the environment of the context.
+func newShellCommand(ctx context.Context, shell []string, commandToRun string) *exec.Cmd {
 	args := make([]string, len(shell)-1)
 	copy(args, shell[1:])
 	if !slices.Contains(args, "-c") {
@@ -123,19 +300,36 @@ func runShellCommand(ctx context.Context, shell []string, commandToRun string) e
 	args = append(args, commandToRun)
 	cmd := exec.CommandContext(ctx, shell[0], args...) // nolint:gosec
 	cmd.Env = append(cmd.Env, AllEnvs(ctx)...)
-	_, err := cmd.Output()
-	if err != nil {
-		return fmt.Errorf("%w: %s", ErrConditionNotMet, err)
-	}
-	return nil
+	return cmd
 }
 
-func runDirectCommand(ctx context.Context, commandToRun string) error {
+// newDirectCommand returns a command that runs commandToRun as a program
+// with the environment of the context.
+func newDirectCommand(ctx context.Context, commandToRun string) *exec.Cmd {
 	cmd := exec.CommandContext(ctx, commandToRun)
 	cmd.Env = append(cmd.Env, AllEnvs(ctx)...)
+	return cmd
+}
+
+// runConditionCommand runs a condition command and reports whether it
+// succeeded. Under withConditionTimeout the entire process group is killed at
+// the deadline instead of only the shell, so a hung child cannot keep the
+// evaluation blocked.
+func runConditionCommand(ctx context.Context, cmd *exec.Cmd) error {
+	bounded := isBoundedCondition(ctx)
+	if bounded {
+		cmdutil.SetupCommand(cmd)
+		cmd.Cancel = func() error {
+			return cmdutil.KillProcessGroup(cmd, os.Kill)
+		}
+		cmd.WaitDelay = conditionWaitDelay
+	}
 	_, err := cmd.Output()
-	if err != nil {
-		return fmt.Errorf("%w: %s", ErrConditionNotMet, err)
+	if err == nil {
+		return nil
 	}
-	return nil
+	if bounded && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%w: %s", errConditionTimedOut, err)
+	}
+	return fmt.Errorf("%w: %s", ErrConditionNotMet, err)
 }
@@ -193,6 +193,154 @@ func TestEvalConditions(t *testing.T) {
 	}
 }
 
+func TestEvalConditions_NumericComparison(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition *core.Condition
+		wantErr   string
+	}{
+		{
+			name:      "GreaterThan",
+			condition: &core.Condition{Condition: "`echo 5`", Expected: ">3"},
+		},
+		{
+			name:      "GreaterThanNotMet",
+			condition: &core.Condition{Condition: "`echo 3`", Expected: ">3"},
+			wantErr:   `expected ">3", got "3"`,
+		},
+		{
+			name:      "LessThan",
+			condition: &core.Condition{Condition: "`echo 2.5`", Expected: "<3"},
+		},
+		{
+			name:      "LessThanNotMet",
+			condition: &core.Condition{Condition: "`echo 10`", Expected: "<3"},
+			wantErr:   `expected "<3", got "10"`,
+		},
+		{
+			name:      "GreaterOrEqual",
+			condition: &core.Condition{Condition: "`echo 3`", Expected: ">=3"},
+		},
+		{
+			name:      "GreaterOrEqualNotMet",
+			condition: &core.Condition{Condition: "`echo -1`", Expected: ">=0"},
+			wantErr:   `expected ">=0", got "-1"`,
+		},
+		{
+			name:      "LessOrEqual",
+			condition: &core.Condition{Condition: "${COUNT}", Expected: "<=10"},
+		},
+		{
+			name:      "LessOrEqualNotMet",
+			condition: &core.Condition{Condition: "${COUNT}", Expected: "<= 9"},
+			wantErr:   `expected "<= 9", got "10"`,
+		},
+		{
+			name:      "NotEqual",
+			condition: &core.Condition{Condition: "${COUNT}", Expected: "!=3"},
+		},
+		{
+			name:      "NotEqualNotMet",
+			condition: &core.Condition{Condition: "${COUNT}", Expected: "!=10.0"},
+			wantErr:   `expected "!=10.0", got "10"`,
+		},
+		{
+			name:      "Negated",
+			condition: &core.Condition{Condition: "${COUNT}", Expected: ">100", Negate: true},
+		},
+		{
+			name:      "NonNumericValueFallsBackToString",
+			condition: &core.Condition{Condition: "abc", Expected: ">3"},
+			wantErr:   `expected ">3", got "abc"`,
+		},
+		{
+			name:      "NonNumericOperandFallsBackToString",
+			condition: &core.Condition{Condition: ">abc", Expected: ">abc"},
+		},
+		{
+			name:      "PlainNumberIsStringEquality",
+			condition: &core.Condition{Condition: "5.0", Expected: "5"},
+			wantErr:   `expected "5", got "5.0"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := newTestContext()
+			env := runtime.GetEnv(ctx)
+			env.Scope = env.Scope.WithEntry("COUNT", "10", eval.EnvSourceDAGEnv)
+			ctx = runtime.WithEnv(ctx, env)
+
+			err := runtime.EvalConditions(ctx, []string{"sh"}, []*core.Condition{tt.condition})
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, runtime.ErrConditionNotMet)
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestEvalConditions_AnyGroup(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition *core.Condition
+		wantErr   string
+	}{
+		{
+			name: "OneOfTwoMet",
+			condition: &core.Condition{Any: []*core.Condition{
+				{Condition: "${ENV}", Expected: "prod"},
+				{Condition: "${ENV}", Expected: "dev"},
+			}},
+		},
+		{
+			name: "NoneMet",
+			condition: &core.Condition{Any: []*core.Condition{
+				{Condition: "${ENV}", Expected: "prod"},
+				{Condition: "false"},
+			}},
+			wantErr: "none of the 2 alternatives was met",
+		},
+		{
+			name: "Negated",
+			condition: &core.Condition{Negate: true, Any: []*core.Condition{
+				{Condition: "${ENV}", Expected: "prod"},
+				{Condition: "${ENV}", Expected: "staging"},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := newTestContext()
+			env := runtime.GetEnv(ctx)
+			env.Scope = env.Scope.WithEntry("ENV", "dev", eval.EnvSourceDAGEnv)
+			ctx = runtime.WithEnv(ctx, env)
+
+			err := runtime.EvalConditions(ctx, []string{"sh"}, []*core.Condition{tt.condition})
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, runtime.ErrConditionNotMet)
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+
+	t.Run("EvaluationErrorIsNotMasked", func(t *testing.T) {
+		ctx := newTestContext()
+		cond := &core.Condition{Any: []*core.Condition{
+			{Condition: "jsonpath:$.status", Source: `{"status":"ok"}`, Expected: "re:["},
+			{Condition: "false"},
+		}}
+		err := runtime.EvalConditions(ctx, []string{"sh"}, []*core.Condition{cond})
+		require.Error(t, err)
+		require.NotErrorIs(t, err, runtime.ErrConditionNotMet)
+	})
+}
+
 func TestEvalConditions_ShellWithDuplicateCFlag(t *testing.T) {
 	ctx := newTestContext()
 	// Shell already includes -c; should not get doubled
@@ -212,6 +360,45 @@ func TestEvalConditions_NilShell(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestEvalSkip(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		want bool
+	}{
+		{name: "True", expr: "true", want: true},
+		{name: "Yes", expr: "yes", want: true},
+		{name: "One", expr: "1", want: true},
+		{name: "AnyText", expr: "skip it", want: true},
+		{name: "VarTruthy", expr: "${SKIP_FLAG}", want: true},
+		{name: "CommandSubstitution", expr: "`echo true`", want: true},
+		{name: "False", expr: "false", want: false},
+		{name: "FalseUppercase", expr: "FALSE", want: false},
+		{name: "Zero", expr: "0", want: false},
+		{name: "No", expr: "no", want: false},
+		{name: "Off", expr: "off", want: false},
+		{name: "Whitespace", expr: "  ", want: false},
+		{name: "VarEmpty", expr: "${EMPTY_FLAG}", want: false},
+		{name: "VarUnset", expr: "${UNSET_SKIP_FLAG}", want: false},
+		{name: "VarUnsetNoBraces", expr: "$UNSET_SKIP_FLAG", want: false},
+	}
+
+	ctx := newTestContext()
+	env := runtime.GetEnv(ctx)
+	env.Scope = env.Scope.
+		WithEntry("SKIP_FLAG", "true", eval.EnvSourceDAGEnv).
+		WithEntry("EMPTY_FLAG", "", eval.EnvSourceDAGEnv)
+	ctx = runtime.WithEnv(ctx, env)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := runtime.EvalSkip(ctx, tt.expr)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestEvalConditions_CommandFormExpandsHomeRelativeScopeVars(t *testing.T) {
 	if goruntime.GOOS == "windows" {
 		t.Skip("Skipping Unix shell test on Windows")
@@ -237,3 +424,64 @@ func TestEvalConditions_CommandFormExpandsHomeRelativeScopeVars(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestEvalConditions_JSONPath(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition *core.Condition
+		wantErr   string
+	}{
+		{
+			name:      "Match",
+			condition: &core.Condition{Condition: "jsonpath:$.status", Source: "${RESP}", Expected: "ready"},
+		},
+		{
+			name:      "NestedArrayIndex",
+			condition: &core.Condition{Condition: "jsonpath:$.items[1].name", Source: "${RESP}", Expected: "b"},
+		},
+		{
+			name:      "NumberAndRegex",
+			condition: &core.Condition{Condition: "jsonpath:$.count", Source: "${RESP}", Expected: "re:^4[0-9]$"},
+		},
+		{
+			name:      "InlineSource",
+			condition: &core.Condition{Condition: "jsonpath:$.ok", Source: `{"ok":true}`, Expected: "true"},
+		},
+		{
+			name:      "NoMatch",
+			condition: &core.Condition{Condition: "jsonpath:$.status", Source: "${RESP}", Expected: "done"},
+			wantErr:   `expected "done", got "ready"`,
+		},
+		{
+			name:      "MissingPath",
+			condition: &core.Condition{Condition: "jsonpath:$.missing.field", Source: "${RESP}", Expected: "ready"},
+			wantErr:   "path $.missing.field not found in source",
+		},
+		{
+			name:      "InvalidJSON",
+			condition: &core.Condition{Condition: "jsonpath:$.status", Source: "not json", Expected: "ready"},
+			wantErr:   "source is not valid JSON",
+		},
+		{
+			name:      "NegatedMissingPath",
+			condition: &core.Condition{Condition: "jsonpath:$.missing", Source: "${RESP}", Expected: "ready", Negate: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := newTestContext()
+			env := runtime.GetEnv(ctx)
+			env.Scope = env.Scope.WithEntry("RESP", `{"status":"ready","count":42,"items":[{"name":"a"},{"name":"b"}]}`, eval.EnvSourceOutput)
+			ctx = runtime.WithEnv(ctx, env)
+
+			err := runtime.EvalConditions(ctx, []string{"sh"}, []*core.Condition{tt.condition})
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, runtime.ErrConditionNotMet)
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -6,6 +6,7 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"maps"
 	"slices"
 	"strconv"
@@ -15,6 +16,8 @@ import (
 
 	"github.com/dagucloud/dagu/internal/cmn/collections"
 	"github.com/dagucloud/dagu/internal/cmn/eval"
+	"github.com/dagucloud/dagu/internal/cmn/logger"
+	"github.com/dagucloud/dagu/internal/cmn/logger/tag"
 	"github.com/dagucloud/dagu/internal/cmn/stringutil"
 	"github.com/dagucloud/dagu/internal/core"
 	"github.com/dagucloud/dagu/internal/core/exec"
@@ -58,9 +61,15 @@ type NodeState struct {
 	SkippedByRetry bool
 	// Error is the error that the executor encountered.
 	Error error
+	// CancelReason records why the node was aborted (or never started) when
+	// the cause lies outside the node, such as a failed upstream step.
+	CancelReason core.CancelReason
 	// ExitCode is the exit code that the command exited with.
 	// It only makes sense when the node is a command executor.
 	ExitCode int
+	// OnErrorExitCode is the exit code of the step's onError command, or nil
+	// if the command did not run.
+	OnErrorExitCode *int
 	// Parallel contains the evaluated parallel execution state for the node.
 	// This is populated when a step has parallel configuration and tracks
 	// all the items that need to be executed in parallel.
@@ -159,6 +168,7 @@ func (d *Data) ResetError() {
 
 	d.inner.State.Error = nil
 	d.inner.State.ExitCode = 0
+	d.inner.State.OnErrorExitCode = nil
 }
 
 func (d *Data) SetExecutorConfig(cfg core.ExecutorConfig) {
@@ -271,6 +281,13 @@ func (d *Data) Setup(ctx context.Context, logFile string, startedAt time.Time) e
 	}
 	d.inner.Step.Stdout = stdout
 
+	// Evaluate the output file field
+	outputFile, err := EvalStepString(ctx, d.inner.Step.OutputFile, eval.WithoutDollarEscape())
+	if err != nil {
+		return fmt.Errorf("failed to evaluate output_file field: %w", err)
+	}
+	d.inner.Step.OutputFile = outputFile
+
 	// Evaluate the stderr field
 	stderr, err := EvalStepString(ctx, d.inner.Step.Stderr, eval.WithoutDollarEscape())
 	if err != nil {
@@ -295,10 +312,24 @@ func (d *Data) Status() core.NodeStatus {
 	return d.inner.State.Status
 }
 
-func (d *Data) SetStatus(s core.NodeStatus) {
+// illegalStatusTransition is called when SetStatus moves a node between
+// statuses that core.NodeStatus.CanTransitionTo rejects. The transition still
+// happens; this only surfaces scheduler bugs.
+var illegalStatusTransition = func(ctx context.Context, step string, from, to core.NodeStatus) {
+	logger.Warn(ctx, "Illegal node status transition",
+		tag.Step(step),
+		slog.String("from", from.String()),
+		slog.String("to", to.String()),
+	)
+}
+
+func (d *Data) SetStatus(ctx context.Context, s core.NodeStatus) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
+	if from := d.inner.State.Status; !from.CanTransitionTo(s) {
+		illegalStatusTransition(ctx, d.inner.Step.Name, from, s)
+	}
 	d.inner.State.Status = s
 }
 
@@ -311,6 +342,12 @@ func (d *Data) StepInfo() eval.StepInfo {
 		Stderr:   d.inner.State.Stderr,
 		ExitCode: strconv.Itoa(d.inner.State.ExitCode),
 	}
+	if status := d.inner.State.Status; status != core.NodeNotStarted {
+		info.Status = status.String()
+	}
+	if startedAt, finishedAt := d.inner.State.StartedAt, d.inner.State.FinishedAt; !startedAt.IsZero() && !finishedAt.Before(startedAt) {
+		info.Duration = strconv.FormatFloat(finishedAt.Sub(startedAt).Seconds(), 'f', 3, 64)
+	}
 
 	// Step-scoped references use OutputValue for both string-form and object-form output.
 	if d.inner.State.OutputValue != nil {
@@ -411,6 +448,17 @@ func (d *Data) SetError(err error) {
 	d.inner.State.Error = err
 }
 
+// SetCancelReason records why the node was aborted. The first reason
+// recorded wins, so a later cleanup cannot overwrite the original cause.
+func (d *Data) SetCancelReason(reason core.CancelReason) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.inner.State.CancelReason == "" {
+		d.inner.State.CancelReason = reason
+	}
+}
+
 func (d *Data) ClearVariable(key string) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -558,6 +606,13 @@ func (d *Data) SetExitCode(exitCode int) {
 	d.inner.State.ExitCode = exitCode
 }
 
+func (d *Data) SetOnErrorExitCode(exitCode int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.inner.State.OnErrorExitCode = &exitCode
+}
+
 func (d *Data) ClearState(s core.Step) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
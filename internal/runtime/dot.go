@@ -0,0 +1,99 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dagucloud/dagu/internal/cmn/stringutil"
+	"github.com/dagucloud/dagu/internal/core"
+)
+
+// dotEscaper escapes characters that would end a quoted DOT string.
+var dotEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// DOTGraph renders the execution graph of the DAG as a Graphviz DOT digraph.
+// Nodes and edges come from the execution plan, so the graph matches the
+// order in which the steps would run. Handler steps are drawn dashed and
+// chained in execution order within each handler. Steps whose preconditions
+// compare constant values that can never match are drawn grey, since they
+// would always be skipped.
+func DOTGraph(dag *core.DAG) (string, error) {
+	plan, err := NewPlan(dag.Steps...)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph \"%s\" {\n", dotEscaper.Replace(dag.GetName()))
+	b.WriteString("    node [shape=box];\n")
+
+	nodes := plan.Nodes()
+	ids := make(map[int]string, len(nodes))
+	for i, node := range nodes {
+		id := fmt.Sprintf("step%d", i)
+		ids[node.ID()] = id
+		step := node.Step()
+		attrs := fmt.Sprintf("label=\"%s\"", dotEscaper.Replace(step.Name))
+		if preconditionNeverMet(step.Preconditions) {
+			attrs += `, style=filled, fillcolor=lightgrey, fontcolor=gray40, tooltip="skipped: precondition is never met"`
+		}
+		fmt.Fprintf(&b, "    %s [%s];\n", id, attrs)
+	}
+	for _, node := range nodes {
+		for _, dep := range plan.Dependencies(node.ID()) {
+			fmt.Fprintf(&b, "    %s -> %s;\n", ids[dep], ids[node.ID()])
+		}
+	}
+
+	for _, handler := range core.HandlerRenderOrder {
+		prev := ""
+		for i, step := range dag.HandlerOn.Steps(handler) {
+			id := fmt.Sprintf("%s%d", handler, i)
+			fmt.Fprintf(&b, "    %s [label=\"%s: %s\", shape=oval, style=dashed];\n",
+				id, handler, dotEscaper.Replace(step.Name))
+			if prev != "" {
+				fmt.Fprintf(&b, "    %s -> %s [style=dashed];\n", prev, id)
+			}
+			prev = id
+		}
+	}
+
+	b.WriteString("}\n")
+	return b.String(), nil
+}
+
+// preconditionNeverMet reports whether any of the preconditions compares a
+// constant value against a constant expectation that it does not satisfy.
+// Conditions that reference variables, run commands or read JSON are
+// resolved at run time and are never treated as constant.
+func preconditionNeverMet(conds []*core.Condition) bool {
+	for _, c := range conds {
+		if c == nil || c.IsJSONPath() || c.Condition == "" || c.Expected == "" {
+			continue
+		}
+		if strings.ContainsAny(c.Condition, "$`") {
+			continue
+		}
+		matched, ok := compareNumbers(c.Expected, c.Condition)
+		if !ok {
+			expected, err := c.ExpectedPatterns()
+			if err != nil {
+				continue
+			}
+			matched = expected.Match(context.Background(), c.Condition, stringutil.WithExactMatch())
+		}
+		if matched == c.Negate {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/dagucloud/dagu/internal/core"
+	"github.com/dagucloud/dagu/internal/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDOTGraph(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Diamond", func(t *testing.T) {
+		t.Parallel()
+
+		dag := &core.DAG{
+			Name: "diamond",
+			Steps: []core.Step{
+				{Name: "extract"},
+				{Name: `say "hi"`, Depends: []string{"extract"}},
+				{Name: "transform", Depends: []string{"extract"}},
+				{Name: "load", Depends: []string{`say "hi"`, "transform"}},
+			},
+			HandlerOn: core.HandlerOn{
+				Exit: core.HandlerSteps{{Name: "cleanup"}, {Name: "report"}},
+			},
+		}
+
+		graph, err := runtime.DOTGraph(dag)
+		require.NoError(t, err)
+		assert.Equal(t, `digraph "diamond" {
+    node [shape=box];
+    step0 [label="extract"];
+    step1 [label="say \"hi\""];
+    step2 [label="transform"];
+    step3 [label="load"];
+    step0 -> step1;
+    step0 -> step2;
+    step1 -> step3;
+    step2 -> step3;
+    onExit0 [label="onExit: cleanup", shape=oval, style=dashed];
+    onExit1 [label="onExit: report", shape=oval, style=dashed];
+    onExit0 -> onExit1 [style=dashed];
+}
+`, graph)
+	})
+
+	t.Run("ConstantPrecondition", func(t *testing.T) {
+		t.Parallel()
+
+		dag := &core.DAG{
+			Name: "preconditions",
+			Steps: []core.Step{
+				{Name: "never", Preconditions: []*core.Condition{{Condition: "prod", Expected: "dev"}}},
+				{Name: "negated", Preconditions: []*core.Condition{{Condition: "dev", Expected: "dev", Negate: true}}},
+				{Name: "always", Preconditions: []*core.Condition{{Condition: "dev", Expected: "re:d.v"}}},
+				{Name: "dynamic", Preconditions: []*core.Condition{{Condition: "${ENV}", Expected: "dev"}}},
+				{Name: "below", Preconditions: []*core.Condition{{Condition: "2", Expected: ">3"}}},
+				{Name: "above", Preconditions: []*core.Condition{{Condition: "5", Expected: ">3"}}},
+			},
+		}
+
+		graph, err := runtime.DOTGraph(dag)
+		require.NoError(t, err)
+		const skipped = `style=filled, fillcolor=lightgrey`
+		assert.Contains(t, graph, `step0 [label="never", `+skipped)
+		assert.Contains(t, graph, `step1 [label="negated", `+skipped)
+		assert.Contains(t, graph, `step2 [label="always"];`)
+		assert.Contains(t, graph, `step3 [label="dynamic"];`)
+		assert.Contains(t, graph, `step4 [label="below", `+skipped)
+		assert.Contains(t, graph, `step5 [label="above"];`)
+	})
+
+	t.Run("MissingDependency", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := runtime.DOTGraph(&core.DAG{
+			Steps: []core.Step{{Name: "load", Depends: []string{"missing"}}},
+		})
+		require.ErrorIs(t, err, runtime.ErrMissingNode)
+	})
+}
@@ -121,10 +121,8 @@ func resolveWorkingDir(ctx context.Context, step core.Step, rCtx Context) string
 func expandStepDir(dir string, dag *core.DAG) string {
 	return os.Expand(dir, func(key string) string {
 		if dag != nil {
-			for _, env := range dag.Env {
-				if k, v, ok := strings.Cut(env, "="); ok && k == key {
-					return v
-				}
+			if v, ok := dag.EnvGet(key); ok {
+				return v
 			}
 		}
 		return os.Getenv(key)
@@ -248,7 +246,20 @@ func (e Env) Shell(ctx context.Context) []string {
 		return shell
 	}
 
-	return defaultShell(ctx)
+	shell := defaultShell(ctx)
+	if len(shell) == 0 || len(e.Step.ShellArgs) == 0 {
+		return shell
+	}
+	// Step shell args without any shell extend the default shell.
+	shell, err := evalShellWithScope(ctx, e.Scope, shell[0], e.Step.ShellArgs)
+	if err != nil {
+		logger.Error(ctx, "Failed to evaluate default shell",
+			tag.String("shell", shell[0]),
+			tag.Error(err),
+		)
+		return nil
+	}
+	return shell
 }
 
 // DAGShell returns the evaluated shell command for DAG-level operations.
@@ -287,7 +298,7 @@ func evalShellWithScope(ctx context.Context, scope *eval.EnvScope, shell string,
 		return nil, fmt.Errorf("failed to evaluate shell: %w", err)
 	}
 
-	result := []string{shellCmd}
+	result := []string{cmdutil.ResolveShell(shellCmd)}
 	for _, arg := range shellArgs {
 		evaluated, err := eval.String(ctx, arg)
 		if err != nil {
@@ -10,6 +10,7 @@ import (
 	goruntime "runtime"
 	"testing"
 
+	"github.com/dagucloud/dagu/internal/cmn/cmdutil"
 	"github.com/dagucloud/dagu/internal/cmn/config"
 	"github.com/dagucloud/dagu/internal/cmn/eval"
 	"github.com/dagucloud/dagu/internal/core"
@@ -66,6 +67,20 @@ func TestDAGShell(t *testing.T) {
 		assert.Equal(t, []string{"/usr/bin/fish"}, result)
 	})
 
+	t.Run("ResolvesAutoShell", func(t *testing.T) {
+		t.Parallel()
+		dag := &core.DAG{
+			Shell:     cmdutil.ShellAuto,
+			ShellArgs: []string{"-e"},
+		}
+		ctx := runtime.NewContext(context.Background(), dag, "test-run", "test.log")
+		result := runtime.DAGShell(ctx)
+		require.Len(t, result, 2)
+		assert.Equal(t, cmdutil.ResolveShell(cmdutil.ShellAuto), result[0])
+		assert.NotEqual(t, cmdutil.ShellAuto, result[0])
+		assert.Equal(t, "-e", result[1])
+	})
+
 	t.Run("ReturnsDefaultShellWhenDAGShellEmpty", func(t *testing.T) {
 		t.Parallel()
 		dag := &core.DAG{
@@ -121,6 +136,20 @@ func TestEnvShell(t *testing.T) {
 		assert.Equal(t, []string{"/bin/bash", "-c"}, result)
 	})
 
+	t.Run("StepShellArgsExtendDefaultShell", func(t *testing.T) {
+		t.Parallel()
+		ctx := runtime.NewContext(context.Background(), &core.DAG{}, "test-run", "test.log")
+		step := core.Step{
+			Name:      "test-step",
+			ShellArgs: []string{"-x"},
+		}
+		env := runtime.NewEnv(ctx, step)
+		result := env.Shell(ctx)
+		require.Len(t, result, 2)
+		assert.NotEmpty(t, result[0])
+		assert.Equal(t, "-x", result[1])
+	})
+
 	t.Run("ExpandsStepShellWithEnvVars", func(t *testing.T) {
 		t.Parallel()
 		dag := &core.DAG{
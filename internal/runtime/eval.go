@@ -80,6 +80,20 @@ func GenerateSubDAGRunID(ctx context.Context, params string, repeated bool) stri
 	return GenerateSubDAGRunIDForTarget(ctx, "", params, repeated)
 }
 
+// GenerateParallelSubDAGRunID generates the run ID of one item of a parallel
+// fan-out. The first round uses the same ID as GenerateSubDAGRunIDForTarget;
+// later rounds of a repeated step also hash the round number, so expanding the
+// same items again yields the same IDs.
+func GenerateParallelSubDAGRunID(ctx context.Context, dagName, params string, iteration int) string {
+	if iteration == 0 {
+		return GenerateSubDAGRunIDForTarget(ctx, dagName, params, false)
+	}
+	env := GetEnv(ctx)
+	return stringutil.Base58EncodeSHA256(
+		fmt.Sprintf("%s:%s:%s\x00%s:%d", env.DAGRunID, env.Step.Name, dagName, params, iteration),
+	)
+}
+
 // GenerateSubDAGRunIDForTarget generates a unique run ID for a sub-DAG target.
 // Including the target keeps deterministic IDs stable for retries while avoiding
 // collisions when one parent step dispatches different child DAGs with identical params.
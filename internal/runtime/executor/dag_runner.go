@@ -369,6 +369,8 @@ func (e *SubDAGExecutor) coordinatorTaskOptions(ctx context.Context, extra ...Ta
 			ID:   rCtx.DAGRunID,
 		}),
 		WithWorkerSelector(e.effectiveWorkerSelector()),
+		WithDAGTags(e.DAG.Labels),
+		WithRequires(e.DAG.Requirements()),
 		WithBaseConfig(baseConfig),
 	}
 	if e.DAG.SourceFile != "" {
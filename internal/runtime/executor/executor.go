@@ -8,12 +8,14 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"sync"
 
 	"github.com/dagucloud/dagu/internal/cmn/logger"
 	"github.com/dagucloud/dagu/internal/cmn/logger/tag"
 	"github.com/dagucloud/dagu/internal/core"
 	"github.com/dagucloud/dagu/internal/core/exec"
+	"github.com/google/jsonschema-go/jsonschema"
 )
 
 // CloseExecutor safely closes an executor if it implements io.Closer.
@@ -78,6 +80,42 @@ var executorRegistry = make(map[string]ExecutorFactory)
 
 var executorRegistryMu sync.RWMutex
 
+// Schema describes a registered executor type for clients such as the UI.
+type Schema struct {
+	// Type is the executor type name used in step definitions.
+	Type string `json:"type"`
+	// Config is the JSON schema of the executor config, or nil when the
+	// executor does not declare one.
+	Config *jsonschema.Schema `json:"config,omitempty"`
+}
+
+// Registered returns the sorted names of all registered executor types. The
+// unnamed default type is omitted.
+func Registered() []string {
+	executorRegistryMu.RLock()
+	defer executorRegistryMu.RUnlock()
+	names := make([]string, 0, len(executorRegistry))
+	for name := range executorRegistry {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Describe returns the schema of a registered executor type.
+func Describe(executorType string) (Schema, bool) {
+	executorRegistryMu.RLock()
+	_, ok := executorRegistry[executorType]
+	executorRegistryMu.RUnlock()
+	if !ok {
+		return Schema{}, false
+	}
+	config, _ := core.ExecutorConfigSchema(executorType)
+	return Schema{Type: executorType, Config: config}, true
+}
+
 // ExitCoder is an interface for executors that can return an exit code.
 type ExitCoder interface {
 	ExitCode() int
@@ -129,6 +167,12 @@ type PushBackPreviousStdoutAware interface {
 	SetPushBackPreviousStdout(path string)
 }
 
+// StdinAware is implemented by executors that can feed a reader to the
+// standard input of the process they run.
+type StdinAware interface {
+	SetStdin(in io.Reader)
+}
+
 // SubRunProvider is an interface for executors that spawn sub-DAG runs.
 // This is used by executors like chat (with tools) to report sub-runs
 // for UI drill-down functionality.
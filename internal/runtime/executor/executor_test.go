@@ -0,0 +1,46 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package executor_test
+
+import (
+	"slices"
+	"testing"
+
+	_ "github.com/dagucloud/dagu/internal/runtime/builtin"
+	"github.com/dagucloud/dagu/internal/runtime/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistered(t *testing.T) {
+	t.Parallel()
+
+	names := executor.Registered()
+	assert.True(t, slices.IsSorted(names))
+	assert.NotContains(t, names, "")
+	for _, name := range []string{"command", "docker", "ssh", "http"} {
+		assert.Contains(t, names, name)
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{"docker", "ssh", "http"} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			schema, ok := executor.Describe(name)
+			require.True(t, ok)
+			assert.Equal(t, name, schema.Type)
+			require.NotNil(t, schema.Config)
+			assert.NotEmpty(t, schema.Config.Properties)
+		})
+	}
+
+	t.Run("Unregistered", func(t *testing.T) {
+		t.Parallel()
+		_, ok := executor.Describe("no-such-executor")
+		assert.False(t, ok)
+	})
+}
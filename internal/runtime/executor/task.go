@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"os"
 
+	"github.com/dagucloud/dagu/internal/core"
 	"github.com/dagucloud/dagu/internal/core/exec"
 	"github.com/dagucloud/dagu/internal/proto/convert"
 	coordinatorv1 "github.com/dagucloud/dagu/proto/coordinator/v1"
@@ -84,6 +85,22 @@ func WithWorkerSelector(selector map[string]string) TaskOption {
 	}
 }
 
+// WithDAGTags sets the DAG's tags on the task so that workers restricted to
+// specific tags can be matched.
+func WithDAGTags(labels core.Labels) TaskOption {
+	return func(task *coordinatorv1.Task) {
+		task.Tags = labels.Strings()
+	}
+}
+
+// WithRequires sets the capability requirements a worker must satisfy to
+// receive the task.
+func WithRequires(requires []string) TaskOption {
+	return func(task *coordinatorv1.Task) {
+		task.Requires = requires
+	}
+}
+
 // WithStep sets the step name for retry operations.
 func WithStep(step string) TaskOption {
 	return func(task *coordinatorv1.Task) {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/url"
 	"runtime/debug"
 	"time"
 
@@ -63,6 +64,17 @@ type Manager struct {
 // Stop stops running DAG-runs and can cancel an explicit failed DAG-run that is
 // still pending DAG-level auto-retry when dagRunID is provided.
 func (m *Manager) Stop(ctx context.Context, dag *core.DAG, dagRunID string) error {
+	return m.stop(ctx, dag, dagRunID, "")
+}
+
+// StopWithSignal stops running DAG-runs like Stop, but asks each run to send
+// sig to its steps instead of the default termination signal. Runs that
+// cannot be reached through their socket are aborted without the signal.
+func (m *Manager) StopWithSignal(ctx context.Context, dag *core.DAG, dagRunID, sig string) error {
+	return m.stop(ctx, dag, dagRunID, sig)
+}
+
+func (m *Manager) stop(ctx context.Context, dag *core.DAG, dagRunID, sig string) error {
 	// Set DAG name in context for all logs in this function
 	ctx = logger.WithValues(ctx, tag.Name(dag.Name))
 	logger.Info(ctx, "Stopping DAG")
@@ -122,7 +134,7 @@ func (m *Manager) Stop(ctx context.Context, dag *core.DAG, dagRunID string) erro
 		// Stop all matching DAG runs
 		var stopErrors []error
 		for _, runID := range matchingRunIDs {
-			if err := m.stopSingleDAGRun(ctx, dag, runID); err != nil {
+			if err := m.stopSingleDAGRun(ctx, dag, runID, sig); err != nil {
 				stopErrors = append(stopErrors, fmt.Errorf("failed to stop DAG run %s: %w", runID, err))
 			}
 		}
@@ -136,12 +148,13 @@ func (m *Manager) Stop(ctx context.Context, dag *core.DAG, dagRunID string) erro
 	}
 
 	// If dagRunID is specified, stop just that specific run
-	return m.stopSingleDAGRun(ctx, dag, dagRunID)
+	return m.stopSingleDAGRun(ctx, dag, dagRunID, sig)
 }
 
 // stopSingleDAGRun stops a single DAG run by its ID. For explicit run IDs, it
 // can also cancel a failed root run that is waiting for DAG-level auto-retry.
-func (m *Manager) stopSingleDAGRun(ctx context.Context, dag *core.DAG, dagRunID string) error {
+// A non-empty sig is forwarded to the run's socket.
+func (m *Manager) stopSingleDAGRun(ctx context.Context, dag *core.DAG, dagRunID, sig string) error {
 	// Set run ID in context for all logs in this function
 	ctx = logger.WithValues(ctx, tag.RunID(dagRunID))
 
@@ -157,7 +170,11 @@ func (m *Manager) stopSingleDAGRun(ctx context.Context, dag *core.DAG, dagRunID
 		if fileutil.FileExists(addr) {
 			// In case the socket exists, we try to send a stop request
 			client := sock.NewClient(addr)
-			if _, err := client.Request("POST", "/stop"); err == nil {
+			path := "/stop"
+			if sig != "" {
+				path += "?signal=" + url.QueryEscape(sig)
+			}
+			if _, err := client.Request("POST", path); err == nil {
 				logger.Info(ctx, "Successfully stopped DAG via socket")
 				return nil
 			}
@@ -166,6 +183,13 @@ func (m *Manager) stopSingleDAGRun(ctx context.Context, dag *core.DAG, dagRunID
 		}
 	}
 
+	if sig != "" {
+		// The signal can only be delivered through the socket; fall back to
+		// the abort flag so that the run is still stopped.
+		logger.Warn(ctx, "Socket is not reachable; stopping DAG run without the requested signal",
+			slog.String("signal", sig))
+	}
+
 	runRef := exec.NewDAGRunRef(dag.Name, dagRunID)
 	run, err := m.dagRunStore.FindAttempt(ctx, runRef)
 	if err == nil {
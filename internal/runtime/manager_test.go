@@ -105,6 +105,29 @@ func TestManager(t *testing.T) {
 		require.Equal(t, 1, len(dagRunStatus.Nodes))
 		require.Equal(t, newStatus, statusByDAGRunID.Nodes[0].Status)
 	})
+	t.Run("StopWithSignalUnreachableSocket", func(t *testing.T) {
+		dag := th.DAG(t, `steps:
+  - name: "1"
+    command: "exit 0"
+`)
+
+		dagRunID := uuid.Must(uuid.NewV7()).String()
+		ctx := th.Context
+
+		att, err := th.DAGRunStore.CreateAttempt(ctx, dag.DAG, time.Now(), dagRunID, exec.NewDAGRunAttemptOptions{})
+		require.NoError(t, err)
+		require.NoError(t, att.Open(ctx))
+		require.NoError(t, att.Write(ctx, testNewStatus(dag.DAG, dagRunID, core.Running, core.NodeRunning)))
+		_ = att.Close(ctx)
+
+		// No socket is listening, so the run is aborted without the signal.
+		err = th.DAGRunMgr.StopWithSignal(ctx, dag.DAG, dagRunID, "SIGINT")
+		require.NoError(t, err)
+
+		aborting, err := att.IsAborting(ctx)
+		require.NoError(t, err)
+		require.True(t, aborting)
+	})
 	t.Run("UpdateSubDAGRunStatus", func(t *testing.T) {
 		dag := th.DAG(t, `
 steps:
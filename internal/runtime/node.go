@@ -13,24 +13,31 @@ import (
 	"path/filepath"
 	"reflect"
 	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"text/template"
 	"time"
 
 	"syscall"
 
+	"github.com/dagucloud/dagu/internal/cmn/cmdutil"
 	"github.com/dagucloud/dagu/internal/cmn/collections"
+	"github.com/dagucloud/dagu/internal/cmn/config"
 	"github.com/dagucloud/dagu/internal/cmn/eval"
 	"github.com/dagucloud/dagu/internal/cmn/fileutil"
 	"github.com/dagucloud/dagu/internal/cmn/logger"
 	"github.com/dagucloud/dagu/internal/cmn/logger/tag"
 	"github.com/dagucloud/dagu/internal/cmn/signal"
 	"github.com/dagucloud/dagu/internal/cmn/stringutil"
+	"github.com/dagucloud/dagu/internal/cmn/telemetry"
+	"github.com/dagucloud/dagu/internal/cmn/templatefuncs"
 	"github.com/dagucloud/dagu/internal/core"
 	"github.com/dagucloud/dagu/internal/core/exec"
 	"github.com/dagucloud/dagu/internal/runtime/executor"
 	"github.com/goccy/go-yaml"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // systemVarPrefix is the prefix for temporary variables used internally by Dagu
@@ -45,6 +52,8 @@ type Node struct {
 	id           int
 	mu           sync.RWMutex
 	cmd          executor.Executor
+	cmdDone      chan struct{} // closed when the current command run returns
+	escalatedRun chan struct{} // cmdDone of the run a signal escalation was started for
 	done         atomic.Bool
 	retryPolicy  RetryPolicy
 	cmdEvaluated atomic.Bool
@@ -155,7 +164,12 @@ func (n *Node) ShouldContinue(ctx context.Context) bool {
 	}
 
 	if len(continueOn.Output) > 0 {
-		ok, err := n.LogContainsPattern(ctx, continueOn.Output)
+		patterns, err := continueOn.OutputPatterns()
+		if err != nil {
+			logger.Error(ctx, "Invalid continue_on output pattern", tag.Error(err))
+			return false
+		}
+		ok, err := n.logMatches(ctx, patterns)
 		if err != nil {
 			logger.Error(ctx, "Failed to check log for pattern", tag.Error(err))
 			return false
@@ -221,6 +235,15 @@ func (n *Node) Execute(ctx context.Context, onSetup ...func()) error {
 		}
 	}
 
+	if stdin := n.Step().Stdin; stdin != nil {
+		closeStdin, err := n.setupStdin(ctx, cmd, stdin)
+		if err != nil {
+			n.SetError(fmt.Errorf("failed to set up step: %w", err))
+			return err
+		}
+		defer closeStdin()
+	}
+
 	flusher := n.startOutputFlusher()
 	defer func() {
 		n.stopOutputFlusher(flusher)
@@ -263,7 +286,7 @@ func (n *Node) Execute(ctx context.Context, onSetup ...func()) error {
 		return err
 	}
 
-	statusErr := n.determineNodeStatus(cmd)
+	statusErr := n.determineNodeStatus(ctx, cmd)
 
 	// Prefer the execution error over the status determination error,
 	// since the execution error describes the root cause.
@@ -331,6 +354,12 @@ func (n *Node) stopOutputFlusher(ctrl *flusherControl) {
 
 // runCommand executes the command and handles errors, timeouts, and exit codes.
 func (n *Node) runCommand(ctx context.Context, cmd executor.Executor, stepTimeout time.Duration) (int, error) {
+	cmdDone := make(chan struct{})
+	n.mu.Lock()
+	n.cmdDone = cmdDone
+	n.mu.Unlock()
+	defer close(cmdDone)
+
 	startTime := time.Now()
 	err := cmd.Run(ctx)
 
@@ -358,7 +387,7 @@ func (n *Node) handleTimeout(ctx context.Context, _ core.Step, stepTimeout, elap
 		tag.Duration(elapsed),
 	)
 	n.SetError(timeoutErr)
-	n.SetStatus(core.NodeFailed)
+	n.SetStatus(ctx, core.NodeFailed)
 	return 124, timeoutErr // Standard timeout exit code
 }
 
@@ -379,6 +408,50 @@ func (n *Node) handleCommandError(cmd executor.Executor, err error) (int, error)
 	return 1, err
 }
 
+// runOnError runs the step's onError command after the step failed and
+// records its exit code. The command runs with the step's shell, environment
+// and working directory; its failure is logged but does not change the
+// status of the step.
+func (n *Node) runOnError(ctx context.Context) {
+	command := n.Step().OnError
+	if command == "" {
+		return
+	}
+
+	env := GetEnv(ctx)
+	shell := env.Shell(ctx)
+	commandToRun, err := EvalString(ctx, command, CommandEvalOptions(shell)...)
+	if err != nil {
+		logger.Error(ctx, "Failed to evaluate onError command", tag.Command(command), tag.Error(err))
+		n.SetOnErrorExitCode(1)
+		return
+	}
+
+	cmd := newDirectCommand(ctx, commandToRun)
+	if len(shell) > 0 {
+		cmd = newShellCommand(ctx, shell, commandToRun)
+	}
+	cmd.Dir = env.WorkingDir
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		logger.Info(ctx, "onError command finished", tag.Command(commandToRun))
+		n.SetOnErrorExitCode(0)
+		return
+	}
+
+	exitCode, found := exitCodeFromError(err)
+	if !found {
+		exitCode = 1
+	}
+	logger.Warn(ctx, "onError command failed",
+		tag.Command(commandToRun),
+		tag.ExitCode(exitCode),
+		tag.Output(strings.TrimSpace(string(out))),
+		tag.Error(err),
+	)
+	n.SetOnErrorExitCode(exitCode)
+}
+
 // captureOutput captures and stores the command output to a variable if configured.
 func (n *Node) captureOutput(ctx context.Context) error {
 	step := n.Step()
@@ -604,7 +677,7 @@ func maxOutputSize(ctx context.Context) int64 {
 }
 
 // determineNodeStatus uses the executor to determine the final node status if supported.
-func (n *Node) determineNodeStatus(cmd executor.Executor) error {
+func (n *Node) determineNodeStatus(ctx context.Context, cmd executor.Executor) error {
 	statusDeterminer, ok := cmd.(executor.NodeStatusDeterminer)
 	if !ok {
 		return nil
@@ -614,7 +687,7 @@ func (n *Node) determineNodeStatus(cmd executor.Executor) error {
 	if err != nil {
 		return err
 	}
-	n.SetStatus(nodeStatus)
+	n.SetStatus(ctx, nodeStatus)
 	return nil
 }
 
@@ -678,6 +751,13 @@ func (n *Node) setupExecutor(ctx context.Context) (executor.Executor, error) {
 		n.SetScript(script)
 	}
 
+	if n.Step().CreateDir {
+		dir := GetEnv(ctx).WorkingDir
+		if err := os.MkdirAll(dir, 0750); err != nil {
+			return nil, fmt.Errorf("failed to create working directory %s: %w", dir, err)
+		}
+	}
+
 	// Create the executor
 	cmd, err := executor.NewExecutor(ctx, n.Step())
 	if err != nil {
@@ -752,6 +832,12 @@ func (n *Node) evaluateCommandArgs(ctx context.Context) error {
 		return nil
 	}
 
+	if n.Step().Template {
+		if err := n.renderStepTemplate(ctx); err != nil {
+			return err
+		}
+	}
+
 	// Get eval options from executor capabilities
 	evalOptions := n.Step().CommandEvalOptions(ctx)
 
@@ -797,6 +883,99 @@ func (n *Node) evaluateCommandArgs(ctx context.Context) error {
 	return nil
 }
 
+// setupStdin evaluates the step's stdin and hands it to the executor. The
+// returned function releases the underlying file, if any.
+func (n *Node) setupStdin(ctx context.Context, cmd executor.Executor, stdin *core.StepStdin) (func(), error) {
+	stdinAware, ok := cmd.(executor.StdinAware)
+	if !ok {
+		return nil, fmt.Errorf("stdin is not supported by executor %q", n.Step().ExecutorConfig.Type)
+	}
+
+	if stdin.File == "" {
+		content, err := EvalString(ctx, stdin.Content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to eval stdin: %w", err)
+		}
+		stdinAware.SetStdin(strings.NewReader(content))
+		return func() {}, nil
+	}
+
+	path, err := EvalString(ctx, stdin.File)
+	if err != nil {
+		return nil, fmt.Errorf("failed to eval stdin file: %w", err)
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(GetEnv(ctx).WorkingDir, path)
+	}
+	file, err := os.Open(path) // nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("failed to open stdin file: %w", err)
+	}
+	stdinAware.SetStdin(file)
+	return func() { _ = file.Close() }, nil
+}
+
+// renderStepTemplate renders the command and script of a step that opted in
+// with `template: true` as Go templates. It runs before ${...} evaluation so
+// the rendered output is still subject to the usual variable expansion.
+func (n *Node) renderStepTemplate(ctx context.Context) error {
+	env := GetEnv(ctx)
+	data := map[string]any{
+		"Params":  env.DAG.ParamsMap(),
+		"Env":     env.Scope.ToMap(),
+		"Outputs": env.Scope.AllBySource(eval.EnvSourceOutput),
+	}
+
+	step := n.Step()
+	commands := make([]core.CommandEntry, len(step.Commands))
+	for i, cmdEntry := range step.Commands {
+		if cmdEntry.CmdWithArgs == "" {
+			commands[i] = cmdEntry
+			continue
+		}
+		rendered, err := renderTemplate(cmdEntry.CmdWithArgs, data)
+		if err != nil {
+			return fmt.Errorf("failed to render command template: %w", err)
+		}
+		cmd, args, err := cmdutil.SplitCommand(rendered)
+		if err != nil {
+			return fmt.Errorf("failed to parse rendered command %q: %w", rendered, err)
+		}
+		commands[i] = core.CommandEntry{
+			Command:     strings.TrimSpace(cmd),
+			Args:        args,
+			CmdWithArgs: rendered,
+		}
+	}
+	step.Commands = commands
+
+	if step.Script != "" {
+		rendered, err := renderTemplate(step.Script, data)
+		if err != nil {
+			return fmt.Errorf("failed to render script template: %w", err)
+		}
+		step.Script = rendered
+	}
+
+	n.SetStep(step)
+	return nil
+}
+
+func renderTemplate(text string, data any) (string, error) {
+	tmpl, err := template.New("step").
+		Option("missingkey=error").
+		Funcs(templatefuncs.FuncMap()).
+		Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
 func (n *Node) Signal(ctx context.Context, sig os.Signal, allowOverride bool) {
 	n.mu.Lock()
 	defer n.mu.Unlock()
@@ -809,7 +988,7 @@ func (n *Node) Signal(ctx context.Context, sig os.Signal, allowOverride bool) {
 			tag.Step(n.Name()),
 		)
 		if signal.IsTerminationSignalOS(killSignal) {
-			n.SetStatus(core.NodeAborted)
+			n.SetStatus(ctx, core.NodeAborted)
 		}
 		if err := n.cmd.Kill(killSignal); err != nil {
 			logger.Error(ctx, "Failed to send signal",
@@ -817,6 +996,38 @@ func (n *Node) Signal(ctx context.Context, sig os.Signal, allowOverride bool) {
 				tag.Step(n.Name()),
 			)
 		}
+		// Repeated stop signals must not stack escalations for the same run.
+		if escalation := n.Step().SignalEscalation; allowOverride && escalation != nil &&
+			n.cmdDone != nil && n.escalatedRun != n.cmdDone {
+			n.escalatedRun = n.cmdDone
+			go n.escalateSignal(ctx, n.cmd, n.cmdDone, escalation)
+		}
+	}
+}
+
+// escalateSignal sends the escalation signal if the command is still running
+// once the grace period has elapsed.
+func (n *Node) escalateSignal(ctx context.Context, cmd executor.Executor, cmdDone <-chan struct{}, escalation *core.SignalEscalation) {
+	timer := time.NewTimer(escalation.After)
+	defer timer.Stop()
+
+	select {
+	case <-cmdDone:
+		return
+	case <-timer.C:
+	}
+
+	sig := syscall.Signal(signal.GetSignalNum(escalation.Signal))
+	logger.Warn(ctx, "Step did not stop within grace period, escalating signal",
+		tag.Signal(sig.String()),
+		tag.Timeout(escalation.After),
+		tag.Step(n.Name()),
+	)
+	if err := cmd.Kill(sig); err != nil {
+		logger.Error(ctx, "Failed to send escalation signal",
+			tag.Error(err),
+			tag.Step(n.Name()),
+		)
 	}
 }
 
@@ -827,12 +1038,12 @@ func (n *Node) signalToSend(sig os.Signal, allowOverride bool) os.Signal {
 	return sig
 }
 
-func (n *Node) Cancel() {
+func (n *Node) Cancel(ctx context.Context) {
 	n.mu.Lock()
 	defer n.mu.Unlock()
 	status := n.Status()
 	if status == core.NodeRunning || status == core.NodeWaiting {
-		n.SetStatus(core.NodeAborted)
+		n.SetStatus(ctx, core.NodeAborted)
 	}
 }
 
@@ -844,11 +1055,57 @@ func (n *Node) SetupEnv(ctx context.Context) context.Context {
 		exec.EnvKeyDAGRunStepStdoutFile, n.GetStdout(), eval.EnvSourceStepEnv,
 	).WithEntry(
 		exec.EnvKeyDAGRunStepStderrFile, n.GetStderr(), eval.EnvSourceStepEnv,
+	).WithEntry(
+		exec.EnvKeyDAGRunStepStartedAt, formatStepTime(ctx, n.executionStartedAt()), eval.EnvSourceStepEnv,
 	)
+	if timeout := n.Step().Timeout; timeout > 0 {
+		// Round up so that a sub-second timeout is not reported as zero.
+		seconds := (timeout + time.Second - 1) / time.Second
+		env.Scope = env.Scope.WithEntry(
+			exec.EnvKeyDAGRunStepTimeoutSec, strconv.FormatInt(int64(seconds), 10), eval.EnvSourceStepEnv,
+		)
+	}
+	if trace.SpanContextFromContext(ctx).IsValid() {
+		// Pass the W3C trace context of the step span to the processes the
+		// step launches as TRACEPARENT and TRACESTATE.
+		for _, kv := range telemetry.InjectTraceContext(ctx) {
+			key, value, _ := strings.Cut(kv, "=")
+			env.Scope = env.Scope.WithEntry(key, value, eval.EnvSourceStepEnv)
+		}
+	}
 	ctx = logger.WithValues(ctx, tag.Step(n.Name()))
 	return WithEnv(ctx, env)
 }
 
+// RefreshStartedAtEnv updates DAG_RUN_STEP_STARTED_AT in the step env so that
+// a retried or repeated execution sees its own start time.
+func (n *Node) RefreshStartedAtEnv(ctx context.Context, startedAt time.Time) context.Context {
+	env := GetEnv(ctx)
+	env.Scope = env.Scope.WithEntry(
+		exec.EnvKeyDAGRunStepStartedAt, formatStepTime(ctx, startedAt), eval.EnvSourceStepEnv,
+	)
+	return WithEnv(ctx, env)
+}
+
+// executionStartedAt returns the start time of the current execution: the
+// last retry time if the step has been retried, otherwise its start time.
+func (n *Node) executionStartedAt() time.Time {
+	state := n.State()
+	startedAt := state.StartedAt
+	if state.RetriedAt.After(startedAt) {
+		startedAt = state.RetriedAt
+	}
+	return startedAt
+}
+
+// formatStepTime formats t as RFC3339 in the configured timezone.
+func formatStepTime(ctx context.Context, t time.Time) string {
+	if loc := config.GetConfig(ctx).Core.Location; loc != nil {
+		t = t.In(loc)
+	}
+	return stringutil.FormatTime(t)
+}
+
 func (n *Node) Prepare(ctx context.Context, logDir string, dagRunID string) error {
 	n.mu.Lock()
 	defer n.mu.Unlock()
@@ -907,6 +1164,24 @@ func (n *Node) LogContainsPattern(ctx context.Context, patterns []string) (bool,
 	if len(patterns) == 0 {
 		return false, nil
 	}
+	return n.scanLog(ctx, func(scanner *bufio.Scanner) bool {
+		return stringutil.MatchPatternScanner(ctx, scanner, patterns)
+	})
+}
+
+// logMatches is LogContainsPattern for patterns compiled ahead of time.
+func (n *Node) logMatches(ctx context.Context, patterns *stringutil.Patterns) (bool, error) {
+	if patterns.Len() == 0 {
+		return false, nil
+	}
+	return n.scanLog(ctx, func(scanner *bufio.Scanner) bool {
+		return patterns.MatchScanner(ctx, scanner)
+	})
+}
+
+// scanLog runs match over the node's stdout log file. When the step rotates
+// its logs, the rotated files are scanned first, oldest to newest.
+func (n *Node) scanLog(ctx context.Context, match func(*bufio.Scanner) bool) (bool, error) {
 
 	// Get the log filename and check if it exists
 	logFilename := n.outputs.StdoutFile()
@@ -914,17 +1189,33 @@ func (n *Node) LogContainsPattern(ctx context.Context, patterns []string) (bool,
 		return false, nil
 	}
 
-	// Open the log file
-	file, err := os.Open(logFilename) //nolint:gosec
-	if err != nil {
-		if os.IsNotExist(err) {
-			return false, nil
+	logFilenames := []string{logFilename}
+	if rotate := n.Step().LogRotate; rotate != nil {
+		logFilenames = make([]string, 0, rotate.Keep+1)
+		for i := rotate.Keep; i >= 1; i-- {
+			logFilenames = append(logFilenames, fmt.Sprintf("%s.%d", logFilename, i))
 		}
-		return false, fmt.Errorf("failed to open log file: %w", err)
+		logFilenames = append(logFilenames, logFilename)
+	}
+
+	// Open the log files
+	var readers []io.Reader
+	for _, name := range logFilenames {
+		file, err := os.Open(name) //nolint:gosec
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return false, fmt.Errorf("failed to open log file: %w", err)
+		}
+		defer func() {
+			_ = file.Close()
+		}()
+		readers = append(readers, file)
+	}
+	if len(readers) == 0 {
+		return false, nil
 	}
-	defer func() {
-		_ = file.Close()
-	}()
 
 	// Get maxOutputSize from DAG configuration
 	var maxOutputSize = defaultMaxOutputSizeBytes
@@ -933,7 +1224,7 @@ func (n *Node) LogContainsPattern(ctx context.Context, patterns []string) (bool,
 	}
 
 	// Create scanner with default buffer, but configure max size based on DAG config
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(io.MultiReader(readers...))
 	// Set scanner buffer to handle lines up to maxOutputSize
 	// Start with default 64KB initial buffer, but allow growth up to maxOutputSize
 	scanner.Buffer(make([]byte, 0, 64*1024), maxOutputSize)
@@ -942,7 +1233,7 @@ func (n *Node) LogContainsPattern(ctx context.Context, patterns []string) (bool,
 	n.outputs.lock()
 	defer n.outputs.unlock()
 
-	if stringutil.MatchPatternScanner(ctx, scanner, patterns) {
+	if match(scanner) {
 		return true, nil
 	}
 
@@ -953,6 +1244,27 @@ func (n *Node) LogContainsPattern(ctx context.Context, patterns []string) (bool,
 	return false, nil
 }
 
+// checkMarkFailure fails an otherwise successful execution when its stdout
+// matches one of the step's markFailure output patterns.
+func (n *Node) checkMarkFailure(ctx context.Context) error {
+	patterns := n.Step().MarkFailure.Output
+	if len(patterns) == 0 {
+		return nil
+	}
+
+	matched, err := n.LogContainsPattern(ctx, patterns)
+	if err != nil {
+		return fmt.Errorf("failed to check output for mark_failure patterns: %w", err)
+	}
+	if !matched {
+		return nil
+	}
+
+	err = fmt.Errorf("%w: %s", ErrOutputMarkedFailed, strings.Join(patterns, ", "))
+	n.SetError(err)
+	return err
+}
+
 var (
 	nextNodeID = 1
 	nextNodeMu sync.Mutex
@@ -1049,26 +1361,43 @@ func (n *Node) BuildSubDAGRuns(ctx context.Context, subDAG *core.SubDAG) ([]SubD
 		return nil, fmt.Errorf("parallel execution requires at least one item")
 	}
 
+	itemParams := make([]string, 0, len(items))
+	for i, item := range items {
+		param, err := n.ItemToParam(item)
+		if err != nil {
+			return nil, fmt.Errorf("failed to process item %d: %w", i, err)
+		}
+		itemParams = append(itemParams, param)
+	}
+	if parallel.Dedupe {
+		itemParams = dedupeStrings(itemParams)
+	}
+	if parallel.Sort {
+		slices.Sort(itemParams)
+	}
+
 	// Validate maximum number of items
 	const maxParallelItems = 1000
-	if len(items) > maxParallelItems {
-		return nil, fmt.Errorf("parallel execution exceeds maximum limit: %d items (max: %d)", len(items), maxParallelItems)
+	if len(itemParams) > maxParallelItems {
+		return nil, fmt.Errorf("parallel execution exceeds maximum limit: %d items (max: %d)", len(itemParams), maxParallelItems)
 	}
 
-	// Build sub runs with deduplication
-	subRunMap := make(map[string]SubDAGRun)
+	// A repeated step expands its items once per repetition. Each round gets
+	// random IDs unless stable repeat IDs are requested, in which case the
+	// repetition count keeps the IDs of each round distinct.
+	var iteration int
 	repeated := n.IsRepeated()
-
 	if repeated {
 		n.AddSubRunsRepeated(n.State().SubRuns...)
+		iteration = n.GetDoneCount()
 	}
+	randomIDs := parallel.RandomIDs || (repeated && !parallel.StableRepeatIDs)
 
-	for i, item := range items {
-		param, err := n.ItemToParam(item)
-		if err != nil {
-			return nil, fmt.Errorf("failed to process item %d: %w", i, err)
-		}
-
+	// Items that resolve to the same sub DAG and params share a single run,
+	// unless every run gets a random ID.
+	subRuns := make([]SubDAGRun, 0, len(itemParams))
+	seen := make(map[string]struct{}, len(itemParams))
+	for _, param := range itemParams {
 		variables := map[string]string{
 			"ITEM": param,
 		}
@@ -1089,24 +1418,42 @@ func (n *Node) BuildSubDAGRuns(ctx context.Context, subDAG *core.SubDAG) ([]SubD
 			finalParams = evaluatedStepParams
 		}
 
-		dagRunID := GenerateSubDAGRunIDForTarget(ctx, dagName, finalParams, repeated)
-		// Use dagRunID as key to deduplicate - same params will generate same ID
-		subRunMap[dagRunID] = SubDAGRun{
+		var dagRunID string
+		if randomIDs {
+			dagRunID = GenerateSubDAGRunIDForTarget(ctx, dagName, finalParams, true)
+		} else {
+			key := dagName + "\x00" + finalParams
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			dagRunID = GenerateParallelSubDAGRunID(ctx, dagName, finalParams, iteration)
+		}
+		subRuns = append(subRuns, SubDAGRun{
 			DAGRunID: dagRunID,
 			Params:   finalParams,
 			DAGName:  dagName,
-		}
-	}
-
-	// Convert map back to slice
-	var subRuns []SubDAGRun
-	for _, run := range subRunMap {
-		subRuns = append(subRuns, run)
+		})
 	}
 
 	return subRuns, nil
 }
 
+// dedupeStrings returns values without repeats, keeping the first occurrence
+// of each value in its original position.
+func dedupeStrings(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	result := values[:0]
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
+
 // ItemToParam converts a parallel item to a parameter string
 func (n *Node) ItemToParam(item any) (string, error) {
 	switch v := item.(type) {
@@ -1139,6 +1486,16 @@ type RetryPolicy struct {
 	Limit     int
 	Interval  time.Duration
 	ExitCodes []int
+	RetryOn   []core.RetryCause
+}
+
+// RetriesOn reports whether the policy retries failures of the given cause.
+// Without retry_on, failures are retried but step timeouts are not.
+func (r *RetryPolicy) RetriesOn(cause core.RetryCause) bool {
+	if len(r.RetryOn) == 0 {
+		return cause == core.RetryOnFailure
+	}
+	return slices.Contains(r.RetryOn, cause)
 }
 
 // ShouldRetry determines if a node should be retried based on the exit code and retry policy
@@ -1193,6 +1550,7 @@ func (n *Node) setupRetryPolicy(ctx context.Context) error {
 		Limit:     limit,
 		Interval:  interval,
 		ExitCodes: exitCodes,
+		RetryOn:   step.RetryPolicy.RetryOn,
 	}
 
 	// Persist the evaluated retry policy so status snapshots carry the concrete
@@ -1217,6 +1575,17 @@ func (n *Node) setupRepeatPolicy(ctx context.Context) error {
 		rp.Limit = v
 	}
 
+	if rp.MaxRepeatsStr != "" {
+		v, err := eval.IntString(ctx, rp.MaxRepeatsStr, eval.WithOSExpansion())
+		if err != nil {
+			return fmt.Errorf("failed to substitute repeat max_repeats %q: %w", rp.MaxRepeatsStr, err)
+		}
+		if v < 0 {
+			return fmt.Errorf("repeat max_repeats must be non-negative, got %d", v)
+		}
+		rp.Limit = v + 1
+	}
+
 	if rp.IntervalStr != "" {
 		v, err := eval.IntString(ctx, rp.IntervalStr, eval.WithOSExpansion())
 		if err != nil {
@@ -1239,6 +1608,17 @@ func (n *Node) setupRepeatPolicy(ctx context.Context) error {
 	return nil
 }
 
+func (node *Node) evalSkip(ctx context.Context) (bool, error) {
+	if node.Step().Skip == "" {
+		return false, nil
+	}
+	skip, err := EvalSkip(ctx, node.Step().Skip)
+	if skip {
+		logger.Infof(ctx, "Skip expression is truthy for \"%s\"", node.Name())
+	}
+	return skip, err
+}
+
 func (node *Node) evalPreconditions(ctx context.Context) error {
 	if len(node.Step().Preconditions) == 0 {
 		return nil
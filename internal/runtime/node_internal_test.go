@@ -5,8 +5,13 @@ package runtime
 
 import (
 	"context"
+	"io"
+	"os"
 	"strings"
+	"sync"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/dagucloud/dagu/internal/cmn/eval"
 	"github.com/dagucloud/dagu/internal/core"
@@ -209,3 +214,85 @@ func TestSetupExecutor_HarnessScriptPreservesLiteralCodeFences(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "```yaml\nenv:\n  TEST_FILE: ~/dagu-test.txt\n\nsteps:\n  - command: touch $TEST_FILE\n```", node.Step().Script)
 }
+
+// recordingKillExecutor records every signal it receives and never exits on
+// its own.
+type recordingKillExecutor struct {
+	mu      sync.Mutex
+	signals []os.Signal
+}
+
+func (e *recordingKillExecutor) SetStdout(io.Writer)       {}
+func (e *recordingKillExecutor) SetStderr(io.Writer)       {}
+func (e *recordingKillExecutor) Run(context.Context) error { return nil }
+
+func (e *recordingKillExecutor) Kill(sig os.Signal) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.signals = append(e.signals, sig)
+	return nil
+}
+
+func (e *recordingKillExecutor) count(sig os.Signal) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var n int
+	for _, s := range e.signals {
+		if s == sig {
+			n++
+		}
+	}
+	return n
+}
+
+// TestNodeSignal_EscalatesOncePerRun verifies that repeated stop signals for
+// the same command run start a single escalation.
+func TestNodeSignal_EscalatesOncePerRun(t *testing.T) {
+	t.Parallel()
+
+	node := NewNode(core.Step{
+		Name:         "stubborn",
+		SignalOnStop: "SIGINT",
+		SignalEscalation: &core.SignalEscalation{
+			Signal: "SIGKILL",
+			After:  50 * time.Millisecond,
+		},
+	}, NodeState{Status: core.NodeRunning})
+	cmd := &recordingKillExecutor{}
+	cmdDone := make(chan struct{})
+	defer close(cmdDone)
+	node.cmd = cmd
+	node.cmdDone = cmdDone
+
+	for range 3 {
+		node.Signal(context.Background(), syscall.SIGTERM, true)
+		node.SetStatus(context.Background(), core.NodeRunning)
+	}
+
+	require.Eventually(t, func() bool {
+		return cmd.count(syscall.SIGKILL) > 0
+	}, time.Second, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, 3, cmd.count(syscall.SIGINT))
+	require.Equal(t, 1, cmd.count(syscall.SIGKILL))
+}
+
+func TestSetStatus_ReportsIllegalTransitions(t *testing.T) {
+	type transition struct{ from, to core.NodeStatus }
+	var reported []transition
+	original := illegalStatusTransition
+	illegalStatusTransition = func(_ context.Context, _ string, from, to core.NodeStatus) {
+		reported = append(reported, transition{from, to})
+	}
+	t.Cleanup(func() { illegalStatusTransition = original })
+
+	ctx := context.Background()
+	node := NewNode(core.Step{Name: "step"}, NodeState{})
+	node.SetStatus(ctx, core.NodeRunning)
+	node.SetStatus(ctx, core.NodeSucceeded)
+	require.Empty(t, reported)
+
+	node.SetStatus(ctx, core.NodeNotStarted)
+	require.Equal(t, []transition{{core.NodeSucceeded, core.NodeNotStarted}}, reported)
+	require.Equal(t, core.NodeNotStarted, node.State().Status, "the transition still applies")
+}
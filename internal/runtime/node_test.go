@@ -176,7 +176,7 @@ func TestNode(t *testing.T) {
 			node.Signal(node.Context, syscall.SIGTERM, false)
 		}()
 
-		node.SetStatus(core.NodeRunning)
+		node.SetStatus(node.Context, core.NodeRunning)
 
 		dagRunID := uuid.Must(uuid.NewV7()).String()
 		err := node.Node.Execute(node.execContext(dagRunID))
@@ -195,7 +195,7 @@ func TestNode(t *testing.T) {
 			node.Signal(node.Context, syscall.Signal(0), true) // allow override signal
 		}()
 
-		node.SetStatus(core.NodeRunning)
+		node.SetStatus(node.Context, core.NodeRunning)
 
 		dagRunID := uuid.Must(uuid.NewV7()).String()
 		err := node.Node.Execute(node.execContext(dagRunID))
@@ -213,7 +213,7 @@ func TestNode(t *testing.T) {
 		defer restore()
 
 		node := setupNode(t, withNodeExecutorType(nodeSignalExecutorType), withNodeSignalOnStop("SIGINT"))
-		node.SetStatus(core.NodeRunning)
+		node.SetStatus(node.Context, core.NodeRunning)
 
 		dagRunID := uuid.Must(uuid.NewV7()).String()
 		errCh := make(chan error, 1)
@@ -261,7 +261,7 @@ func TestNode(t *testing.T) {
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
 				node := runtime.NewNode(core.Step{Name: tt.name}, runtime.NodeState{Status: tt.status})
-				node.Cancel()
+				node.Cancel(context.Background())
 				require.Equal(t, tt.want, node.State().Status)
 			})
 		}
@@ -451,7 +451,7 @@ func TestNodeShouldMarkSuccess(t *testing.T) {
 			})
 
 			// Now we can test the public method directly
-			node.SetStatus(tt.nodeStatus)
+			node.SetStatus(ctx, tt.nodeStatus)
 			result := node.ShouldMarkSuccess(ctx)
 			assert.Equal(t, tt.expectMarkSuccess, result)
 		})
@@ -722,6 +722,186 @@ func TestNodeBuildSubDAGRuns(t *testing.T) {
 	}
 }
 
+func TestNodeBuildSubDAGRuns_DedupeAndSort(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		parallel *core.ParallelConfig
+		want     []string
+	}{
+		{
+			name:     "SameParamsShareARun",
+			parallel: &core.ParallelConfig{Variable: "${ITEMS}"},
+			want:     []string{"c", "a", "b"},
+		},
+		{
+			// Random IDs keep duplicate items apart unless dedupe is set
+			name:     "KeepsItemOrderWithRandomIDs",
+			parallel: &core.ParallelConfig{Variable: "${ITEMS}", RandomIDs: true},
+			want:     []string{"c", "a", "c", "b", "a"},
+		},
+		{
+			name:     "Dedupe",
+			parallel: &core.ParallelConfig{Variable: "${ITEMS}", Dedupe: true, RandomIDs: true},
+			want:     []string{"c", "a", "b"},
+		},
+		{
+			name:     "Sort",
+			parallel: &core.ParallelConfig{Variable: "${ITEMS}", Sort: true, RandomIDs: true},
+			want:     []string{"a", "a", "b", "c", "c"},
+		},
+		{
+			name:     "DedupeAndSort",
+			parallel: &core.ParallelConfig{Variable: "${ITEMS}", Dedupe: true, Sort: true, RandomIDs: true},
+			want:     []string{"a", "b", "c"},
+		},
+		{
+			name: "DedupeParams",
+			parallel: &core.ParallelConfig{
+				Items: []core.ParallelItem{
+					{Params: map[string]string{"REGION": "us", "TYPE": "csv"}},
+					{Params: map[string]string{"TYPE": "csv", "REGION": "us"}},
+					{Params: map[string]string{"REGION": "eu", "TYPE": "csv"}},
+				},
+				Dedupe:    true,
+				Sort:      true,
+				RandomIDs: true,
+			},
+			want: []string{
+				`{"REGION":"eu","TYPE":"csv"}`,
+				`{"REGION":"us","TYPE":"csv"}`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := runtime.NewContext(context.Background(), &core.DAG{}, "test-run", "test.log")
+			env := runtime.GetEnv(ctx)
+			env.Scope = env.Scope.WithEntry("ITEMS", `["c", "a", "c", "b", "a"]`, eval.EnvSourceStepEnv)
+			ctx = runtime.WithEnv(ctx, env)
+
+			subDAG := &core.SubDAG{Name: "sub-dag"}
+			node := runtime.NewNode(core.Step{
+				Name:     "test-step",
+				Parallel: tt.parallel,
+				SubDAG:   subDAG,
+			}, runtime.NodeState{})
+
+			runs, err := node.BuildSubDAGRuns(ctx, subDAG)
+			require.NoError(t, err)
+
+			var params []string
+			for _, run := range runs {
+				params = append(params, run.Params)
+			}
+			assert.Equal(t, tt.want, params)
+		})
+	}
+}
+
+func TestNodeBuildSubDAGRuns_DeterministicIDs(t *testing.T) {
+	t.Parallel()
+
+	expand := func(t *testing.T, dagRunID string, parallel *core.ParallelConfig, state runtime.NodeState) []string {
+		t.Helper()
+
+		ctx := runtime.NewContext(context.Background(), &core.DAG{}, dagRunID, "test.log")
+		subDAG := &core.SubDAG{Name: "sub-dag"}
+		node := runtime.NewNode(core.Step{
+			Name:     "test-step",
+			Parallel: parallel,
+			SubDAG:   subDAG,
+		}, state)
+
+		runs, err := node.BuildSubDAGRuns(ctx, subDAG)
+		require.NoError(t, err)
+		var ids []string
+		for _, run := range runs {
+			ids = append(ids, run.DAGRunID)
+		}
+		return ids
+	}
+	items := func(values ...string) *core.ParallelConfig {
+		config := &core.ParallelConfig{}
+		for _, v := range values {
+			config.Items = append(config.Items, core.ParallelItem{Value: v})
+		}
+		return config
+	}
+
+	t.Run("SameInputsSameIDs", func(t *testing.T) {
+		t.Parallel()
+
+		first := expand(t, "parent-run", items("a", "b", "a", "c"), runtime.NodeState{})
+		second := expand(t, "parent-run", items("a", "b", "a", "c"), runtime.NodeState{})
+		require.Len(t, first, 3)
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("KeepsSubDAGRunIDs", func(t *testing.T) {
+		t.Parallel()
+
+		// The IDs match those of sub DAG-runs created before the parallel
+		// IDs were derived from the item, so existing runs keep their IDs.
+		ctx := runtime.NewContext(context.Background(), &core.DAG{}, "parent-run", "test.log")
+		first := expand(t, "parent-run", items("a", "b"), runtime.NodeState{})
+		swapped := expand(t, "parent-run", items("b", "a"), runtime.NodeState{})
+		assert.Equal(t, []string{
+			runtime.GenerateSubDAGRunIDForTarget(ctx, "sub-dag", "a", false),
+			runtime.GenerateSubDAGRunIDForTarget(ctx, "sub-dag", "b", false),
+		}, first)
+		assert.Equal(t, []string{first[1], first[0]}, swapped)
+	})
+
+	t.Run("DifferentParentRun", func(t *testing.T) {
+		t.Parallel()
+
+		first := expand(t, "parent-run-1", items("a", "b"), runtime.NodeState{})
+		second := expand(t, "parent-run-2", items("a", "b"), runtime.NodeState{})
+		assert.NotEqual(t, first[0], second[0])
+		assert.NotEqual(t, first[1], second[1])
+	})
+
+	t.Run("RepeatedStepRoundsAreRandom", func(t *testing.T) {
+		t.Parallel()
+
+		first := expand(t, "parent-run", items("a"), runtime.NodeState{Repeated: true, DoneCount: 1})
+		again := expand(t, "parent-run", items("a"), runtime.NodeState{Repeated: true, DoneCount: 1})
+		require.Len(t, first, 1)
+		assert.NotEqual(t, first, again)
+	})
+
+	t.Run("StableRepeatIDs", func(t *testing.T) {
+		t.Parallel()
+
+		config := items("a")
+		config.StableRepeatIDs = true
+		initial := expand(t, "parent-run", config, runtime.NodeState{})
+		first := expand(t, "parent-run", config, runtime.NodeState{Repeated: true, DoneCount: 1})
+		again := expand(t, "parent-run", config, runtime.NodeState{Repeated: true, DoneCount: 1})
+		next := expand(t, "parent-run", config, runtime.NodeState{Repeated: true, DoneCount: 2})
+		assert.Equal(t, first, again)
+		assert.NotEqual(t, first, next)
+		assert.NotEqual(t, initial, first)
+	})
+
+	t.Run("RandomIDs", func(t *testing.T) {
+		t.Parallel()
+
+		config := items("a", "b")
+		config.RandomIDs = true
+		first := expand(t, "parent-run", config, runtime.NodeState{})
+		second := expand(t, "parent-run", config, runtime.NodeState{})
+		require.Len(t, first, 2)
+		assert.NotEqual(t, first[0], second[0])
+		assert.NotEqual(t, first[1], second[1])
+	})
+}
+
 func TestNodeItemToParam(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -949,11 +1129,12 @@ func TestNodeCancel(t *testing.T) {
 		}},
 	}
 
+	ctx := context.Background()
 	node := runtime.NewNode(step, runtime.NodeState{})
-	node.SetStatus(core.NodeRunning)
+	node.SetStatus(ctx, core.NodeRunning)
 
 	// Cancel the node
-	node.Cancel()
+	node.Cancel(ctx)
 
 	// Check status changed to cancel
 	assert.Equal(t, core.NodeAborted, node.NodeData().State.Status)
@@ -1241,7 +1422,7 @@ func TestNodeShouldContinue(t *testing.T) {
 			})
 
 			// Now we can test the public method directly
-			node.SetStatus(tt.nodeStatus)
+			node.SetStatus(ctx, tt.nodeStatus)
 			node.SetExitCode(tt.exitCode)
 
 			result := node.ShouldContinue(ctx)
@@ -34,11 +34,20 @@ type OutputCoordinator struct {
 	stderrFile     *os.File
 	stderrWriter   io.Writer
 
+	// Rotating log files used instead of stdoutFile and stderrFile when the
+	// step sets LogRotate
+	stdoutRotator *rotatingFile
+	stderrRotator *rotatingFile
+
 	stdoutRedirectFile   *os.File
 	stdoutRedirectWriter io.Writer
 	StderrRedirectFile   *os.File
 	stderrRedirectWriter io.Writer
 
+	// outputFile receives an uncapped copy of stdout (step.OutputFile)
+	outputFile       *os.File
+	outputFileWriter io.Writer
+
 	// Output capture with size limits to prevent OOM
 	outputWriter         *os.File
 	outputReader         *os.File
@@ -110,6 +119,9 @@ func (oc *OutputCoordinator) setup(ctx context.Context, data NodeData) error {
 	if err := oc.setupStdoutRedirect(ctx, data); err != nil {
 		return err
 	}
+	if err := oc.setupOutputFile(ctx, data); err != nil {
+		return err
+	}
 	return oc.setupStderrRedirect(ctx, data)
 }
 
@@ -126,6 +138,9 @@ func (oc *OutputCoordinator) setupExecutorIO(ctx context.Context, cmd executor.E
 	if oc.stdoutRedirectWriter != nil {
 		stdout = newFlushableMultiWriter(oc.stdoutWriter, oc.stdoutRedirectWriter)
 	}
+	if oc.outputFileWriter != nil {
+		stdout = newFlushableMultiWriter(stdout, oc.outputFileWriter)
+	}
 
 	needStdoutCapture := data.Step.Output != "" || data.Step.UsesStructuredOutputSource("stdout")
 	if needStdoutCapture && oc.outputReader == nil {
@@ -194,7 +209,7 @@ func (oc *OutputCoordinator) flushWriters() error {
 	}
 
 	var lastErr error
-	for _, w := range []io.Writer{oc.stdoutWriter, oc.stderrWriter, oc.stdoutRedirectWriter, oc.stderrRedirectWriter} {
+	for _, w := range []io.Writer{oc.stdoutWriter, oc.stderrWriter, oc.stdoutRedirectWriter, oc.stderrRedirectWriter, oc.outputFileWriter} {
 		if w == nil {
 			continue
 		}
@@ -232,7 +247,7 @@ func (oc *OutputCoordinator) closeResources() error {
 	// Log streaming failures are non-fatal - they shouldn't fail an otherwise
 	// successful step execution. Lost logs are unfortunate but acceptable.
 	closedWriters := make(map[io.Writer]bool)
-	for _, w := range []io.Writer{oc.stdoutWriter, oc.stderrWriter, oc.stdoutRedirectWriter, oc.stderrRedirectWriter} {
+	for _, w := range []io.Writer{oc.stdoutWriter, oc.stderrWriter, oc.stdoutRedirectWriter, oc.stderrRedirectWriter, oc.outputFileWriter} {
 		if w == nil || closedWriters[w] {
 			continue
 		}
@@ -264,11 +279,21 @@ func (oc *OutputCoordinator) closeResources() error {
 		}
 	}
 
+	for _, r := range []*rotatingFile{oc.stdoutRotator, oc.stderrRotator} {
+		if r != nil {
+			if err := r.Sync(); err != nil {
+				lastErr = err
+			}
+			_ = r.Close()
+		}
+	}
+
 	for _, f := range []*os.File{
 		oc.stdoutFile,
 		oc.stderrFile,
 		oc.stdoutRedirectFile,
 		oc.StderrRedirectFile,
+		oc.outputFile,
 		oc.outputReader,
 		oc.stderrOutputReader,
 	} {
@@ -306,6 +331,43 @@ func (oc *OutputCoordinator) setupStdoutRedirect(ctx context.Context, data NodeD
 	return nil
 }
 
+// setupOutputFile opens step.OutputFile, creating missing parent directories.
+// Unlike the stdout redirect, the file is truncated so it only holds the
+// output of the current attempt.
+func (oc *OutputCoordinator) setupOutputFile(ctx context.Context, data NodeData) error {
+	oc.mu.Lock()
+	defer oc.mu.Unlock()
+
+	if data.Step.OutputFile == "" {
+		return nil
+	}
+
+	filePath, err := resolveStepFilePath(ctx, data.Step.OutputFile)
+	if err != nil {
+		return fmt.Errorf("failed to setup output file: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0750); err != nil {
+		return fmt.Errorf("failed to create directory for output file %q: %w", filePath, err)
+	}
+	file, err := oc.setupFile(ctx, filePath, data)
+	if err != nil {
+		return fmt.Errorf("failed to setup output file: %w", err)
+	}
+	if err := file.Truncate(0); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to truncate output file %q: %w", filePath, err)
+	}
+
+	oc.outputFile = file
+	var writer io.Writer = oc.outputFile
+	if oc.masker != nil {
+		writer = masking.NewMaskingWriter(oc.outputFile, oc.masker)
+	}
+	oc.outputFileWriter = newSafeBufferedWriter(writer)
+
+	return nil
+}
+
 func (oc *OutputCoordinator) setupStderrRedirect(ctx context.Context, data NodeData) error {
 	oc.mu.Lock()
 	defer oc.mu.Unlock()
@@ -369,15 +431,25 @@ func (oc *OutputCoordinator) setupLocalWriters(_ context.Context, data NodeData)
 	isMerged := data.State.Stdout == data.State.Stderr
 
 	// stdout
-	var err error
-	oc.stdoutFile, err = fileutil.OpenOrCreateFile(data.State.Stdout)
-	if err != nil {
-		return fmt.Errorf("failed to open log file: %w", err)
+	var stdoutWriter io.Writer
+	if rotate := data.Step.LogRotate; rotate != nil {
+		rotator, err := newRotatingFile(data.State.Stdout, rotate.MaxSize, rotate.Keep)
+		if err != nil {
+			return fmt.Errorf("failed to open log file: %w", err)
+		}
+		oc.stdoutRotator = rotator
+		stdoutWriter = rotator
+	} else {
+		var err error
+		oc.stdoutFile, err = fileutil.OpenOrCreateFile(data.State.Stdout)
+		if err != nil {
+			return fmt.Errorf("failed to open log file: %w", err)
+		}
+		stdoutWriter = oc.stdoutFile
 	}
 	// Wrap with MaskingWriter if masker is available
-	var stdoutWriter io.Writer = oc.stdoutFile
 	if oc.masker != nil {
-		stdoutWriter = masking.NewMaskingWriter(oc.stdoutFile, oc.masker)
+		stdoutWriter = masking.NewMaskingWriter(stdoutWriter, oc.masker)
 	}
 	oc.stdoutWriter = newSafeBufferedWriter(stdoutWriter)
 	oc.stdoutFileName = data.State.Stdout
@@ -390,14 +462,25 @@ func (oc *OutputCoordinator) setupLocalWriters(_ context.Context, data NodeData)
 		oc.stderrFileName = data.State.Stderr
 	} else {
 		// Separate mode: open a separate file for stderr
-		oc.stderrFile, err = fileutil.OpenOrCreateFile(data.State.Stderr)
-		if err != nil {
-			return fmt.Errorf("failed to open stderr file: %w", err)
+		var stderrWriter io.Writer
+		if rotate := data.Step.LogRotate; rotate != nil {
+			rotator, err := newRotatingFile(data.State.Stderr, rotate.MaxSize, rotate.Keep)
+			if err != nil {
+				return fmt.Errorf("failed to open stderr file: %w", err)
+			}
+			oc.stderrRotator = rotator
+			stderrWriter = rotator
+		} else {
+			var err error
+			oc.stderrFile, err = fileutil.OpenOrCreateFile(data.State.Stderr)
+			if err != nil {
+				return fmt.Errorf("failed to open stderr file: %w", err)
+			}
+			stderrWriter = oc.stderrFile
 		}
 		// Wrap with MaskingWriter if masker is available
-		var stderrWriter io.Writer = oc.stderrFile
 		if oc.masker != nil {
-			stderrWriter = masking.NewMaskingWriter(oc.stderrFile, oc.masker)
+			stderrWriter = masking.NewMaskingWriter(stderrWriter, oc.masker)
 		}
 		oc.stderrWriter = newSafeBufferedWriter(stderrWriter)
 		oc.stderrFileName = data.State.Stderr
@@ -407,11 +490,9 @@ func (oc *OutputCoordinator) setupLocalWriters(_ context.Context, data NodeData)
 }
 
 func (oc *OutputCoordinator) setupFile(ctx context.Context, filePath string, _ NodeData) (*os.File, error) {
-	absFilePath := filePath
-	if !filepath.IsAbs(absFilePath) {
-		dir := GetEnv(ctx).WorkingDir
-		absFilePath = filepath.Join(dir, absFilePath)
-		absFilePath = filepath.Clean(absFilePath)
+	absFilePath, err := resolveStepFilePath(ctx, filePath)
+	if err != nil {
+		return nil, err
 	}
 
 	file, err := fileutil.OpenOrCreateFile(absFilePath)
@@ -422,6 +503,22 @@ func (oc *OutputCoordinator) setupFile(ctx context.Context, filePath string, _ N
 	return file, nil
 }
 
+// resolveStepFilePath expands a leading ~ to the user's home directory and
+// resolves relative paths against the step's working directory.
+func resolveStepFilePath(ctx context.Context, filePath string) (string, error) {
+	if filePath == "~" || strings.HasPrefix(filePath, "~/") {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to get user home directory: %w", err)
+		}
+		filePath = filepath.Join(homeDir, filePath[1:])
+	}
+	if !filepath.IsAbs(filePath) {
+		filePath = filepath.Join(GetEnv(ctx).WorkingDir, filePath)
+	}
+	return filepath.Clean(filePath), nil
+}
+
 func (oc *OutputCoordinator) capturedOutput(ctx context.Context) (string, error) {
 	oc.mu.Lock()
 	defer oc.mu.Unlock()
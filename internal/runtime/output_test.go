@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	osrt "runtime"
 	"strings"
 	"testing"
@@ -96,7 +97,7 @@ func startNodeExecuteAsync(t *testing.T, node *Node, ctx context.Context) <-chan
 	}()
 
 	t.Cleanup(func() {
-		node.Cancel()
+		node.Cancel(ctx)
 		select {
 		case <-finished:
 		case <-time.After(outputCommandCleanupWait()):
@@ -478,6 +479,26 @@ func TestOutputCoordinator_StdoutFile(t *testing.T) {
 	})
 }
 
+func TestOutputCoordinator_SetupOutputFile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	path := filepath.Join(home, "artifacts", "out.txt")
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0750))
+	require.NoError(t, os.WriteFile(path, []byte("previous attempt\n"), 0600))
+
+	oc := &OutputCoordinator{}
+	data := NodeData{Step: core.Step{OutputFile: "~/artifacts/out.txt"}}
+	require.NoError(t, oc.setupOutputFile(context.Background(), data))
+	_, err := oc.outputFileWriter.Write([]byte("hello\n"))
+	require.NoError(t, err)
+	require.NoError(t, oc.closeResources())
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "hello\n", string(content))
+}
+
 func TestOutputCoordinator_FlushWriters(t *testing.T) {
 	t.Parallel()
 
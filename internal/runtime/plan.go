@@ -16,7 +16,7 @@ import (
 )
 
 var (
-	ErrCyclicPlan  = errors.New("cyclic plan detected")
+	ErrCyclicPlan  = core.ErrDependencyCycle
 	ErrMissingNode = errors.New("missing node in execution plan")
 )
 
@@ -126,6 +126,17 @@ func NewPlanFromNodes(nodes ...*Node) (*Plan, error) {
 
 // CreateStepRetryPlan creates a new execution plan for retrying a specific step.
 func CreateStepRetryPlan(dag *core.DAG, nodes []*Node, stepName string) (*Plan, error) {
+	return createStepRetryPlan(dag, nodes, stepName, false)
+}
+
+// CreateStepRetryPlanWithDescendants creates a new execution plan for retrying
+// a specific step together with every step that transitively depends on it.
+// Ancestors and unrelated steps keep their previous results.
+func CreateStepRetryPlanWithDescendants(dag *core.DAG, nodes []*Node, stepName string) (*Plan, error) {
+	return createStepRetryPlan(dag, nodes, stepName, true)
+}
+
+func createStepRetryPlan(dag *core.DAG, nodes []*Node, stepName string, withDescendants bool) (*Plan, error) {
 	p := &Plan{
 		nodeByID:      make(map[int]*Node),
 		nodeByName:    make(map[string]*Node),
@@ -168,9 +179,43 @@ func CreateStepRetryPlan(dag *core.DAG, nodes []*Node, stepName string) (*Plan,
 		targetNode.retryPolicy = RetryPolicy{} // manual step retries start with a fresh retry budget
 	}
 
+	if withDescendants {
+		for _, node := range p.descendants(targetNode) {
+			step, ok := steps[node.Name()]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", ErrMissingNode, node.Name())
+			}
+			node.ClearState(step)
+			node.retryPolicy = RetryPolicy{}
+		}
+	}
+
 	return p, nil
 }
 
+// descendants returns the nodes that transitively depend on node, in
+// breadth-first order.
+func (p *Plan) descendants(node *Node) []*Node {
+	visited := map[int]bool{node.id: true}
+	var result []*Node
+	frontier := []int{node.id}
+	for len(frontier) > 0 {
+		var next []int
+		for _, u := range frontier {
+			for _, v := range p.DependantMap[u] {
+				if visited[v] {
+					continue
+				}
+				visited[v] = true
+				result = append(result, p.nodeByID[v])
+				next = append(next, v)
+			}
+		}
+		frontier = next
+	}
+	return result
+}
+
 // addNode adds a node to the plan structures.
 func (p *Plan) addNode(node *Node) {
 	p.nodeByID[node.id] = node
@@ -190,8 +235,8 @@ func (p *Plan) buildEdges() error {
 		}
 	}
 
-	if p.isCyclic() {
-		return ErrCyclicPlan
+	if cycle := p.findCycle(); len(cycle) > 0 {
+		return core.NewDependencyCycleError(cycle)
 	}
 	return nil
 }
@@ -220,35 +265,14 @@ func (p *Plan) addEdge(from, to *Node) {
 	p.DependencyMap[to.id] = append(p.DependencyMap[to.id], from.id)
 }
 
-// isCyclic checks for cycles in the graph using Kahn's algorithm.
-func (p *Plan) isCyclic() bool {
-	inDegrees := make(map[int]int)
-	for id, deps := range p.DependencyMap {
-		inDegrees[id] = len(deps)
-	}
-
-	var queue []int
+// findCycle returns the dependency path of a cycle in the graph, or nil if
+// the graph is acyclic.
+func (p *Plan) findCycle() []string {
+	steps := make([]core.Step, 0, len(p.nodes))
 	for _, node := range p.nodes {
-		if inDegrees[node.id] == 0 {
-			queue = append(queue, node.id)
-		}
+		steps = append(steps, node.Step())
 	}
-
-	processedCount := 0
-	for len(queue) > 0 {
-		u := queue[0]
-		queue = queue[1:]
-		processedCount++
-
-		for _, v := range p.DependantMap[u] {
-			inDegrees[v]--
-			if inDegrees[v] == 0 {
-				queue = append(queue, v)
-			}
-		}
-	}
-
-	return processedCount != len(p.nodes)
+	return core.FindDependencyCycle(steps)
 }
 
 // setupRetry resets the state of failed/aborted nodes and their dependents.
@@ -316,6 +340,39 @@ func (p *Plan) Nodes() []*Node {
 	return nodes
 }
 
+// TopologicalLevels partitions the step names into levels of steps that can
+// run concurrently. A step is placed one level after the deepest of its
+// dependencies, so every level only depends on earlier levels. Steps within a
+// level keep their order in the plan. An empty plan has no levels.
+func (p *Plan) TopologicalLevels() [][]string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	depth := make(map[int]int, len(p.nodes))
+	var levelOf func(id int) int
+	levelOf = func(id int) int {
+		if d, ok := depth[id]; ok {
+			return d
+		}
+		d := 0
+		for _, dep := range p.DependencyMap[id] {
+			d = max(d, levelOf(dep)+1)
+		}
+		depth[id] = d
+		return d
+	}
+
+	var levels [][]string
+	for _, node := range p.nodes {
+		d := levelOf(node.id)
+		for len(levels) <= d {
+			levels = append(levels, nil)
+		}
+		levels[d] = append(levels[d], node.Name())
+	}
+	return levels
+}
+
 // GetNode returns the node with the given ID.
 func (p *Plan) GetNode(id int) *Node {
 	p.mu.RLock()
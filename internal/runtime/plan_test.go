@@ -36,6 +36,92 @@ func TestPlan_Cyclic(t *testing.T) {
 	_, err := runtime.NewPlan(step1, step2)
 	require.Error(t, err)
 	require.ErrorIs(t, err, runtime.ErrCyclicPlan)
+	require.EqualError(t, err, "cycle detected: 1 -> 2 -> 1")
+}
+
+func TestPlan_CyclicLongerPath(t *testing.T) {
+	steps := []core.Step{
+		{Name: "root"},
+		{Name: "a", Depends: []string{"root", "d"}},
+		{Name: "b", Depends: []string{"a"}},
+		{Name: "c", Depends: []string{"b"}},
+		{Name: "d", Depends: []string{"c"}},
+	}
+	_, err := runtime.NewPlan(steps...)
+	require.ErrorIs(t, err, runtime.ErrCyclicPlan)
+	require.EqualError(t, err, "cycle detected: a -> d -> c -> b -> a")
+}
+
+func TestPlan_DiamondIsNotCyclic(t *testing.T) {
+	steps := []core.Step{
+		{Name: "a"},
+		{Name: "b", Depends: []string{"a"}},
+		{Name: "c", Depends: []string{"a"}},
+		{Name: "d", Depends: []string{"b", "c"}},
+	}
+	p, err := runtime.NewPlan(steps...)
+	require.NoError(t, err)
+	require.Len(t, p.Nodes(), 4)
+}
+
+func TestPlan_TopologicalLevels(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps []core.Step
+		want  [][]string
+	}{
+		{
+			name:  "Empty",
+			steps: nil,
+			want:  nil,
+		},
+		{
+			name:  "SingleNode",
+			steps: []core.Step{{Name: "a"}},
+			want:  [][]string{{"a"}},
+		},
+		{
+			name: "Sequential",
+			steps: []core.Step{
+				{Name: "a"},
+				{Name: "b", Depends: []string{"a"}},
+				{Name: "c", Depends: []string{"b"}},
+			},
+			want: [][]string{{"a"}, {"b"}, {"c"}},
+		},
+		{
+			name:  "Parallel",
+			steps: []core.Step{{Name: "a"}, {Name: "b"}, {Name: "c"}},
+			want:  [][]string{{"a", "b", "c"}},
+		},
+		{
+			name: "Diamond",
+			steps: []core.Step{
+				{Name: "a"},
+				{Name: "b", Depends: []string{"a"}},
+				{Name: "c", Depends: []string{"a"}},
+				{Name: "d", Depends: []string{"b", "c"}},
+			},
+			want: [][]string{{"a"}, {"b", "c"}, {"d"}},
+		},
+		{
+			name: "UnevenBranches",
+			steps: []core.Step{
+				{Name: "d", Depends: []string{"a", "c"}},
+				{Name: "a"},
+				{Name: "b", Depends: []string{"a"}},
+				{Name: "c", Depends: []string{"b"}},
+			},
+			want: [][]string{{"a"}, {"b"}, {"c"}, {"d"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := runtime.NewPlan(tt.steps...)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, p.TopologicalLevels())
+		})
+	}
 }
 
 func TestPlan_NodeByName(t *testing.T) {
@@ -348,6 +434,68 @@ func TestStepRetryPlan(t *testing.T) {
 	}
 }
 
+func TestStepRetryPlanWithDescendants(t *testing.T) {
+	// Diamond: a -> b, a -> c, b -> d, c -> d
+	dag := &core.DAG{Steps: []core.Step{
+		{Name: "a"},
+		{Name: "b", Depends: []string{"a"}},
+		{Name: "c", Depends: []string{"a"}},
+		{Name: "d", Depends: []string{"b", "c"}},
+	}}
+	tests := []struct {
+		name       string
+		step       string
+		wantStatus map[string]core.NodeStatus
+	}{
+		{
+			name: "retry top node re-runs whole graph",
+			step: "a",
+			wantStatus: map[string]core.NodeStatus{
+				"a": core.NodeNotStarted,
+				"b": core.NodeNotStarted,
+				"c": core.NodeNotStarted,
+				"d": core.NodeNotStarted,
+			},
+		},
+		{
+			name: "retry mid node re-runs only its subtree",
+			step: "b",
+			wantStatus: map[string]core.NodeStatus{
+				"a": core.NodeSucceeded,
+				"b": core.NodeNotStarted,
+				"c": core.NodeSucceeded,
+				"d": core.NodeNotStarted,
+			},
+		},
+		{
+			name: "retry leaf node re-runs only itself",
+			step: "d",
+			wantStatus: map[string]core.NodeStatus{
+				"a": core.NodeSucceeded,
+				"b": core.NodeSucceeded,
+				"c": core.NodeSucceeded,
+				"d": core.NodeNotStarted,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes := []*runtime.Node{
+				makeNode("a", core.NodeSucceeded),
+				makeNode("b", core.NodeSucceeded, "a"),
+				makeNode("c", core.NodeSucceeded, "a"),
+				makeNode("d", core.NodeSucceeded, "b", "c"),
+			}
+			p, err := runtime.CreateStepRetryPlanWithDescendants(dag, nodes, tt.step)
+			require.NoError(t, err)
+			require.NotNil(t, p)
+			for _, n := range nodes {
+				require.Equal(t, tt.wantStatus[n.Name()], n.State().Status, "status mismatch for %s", n.Name())
+			}
+		})
+	}
+}
+
 func TestStepRetryPlan_PreservesRetryCountForRetryingStep(t *testing.T) {
 	dag := &core.DAG{Steps: []core.Step{
 		{Name: "retrying-step", RetryPolicy: core.RetryPolicy{Limit: 1}},
@@ -14,6 +14,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/dagucloud/dagu/internal/cmn/eval"
@@ -24,14 +25,19 @@ import (
 	"github.com/dagucloud/dagu/internal/core/exec"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 )
 
 var (
-	ErrUpstreamFailed   = fmt.Errorf("upstream failed")
-	ErrUpstreamSkipped  = fmt.Errorf("upstream skipped")
-	ErrUpstreamRejected = fmt.Errorf("upstream rejected")
-	ErrDeadlockDetected = errors.New("deadlock detected: no runnable nodes but DAG not finished")
+	ErrUpstreamFailed     = fmt.Errorf("upstream failed")
+	ErrUpstreamSkipped    = fmt.Errorf("upstream skipped")
+	ErrUpstreamRejected   = fmt.Errorf("upstream rejected")
+	ErrUpstreamOutcome    = fmt.Errorf("upstream outcome not in depends.on")
+	ErrJoinNotSatisfied   = errors.New("not enough upstreams succeeded for join policy")
+	ErrJoinSatisfied      = errors.New("stopped after downstream join policy was satisfied")
+	ErrDeadlockDetected   = errors.New("deadlock detected: no runnable nodes but DAG not finished")
+	ErrOutputMarkedFailed = errors.New("output matched mark_failure pattern")
 )
 
 // ChatMessagesHandler handles chat session messages for persistence.
@@ -49,23 +55,28 @@ type Runner struct {
 	timeout         time.Duration
 	delay           time.Duration
 	dry             bool
-	onInit          *core.Step
-	onExit          *core.Step
-	onSuccess       *core.Step
-	onFailure       *core.Step
-	onAbort         *core.Step
+	onInit          core.HandlerSteps
+	onExit          core.HandlerSteps
+	onSuccess       core.HandlerSteps
+	onFailure       core.HandlerSteps
+	onAbort         core.HandlerSteps
 	dagRunID        string
 	messagesHandler ChatMessagesHandler
-	onWait          *core.Step
+	onWait          core.HandlerSteps
+	onRetry         core.HandlerSteps
 	forcedStatus    *core.Status
 
-	canceled  int32
-	mu        sync.RWMutex
-	pause     time.Duration
-	lastError error
+	canceled     int32
+	cancelReason core.CancelReason
+	mu           sync.RWMutex
+	pause        time.Duration
+	lastError    error
+
+	// failedPrecondition is the DAG-level precondition that stopped the run.
+	failedPrecondition *core.Condition
 
 	handlerMu sync.RWMutex
-	handlers  map[core.HandlerType]*Node
+	handlers  map[core.HandlerType][]*Node
 
 	metrics struct {
 		startTime          time.Time
@@ -94,6 +105,7 @@ func New(cfg *Config) *Runner {
 		messagesHandler: cfg.MessagesHandler,
 		pause:           time.Millisecond * 100,
 		onWait:          cfg.OnWait,
+		onRetry:         cfg.OnRetry,
 		forcedStatus:    cfg.ForcedStatus,
 	}
 }
@@ -104,14 +116,15 @@ type Config struct {
 	Timeout         time.Duration
 	Delay           time.Duration
 	Dry             bool
-	OnInit          *core.Step
-	OnExit          *core.Step
-	OnSuccess       *core.Step
-	OnFailure       *core.Step
-	OnAbort         *core.Step
+	OnInit          core.HandlerSteps
+	OnExit          core.HandlerSteps
+	OnSuccess       core.HandlerSteps
+	OnFailure       core.HandlerSteps
+	OnAbort         core.HandlerSteps
 	DAGRunID        string
 	MessagesHandler ChatMessagesHandler
-	OnWait          *core.Step
+	OnWait          core.HandlerSteps
+	OnRetry         core.HandlerSteps
 	ForcedStatus    *core.Status
 }
 
@@ -141,22 +154,23 @@ func (r *Runner) Run(ctx context.Context, plan *Plan, progressCh chan *Node) err
 	shell := DAGShell(ctx)
 	if err := EvalConditions(ctx, shell, rCtx.DAG.Preconditions); err != nil {
 		logger.Info(ctx, "Preconditions are not met", tag.Error(err))
-		r.Cancel(plan)
+		r.mu.Lock()
+		r.failedPrecondition = failedCondition(rCtx.DAG.Preconditions)
+		r.mu.Unlock()
+		r.setCancelReason(core.CancelReasonPreconditionNotMet)
+		r.Cancel(ctx, plan)
 	}
 
 	// Execute init handler after preconditions pass, before steps
 	if !r.isCanceled() {
-		if initNode := r.handlers[core.HandlerOnInit]; initNode != nil {
+		if initNode := r.HandlerNode(core.HandlerOnInit); initNode != nil {
 			logger.Debug(ctx, "Init handler execution started",
 				tag.Handler(initNode.Name()),
 			)
-			if err := r.runEventHandler(ctx, plan, initNode, nil); err != nil {
+			if err := r.runHandler(ctx, plan, core.HandlerOnInit, nil, progressCh); err != nil {
 				r.setLastError(err)
 				r.setCanceled() // Fail the DAG if init fails
 			}
-			if progressCh != nil {
-				progressCh <- initNode
-			}
 		}
 	}
 
@@ -173,6 +187,11 @@ func (r *Runner) Run(ctx context.Context, plan *Plan, progressCh chan *Node) err
 		}
 	}
 
+	// Every running node holds one slot of the DAG-wide step budget. Executors
+	// that fan out within a node draw additional slots from the same budget.
+	slots := NewStepSlots(r.maxActiveRuns)
+	ctx = WithStepSlots(ctx, slots)
+
 	var wg sync.WaitGroup
 	running := 0
 
@@ -184,11 +203,15 @@ func (r *Runner) Run(ctx context.Context, plan *Plan, progressCh chan *Node) err
 			break
 		}
 
+		// Capture the release signal before checking availability so a slot
+		// freed in between still wakes up the loop.
+		slotReleased := slots.Released()
+
 		var activeReadyCh chan *Node
 		// Only accept new nodes if:
 		// 1. Not canceled
-		// 2. maxActiveRuns is 0 (unlimited) OR running < maxActiveRuns
-		if !r.isCanceled() && (r.maxActiveRuns == 0 || running < r.maxActiveRuns) {
+		// 2. A slot of the DAG-wide step budget is available
+		if !r.isCanceled() && slots.Available() {
 			activeReadyCh = readyCh
 		}
 
@@ -219,9 +242,16 @@ func (r *Runner) Run(ctx context.Context, plan *Plan, progressCh chan *Node) err
 				continue
 			}
 
+			// A fan-out executor may have taken the last slot since the
+			// availability check; put the node back until a slot is released.
+			if !slots.TryAcquire() {
+				readyCh <- node
+				continue
+			}
+
 			// Immediately mark as running to prevent duplicate execution
 			// when multiple parents complete simultaneously
-			node.SetStatus(core.NodeRunning)
+			node.SetStatus(ctx, core.NodeRunning)
 
 			running++
 			wg.Add(1)
@@ -246,7 +276,7 @@ func (r *Runner) Run(ctx context.Context, plan *Plan, progressCh chan *Node) err
 				if err := r.prepareNode(ctx, n); err != nil {
 					r.setLastError(err)
 					n.MarkError(err)
-					n.SetStatus(core.NodeFailed)
+					n.SetStatus(ctx, core.NodeFailed)
 					return
 				}
 
@@ -266,12 +296,21 @@ func (r *Runner) Run(ctx context.Context, plan *Plan, progressCh chan *Node) err
 		case node := <-doneCh:
 			logger.Debug(ctx, "Node execution finished", tag.Step(node.Name()))
 			running--
+			slots.Release()
 			r.processCompletedNode(ctx, plan, node, readyCh)
 
+		case <-slotReleased:
+			// A slot returned by a fan-out executor may unblock ready nodes.
+
 		case <-ctxDoneCh:
 			r.mu.Lock()
-			if r.lastError == nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
-				r.lastError = ctx.Err()
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				if r.lastError == nil {
+					r.lastError = ctx.Err()
+				}
+				if r.timeout > 0 && r.cancelReason == "" {
+					r.cancelReason = core.CancelReasonTimeout
+				}
 			}
 			r.mu.Unlock()
 			ctxDoneCh = nil
@@ -304,11 +343,7 @@ func (r *Runner) Run(ctx context.Context, plan *Plan, progressCh chan *Node) err
 
 	case core.Waiting:
 		// Execute onWait handler before terminating
-		r.handlerMu.RLock()
-		handlerNode := r.handlers[core.HandlerOnWait]
-		r.handlerMu.RUnlock()
-
-		if handlerNode != nil {
+		if handlerNode := r.HandlerNode(core.HandlerOnWait); handlerNode != nil {
 			// Set DAG_WAITING_STEPS environment variable
 			waitingSteps := strings.Join(plan.WaitingStepNames(), ",")
 
@@ -316,16 +351,12 @@ func (r *Runner) Run(ctx context.Context, plan *Plan, progressCh chan *Node) err
 				slog.String("waitingSteps", waitingSteps),
 			)
 
-			if err := r.runEventHandler(ctx, plan, handlerNode, map[string]string{
+			if err := r.runHandler(ctx, plan, core.HandlerOnWait, map[string]string{
 				"DAG_WAITING_STEPS": waitingSteps,
-			}); err != nil {
+			}, progressCh); err != nil {
 				// Log error but don't fail - notification failure shouldn't block Wait status
 				logger.Error(ctx, "onWait handler failed", tag.Error(err))
 			}
-
-			if progressCh != nil {
-				progressCh <- handlerNode
-			}
 		}
 
 		logger.Info(ctx, "DAG waiting for approval")
@@ -344,21 +375,14 @@ func (r *Runner) Run(ctx context.Context, plan *Plan, progressCh chan *Node) err
 
 	eventHandlers = append(eventHandlers, core.HandlerOnExit)
 
-	r.handlerMu.RLock()
-	defer r.handlerMu.RUnlock()
-
 	for _, handler := range eventHandlers {
-		if handlerNode := r.handlers[handler]; handlerNode != nil {
+		if handlerNode := r.HandlerNode(handler); handlerNode != nil {
 			logger.Debug(ctx, "Handler execution started",
 				tag.Handler(handlerNode.Name()),
 			)
-			if err := r.runEventHandler(ctx, plan, handlerNode, nil); err != nil {
+			if err := r.runHandler(ctx, plan, handler, nil, progressCh); err != nil {
 				r.setLastError(err)
 			}
-
-			if progressCh != nil {
-				progressCh <- handlerNode
-			}
 		}
 	}
 
@@ -391,6 +415,10 @@ func (r *Runner) processCompletedNode(ctx context.Context, plan *Plan, node *Nod
 						tag.Parent(curr.Name()),
 					)
 					readyCh <- child
+					if child.Step().JoinPolicy.IsAny() {
+						// Upstreams stopped before starting must propagate to their children
+						queue = append(queue, stopJoinUpstreams(ctx, plan, child)...)
+					}
 				} else if child.State().Status != core.NodeNotStarted {
 					// Child was marked as Aborted/Skipped/Failed by isReady
 					// Add to queue to propagate to its children
@@ -410,8 +438,11 @@ func (r *Runner) runNodeExecution(ctx context.Context, plan *Plan, node *Node, p
 	spanCtx := nodeCtx
 	parentSpan := trace.SpanFromContext(nodeCtx)
 	if parentSpan.SpanContext().IsValid() {
+		step := node.Step()
 		spanAttrs := []attribute.KeyValue{
 			attribute.String("step.name", node.Name()),
+			attribute.String("step.id", step.ID),
+			attribute.String("step.executor_type", step.ExecutorConfig.Type),
 		}
 		// Use the otel package to get the global tracer
 		tracer := otel.Tracer("github.com/dagucloud/dagu")
@@ -422,13 +453,20 @@ func (r *Runner) runNodeExecution(ctx context.Context, plan *Plan, node *Node, p
 			trace.WithAttributes(spanAttrs...),
 		)
 		defer func() {
-			// Set final step attributes
+			// Set final step attributes; they are recorded whatever the
+			// outcome so failed steps carry the same metadata.
 			nodeData := node.NodeData()
 			span.SetAttributes(
 				attribute.String("step.status", nodeData.State.Status.String()),
+				attribute.Int("step.exit_code", nodeData.State.ExitCode),
+				attribute.Int("step.retry_count", nodeData.State.RetryCount),
 			)
-			if nodeData.State.ExitCode != 0 {
-				span.SetAttributes(attribute.Int("step.exit_code", nodeData.State.ExitCode))
+			if nodeData.State.Status == core.NodeFailed {
+				msg := nodeData.State.Status.String()
+				if nodeData.State.Error != nil {
+					msg = nodeData.State.Error.Error()
+				}
+				span.SetStatus(codes.Error, msg)
 			}
 			span.End()
 		}()
@@ -439,7 +477,7 @@ func (r *Runner) runNodeExecution(ctx context.Context, plan *Plan, node *Node, p
 
 	// Check preconditions
 	logger.Debug(ctx, "Checking preconditions")
-	if !meetsPreconditions(ctx, node, progressCh) {
+	if !r.meetsPreconditions(ctx, node, progressCh) {
 		return
 	}
 
@@ -453,6 +491,7 @@ ExecRepeat: // repeat execution
 		execErr := r.execNode(ctx, node, progressCh)
 		isRetriable := r.handleNodeExecutionError(ctx, plan, node, execErr)
 		if isRetriable {
+			ctx = node.RefreshStartedAtEnv(ctx, node.State().RetriedAt)
 			continue ExecRepeat
 		}
 		if node.State().Status == core.NodeRetrying {
@@ -469,6 +508,7 @@ ExecRepeat: // repeat execution
 		shouldRepeat := r.shouldRepeatNode(ctx, node, execErr)
 		if shouldRepeat && !r.isCanceled() {
 			r.prepareNodeForRepeat(ctx, node, progressCh)
+			ctx = node.RefreshStartedAtEnv(ctx, time.Now())
 			continue
 		}
 
@@ -486,13 +526,16 @@ ExecRepeat: // repeat execution
 	if node.State().Status == core.NodeRunning {
 		isRepetitive := node.Step().RepeatPolicy.RepeatMode != ""
 		if !isRepetitive && r.isCanceled() {
-			node.SetStatus(core.NodeAborted)
+			node.SetStatus(ctx, core.NodeAborted)
+			if reason := r.CancelReason(); reason != "" {
+				node.SetCancelReason(reason)
+			}
 		} else if node.Step().Approval != nil {
 			// Step has approval config — enter waiting state for human review.
 			// Push-back is human-controlled, no iteration limit.
-			node.SetStatus(core.NodeWaiting)
+			node.SetStatus(ctx, core.NodeWaiting)
 		} else {
-			node.SetStatus(core.NodeSucceeded)
+			node.SetStatus(ctx, core.NodeSucceeded)
 		}
 	}
 
@@ -500,7 +543,7 @@ ExecRepeat: // repeat execution
 	// the status may have been set to NodeSucceeded by the executor.
 	// If the step has an approval config, override to NodeWaiting.
 	if node.State().Status == core.NodeSucceeded && node.Step().Approval != nil {
-		node.SetStatus(core.NodeWaiting)
+		node.SetStatus(ctx, core.NodeWaiting)
 	}
 
 	// Save chat messages after execution (including waiting steps for push-back continuity).
@@ -510,7 +553,7 @@ ExecRepeat: // repeat execution
 
 	if err := r.teardownNode(node); err != nil {
 		r.setLastError(err)
-		node.SetStatus(core.NodeFailed)
+		node.SetStatus(ctx, core.NodeFailed)
 	}
 
 	if progressCh != nil {
@@ -806,12 +849,18 @@ func (r *Runner) execNode(ctx context.Context, node *Node, progressCh chan *Node
 	if r.dry {
 		return nil
 	}
+	var err error
 	if progressCh != nil && node.Step().SubDAG != nil {
 		// Send an additional progress notification after the executor is set up
 		// so that SubRuns are persisted to storage before the subDAG starts running.
-		return node.Execute(ctx, func() { progressCh <- node })
+		err = node.Execute(ctx, func() { progressCh <- node })
+	} else {
+		err = node.Execute(ctx)
 	}
-	return node.Execute(ctx)
+	if err != nil {
+		return err
+	}
+	return node.checkMarkFailure(ctx)
 }
 
 // Signal sends a signal to the runner.
@@ -827,6 +876,7 @@ func (r *Runner) Signal(
 	// before we've set the flag, causing it to mark nodes as Succeeded
 	// instead of Aborted.
 	if !r.isCanceled() && isTermination {
+		r.setCancelReason(core.CancelReasonSignal)
 		r.setCanceled()
 	}
 
@@ -839,6 +889,9 @@ func (r *Runner) Signal(
 			)
 			continue
 		}
+		if isTermination && !node.State().Status.IsDone() {
+			node.SetCancelReason(r.CancelReason())
+		}
 		node.Signal(ctx, sig, allowOverride)
 	}
 
@@ -852,11 +905,16 @@ func (r *Runner) Signal(
 	}
 }
 
-// Cancel sends -1 signal to all nodes.
-func (r *Runner) Cancel(p *Plan) {
+// Cancel sends -1 signal to all nodes. Nodes that have not finished record
+// the cancel reason of the runner, if one was set.
+func (r *Runner) Cancel(ctx context.Context, p *Plan) {
 	r.setCanceled()
+	reason := r.CancelReason()
 	for _, node := range p.Nodes() {
-		node.Cancel()
+		if reason != "" && !node.State().Status.IsDone() {
+			node.SetCancelReason(reason)
+		}
+		node.Cancel(ctx)
 	}
 }
 
@@ -911,16 +969,25 @@ func (r *Runner) isError() bool {
 	return r.lastError != nil
 }
 
-// HandlerNode returns the handler node with the given name.
+// HandlerNode returns the node of the first step of the handler with the
+// given name.
 func (r *Runner) HandlerNode(name core.HandlerType) *Node {
 	r.handlerMu.RLock()
 	defer r.handlerMu.RUnlock()
-	if v, ok := r.handlers[name]; ok {
-		return v
+	if nodes := r.handlers[name]; len(nodes) > 0 {
+		return nodes[0]
 	}
 	return nil
 }
 
+// HandlerNodes returns the nodes of every step of the handler with the given
+// name, in execution order.
+func (r *Runner) HandlerNodes(name core.HandlerType) []*Node {
+	r.handlerMu.RLock()
+	defer r.handlerMu.RUnlock()
+	return slices.Clone(r.handlers[name])
+}
+
 // isCanceled returns true if the runner is canceled.
 func (r *Runner) isCanceled() bool {
 	r.mu.RLock()
@@ -929,10 +996,41 @@ func (r *Runner) isCanceled() bool {
 }
 
 func isReady(ctx context.Context, plan *Plan, node *Node) bool {
+	if policy := node.Step().JoinPolicy; policy.IsAny() {
+		return isJoinReady(ctx, plan, node, policy.Count)
+	}
+
 	for _, depID := range plan.Dependencies(node.id) {
 		dep := plan.GetNode(depID)
 		status := dep.State().Status
 
+		if allowed := node.Step().DependsOn[dep.Name()]; len(allowed) > 0 {
+			if outcome, ok := dependencyOutcome(dep); ok {
+				if slices.Contains(allowed, outcome) {
+					logger.Debug(ctx, "Dependency finished with allowed outcome",
+						tag.Step(node.Name()), tag.Dependency(dep.Name()),
+						tag.Status(status.String()))
+					continue
+				}
+				logger.Debug(ctx, "Dependency finished with outcome not in depends.on",
+					tag.Step(node.Name()), tag.Dependency(dep.Name()),
+					tag.Status(status.String()))
+				switch outcome {
+				case core.DependencyFailed:
+					node.SetStatus(ctx, core.NodeAborted)
+					node.SetError(ErrUpstreamFailed)
+					node.SetCancelReason(core.CancelReasonUpstreamFailed)
+				case core.DependencySkipped:
+					node.SetStatus(ctx, core.NodeSkipped)
+					node.SetError(ErrUpstreamSkipped)
+				default:
+					node.SetStatus(ctx, core.NodeSkipped)
+					node.SetError(ErrUpstreamOutcome)
+				}
+				return false
+			}
+		}
+
 		switch status {
 		case core.NodeSucceeded, core.NodePartiallySucceeded:
 			continue
@@ -945,8 +1043,9 @@ func isReady(ctx context.Context, plan *Plan, node *Node) bool {
 			}
 			logger.Debug(ctx, "Dependency failed",
 				tag.Step(node.Name()), tag.Dependency(dep.Name()))
-			node.SetStatus(core.NodeAborted)
+			node.SetStatus(ctx, core.NodeAborted)
 			node.SetError(ErrUpstreamFailed)
+			node.SetCancelReason(core.CancelReasonUpstreamFailed)
 			return false
 
 		case core.NodeSkipped:
@@ -962,21 +1061,26 @@ func isReady(ctx context.Context, plan *Plan, node *Node) bool {
 			}
 			logger.Debug(ctx, "Dependency skipped",
 				tag.Step(node.Name()), tag.Dependency(dep.Name()))
-			node.SetStatus(core.NodeSkipped)
+			node.SetStatus(ctx, core.NodeSkipped)
 			node.SetError(ErrUpstreamSkipped)
 			return false
 
 		case core.NodeAborted:
 			logger.Debug(ctx, "Dependency aborted",
 				tag.Step(node.Name()), tag.Dependency(dep.Name()))
-			node.SetStatus(core.NodeAborted)
+			node.SetStatus(ctx, core.NodeAborted)
+			// Carry the cause of the upstream abort down the chain.
+			if reason := dep.State().CancelReason; reason != "" {
+				node.SetCancelReason(reason)
+			}
 			return false
 
 		case core.NodeRejected:
 			logger.Debug(ctx, "Dependency rejected",
 				tag.Step(node.Name()), tag.Dependency(dep.Name()))
-			node.SetStatus(core.NodeAborted)
+			node.SetStatus(ctx, core.NodeAborted)
 			node.SetError(ErrUpstreamRejected)
+			node.SetCancelReason(core.CancelReasonUpstreamFailed)
 			return false
 
 		case core.NodeNotStarted, core.NodeRunning:
@@ -1002,11 +1106,121 @@ func isReady(ctx context.Context, plan *Plan, node *Node) bool {
 	return true
 }
 
+// isJoinReady reports whether at least count dependencies of node have
+// succeeded. It marks the node failed once too few dependencies remain to
+// ever reach count.
+func isJoinReady(ctx context.Context, plan *Plan, node *Node, count int) bool {
+	var succeeded, pending int
+	for _, depID := range plan.Dependencies(node.id) {
+		state := plan.GetNode(depID).State()
+		switch state.Status {
+		case core.NodeSucceeded, core.NodePartiallySucceeded:
+			succeeded++
+		case core.NodeSkipped:
+			if state.SkippedByRetry {
+				succeeded++
+			}
+		case core.NodeNotStarted, core.NodeRunning, core.NodeRetrying, core.NodeWaiting:
+			pending++
+		case core.NodeFailed, core.NodeAborted, core.NodeRejected:
+			// Does not count toward the join
+		}
+	}
+
+	if succeeded >= count {
+		logger.Debug(ctx, "Join policy satisfied",
+			tag.Step(node.Name()), slog.Int("succeeded", succeeded), slog.Int("count", count))
+		return true
+	}
+	if succeeded+pending < count {
+		logger.Debug(ctx, "Join policy can no longer be satisfied",
+			tag.Step(node.Name()), slog.Int("succeeded", succeeded), slog.Int("count", count))
+		node.SetStatus(ctx, core.NodeFailed)
+		node.SetError(ErrJoinNotSatisfied)
+	}
+	return false
+}
+
+// stopJoinUpstreams stops the dependencies of a join step that are still in
+// flight once its join policy is satisfied. Running dependencies are signaled
+// and finish through the normal completion path; dependencies that have not
+// started are aborted directly and returned so their dependents can be
+// updated by the caller.
+func stopJoinUpstreams(ctx context.Context, plan *Plan, node *Node) []*Node {
+	var stopped []*Node
+	for _, depID := range plan.Dependencies(node.id) {
+		dep := plan.GetNode(depID)
+		switch dep.State().Status {
+		case core.NodeRunning, core.NodeWaiting:
+			logger.Info(ctx, "Stopping upstream after join policy was satisfied",
+				tag.Step(dep.Name()), slog.String("join", node.Name()))
+			dep.Signal(ctx, syscall.SIGTERM, true)
+			dep.Cancel(ctx)
+		case core.NodeNotStarted, core.NodeRetrying:
+			logger.Info(ctx, "Cancelling upstream after join policy was satisfied",
+				tag.Step(dep.Name()), slog.String("join", node.Name()))
+			dep.SetStatus(ctx, core.NodeAborted)
+			dep.SetError(ErrJoinSatisfied)
+			stopped = append(stopped, dep)
+		default:
+			// Already finished
+		}
+	}
+	return stopped
+}
+
+// dependencyOutcome maps a finished dependency to the outcome matched against
+// depends[].on. It returns false while the dependency is still in progress or
+// ended in a state that is not selectable (aborted, rejected).
+func dependencyOutcome(dep *Node) (core.DependencyOutcome, bool) {
+	state := dep.State()
+	switch state.Status {
+	case core.NodeSucceeded, core.NodePartiallySucceeded:
+		return core.DependencySuccess, true
+	case core.NodeFailed:
+		return core.DependencyFailed, true
+	case core.NodeSkipped:
+		if state.SkippedByRetry {
+			return core.DependencySuccess, true
+		}
+		return core.DependencySkipped, true
+	default:
+		return "", false
+	}
+}
+
+// runHandler runs the steps of an event handler in order. The handler stops
+// at the first failed step unless that step continues on failure; the first
+// error is returned either way.
+func (r *Runner) runHandler(ctx context.Context, plan *Plan, handler core.HandlerType, extraEnvs map[string]string, progressCh chan *Node) error {
+	var handlerErr error
+	nodes := r.HandlerNodes(handler)
+	for i, node := range nodes {
+		if err := r.runEventHandler(ctx, plan, node, extraEnvs); err != nil && handlerErr == nil {
+			handlerErr = err
+		}
+		if progressCh != nil {
+			progressCh <- node
+		}
+
+		if node.State().Status == core.NodeFailed && !node.Step().ContinueOn.Failure {
+			if skipped := len(nodes) - i - 1; skipped > 0 {
+				logger.Info(ctx, "Handler stopped after failed step",
+					tag.Handler(node.Name()),
+					slog.Int("skippedSteps", skipped),
+				)
+			}
+			break
+		}
+	}
+	return handlerErr
+}
+
 func (r *Runner) runEventHandler(ctx context.Context, plan *Plan, node *Node, extraEnvs map[string]string) error {
 	defer node.Finish()
 
 	if r.dry {
-		node.SetStatus(core.NodeSucceeded)
+		node.SetStatus(ctx, core.NodeSucceeded)
 		return nil
 	}
 
@@ -1015,24 +1229,34 @@ func (r *Runner) runEventHandler(ctx context.Context, plan *Plan, node *Node, ex
 	ctx = r.setupEnvironEventHandler(ctx, plan, node, extraEnvs)
 
 	if err := node.Prepare(ctx, r.logDir, r.dagRunID); err != nil {
-		node.SetStatus(core.NodeFailed)
+		node.SetStatus(ctx, core.NodeFailed)
 		return nil
 	}
 	defer func() { _ = node.Teardown() }()
 
+	skip, err := node.evalSkip(ctx)
+	if err != nil {
+		node.SetError(err)
+		node.SetStatus(ctx, core.NodeFailed)
+		return err
+	}
+	if skip {
+		node.SetStatus(ctx, core.NodeSkipped)
+		return nil
+	}
 	if err := node.evalPreconditions(ctx); err != nil {
-		node.SetStatus(core.NodeSkipped)
+		node.SetStatus(ctx, core.NodeSkipped)
 		return nil
 	}
 
-	node.SetStatus(core.NodeRunning)
+	node.SetStatus(ctx, core.NodeRunning)
 
 	if err := node.Execute(ctx); err != nil {
-		node.SetStatus(core.NodeFailed)
+		node.SetStatus(ctx, core.NodeFailed)
 		return err
 	}
 
-	node.SetStatus(core.NodeSucceeded)
+	node.SetStatus(ctx, core.NodeSucceeded)
 	return nil
 }
 
@@ -1047,8 +1271,8 @@ func (r *Runner) setup(ctx context.Context) (err error) {
 	r.handlerMu.Lock()
 	defer r.handlerMu.Unlock()
 
-	r.handlers = make(map[core.HandlerType]*Node)
-	handlerSteps := map[core.HandlerType]*core.Step{
+	r.handlers = make(map[core.HandlerType][]*Node)
+	handlerSteps := map[core.HandlerType]core.HandlerSteps{
 		core.HandlerOnInit:    r.onInit,
 		core.HandlerOnExit:    r.onExit,
 		core.HandlerOnSuccess: r.onSuccess,
@@ -1056,9 +1280,9 @@ func (r *Runner) setup(ctx context.Context) (err error) {
 		core.HandlerOnAbort:   r.onAbort,
 		core.HandlerOnWait:    r.onWait,
 	}
-	for handlerType, step := range handlerSteps {
-		if step != nil {
-			r.handlers[handlerType] = &Node{Data: newSafeData(NodeData{Step: *step})}
+	for handlerType, steps := range handlerSteps {
+		if nodes := newHandlerNodes(steps); len(nodes) > 0 {
+			r.handlers[handlerType] = nodes
 		}
 	}
 
@@ -1074,12 +1298,50 @@ func (r *Runner) setup(ctx context.Context) (err error) {
 	return nil
 }
 
+// newHandlerNodes returns a fresh node for each step of a handler.
+func newHandlerNodes(steps core.HandlerSteps) []*Node {
+	var nodes []*Node
+	for _, step := range steps {
+		if step != nil {
+			nodes = append(nodes, &Node{Data: newSafeData(NodeData{Step: *step})})
+		}
+	}
+	return nodes
+}
+
 func (r *Runner) setCanceled() {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	r.canceled = 1
 }
 
+// setCancelReason records why the runner is being canceled. The first reason
+// wins, so a signal sent while a precondition abort is in progress does not
+// mask the original cause.
+func (r *Runner) setCancelReason(reason core.CancelReason) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cancelReason == "" {
+		r.cancelReason = reason
+	}
+}
+
+// FailedPrecondition returns the DAG-level precondition that was not met,
+// or nil if the preconditions passed or have not been evaluated yet.
+func (r *Runner) FailedPrecondition() *core.Condition {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.failedPrecondition
+}
+
+// CancelReason returns why the run was canceled, or an empty reason if it
+// has not been canceled.
+func (r *Runner) CancelReason() core.CancelReason {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cancelReason
+}
+
 func (r *Runner) isSucceed(p *Plan) bool {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
@@ -1161,7 +1423,7 @@ func (r *Runner) GetMetrics() map[string]any {
 }
 
 // shouldRetryNode handles the retry logic for a node based on exit codes and retry policy
-func (r *Runner) shouldRetryNode(ctx context.Context, node *Node, execErr error) (shouldRetry bool) {
+func (r *Runner) shouldRetryNode(ctx context.Context, plan *Plan, node *Node, execErr error) (shouldRetry bool) {
 	exitCode := 1
 	if code, found := exitCodeFromError(execErr); found {
 		exitCode = code
@@ -1185,7 +1447,8 @@ func (r *Runner) shouldRetryNode(ctx context.Context, node *Node, execErr error)
 
 	if !shouldRetry {
 		// finish the node with error
-		node.SetStatus(core.NodeFailed)
+		node.SetStatus(ctx, core.NodeFailed)
+		node.runOnError(ctx)
 		node.MarkError(execErr)
 		r.setLastError(execErr)
 		return false
@@ -1197,9 +1460,16 @@ func (r *Runner) shouldRetryNode(ctx context.Context, node *Node, execErr error)
 		tag.ExitCode(exitCode),
 	)
 
+	return r.retryNode(ctx, plan, node)
+}
+
+// retryNode schedules the next attempt of a failed node. It returns true when
+// the node should be executed again inline.
+func (r *Runner) retryNode(ctx context.Context, plan *Plan, node *Node) bool {
 	if externalStepRetryEnabled(ctx) {
 		node.IncRetryCount()
-		node.SetStatus(core.NodeRetrying)
+		r.runRetryHandler(ctx, plan, node)
+		node.SetStatus(ctx, core.NodeRetrying)
 		logger.Info(ctx, "Step retry will be scheduled by the parent executor",
 			slog.Int("retry", node.GetRetryCount()),
 			slog.Duration("interval", core.CalculateBackoffInterval(
@@ -1221,11 +1491,38 @@ func (r *Runner) shouldRetryNode(ctx context.Context, node *Node, execErr error)
 		node.GetRetryCount()-1, // -1 because we just incremented
 	)
 	time.Sleep(interval)
+	r.runRetryHandler(ctx, plan, node)
 	node.SetRetriedAt(time.Now())
-	node.SetStatus(core.NodeRunning)
+	node.SetStatus(ctx, core.NodeRunning)
 	return true
 }
 
+// runRetryHandler runs the onRetry handler right before a step is retried.
+// Steps may retry concurrently and many times, so each invocation runs on
+// fresh nodes; HandlerNodes returns the most recent ones.
+func (r *Runner) runRetryHandler(ctx context.Context, plan *Plan, node *Node) {
+	if len(r.onRetry) == 0 {
+		return
+	}
+
+	r.handlerMu.Lock()
+	r.handlers[core.HandlerOnRetry] = newHandlerNodes(r.onRetry)
+	r.handlerMu.Unlock()
+
+	logger.Info(ctx, "Executing onRetry handler",
+		slog.Int("retry", node.GetRetryCount()),
+	)
+
+	if err := r.runHandler(ctx, plan, core.HandlerOnRetry, map[string]string{
+		exec.EnvKeyDAGRetryStepName: node.Name(),
+		exec.EnvKeyDAGRetryStepID:   node.Step().ID,
+		exec.EnvKeyDAGRetryAttempt:  strconv.Itoa(node.GetRetryCount()),
+	}, nil); err != nil {
+		// A failing notification must not block the retry
+		logger.Error(ctx, "onRetry handler failed", tag.Error(err))
+	}
+}
+
 // recoverNodePanic handles panic recovery for a node goroutine.
 // It signals progressCh so the agent can write the updated status to storage.
 func (r *Runner) recoverNodePanic(ctx context.Context, node *Node, progressCh chan *Node) {
@@ -1287,13 +1584,27 @@ func externalStepRetryEnabled(ctx context.Context) bool {
 	return false
 }
 
-// checkPreconditions evaluates the preconditions for a node and updates its status accordingly.
-func meetsPreconditions(ctx context.Context, node *Node, progressCh chan *Node) bool {
-	err := node.evalPreconditions(ctx)
+// checkPreconditions evaluates the skip expression and preconditions for a node
+// and updates its status accordingly. A skip expression that cannot be
+// evaluated fails the node, as it does for event handlers.
+func (r *Runner) meetsPreconditions(ctx context.Context, node *Node, progressCh chan *Node) bool {
+	skip, err := node.evalSkip(ctx)
 	if err != nil {
-		// Precondition not met, skip the node
-		node.SetStatus(core.NodeSkipped)
-		if !errors.Is(err, ErrConditionNotMet) {
+		r.setLastError(err)
+		node.SetError(err)
+		node.SetStatus(ctx, core.NodeFailed)
+		if progressCh != nil {
+			progressCh <- node
+		}
+		return false
+	}
+	if !skip {
+		err = node.evalPreconditions(ctx)
+	}
+	if skip || err != nil {
+		// Skip requested or precondition not met, skip the node
+		node.SetStatus(ctx, core.NodeSkipped)
+		if err != nil && !errors.Is(err, ErrConditionNotMet) {
 			node.SetError(err)
 		}
 		if progressCh != nil {
@@ -1315,29 +1626,43 @@ func (r *Runner) handleNodeExecutionError(ctx context.Context, plan *Plan, node
 	case s == core.NodeSucceeded || s == core.NodeAborted || s == core.NodePartiallySucceeded:
 		// do nothing
 
+	// Step-level timeouts are retried only when retry_on lists timeout
+	case errors.Is(execErr, context.DeadlineExceeded) && node.Step().Timeout > 0 &&
+		!r.isTimeout(plan.StartAt()) && !r.isCanceled() &&
+		node.retryPolicy.Limit > node.GetRetryCount() &&
+		node.retryPolicy.RetriesOn(core.RetryOnTimeout):
+		logger.Info(ctx, "Step timed out (step-level timeout); retrying",
+			tag.Timeout(node.Step().Timeout),
+			slog.Int("retry", node.GetRetryCount()),
+		)
+		return r.retryNode(ctx, plan, node)
+
 	// Check for timeout errors first (both step-level and DAG-level)
 	case errors.Is(execErr, context.DeadlineExceeded):
 		step := node.Step()
 		if step.Timeout > 0 {
 			// Step-level timeout: Node.Execute already set status to failed and exitCode=124.
-			// Keep failed status and ensure we don't retry.
+			// Keep failed status; retries on timeout are handled above.
 			logger.Info(ctx, "Step timed out (step-level timeout)",
 				tag.Timeout(step.Timeout),
 				tag.Error(execErr),
 			)
 			// Ensure status is failed (in case earlier logic differed)
-			node.SetStatus(core.NodeFailed)
+			node.SetStatus(ctx, core.NodeFailed)
+			node.SetCancelReason(core.CancelReasonStepTimeout)
+			node.runOnError(ctx)
 		} else if r.isTimeout(plan.StartAt()) {
 			// DAG-level timeout -> treat as aborted (global cancellation semantics)
 			logger.Info(ctx, "Step deadline exceeded (DAG-level timeout)",
 				tag.Timeout(r.timeout),
 				tag.Error(execErr),
 			)
-			node.SetStatus(core.NodeAborted)
+			node.SetStatus(ctx, core.NodeAborted)
+			node.SetCancelReason(core.CancelReasonTimeout)
 		} else {
 			// Parent context canceled or other deadline; mark aborted for safety
 			logger.Info(ctx, "Step deadline exceeded", tag.Error(execErr))
-			node.SetStatus(core.NodeAborted)
+			node.SetStatus(ctx, core.NodeAborted)
 		}
 		r.setLastError(execErr)
 
@@ -1347,24 +1672,26 @@ func (r *Runner) handleNodeExecutionError(ctx context.Context, plan *Plan, node
 			tag.Timeout(r.timeout),
 			tag.Error(execErr),
 		)
-		node.SetStatus(core.NodeAborted)
+		node.SetStatus(ctx, core.NodeAborted)
+		node.SetCancelReason(core.CancelReasonTimeout)
 		r.setLastError(execErr)
 
 	case r.isCanceled():
 		r.setLastError(execErr)
 
-	case node.retryPolicy.Limit > node.GetRetryCount():
-		if r.shouldRetryNode(ctx, node, execErr) {
+	case node.retryPolicy.Limit > node.GetRetryCount() && node.retryPolicy.RetriesOn(core.RetryOnFailure):
+		if r.shouldRetryNode(ctx, plan, node, execErr) {
 			return true
 		}
 
 	default:
 		// node execution error is unexpected and unrecoverable
-		node.SetStatus(core.NodeFailed)
+		node.SetStatus(ctx, core.NodeFailed)
+		node.runOnError(ctx)
 		if node.ShouldMarkSuccess(ctx) {
 			// mark as success if the node should be force marked as success
 			// i.e. continueOn.markSuccess is set to true
-			node.SetStatus(core.NodeSucceeded)
+			node.SetStatus(ctx, core.NodeSucceeded)
 		} else {
 			node.MarkError(execErr)
 			r.setLastError(execErr)
@@ -1387,16 +1714,41 @@ func (r *Runner) shouldRepeatNode(ctx context.Context, node *Node, execErr error
 	ctx = r.reloadNodeOutputs(ctx, node)
 	shell := GetEnv(ctx).Shell(ctx)
 
+	var condErr error
+	if rp.Condition != nil {
+		condErr = evalRepeatCondition(ctx, shell, rp)
+		// A condition that did not finish in time is treated as an
+		// evaluation error: stop repeating and fail the step.
+		if errors.Is(condErr, errConditionTimedOut) {
+			err := fmt.Errorf("repeat condition timed out after %s", rp.ConditionTimeout)
+			logger.Error(ctx, "Repeat condition timed out", tag.Error(err))
+			node.MarkError(err)
+			r.setLastError(err)
+			return false
+		}
+	}
+
 	switch rp.RepeatMode {
 	case core.RepeatModeWhile:
-		return r.evalWhileCondition(ctx, shell, node, rp, execErr)
+		return r.evalWhileCondition(node, rp, condErr, execErr)
 	case core.RepeatModeUntil:
-		return r.evalUntilCondition(ctx, shell, node, rp, execErr)
+		return r.evalUntilCondition(node, rp, condErr, execErr)
 	default:
 		return false
 	}
 }
 
+// evalRepeatCondition evaluates the repeat condition, bounded by the policy's
+// condition timeout when one is set.
+func evalRepeatCondition(ctx context.Context, shell []string, rp core.RepeatPolicy) error {
+	if rp.ConditionTimeout <= 0 {
+		return EvalCondition(ctx, shell, rp.Condition)
+	}
+	evalCtx, cancel := withConditionTimeout(ctx, rp.ConditionTimeout)
+	defer cancel()
+	return EvalCondition(evalCtx, shell, rp.Condition)
+}
+
 // reloadNodeOutputs updates the context with the node's current output variables.
 func (r *Runner) reloadNodeOutputs(ctx context.Context, node *Node) context.Context {
 	outputs := node.OutputVariablesMap()
@@ -1413,10 +1765,9 @@ func (r *Runner) reloadNodeOutputs(ctx context.Context, node *Node) context.Cont
 }
 
 // evalWhileCondition evaluates the repeat condition for a "while" loop.
-func (r *Runner) evalWhileCondition(ctx context.Context, shell []string, node *Node, rp core.RepeatPolicy, execErr error) bool {
+func (r *Runner) evalWhileCondition(node *Node, rp core.RepeatPolicy, condErr, execErr error) bool {
 	if rp.Condition != nil {
-		err := EvalCondition(ctx, shell, rp.Condition)
-		return err == nil // Repeat while condition is met
+		return condErr == nil // Repeat while condition is met
 	}
 	if len(rp.ExitCode) > 0 {
 		return slices.Contains(rp.ExitCode, node.State().ExitCode)
@@ -1426,10 +1777,9 @@ func (r *Runner) evalWhileCondition(ctx context.Context, shell []string, node *N
 }
 
 // evalUntilCondition evaluates the repeat condition for an "until" loop.
-func (r *Runner) evalUntilCondition(ctx context.Context, shell []string, node *Node, rp core.RepeatPolicy, execErr error) bool {
+func (r *Runner) evalUntilCondition(node *Node, rp core.RepeatPolicy, condErr, execErr error) bool {
 	if rp.Condition != nil {
-		err := EvalCondition(ctx, shell, rp.Condition)
-		return err != nil // Repeat until condition is met
+		return condErr != nil // Repeat until condition is met
 	}
 	if len(rp.ExitCode) > 0 {
 		return !slices.Contains(rp.ExitCode, node.State().ExitCode)
@@ -1442,7 +1792,7 @@ func (r *Runner) evalUntilCondition(ctx context.Context, shell []string, node *N
 func (r *Runner) prepareNodeForRepeat(ctx context.Context, node *Node, progressCh chan *Node) {
 	step := node.Step()
 
-	node.SetStatus(core.NodeRunning) // reset status to running for the repeat
+	node.SetStatus(ctx, core.NodeRunning) // reset status to running for the repeat
 	if r.lastError == node.Error() {
 		r.setLastError(nil) // clear last error if we are repeating
 	}
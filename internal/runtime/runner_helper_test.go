@@ -42,12 +42,41 @@ func withDepends(depends ...string) stepOption {
 	}
 }
 
+func withDependsOn(dep string, on ...core.DependencyOutcome) stepOption {
+	return func(step *core.Step) {
+		step.Depends = append(step.Depends, dep)
+		if step.DependsOn == nil {
+			step.DependsOn = make(map[string][]core.DependencyOutcome)
+		}
+		step.DependsOn[dep] = on
+	}
+}
+
+func withJoinAny(count int) stepOption {
+	return func(step *core.Step) {
+		step.JoinPolicy = &core.JoinPolicy{Mode: core.JoinModeAny, Count: count}
+	}
+}
+
 func withContinueOn(c core.ContinueOn) stepOption {
 	return func(step *core.Step) {
 		step.ContinueOn = c
 	}
 }
 
+func withMarkFailure(m core.MarkFailure) stepOption {
+	return func(step *core.Step) {
+		step.MarkFailure = m
+	}
+}
+
+func withSignalOnStop(sig string, escalation *core.SignalEscalation) stepOption {
+	return func(step *core.Step) {
+		step.SignalOnStop = sig
+		step.SignalEscalation = escalation
+	}
+}
+
 func withRetryPolicy(limit int, interval time.Duration) stepOption {
 	return func(step *core.Step) {
 		step.RetryPolicy.Limit = limit
@@ -55,6 +84,12 @@ func withRetryPolicy(limit int, interval time.Duration) stepOption {
 	}
 }
 
+func withRetryOn(causes ...core.RetryCause) stepOption {
+	return func(step *core.Step) {
+		step.RetryPolicy.RetryOn = causes
+	}
+}
+
 func withRepeatPolicy(repeat bool, interval time.Duration) stepOption {
 	return func(step *core.Step) {
 		if repeat {
@@ -70,6 +105,24 @@ func withPrecondition(condition *core.Condition) stepOption {
 	}
 }
 
+func withOnError(command string) stepOption {
+	return func(step *core.Step) {
+		step.OnError = command
+	}
+}
+
+func withLogRotate(maxSize int64, keep int) stepOption {
+	return func(step *core.Step) {
+		step.LogRotate = &core.LogRotateConfig{MaxSize: maxSize, Keep: keep}
+	}
+}
+
+func withSkip(expr string) stepOption {
+	return func(step *core.Step) {
+		step.Skip = expr
+	}
+}
+
 func withScript(script string) stepOption {
 	return func(step *core.Step) {
 		step.Script = script
@@ -82,12 +135,36 @@ func withWorkingDir(dir string) stepOption {
 	}
 }
 
+func withCreateDir() stepOption {
+	return func(step *core.Step) {
+		step.CreateDir = true
+	}
+}
+
+func withTemplate() stepOption {
+	return func(step *core.Step) {
+		step.Template = true
+	}
+}
+
 func withOutput(output string) stepOption {
 	return func(step *core.Step) {
 		step.Output = output
 	}
 }
 
+func withStdin(stdin core.StepStdin) stepOption {
+	return func(step *core.Step) {
+		step.Stdin = &stdin
+	}
+}
+
+func withOutputFile(path string) stepOption {
+	return func(step *core.Step) {
+		step.OutputFile = path
+	}
+}
+
 func withStdout(stdout string) stepOption {
 	return func(step *core.Step) {
 		step.Stdout = stdout
@@ -183,25 +260,41 @@ func newHandlerStep(_ *testing.T, name, id, command string) core.Step {
 
 func withOnSuccess(step core.Step) runnerOption {
 	return func(cfg *runtime.Config) {
-		cfg.OnSuccess = &step
+		cfg.OnSuccess = core.HandlerSteps{&step}
 	}
 }
 
 func withOnFailure(step core.Step) runnerOption {
 	return func(cfg *runtime.Config) {
-		cfg.OnFailure = &step
+		cfg.OnFailure = core.HandlerSteps{&step}
+	}
+}
+
+// withOnFailureSteps configures the failure handler as a list of steps.
+func withOnFailureSteps(first core.Step, rest ...core.Step) runnerOption {
+	return func(cfg *runtime.Config) {
+		cfg.OnFailure = core.HandlerSteps{&first}
+		for i := range rest {
+			cfg.OnFailure = append(cfg.OnFailure, &rest[i])
+		}
 	}
 }
 
 func withOnExit(step core.Step) runnerOption {
 	return func(cfg *runtime.Config) {
-		cfg.OnExit = &step
+		cfg.OnExit = core.HandlerSteps{&step}
+	}
+}
+
+func withOnRetry(step core.Step) runnerOption {
+	return func(cfg *runtime.Config) {
+		cfg.OnRetry = core.HandlerSteps{&step}
 	}
 }
 
 func withOnAbort(step core.Step) runnerOption {
 	return func(cfg *runtime.Config) {
-		cfg.OnAbort = &step
+		cfg.OnAbort = core.HandlerSteps{&step}
 	}
 }
 
@@ -309,7 +402,7 @@ func (ph planHelper) signal(sig syscall.Signal) {
 func (ph planHelper) cancel(t *testing.T) {
 	t.Helper()
 
-	ph.runner.Cancel(ph.Plan)
+	ph.runner.Cancel(ph.Context, ph.Plan)
 }
 
 type runResult struct {
@@ -323,16 +416,16 @@ func (rr runResult) assertNodeStatus(t *testing.T, stepName string, expected cor
 
 	target := rr.GetNodeByName(stepName)
 	if target == nil {
-		if rr.cfg.OnExit != nil && rr.cfg.OnExit.Name == stepName {
+		if rr.cfg.OnExit.First() != nil && rr.cfg.OnExit.First().Name == stepName {
 			target = rr.runner.HandlerNode(core.HandlerOnExit)
 		}
-		if rr.cfg.OnSuccess != nil && rr.cfg.OnSuccess.Name == stepName {
+		if rr.cfg.OnSuccess.First() != nil && rr.cfg.OnSuccess.First().Name == stepName {
 			target = rr.runner.HandlerNode(core.HandlerOnSuccess)
 		}
-		if rr.cfg.OnFailure != nil && rr.cfg.OnFailure.Name == stepName {
+		if rr.cfg.OnFailure.First() != nil && rr.cfg.OnFailure.First().Name == stepName {
 			target = rr.runner.HandlerNode(core.HandlerOnFailure)
 		}
-		if rr.cfg.OnAbort != nil && rr.cfg.OnAbort.Name == stepName {
+		if rr.cfg.OnAbort.First() != nil && rr.cfg.OnAbort.First().Name == stepName {
 			target = rr.runner.HandlerNode(core.HandlerOnAbort)
 		}
 	}
@@ -348,16 +441,16 @@ func (rr runResult) nodeByName(t *testing.T, stepName string) *runtime.Node {
 		return node
 	}
 
-	if rr.cfg.OnExit != nil && rr.cfg.OnExit.Name == stepName {
+	if rr.cfg.OnExit.First() != nil && rr.cfg.OnExit.First().Name == stepName {
 		return rr.runner.HandlerNode(core.HandlerOnExit)
 	}
-	if rr.cfg.OnSuccess != nil && rr.cfg.OnSuccess.Name == stepName {
+	if rr.cfg.OnSuccess.First() != nil && rr.cfg.OnSuccess.First().Name == stepName {
 		return rr.runner.HandlerNode(core.HandlerOnSuccess)
 	}
-	if rr.cfg.OnFailure != nil && rr.cfg.OnFailure.Name == stepName {
+	if rr.cfg.OnFailure.First() != nil && rr.cfg.OnFailure.First().Name == stepName {
 		return rr.runner.HandlerNode(core.HandlerOnFailure)
 	}
-	if rr.cfg.OnAbort != nil && rr.cfg.OnAbort.Name == stepName {
+	if rr.cfg.OnAbort.First() != nil && rr.cfg.OnAbort.First().Name == stepName {
 		return rr.runner.HandlerNode(core.HandlerOnAbort)
 	}
 
@@ -7,11 +7,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"testing"
 	"time"
@@ -21,10 +25,17 @@ import (
 	"github.com/dagucloud/dagu/internal/runtime"
 	"github.com/dagucloud/dagu/internal/runtime/builtin/agentstep"
 	"github.com/dagucloud/dagu/internal/runtime/builtin/chat"
+	runtimeexec "github.com/dagucloud/dagu/internal/runtime/executor"
 	"github.com/dagucloud/dagu/internal/test"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 func shellTestPath(path string) string {
@@ -487,6 +498,188 @@ func TestRunner(t *testing.T) {
 		result.assertNodeStatus(t, "2", core.NodeSkipped)
 		result.assertNodeStatus(t, "3", core.NodeSucceeded)
 	})
+	t.Run("SkipTruthy", func(t *testing.T) {
+		r := setupRunner(t)
+
+		// 1 -> 2 (skip) -> 3
+		plan := r.newPlan(t,
+			successStep("1"),
+			newStep("2",
+				withDepends("1"),
+				withCommand("false"),
+				withEnvVars("SKIP_TESTS=true"),
+				withSkip("${SKIP_TESTS}"),
+			),
+			successStep("3", "2"),
+		)
+
+		result := plan.assertRun(t, core.Succeeded)
+
+		result.assertNodeStatus(t, "1", core.NodeSucceeded)
+		result.assertNodeStatus(t, "2", core.NodeSkipped)
+		result.assertNodeStatus(t, "3", core.NodeSkipped)
+		assert.NoError(t, result.nodeByName(t, "2").State().Error)
+	})
+	t.Run("SkipTruthyContinueOnSkipped", func(t *testing.T) {
+		r := setupRunner(t)
+
+		// 1 (skip) -> 2
+		plan := r.newPlan(t,
+			newStep("1",
+				withCommand("false"),
+				withSkip("yes"),
+				withContinueOn(core.ContinueOn{Skipped: true}),
+			),
+			successStep("2", "1"),
+		)
+
+		result := plan.assertRun(t, core.Succeeded)
+
+		result.assertNodeStatus(t, "1", core.NodeSkipped)
+		result.assertNodeStatus(t, "2", core.NodeSucceeded)
+	})
+	t.Run("SkipFalsy", func(t *testing.T) {
+		for _, value := range []string{"", "false", "0", "no", "off", "FALSE"} {
+			t.Run("Value="+value, func(t *testing.T) {
+				r := setupRunner(t)
+
+				plan := r.newPlan(t,
+					newStep("1",
+						withCommand("exit 0"),
+						withEnvVars("SKIP_TESTS="+value),
+						withSkip("${SKIP_TESTS}"),
+					),
+				)
+
+				result := plan.assertRun(t, core.Succeeded)
+
+				result.assertNodeStatus(t, "1", core.NodeSucceeded)
+			})
+		}
+	})
+	t.Run("SkipEvalError", func(t *testing.T) {
+		r := setupRunner(t)
+
+		plan := r.newPlan(t,
+			newStep("1",
+				withCommand("exit 0"),
+				withSkip("`exit 1`"),
+			),
+			successStep("2", "1"),
+		)
+
+		result := plan.assertRun(t, core.Failed)
+
+		result.assertNodeStatus(t, "1", core.NodeFailed)
+		result.assertNodeStatus(t, "2", core.NodeAborted)
+		assert.ErrorContains(t, result.nodeByName(t, "1").State().Error, "skip expression")
+	})
+	t.Run("DependsOnFailedRunsCleanupAfterFailure", func(t *testing.T) {
+		r := setupRunner(t)
+
+		// 1 (fail) -> cleanup (on: failed)
+		//          -> 3
+		plan := r.newPlan(t,
+			failStep("1"),
+			newStep("cleanup",
+				withDependsOn("1", core.DependencyFailed),
+				withCommand("exit 0"),
+			),
+			successStep("3", "1"),
+		)
+
+		result := plan.assertRun(t, core.Failed)
+
+		result.assertNodeStatus(t, "1", core.NodeFailed)
+		result.assertNodeStatus(t, "cleanup", core.NodeSucceeded)
+		result.assertNodeStatus(t, "3", core.NodeAborted)
+	})
+	t.Run("DependsOnFailedSkipsCleanupAfterSuccess", func(t *testing.T) {
+		r := setupRunner(t)
+
+		// 1 -> cleanup (on: failed)
+		plan := r.newPlan(t,
+			successStep("1"),
+			newStep("cleanup",
+				withDependsOn("1", core.DependencyFailed),
+				withCommand("exit 0"),
+			),
+		)
+
+		result := plan.assertRun(t, core.Succeeded)
+
+		result.assertNodeStatus(t, "1", core.NodeSucceeded)
+		result.assertNodeStatus(t, "cleanup", core.NodeSkipped)
+		require.ErrorIs(t, result.nodeByName(t, "cleanup").State().Error, runtime.ErrUpstreamOutcome)
+	})
+	t.Run("DependsOnSuccessOrSkipped", func(t *testing.T) {
+		r := setupRunner(t)
+
+		// 1 (skip) -> 2 (on: success, skipped)
+		plan := r.newPlan(t,
+			newStep("1",
+				withCommand("exit 0"),
+				withPrecondition(&core.Condition{
+					Condition: "`echo 1`",
+					Expected:  "0",
+				}),
+			),
+			newStep("2",
+				withDependsOn("1", core.DependencySuccess, core.DependencySkipped),
+				withCommand("exit 0"),
+			),
+		)
+
+		result := plan.assertRun(t, core.Succeeded)
+
+		result.assertNodeStatus(t, "1", core.NodeSkipped)
+		result.assertNodeStatus(t, "2", core.NodeSucceeded)
+	})
+	t.Run("JoinAnyStopsRacingUpstreams", func(t *testing.T) {
+		r := setupRunner(t)
+
+		// fast, slow1, slow2 -> join (any, count: 1)
+		plan := r.newPlan(t,
+			successStep("fast"),
+			newStep("slow1", withCommand(test.Sleep(10*time.Second))),
+			newStep("slow2", withCommand(test.Sleep(10*time.Second))),
+			newStep("join",
+				withDepends("fast", "slow1", "slow2"),
+				withJoinAny(1),
+				withCommand("exit 0"),
+			),
+		)
+
+		start := time.Now()
+		result := plan.assertRun(t, core.Succeeded)
+		assert.Less(t, time.Since(start), 8*time.Second)
+
+		result.assertNodeStatus(t, "fast", core.NodeSucceeded)
+		result.assertNodeStatus(t, "slow1", core.NodeAborted)
+		result.assertNodeStatus(t, "slow2", core.NodeAborted)
+		result.assertNodeStatus(t, "join", core.NodeSucceeded)
+	})
+	t.Run("JoinAnyFailsWhenCountUnreachable", func(t *testing.T) {
+		r := setupRunner(t)
+
+		// a (fail), b (fail), c -> join (any, count: 2)
+		plan := r.newPlan(t,
+			newStep("a", withCommand("exit 1"), withContinueOn(core.ContinueOn{Failure: true})),
+			newStep("b", withCommand("exit 1"), withContinueOn(core.ContinueOn{Failure: true})),
+			successStep("c"),
+			newStep("join",
+				withDepends("a", "b", "c"),
+				withJoinAny(2),
+				withCommand("exit 0"),
+			),
+		)
+
+		result := plan.assertRun(t, core.Failed)
+
+		result.assertNodeStatus(t, "c", core.NodeSucceeded)
+		result.assertNodeStatus(t, "join", core.NodeFailed)
+		require.ErrorIs(t, result.nodeByName(t, "join").State().Error, runtime.ErrJoinNotSatisfied)
+	})
 	t.Run("ContinueOnExitCode", func(t *testing.T) {
 		r := setupRunner(t)
 
@@ -529,6 +722,133 @@ func TestRunner(t *testing.T) {
 		result.assertNodeStatus(t, "1", core.NodeFailed)
 		result.assertNodeStatus(t, "2", core.NodeSucceeded)
 	})
+	t.Run("ContinueOnOutputCompiledRegexp", func(t *testing.T) {
+		r := setupRunner(t)
+
+		continueOn := core.ContinueOn{Output: []string{"re:^warn: .+"}}
+		require.NoError(t, continueOn.CompileOutput())
+
+		// 1 (exit code 1) -> 2
+		plan := r.newPlan(t,
+			newStep("1",
+				withCommand("echo 'warn: disk low'; false"),
+				withContinueOn(continueOn),
+			),
+			successStep("2", "1"),
+		)
+
+		result := plan.assertRun(t, core.PartiallySucceeded)
+
+		result.assertNodeStatus(t, "1", core.NodeFailed)
+		result.assertNodeStatus(t, "2", core.NodeSucceeded)
+	})
+	t.Run("MarkFailureOnOutput", func(t *testing.T) {
+		r := setupRunner(t)
+
+		// 1 (exit code 0, prints error token) -> 2
+		plan := r.newPlan(t,
+			newStep("1",
+				withCommand("echo ERROR: something went wrong"),
+				withMarkFailure(core.MarkFailure{
+					Output: []string{"ERROR"},
+				}),
+			),
+			successStep("2", "1"),
+		)
+
+		result := plan.assertRun(t, core.Failed)
+
+		result.assertNodeStatus(t, "1", core.NodeFailed)
+		result.assertNodeStatus(t, "2", core.NodeAborted)
+
+		node := result.nodeByName(t, "1")
+		assert.Equal(t, 0, node.State().ExitCode)
+		assert.ErrorIs(t, node.State().Error, runtime.ErrOutputMarkedFailed)
+	})
+	t.Run("MarkFailureOnOutputRegexp", func(t *testing.T) {
+		r := setupRunner(t)
+
+		plan := r.newPlan(t,
+			newStep("1",
+				withCommand("echo panic: runtime error"),
+				withMarkFailure(core.MarkFailure{
+					Output: []string{"re:^panic:"},
+				}),
+			),
+		)
+
+		result := plan.assertRun(t, core.Failed)
+		result.assertNodeStatus(t, "1", core.NodeFailed)
+	})
+	t.Run("MarkFailureNoMatch", func(t *testing.T) {
+		r := setupRunner(t)
+
+		plan := r.newPlan(t,
+			newStep("1",
+				withCommand("echo all good"),
+				withMarkFailure(core.MarkFailure{
+					Output: []string{"ERROR", "re:panic:"},
+				}),
+			),
+		)
+
+		result := plan.assertRun(t, core.Succeeded)
+		result.assertNodeStatus(t, "1", core.NodeSucceeded)
+	})
+	t.Run("MarkFailureWithContinueOn", func(t *testing.T) {
+		r := setupRunner(t)
+
+		plan := r.newPlan(t,
+			newStep("1",
+				withCommand("echo ERROR"),
+				withMarkFailure(core.MarkFailure{
+					Output: []string{"ERROR"},
+				}),
+				withContinueOn(core.ContinueOn{Failure: true}),
+			),
+			successStep("2", "1"),
+		)
+
+		result := plan.assertRun(t, core.PartiallySucceeded)
+		result.assertNodeStatus(t, "1", core.NodeFailed)
+		result.assertNodeStatus(t, "2", core.NodeSucceeded)
+	})
+	t.Run("MarkSuccessOverridesMarkFailure", func(t *testing.T) {
+		r := setupRunner(t)
+
+		plan := r.newPlan(t,
+			newStep("1",
+				withCommand("echo WARN ERROR"),
+				withMarkFailure(core.MarkFailure{
+					Output: []string{"ERROR"},
+				}),
+				withContinueOn(core.ContinueOn{Output: []string{"WARN"}, MarkSuccess: true}),
+			),
+			successStep("2", "1"),
+		)
+
+		result := plan.assertRun(t, core.Succeeded)
+		result.assertNodeStatus(t, "1", core.NodeSucceeded)
+		result.assertNodeStatus(t, "2", core.NodeSucceeded)
+	})
+	t.Run("MarkFailureWhenMarkSuccessDoesNotMatch", func(t *testing.T) {
+		r := setupRunner(t)
+
+		plan := r.newPlan(t,
+			newStep("1",
+				withCommand("echo ERROR"),
+				withMarkFailure(core.MarkFailure{
+					Output: []string{"ERROR"},
+				}),
+				withContinueOn(core.ContinueOn{Output: []string{"WARN"}, MarkSuccess: true}),
+			),
+			successStep("2", "1"),
+		)
+
+		result := plan.assertRun(t, core.Failed)
+		result.assertNodeStatus(t, "1", core.NodeFailed)
+		result.assertNodeStatus(t, "2", core.NodeAborted)
+	})
 	t.Run("ContinueOnOutputStderr", func(t *testing.T) {
 		r := setupRunner(t)
 		command := test.JoinLines(
@@ -924,6 +1244,20 @@ func TestRunner(t *testing.T) {
 		result.assertNodeStatus(t, "1", core.NodeSucceeded)
 		result.assertNodeStatus(t, "onSuccess", core.NodeSucceeded)
 	})
+	t.Run("OnSuccessHandlerSkipEvalError", func(t *testing.T) {
+		r := setupRunner(t, withOnSuccess(newStep("onSuccess",
+			withCommand("true"),
+			withSkip("`exit 1`"),
+		)))
+
+		plan := r.newPlan(t, successStep("1"))
+
+		result := plan.assertRun(t, core.Failed)
+
+		result.assertNodeStatus(t, "1", core.NodeSucceeded)
+		result.assertNodeStatus(t, "onSuccess", core.NodeFailed)
+		assert.ErrorContains(t, result.nodeByName(t, "onSuccess").State().Error, "skip expression")
+	})
 	t.Run("OnFailureHandler", func(t *testing.T) {
 		r := setupRunner(t, withOnFailure(successStep("onFailure")))
 
@@ -1056,6 +1390,132 @@ func TestRunner(t *testing.T) {
 			require.Contains(t, result.Error.Error(), "no such file or directory")
 		}
 	})
+	t.Run("CreateDir", func(t *testing.T) {
+		t.Parallel()
+		r := setupRunner(t)
+		dir := filepath.Join(t.TempDir(), "nested", "work")
+
+		plan := r.newPlan(t,
+			newStep("1", withWorkingDir(dir), withCreateDir(),
+				withScript("echo created > result.txt"),
+			),
+		)
+
+		result := plan.assertRun(t, core.Succeeded)
+
+		result.assertNodeStatus(t, "1", core.NodeSucceeded)
+		data, err := os.ReadFile(filepath.Join(dir, "result.txt"))
+		require.NoError(t, err)
+		require.Equal(t, "created", strings.TrimSpace(string(data)))
+	})
+	t.Run("CreateDirFailure", func(t *testing.T) {
+		t.Parallel()
+		r := setupRunner(t)
+		file := filepath.Join(t.TempDir(), "file")
+		require.NoError(t, os.WriteFile(file, []byte("x"), 0o600))
+
+		plan := r.newPlan(t,
+			newStep("1", withWorkingDir(filepath.Join(file, "work")), withCreateDir(),
+				withScript("echo 1"),
+			),
+		)
+
+		result := plan.assertRun(t, core.Failed)
+
+		result.assertNodeStatus(t, "1", core.NodeFailed)
+		require.Contains(t, result.Error.Error(), "failed to create working directory")
+	})
+	t.Run("TemplateCommand", func(t *testing.T) {
+		t.Parallel()
+		r := setupRunner(t)
+
+		plan := r.newPlan(t,
+			newStep("1", withCommand("echo v1"), withOutput("VERSION")),
+			newStep("2",
+				withEnvVars("MODE=prod"),
+				withTemplate(),
+				withCommand(`{{ if eq .Env.MODE "prod" }}echo deploy {{ .Outputs.VERSION }}{{ else }}echo skip{{ end }}`),
+				withDepends("1"),
+				withOutput("RESULT"),
+			),
+		)
+
+		result := plan.assertRun(t, core.Succeeded)
+
+		result.assertNodeStatus(t, "2", core.NodeSucceeded)
+		node := result.nodeByName(t, "2")
+		require.Equal(t, "echo deploy v1", node.Step().Commands[0].CmdWithArgs)
+		output, ok := node.NodeData().State.OutputVariables.Load("RESULT")
+		require.True(t, ok, "output variable not found")
+		require.Equal(t, "RESULT=deploy v1", output)
+	})
+	t.Run("TemplateCommandMissingKey", func(t *testing.T) {
+		t.Parallel()
+		r := setupRunner(t)
+
+		plan := r.newPlan(t,
+			newStep("1", withTemplate(), withCommand("echo {{ .Env.UNDEFINED_TEMPLATE_KEY }}")),
+		)
+
+		result := plan.assertRun(t, core.Failed)
+
+		result.assertNodeStatus(t, "1", core.NodeFailed)
+		require.Contains(t, result.Error.Error(), "failed to render command template")
+	})
+	t.Run("OutputFile", func(t *testing.T) {
+		t.Parallel()
+		r := setupRunner(t)
+		dir := t.TempDir()
+
+		plan := r.newPlan(t,
+			newStep("1",
+				withEnvVars("OUT_DIR="+dir),
+				withCommand("echo hello"),
+				withOutputFile("${OUT_DIR}/nested/out.txt"),
+				withOutput("OUT"),
+			),
+		)
+
+		result := plan.assertRun(t, core.Succeeded)
+
+		result.assertNodeStatus(t, "1", core.NodeSucceeded)
+		data, err := os.ReadFile(filepath.Join(dir, "nested", "out.txt"))
+		require.NoError(t, err)
+		require.Equal(t, "hello\n", string(data))
+		output, ok := result.nodeByName(t, "1").NodeData().State.OutputVariables.Load("OUT")
+		require.True(t, ok, "output variable not found")
+		require.Equal(t, "OUT=hello", output)
+	})
+	t.Run("Stdin", func(t *testing.T) {
+		t.Parallel()
+		r := setupRunner(t)
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "input.txt"), []byte("from file"), 0o600))
+
+		plan := r.newPlan(t,
+			newStep("literal",
+				withEnvVars("NAME=dagu"),
+				withCommand("cat"),
+				withStdin(core.StepStdin{Content: "hello ${NAME}"}),
+				withOutput("LITERAL"),
+			),
+			newStep("file",
+				withWorkingDir(dir),
+				withCommand("cat"),
+				withStdin(core.StepStdin{File: "input.txt"}),
+				withOutput("FILE"),
+			),
+		)
+
+		result := plan.assertRun(t, core.Succeeded)
+
+		output, ok := result.nodeByName(t, "literal").NodeData().State.OutputVariables.Load("LITERAL")
+		require.True(t, ok, "output variable not found")
+		require.Equal(t, "LITERAL=hello dagu", output)
+		output, ok = result.nodeByName(t, "file").NodeData().State.OutputVariables.Load("FILE")
+		require.True(t, ok, "output variable not found")
+		require.Equal(t, "FILE=from file", output)
+	})
 	t.Run("OutputVariables", func(t *testing.T) {
 		t.Parallel()
 		r := setupRunner(t)
@@ -1119,79 +1579,235 @@ func TestRunner(t *testing.T) {
 
 		result := plan.assertRun(t, core.Succeeded)
 
-		// check if RESULT variable is set to "value"
-		node := result.nodeByName(t, "2")
-
-		output, _ := node.NodeData().State.OutputVariables.Load("RESULT")
-		require.Equal(t, "RESULT=value", output, "expected output %q, got %q", "value", output)
+		// check if RESULT variable is set to "value"
+		node := result.nodeByName(t, "2")
+
+		output, _ := node.NodeData().State.OutputVariables.Load("RESULT")
+		require.Equal(t, "RESULT=value", output, "expected output %q, got %q", "value", output)
+	})
+	t.Run("HandlingJSONWithSpecialChars", func(t *testing.T) {
+		r := setupRunner(t)
+
+		jsonData := "{\n\t\"key\": \"value\"\n}"
+		plan := r.newPlan(t,
+			newStep("1", withCommand(test.Output(jsonData)), withOutput("OUT")),
+			newStep("2", withCommand(test.ExpandedOutput("${OUT.key}")), withDepends("1"), withOutput("RESULT")),
+		)
+
+		result := plan.assertRun(t, core.Succeeded)
+
+		// check if RESULT variable is set to "value"
+		node := result.nodeByName(t, "2")
+
+		output, _ := node.NodeData().State.OutputVariables.Load("RESULT")
+		require.Equal(t, "RESULT=value", output, "expected output %q, got %q", "value", output)
+	})
+	t.Run("SpecialVarsDAGRUNLOGFILE", func(t *testing.T) {
+		r := setupRunner(t)
+
+		plan := r.newPlan(t,
+			newStep("1", withCommand(test.ExpandedOutput("${DAG_RUN_LOG_FILE}")), withOutput("RESULT")),
+		)
+
+		result := plan.assertRun(t, core.Succeeded)
+		node := result.nodeByName(t, "1")
+
+		outputRaw, ok := node.NodeData().State.OutputVariables.Load("RESULT")
+		require.True(t, ok, "output variable not found")
+		output, ok := outputRaw.(string)
+		require.True(t, ok, "output variable is not a string")
+		require.True(t, strings.HasPrefix(output, "RESULT="), "unexpected output %q", output)
+		require.True(t, strings.HasSuffix(strings.TrimPrefix(output, "RESULT="), ".log"), "unexpected output %q", output)
+	})
+	t.Run("SpecialVarsDAGRUNSTEPSTDOUTFILE", func(t *testing.T) {
+		r := setupRunner(t)
+
+		plan := r.newPlan(t,
+			newStep("1", withCommand(test.ExpandedOutput("${DAG_RUN_STEP_STDOUT_FILE}")), withOutput("RESULT")),
+		)
+
+		result := plan.assertRun(t, core.Succeeded)
+		node := result.nodeByName(t, "1")
+
+		outputRaw, ok := node.NodeData().State.OutputVariables.Load("RESULT")
+		require.True(t, ok, "output variable not found")
+		output, ok := outputRaw.(string)
+		require.True(t, ok, "output variable is not a string")
+		require.True(t, strings.HasPrefix(output, "RESULT="), "unexpected output %q", output)
+		require.True(t, strings.HasSuffix(strings.TrimPrefix(output, "RESULT="), ".out"), "unexpected output %q", output)
+	})
+	t.Run("SpecialVarsDAGRUNSTEPSTDERRFILE", func(t *testing.T) {
+		r := setupRunner(t)
+
+		plan := r.newPlan(t,
+			newStep("1", withCommand(test.ExpandedOutput("${DAG_RUN_STEP_STDERR_FILE}")), withOutput("RESULT")),
+		)
+
+		result := plan.assertRun(t, core.Succeeded)
+		node := result.nodeByName(t, "1")
+
+		outputRaw, ok := node.NodeData().State.OutputVariables.Load("RESULT")
+		require.True(t, ok, "output variable not found")
+		output, ok := outputRaw.(string)
+		require.True(t, ok, "output variable is not a string")
+		require.True(t, strings.HasPrefix(output, "RESULT="), "unexpected output %q", output)
+		require.True(t, strings.HasSuffix(strings.TrimPrefix(output, "RESULT="), ".err"), "unexpected output %q", output)
+	})
+	t.Run("SpecialVarsDAGRUNSTEPSTARTEDAT", func(t *testing.T) {
+		r := setupRunner(t)
+
+		plan := r.newPlan(t,
+			newStep("1", withCommand(test.ExpandedOutput("${DAG_RUN_STEP_STARTED_AT}")), withOutput("RESULT")),
+		)
+
+		result := plan.assertRun(t, core.Succeeded)
+		node := result.nodeByName(t, "1")
+
+		output := node.OutputVariablesMap()["RESULT"]
+		startedAt, err := time.Parse(time.RFC3339, output)
+		require.NoError(t, err, "unexpected output %q", output)
+		assert.True(t, startedAt.Equal(node.State().StartedAt.Truncate(time.Second)),
+			"expected %s, got %s", node.State().StartedAt, startedAt)
+	})
+	t.Run("SpecialVarsDAGRUNSTEPSTARTEDATUsesConfiguredTimezone", func(t *testing.T) {
+		r := setupRunner(t)
+		r.Config.Core.Location = time.FixedZone("UTC+9", 9*60*60)
+
+		plan := r.newPlan(t,
+			newStep("1", withCommand(test.ExpandedOutput("${DAG_RUN_STEP_STARTED_AT}")), withOutput("RESULT")),
+		)
+
+		result := plan.assertRun(t, core.Succeeded)
+
+		output := result.nodeByName(t, "1").OutputVariablesMap()["RESULT"]
+		require.True(t, strings.HasSuffix(output, "+09:00"), "unexpected output %q", output)
 	})
-	t.Run("HandlingJSONWithSpecialChars", func(t *testing.T) {
+	t.Run("SpecialVarsDAGRUNSTEPTIMEOUTSEC", func(t *testing.T) {
 		r := setupRunner(t)
 
-		jsonData := "{\n\t\"key\": \"value\"\n}"
 		plan := r.newPlan(t,
-			newStep("1", withCommand(test.Output(jsonData)), withOutput("OUT")),
-			newStep("2", withCommand(test.ExpandedOutput("${OUT.key}")), withDepends("1"), withOutput("RESULT")),
+			newStep("timed",
+				withCommand(test.EnvOutput("DAG_RUN_STEP_TIMEOUT_SEC")),
+				withStepTimeout(90*time.Second),
+				withOutput("TIMED"),
+			),
+			newStep("untimed",
+				withCommand(test.EnvOutput("DAG_RUN_STEP_TIMEOUT_SEC")),
+				withOutput("UNTIMED"),
+			),
 		)
 
 		result := plan.assertRun(t, core.Succeeded)
 
-		// check if RESULT variable is set to "value"
-		node := result.nodeByName(t, "2")
-
-		output, _ := node.NodeData().State.OutputVariables.Load("RESULT")
-		require.Equal(t, "RESULT=value", output, "expected output %q, got %q", "value", output)
+		assert.Equal(t, "90", result.nodeByName(t, "timed").OutputVariablesMap()["TIMED"])
+		assert.Empty(t, result.nodeByName(t, "untimed").OutputVariablesMap()["UNTIMED"])
 	})
-	t.Run("SpecialVarsDAGRUNLOGFILE", func(t *testing.T) {
+	t.Run("SpecialVarsDAGRUNSTEPSTARTEDATRefreshedOnRetry", func(t *testing.T) {
+		if windowsShellTest() {
+			t.Skip("Skipping Unix-specific env assertion on Windows")
+		}
+
+		dir := t.TempDir()
+		marker := filepath.Join(dir, "marker")
+		logFile := filepath.Join(dir, "started_at.log")
+
 		r := setupRunner(t)
 
 		plan := r.newPlan(t,
-			newStep("1", withCommand(test.ExpandedOutput("${DAG_RUN_LOG_FILE}")), withOutput("RESULT")),
+			newStep("1",
+				withScript(fmt.Sprintf(`
+					echo "$DAG_RUN_STEP_STARTED_AT" >> %s
+					if [ ! -f %s ]; then
+						touch %s
+						exit 1
+					fi
+				`, test.PosixQuote(logFile), test.PosixQuote(marker), test.PosixQuote(marker))),
+				withRetryPolicy(1, 1100*time.Millisecond),
+			),
 		)
 
 		result := plan.assertRun(t, core.Succeeded)
-		node := result.nodeByName(t, "1")
+		result.assertNodeStatus(t, "1", core.NodeSucceeded)
 
-		outputRaw, ok := node.NodeData().State.OutputVariables.Load("RESULT")
-		require.True(t, ok, "output variable not found")
-		output, ok := outputRaw.(string)
-		require.True(t, ok, "output variable is not a string")
-		require.True(t, strings.HasPrefix(output, "RESULT="), "unexpected output %q", output)
-		require.True(t, strings.HasSuffix(strings.TrimPrefix(output, "RESULT="), ".log"), "unexpected output %q", output)
+		data, err := os.ReadFile(logFile)
+		require.NoError(t, err)
+		lines := strings.Fields(string(data))
+		require.Len(t, lines, 2)
+
+		first, err := time.Parse(time.RFC3339, lines[0])
+		require.NoError(t, err)
+		second, err := time.Parse(time.RFC3339, lines[1])
+		require.NoError(t, err)
+		assert.True(t, second.After(first), "expected retry start %s to be after %s", second, first)
 	})
-	t.Run("SpecialVarsDAGRUNSTEPSTDOUTFILE", func(t *testing.T) {
+	t.Run("SpecialVarsDAGRUNSTEPSTARTEDATRefreshedOnRepeat", func(t *testing.T) {
+		if windowsShellTest() {
+			t.Skip("Skipping Unix-specific env assertion on Windows")
+		}
+
+		logFile := filepath.Join(t.TempDir(), "started_at.log")
+
 		r := setupRunner(t)
 
 		plan := r.newPlan(t,
-			newStep("1", withCommand(test.ExpandedOutput("${DAG_RUN_STEP_STDOUT_FILE}")), withOutput("RESULT")),
+			newStep("1",
+				withScript(fmt.Sprintf(`echo "$DAG_RUN_STEP_STARTED_AT" >> %s`, test.PosixQuote(logFile))),
+				withRepeatPolicy(true, 1100*time.Millisecond),
+				func(step *core.Step) {
+					step.RepeatPolicy.Limit = 2
+				},
+			),
 		)
 
 		result := plan.assertRun(t, core.Succeeded)
-		node := result.nodeByName(t, "1")
+		result.assertNodeStatus(t, "1", core.NodeSucceeded)
 
-		outputRaw, ok := node.NodeData().State.OutputVariables.Load("RESULT")
-		require.True(t, ok, "output variable not found")
-		output, ok := outputRaw.(string)
-		require.True(t, ok, "output variable is not a string")
-		require.True(t, strings.HasPrefix(output, "RESULT="), "unexpected output %q", output)
-		require.True(t, strings.HasSuffix(strings.TrimPrefix(output, "RESULT="), ".out"), "unexpected output %q", output)
+		data, err := os.ReadFile(logFile)
+		require.NoError(t, err)
+		lines := strings.Fields(string(data))
+		require.Len(t, lines, 2)
+
+		first, err := time.Parse(time.RFC3339, lines[0])
+		require.NoError(t, err)
+		second, err := time.Parse(time.RFC3339, lines[1])
+		require.NoError(t, err)
+		assert.True(t, second.After(first), "expected repeat start %s to be after %s", second, first)
 	})
-	t.Run("SpecialVarsDAGRUNSTEPSTDERRFILE", func(t *testing.T) {
-		r := setupRunner(t)
+	t.Run("OnRetryHandlerRunsBeforeEachRetry", func(t *testing.T) {
+		if windowsShellTest() {
+			t.Skip("Skipping Unix-specific retry handler test on Windows")
+		}
+
+		dir := t.TempDir()
+		counter := filepath.Join(dir, "attempts")
+		handlerLog := filepath.Join(dir, "handler.log")
+
+		r := setupRunner(t,
+			withOnRetry(newHandlerStep(t, "on_retry", "",
+				fmt.Sprintf(`sh -c 'echo "$DAG_RETRY_STEP_NAME:$DAG_RETRY_STEP_ID:$DAG_RETRY_ATTEMPT" >> %s'`, test.PosixQuote(handlerLog)))),
+		)
 
 		plan := r.newPlan(t,
-			newStep("1", withCommand(test.ExpandedOutput("${DAG_RUN_STEP_STDERR_FILE}")), withOutput("RESULT")),
+			successStep("stable"),
+			newStep("flaky",
+				withID("flaky_id"),
+				// Fails on the first two attempts, succeeds on the third
+				withScript(fmt.Sprintf(`
+					echo x >> %s
+					[ "$(wc -l < %s)" -ge 3 ]
+				`, test.PosixQuote(counter), test.PosixQuote(counter))),
+				withRetryPolicy(2, 10*time.Millisecond),
+			),
 		)
 
 		result := plan.assertRun(t, core.Succeeded)
-		node := result.nodeByName(t, "1")
+		result.assertNodeStatus(t, "stable", core.NodeSucceeded)
+		result.assertNodeStatus(t, "flaky", core.NodeSucceeded)
+		require.Equal(t, 2, result.nodeByName(t, "flaky").State().RetryCount)
 
-		outputRaw, ok := node.NodeData().State.OutputVariables.Load("RESULT")
-		require.True(t, ok, "output variable not found")
-		output, ok := outputRaw.(string)
-		require.True(t, ok, "output variable is not a string")
-		require.True(t, strings.HasPrefix(output, "RESULT="), "unexpected output %q", output)
-		require.True(t, strings.HasSuffix(strings.TrimPrefix(output, "RESULT="), ".err"), "unexpected output %q", output)
+		data, err := os.ReadFile(handlerLog)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"flaky:flaky_id:1", "flaky:flaky_id:2"}, strings.Fields(string(data)))
 	})
 	t.Run("SpecialVarsDAGRUNID", func(t *testing.T) {
 		r := setupRunner(t)
@@ -1586,6 +2202,9 @@ func TestRunner_StepLevelTimeout(t *testing.T) {
 		assert.Equal(t, 124, node.State().ExitCode)
 		require.NotNil(t, node.State().Error)
 		assert.Contains(t, node.State().Error.Error(), "step timed out")
+		assert.Equal(t, core.CancelReasonStepTimeout, node.State().CancelReason)
+		assert.Equal(t, core.CancelReasonUpstreamFailed, result.nodeByName(t, "after").State().CancelReason)
+		assert.Empty(t, r.runner.CancelReason())
 	})
 
 	t.Run("TimeoutPreemptsRetriesAndMarksFailed", func(t *testing.T) {
@@ -1611,6 +2230,71 @@ func TestRunner_StepLevelTimeout(t *testing.T) {
 		assert.Equal(t, 124, node.State().ExitCode)
 	})
 
+	t.Run("RetryOnTimeout", func(t *testing.T) {
+		if windowsShellTest() {
+			t.Skip("Skipping Unix-specific retry timeout test on Windows")
+		}
+
+		// Times out on the first attempt only
+		timeoutOnce := func(marker string) string {
+			return fmt.Sprintf(`
+				if [ ! -f %[1]s ]; then
+					touch %[1]s
+					sleep 2
+				fi
+			`, test.PosixQuote(marker))
+		}
+
+		t.Run("RetriedWhenTimeoutListed", func(t *testing.T) {
+			r := setupRunner(t)
+			plan := r.newPlan(t,
+				newStep("slow_once",
+					withScript(timeoutOnce(filepath.Join(t.TempDir(), "marker"))),
+					withRetryPolicy(2, 10*time.Millisecond),
+					withRetryOn(core.RetryOnTimeout, core.RetryOnFailure),
+					withStepTimeout(200*time.Millisecond),
+				),
+			)
+
+			result := plan.assertRun(t, core.Succeeded)
+			result.assertNodeStatus(t, "slow_once", core.NodeSucceeded)
+			assert.Equal(t, 1, result.nodeByName(t, "slow_once").State().RetryCount)
+		})
+
+		t.Run("NotRetriedWhenTimeoutNotListed", func(t *testing.T) {
+			r := setupRunner(t)
+			plan := r.newPlan(t,
+				newStep("slow_once",
+					withScript(timeoutOnce(filepath.Join(t.TempDir(), "marker"))),
+					withRetryPolicy(2, 10*time.Millisecond),
+					withRetryOn(core.RetryOnFailure),
+					withStepTimeout(200*time.Millisecond),
+				),
+			)
+
+			result := plan.assertRun(t, core.Failed)
+			result.assertNodeStatus(t, "slow_once", core.NodeFailed)
+			node := result.nodeByName(t, "slow_once")
+			assert.Equal(t, 0, node.State().RetryCount)
+			assert.Equal(t, 124, node.State().ExitCode)
+		})
+
+		t.Run("FailureNotRetriedWhenOnlyTimeoutListed", func(t *testing.T) {
+			r := setupRunner(t)
+			plan := r.newPlan(t,
+				newStep("fails",
+					withCommand("exit 1"),
+					withRetryPolicy(2, 10*time.Millisecond),
+					withRetryOn(core.RetryOnTimeout),
+				),
+			)
+
+			result := plan.assertRun(t, core.Failed)
+			result.assertNodeStatus(t, "fails", core.NodeFailed)
+			assert.Equal(t, 0, result.nodeByName(t, "fails").State().RetryCount)
+		})
+	})
+
 	t.Run("ParallelStepsTimeoutFailIndividually", func(t *testing.T) {
 		stepTimeout := platformTestDuration(80*time.Millisecond, 140*time.Millisecond)
 		sleepDuration := platformTestDuration(200*time.Millisecond, 320*time.Millisecond)
@@ -1837,6 +2521,124 @@ func TestRunner_ConcurrentExecution(t *testing.T) {
 	resultConcurrent.assertNodeStatus(t, "1", core.NodeSucceeded)
 	resultConcurrent.assertNodeStatus(t, "2", core.NodeSucceeded)
 	resultConcurrent.assertNodeStatus(t, "3", core.NodeSucceeded)
+
+	slotProbeStep := func(name string) core.Step {
+		return newStep(name, withExecutorType(slotProbeExecutorType))
+	}
+
+	t.Run("StepsHoldSlotsOfDAGBudget", func(t *testing.T) {
+		probe := withSlotProbeExecutor(t, 2)
+		runner := setupRunner(t, withMaxActiveRuns(2))
+		plan := runner.newPlan(t, slotProbeStep("a"), slotProbeStep("b"))
+		result := plan.assertRun(t, core.Succeeded)
+		result.assertNodeStatus(t, "a", core.NodeSucceeded)
+		result.assertNodeStatus(t, "b", core.NodeSucceeded)
+		assert.Equal(t, []bool{false, false}, probe.availability(),
+			"both running steps hold a slot, so none is left for a fan-out")
+	})
+
+	t.Run("RemainingBudgetIsAvailable", func(t *testing.T) {
+		probe := withSlotProbeExecutor(t, 2)
+		runner := setupRunner(t, withMaxActiveRuns(3))
+		plan := runner.newPlan(t, slotProbeStep("a"), slotProbeStep("b"))
+		result := plan.assertRun(t, core.Succeeded)
+		result.assertNodeStatus(t, "a", core.NodeSucceeded)
+		result.assertNodeStatus(t, "b", core.NodeSucceeded)
+		assert.Equal(t, []bool{true, true}, probe.availability())
+	})
+}
+
+const slotProbeExecutorType = "test-slot-probe"
+
+var (
+	registerSlotProbeExecutorOnce sync.Once
+	slotProbeMu                   sync.Mutex
+	slotProbeCurrent              *slotProbe
+)
+
+// slotProbe records whether the DAG-wide step budget passed to executors has
+// a free slot once all probing steps are running at the same time. No step
+// returns before every step has probed, so no slot is released early.
+type slotProbe struct {
+	mu        sync.Mutex
+	running   sync.WaitGroup
+	probed    sync.WaitGroup
+	available []bool
+}
+
+func (p *slotProbe) availability() []bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return slices.Clone(p.available)
+}
+
+type slotProbeExecutor struct {
+	probe *slotProbe
+}
+
+func (e *slotProbeExecutor) SetStdout(io.Writer) {}
+
+func (e *slotProbeExecutor) SetStderr(io.Writer) {}
+
+func (e *slotProbeExecutor) Kill(os.Signal) error { return nil }
+
+func (e *slotProbeExecutor) Run(ctx context.Context) error {
+	e.probe.running.Done()
+	if err := waitGroupOrDone(ctx, &e.probe.running); err != nil {
+		return err
+	}
+
+	available := runtime.GetStepSlots(ctx).Available()
+	e.probe.mu.Lock()
+	e.probe.available = append(e.probe.available, available)
+	e.probe.mu.Unlock()
+
+	e.probe.probed.Done()
+	return waitGroupOrDone(ctx, &e.probe.probed)
+}
+
+func waitGroupOrDone(ctx context.Context, wg *sync.WaitGroup) error {
+	waitCh := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(waitCh)
+	}()
+	select {
+	case <-waitCh:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func withSlotProbeExecutor(t *testing.T, steps int) *slotProbe {
+	t.Helper()
+
+	registerSlotProbeExecutorOnce.Do(func() {
+		runtimeexec.RegisterExecutor(
+			slotProbeExecutorType,
+			func(_ context.Context, _ core.Step) (runtimeexec.Executor, error) {
+				slotProbeMu.Lock()
+				defer slotProbeMu.Unlock()
+				return &slotProbeExecutor{probe: slotProbeCurrent}, nil
+			},
+			nil,
+			core.ExecutorCapabilities{},
+		)
+	})
+
+	probe := &slotProbe{}
+	probe.running.Add(steps)
+	probe.probed.Add(steps)
+	slotProbeMu.Lock()
+	slotProbeCurrent = probe
+	slotProbeMu.Unlock()
+	t.Cleanup(func() {
+		slotProbeMu.Lock()
+		slotProbeCurrent = nil
+		slotProbeMu.Unlock()
+	})
+	return probe
 }
 
 func TestRunner_ErrorHandling(t *testing.T) {
@@ -1941,6 +2743,42 @@ func TestRunner_DAGPreconditions(t *testing.T) {
 		// Check that the runner was canceled
 		assert.Equal(t, core.Aborted, r.runner.Status(ctx, plan.Plan))
 	})
+
+	t.Run("RecordsFailedPrecondition", func(t *testing.T) {
+		r := setupRunner(t)
+
+		dag := &core.DAG{
+			Name: "test_dag",
+			Preconditions: []*core.Condition{
+				{Condition: "prod", Expected: "prod"},
+				{Condition: "staging", Expected: "prod"},
+				{Condition: "dev", Expected: "dev"},
+			},
+		}
+
+		plan := r.newPlan(t, successStep("1"))
+
+		logFilename := fmt.Sprintf("%s_%s.log", dag.Name, r.cfg.DAGRunID)
+		logFilePath := filepath.Join(r.cfg.LogDir, logFilename)
+		ctx := runtime.NewContext(plan.Context, dag, r.cfg.DAGRunID, logFilePath)
+
+		require.NoError(t, r.runner.Run(ctx, plan.Plan, nil))
+		assert.Equal(t, core.Aborted, r.runner.Status(ctx, plan.Plan))
+
+		failed := r.runner.FailedPrecondition()
+		require.NotNil(t, failed)
+		assert.Equal(t, "staging", failed.Condition)
+		assert.Equal(t, "prod", failed.Expected)
+		assert.Contains(t, failed.GetErrorMessage(), `expected "prod", got "staging"`)
+	})
+
+	t.Run("NoFailedPreconditionWhenMet", func(t *testing.T) {
+		r := setupRunner(t)
+		plan := r.newPlan(t, successStep("1"))
+
+		plan.assertRun(t, core.Succeeded)
+		assert.Nil(t, r.runner.FailedPrecondition())
+	})
 }
 
 func TestRunner_StatusDefersForcedStatusUntilTerminal(t *testing.T) {
@@ -2016,6 +2854,35 @@ func TestRunner_SignalHandling(t *testing.T) {
 		result := plan.assertRun(t, core.Aborted)
 		result.assertNodeStatus(t, "1", core.NodeAborted)
 	})
+
+	t.Run("SignalOnStopEscalation", func(t *testing.T) {
+		r := setupRunner(t)
+
+		// The process ignores SIGINT and only dies once escalated to SIGKILL
+		plan := r.newPlan(t,
+			newStep("1",
+				withScript("trap '' INT\nsleep 10"),
+				withSignalOnStop("SIGINT", &core.SignalEscalation{
+					Signal: "SIGKILL",
+					After:  500 * time.Millisecond,
+				}),
+			),
+		)
+
+		go func() {
+			waitForNodeStatus(plan.Plan, "1", core.NodeRunning, 5*time.Second)
+			time.Sleep(100 * time.Millisecond) // let the trap be installed
+			r.runner.Signal(r.Context, plan.Plan, syscall.SIGTERM, nil, true)
+		}()
+
+		start := time.Now()
+		result := plan.assertRun(t, core.Aborted)
+		elapsed := time.Since(start)
+
+		result.assertNodeStatus(t, "1", core.NodeAborted)
+		assert.GreaterOrEqual(t, elapsed, 500*time.Millisecond, "Should wait for the grace period")
+		assert.Less(t, elapsed, 5*time.Second, "Should escalate before the process exits on its own")
+	})
 }
 
 func TestRunner_ComplexDependencyChains(t *testing.T) {
@@ -2050,12 +2917,132 @@ func TestRunner_ComplexDependencyChains(t *testing.T) {
 			successStep("4", "2", "3"),
 		)
 
-		result := plan.assertRun(t, core.Failed)
+		result := plan.assertRun(t, core.Failed)
+
+		result.assertNodeStatus(t, "1", core.NodeSucceeded)
+		result.assertNodeStatus(t, "2", core.NodeFailed)
+		result.assertNodeStatus(t, "3", core.NodeSucceeded)
+		result.assertNodeStatus(t, "4", core.NodeAborted) // Canceled due to 2's failure
+	})
+}
+
+func TestRunner_CancelReason(t *testing.T) {
+	t.Run("UpstreamFailed", func(t *testing.T) {
+		r := setupRunner(t)
+
+		// 1 -> 2 (fail) -> 4 -> 5
+		//   -> 3 -------->
+		plan := r.newPlan(t,
+			successStep("1"),
+			failStep("2", "1"),
+			successStep("3", "1"),
+			successStep("4", "2", "3"),
+			successStep("5", "4"),
+		)
+
+		result := plan.assertRun(t, core.Failed)
+
+		assert.Empty(t, result.nodeByName(t, "2").State().CancelReason)
+		assert.Empty(t, result.nodeByName(t, "3").State().CancelReason)
+		result.assertNodeStatus(t, "4", core.NodeAborted)
+		assert.Equal(t, core.CancelReasonUpstreamFailed, result.nodeByName(t, "4").State().CancelReason)
+		result.assertNodeStatus(t, "5", core.NodeAborted)
+		assert.Equal(t, core.CancelReasonUpstreamFailed, result.nodeByName(t, "5").State().CancelReason)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		dagTimeout := 500 * time.Millisecond
+		secondSleep := 500 * time.Millisecond
+		if windowsShellTest() {
+			dagTimeout = 3 * time.Second
+			secondSleep = 5 * time.Second
+		}
+
+		r := setupRunner(t, withTimeout(dagTimeout))
+		plan := r.newPlan(t,
+			successStep("1"),
+			newStep("2", withCommand(test.Sleep(secondSleep)), withDepends("1")),
+			successStep("3", "2"),
+		)
+
+		result := plan.assertRun(t, core.Failed)
+
+		assert.Empty(t, result.nodeByName(t, "1").State().CancelReason)
+		result.assertNodeStatus(t, "2", core.NodeAborted)
+		assert.Equal(t, core.CancelReasonTimeout, result.nodeByName(t, "2").State().CancelReason)
+		result.assertNodeStatus(t, "3", core.NodeAborted)
+		assert.Equal(t, core.CancelReasonTimeout, result.nodeByName(t, "3").State().CancelReason)
+		assert.Equal(t, core.CancelReasonTimeout, r.runner.CancelReason())
+	})
+
+	t.Run("TimeoutAbortsRunningSteps", func(t *testing.T) {
+		dagTimeout := 300 * time.Millisecond
+		stepSleep := 5 * time.Second
+		if windowsShellTest() {
+			dagTimeout = 3 * time.Second
+			stepSleep = 20 * time.Second
+		}
+
+		r := setupRunner(t, withTimeout(dagTimeout))
+		// 1 and 2 run concurrently and outlive the DAG timeout; 3 never starts.
+		plan := r.newPlan(t,
+			newStep("1", withCommand(test.Sleep(stepSleep))),
+			newStep("2", withCommand(test.Sleep(stepSleep))),
+			successStep("3", "1", "2"),
+		)
+
+		startedAt := time.Now()
+		result := plan.assertRun(t, core.Failed)
+		assert.Less(t, time.Since(startedAt), stepSleep, "running steps should be signaled when the DAG times out")
+
+		for _, name := range []string{"1", "2", "3"} {
+			result.assertNodeStatus(t, name, core.NodeAborted)
+			assert.Equal(t, core.CancelReasonTimeout, result.nodeByName(t, name).State().CancelReason)
+		}
+		assert.Equal(t, core.CancelReasonTimeout, r.runner.CancelReason())
+	})
+
+	t.Run("Signal", func(t *testing.T) {
+		r := setupRunner(t)
+		plan := r.newPlan(t,
+			newStep("1", withCommand("sleep 0.5")),
+			successStep("2", "1"),
+		)
+
+		go func() {
+			waitForNodeStatus(plan.Plan, "1", core.NodeRunning, 5*time.Second)
+			r.runner.Signal(r.Context, plan.Plan, syscall.SIGTERM, nil, false)
+		}()
+
+		result := plan.assertRun(t, core.Aborted)
+
+		result.assertNodeStatus(t, "1", core.NodeAborted)
+		assert.Equal(t, core.CancelReasonSignal, result.nodeByName(t, "1").State().CancelReason)
+		result.assertNodeStatus(t, "2", core.NodeNotStarted)
+		assert.Equal(t, core.CancelReasonSignal, result.nodeByName(t, "2").State().CancelReason)
+		assert.Equal(t, core.CancelReasonSignal, r.runner.CancelReason())
+	})
+
+	t.Run("DAGPreconditionNotMet", func(t *testing.T) {
+		r := setupRunner(t)
+		plan := r.newPlan(t, successStep("1"), successStep("2", "1"))
+
+		dag := &core.DAG{
+			Name:          "test_dag",
+			WorkingDir:    plan.workDir,
+			Preconditions: []*core.Condition{{Condition: "prod", Expected: "dev"}},
+		}
+		logFilePath := filepath.Join(r.cfg.LogDir, fmt.Sprintf("%s_%s.log", dag.Name, r.cfg.DAGRunID))
+		ctx := runtime.NewContext(plan.Context, dag, r.cfg.DAGRunID, logFilePath)
+
+		require.NoError(t, r.runner.Run(ctx, plan.Plan, nil))
+		require.Equal(t, core.Aborted, r.runner.Status(ctx, plan.Plan))
 
-		result.assertNodeStatus(t, "1", core.NodeSucceeded)
-		result.assertNodeStatus(t, "2", core.NodeFailed)
-		result.assertNodeStatus(t, "3", core.NodeSucceeded)
-		result.assertNodeStatus(t, "4", core.NodeAborted) // Canceled due to 2's failure
+		for _, name := range []string{"1", "2"} {
+			node := plan.GetNodeByName(name)
+			assert.Equal(t, core.NodeNotStarted, node.State().Status)
+			assert.Equal(t, core.CancelReasonPreconditionNotMet, node.State().CancelReason)
+		}
 	})
 }
 
@@ -2136,6 +3123,278 @@ func TestRunner_PreconditionWithError(t *testing.T) {
 	// Conditions that exit with non-zero are just "not met", not errors
 }
 
+func TestRunner_AnyPreconditionGroup(t *testing.T) {
+	r := setupRunner(t)
+
+	plan := r.newPlan(t,
+		newStep("1",
+			withPrecondition(&core.Condition{Any: []*core.Condition{
+				{Condition: "false"},
+				{Condition: "`echo ok`", Expected: "ok"},
+			}}),
+			withCommand("echo run"),
+		),
+		newStep("2",
+			withPrecondition(&core.Condition{Any: []*core.Condition{
+				{Condition: "false"},
+				{Condition: "`echo ok`", Expected: "ng"},
+			}}),
+			withCommand("echo should_not_run"),
+		),
+	)
+
+	result := plan.assertRun(t, core.Succeeded)
+
+	result.assertNodeStatus(t, "1", core.NodeSucceeded)
+	result.assertNodeStatus(t, "2", core.NodeSkipped)
+}
+
+func TestRunner_StepLogRotate(t *testing.T) {
+	r := setupRunner(t)
+
+	plan := r.newPlan(t,
+		newStep("1",
+			// About 40KB of output rotates several times with an 8KB limit.
+			withCommand("for i in $(seq 1 1000); do echo 0123456789012345678901234567890123456789; done"),
+			withLogRotate(8*1024, 2),
+		),
+	)
+
+	result := plan.assertRun(t, core.Succeeded)
+
+	stdout := result.nodeByName(t, "1").State().Stdout
+	require.NotEmpty(t, stdout)
+	assert.FileExists(t, stdout)
+	assert.FileExists(t, stdout+".1")
+	assert.FileExists(t, stdout+".2")
+	assert.NoFileExists(t, stdout+".3")
+	for _, p := range []string{stdout, stdout + ".1", stdout + ".2"} {
+		info, err := os.Stat(p)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, info.Size(), int64(8*1024))
+	}
+}
+
+func TestRunner_StepLogRotateMarkFailure(t *testing.T) {
+	r := setupRunner(t)
+
+	plan := r.newPlan(t,
+		newStep("1",
+			// The marker is rotated out of the current log file by the output
+			// that follows it.
+			withCommand("echo ERROR: early failure; for i in $(seq 1 400); do echo 0123456789012345678901234567890123456789; done"),
+			withLogRotate(8*1024, 2),
+			withMarkFailure(core.MarkFailure{
+				Output: []string{"ERROR"},
+			}),
+		),
+	)
+
+	result := plan.assertRun(t, core.Failed)
+
+	result.assertNodeStatus(t, "1", core.NodeFailed)
+	stdout := result.nodeByName(t, "1").State().Stdout
+	require.FileExists(t, stdout+".1")
+	data, err := os.ReadFile(stdout)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "ERROR")
+	assert.ErrorIs(t, result.nodeByName(t, "1").State().Error, runtime.ErrOutputMarkedFailed)
+}
+
+func TestRunner_StepSpanAttributes(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prev)
+		_ = provider.Shutdown(context.Background())
+	})
+
+	r := setupRunner(t)
+	// Step spans are only created under an active DAG span.
+	ctx, parent := provider.Tracer("test").Start(r.Context, "DAG: test_dag")
+	r.Context = ctx
+
+	plan := r.newPlan(t,
+		newStep("ok", withID("ok_id"), withCommand("true"), withExecutorType("command")),
+		newStep("bad",
+			withID("bad_id"),
+			withCommand("exit 3"),
+			withExecutorType("command"),
+			withRetryPolicy(1, 0),
+		),
+	)
+	plan.assertRun(t, core.Failed)
+	parent.End()
+
+	spans := make(map[string]tracetest.SpanStub)
+	for _, span := range exporter.GetSpans() {
+		spans[span.Name] = span
+	}
+	attrs := func(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+		m := make(map[attribute.Key]attribute.Value, len(span.Attributes))
+		for _, kv := range span.Attributes {
+			m[kv.Key] = kv.Value
+		}
+		return m
+	}
+
+	ok, found := spans["Step: ok"]
+	require.True(t, found, "span for step ok not exported")
+	okAttrs := attrs(ok)
+	assert.Equal(t, "ok", okAttrs["step.name"].AsString())
+	assert.Equal(t, "ok_id", okAttrs["step.id"].AsString())
+	assert.Equal(t, "command", okAttrs["step.executor_type"].AsString())
+	assert.Equal(t, core.NodeSucceeded.String(), okAttrs["step.status"].AsString())
+	assert.Equal(t, int64(0), okAttrs["step.exit_code"].AsInt64())
+	assert.Equal(t, int64(0), okAttrs["step.retry_count"].AsInt64())
+	assert.Equal(t, parent.SpanContext().SpanID(), ok.Parent.SpanID())
+
+	bad, found := spans["Step: bad"]
+	require.True(t, found, "span for step bad not exported")
+	badAttrs := attrs(bad)
+	assert.Equal(t, "bad", badAttrs["step.name"].AsString())
+	assert.Equal(t, "bad_id", badAttrs["step.id"].AsString())
+	assert.Equal(t, "command", badAttrs["step.executor_type"].AsString())
+	assert.Equal(t, core.NodeFailed.String(), badAttrs["step.status"].AsString())
+	assert.Equal(t, int64(3), badAttrs["step.exit_code"].AsInt64())
+	assert.Equal(t, int64(1), badAttrs["step.retry_count"].AsInt64())
+	assert.Equal(t, codes.Error, bad.Status.Code)
+}
+
+func TestRunner_StepTraceContextEnv(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+		_ = provider.Shutdown(context.Background())
+	})
+
+	t.Run("Traced", func(t *testing.T) {
+		r := setupRunner(t)
+		ctx, parent := provider.Tracer("test").Start(r.Context, "DAG: test_dag")
+		r.Context = ctx
+
+		plan := r.newPlan(t,
+			newStep("1", withCommand(test.EnvOutput("TRACEPARENT")), withOutput("TRACEPARENT_OUT")),
+		)
+		result := plan.assertRun(t, core.Succeeded)
+		parent.End()
+
+		traceparent := result.nodeByName(t, "1").OutputVariablesMap()["TRACEPARENT_OUT"]
+		require.Regexp(t, `^00-[0-9a-f]{32}-[0-9a-f]{16}-[0-9a-f]{2}$`, traceparent)
+
+		// The trace context is that of the step span.
+		var stepSpan *tracetest.SpanStub
+		for _, span := range exporter.GetSpans() {
+			if span.Name == "Step: 1" {
+				stepSpan = &span
+			}
+		}
+		require.NotNil(t, stepSpan)
+		parts := strings.Split(traceparent, "-")
+		assert.Equal(t, parent.SpanContext().TraceID().String(), parts[1])
+		assert.Equal(t, stepSpan.SpanContext.SpanID().String(), parts[2])
+	})
+
+	t.Run("NotTraced", func(t *testing.T) {
+		r := setupRunner(t)
+
+		plan := r.newPlan(t,
+			newStep("1", withCommand(test.EnvOutput("TRACEPARENT")), withOutput("TRACEPARENT_OUT")),
+		)
+		result := plan.assertRun(t, core.Succeeded)
+
+		assert.Empty(t, result.nodeByName(t, "1").OutputVariablesMap()["TRACEPARENT_OUT"])
+	})
+}
+
+func TestRunner_StepOnError(t *testing.T) {
+	t.Run("RunsOnFailure", func(t *testing.T) {
+		r := setupRunner(t)
+		marker := filepath.Join(t.TempDir(), "on_error")
+
+		plan := r.newPlan(t,
+			newStep("1", withCommand("exit 3"), withOnError("touch "+marker)),
+		)
+
+		result := plan.assertRun(t, core.Failed)
+
+		result.assertNodeStatus(t, "1", core.NodeFailed)
+		require.FileExists(t, marker)
+		state := result.nodeByName(t, "1").State()
+		assert.Equal(t, 3, state.ExitCode)
+		require.NotNil(t, state.OnErrorExitCode)
+		assert.Equal(t, 0, *state.OnErrorExitCode)
+	})
+
+	t.Run("SkippedOnSuccess", func(t *testing.T) {
+		r := setupRunner(t)
+		marker := filepath.Join(t.TempDir(), "on_error")
+
+		plan := r.newPlan(t,
+			newStep("1", withCommand("true"), withOnError("touch "+marker)),
+		)
+
+		result := plan.assertRun(t, core.Succeeded)
+
+		result.assertNodeStatus(t, "1", core.NodeSucceeded)
+		require.NoFileExists(t, marker)
+		assert.Nil(t, result.nodeByName(t, "1").State().OnErrorExitCode)
+	})
+
+	t.Run("RunsOnNonRetryableExitCode", func(t *testing.T) {
+		r := setupRunner(t)
+		marker := filepath.Join(t.TempDir(), "on_error")
+
+		plan := r.newPlan(t,
+			newStep("1",
+				withCommand("exit 3"),
+				withOnError("touch "+marker),
+				withRetryPolicy(2, 20*time.Millisecond),
+				func(step *core.Step) {
+					step.RetryPolicy.ExitCodes = []int{42}
+				},
+			),
+		)
+
+		result := plan.assertRun(t, core.Failed)
+
+		result.assertNodeStatus(t, "1", core.NodeFailed)
+		require.FileExists(t, marker)
+		state := result.nodeByName(t, "1").State()
+		assert.Equal(t, 0, state.RetryCount)
+		require.NotNil(t, state.OnErrorExitCode)
+		assert.Equal(t, 0, *state.OnErrorExitCode)
+	})
+
+	t.Run("FailingHandlerKeepsStepStatus", func(t *testing.T) {
+		r := setupRunner(t)
+
+		plan := r.newPlan(t,
+			newStep("1",
+				withCommand("exit 1"),
+				withOnError("exit 7"),
+				withContinueOn(core.ContinueOn{Failure: true}),
+			),
+			successStep("2", "1"),
+		)
+
+		result := plan.assertRun(t, core.PartiallySucceeded)
+
+		result.assertNodeStatus(t, "1", core.NodeFailed)
+		result.assertNodeStatus(t, "2", core.NodeSucceeded)
+		state := result.nodeByName(t, "1").State()
+		require.NotNil(t, state.OnErrorExitCode)
+		assert.Equal(t, 7, *state.OnErrorExitCode)
+	})
+}
+
 func TestRunner_MultipleHandlerExecution(t *testing.T) {
 	recordHandler := func(name string) core.Step {
 		return newStep(name, withScript(fmt.Sprintf(`echo "Handler %s executed"`, name)))
@@ -2156,6 +3415,84 @@ func TestRunner_MultipleHandlerExecution(t *testing.T) {
 	result.assertNodeStatus(t, "onExit", core.NodeSucceeded)
 }
 
+func TestRunner_FailureHandlerSteps(t *testing.T) {
+	if windowsShellTest() {
+		t.Skip("Skipping Unix-specific handler step test on Windows")
+	}
+
+	recordStep := func(logFile, name string, fail bool) core.Step {
+		script := fmt.Sprintf("echo %s >> %s", name, test.PosixQuote(logFile))
+		if fail {
+			script += "\nexit 1"
+		}
+		return newStep(core.HandlerOnFailure.String(), withScript(script))
+	}
+	readLog := func(t *testing.T, logFile string) []string {
+		t.Helper()
+		data, err := os.ReadFile(logFile)
+		require.NoError(t, err)
+		return strings.Fields(string(data))
+	}
+
+	t.Run("RunsStepsInOrder", func(t *testing.T) {
+		logFile := filepath.Join(t.TempDir(), "handler.log")
+		r := setupRunner(t, withOnFailureSteps(
+			recordStep(logFile, "notify", false),
+			recordStep(logFile, "cleanup", false),
+		))
+
+		plan := r.newPlan(t, failStep("1"))
+		result := plan.assertRun(t, core.Failed)
+
+		result.assertNodeStatus(t, "1", core.NodeFailed)
+		assert.Equal(t, []string{"notify", "cleanup"}, readLog(t, logFile))
+
+		// Every step keeps its own node
+		handlerNodes := r.runner.HandlerNodes(core.HandlerOnFailure)
+		require.Len(t, handlerNodes, 2)
+		assert.Contains(t, handlerNodes[0].Step().Script, "notify")
+		assert.Equal(t, core.NodeSucceeded, handlerNodes[0].State().Status)
+		assert.Contains(t, handlerNodes[1].Step().Script, "cleanup")
+		assert.Equal(t, core.NodeSucceeded, handlerNodes[1].State().Status)
+	})
+
+	t.Run("StopsAtFirstFailedStep", func(t *testing.T) {
+		logFile := filepath.Join(t.TempDir(), "handler.log")
+		r := setupRunner(t, withOnFailureSteps(
+			recordStep(logFile, "notify", true),
+			recordStep(logFile, "cleanup", false),
+		))
+
+		plan := r.newPlan(t, successStep("1"), failStep("2"))
+		plan.assertRun(t, core.Failed)
+
+		assert.Equal(t, []string{"notify"}, readLog(t, logFile))
+		handlerNodes := r.runner.HandlerNodes(core.HandlerOnFailure)
+		require.Len(t, handlerNodes, 2)
+		assert.Equal(t, core.NodeFailed, handlerNodes[0].State().Status)
+		assert.Equal(t, core.NodeNotStarted, handlerNodes[1].State().Status)
+	})
+
+	t.Run("ContinuesPastFailedStepWithContinueOn", func(t *testing.T) {
+		logFile := filepath.Join(t.TempDir(), "handler.log")
+		notify := recordStep(logFile, "notify", true)
+		notify.ContinueOn = core.ContinueOn{Failure: true}
+		r := setupRunner(t, withOnFailureSteps(
+			notify,
+			recordStep(logFile, "cleanup", false),
+		))
+
+		plan := r.newPlan(t, failStep("1"))
+		plan.assertRun(t, core.Failed)
+
+		assert.Equal(t, []string{"notify", "cleanup"}, readLog(t, logFile))
+		handlerNodes := r.runner.HandlerNodes(core.HandlerOnFailure)
+		require.Len(t, handlerNodes, 2)
+		assert.Equal(t, core.NodeFailed, handlerNodes[0].State().Status)
+		assert.Equal(t, core.NodeSucceeded, handlerNodes[1].State().Status)
+	})
+}
+
 func TestRunner_TimeoutDuringRetry(t *testing.T) {
 	r := setupRunner(t, withTimeout(500*time.Millisecond))
 
@@ -2192,7 +3529,7 @@ func TestRunner_CancelDuringHandlerExecution(t *testing.T) {
 
 	go func() {
 		waitForHandlerNodeStatus(r.runner, core.HandlerOnExit, core.NodeRunning, 5*time.Second)
-		r.runner.Cancel(plan.Plan)
+		r.runner.Cancel(plan.Context, plan.Plan)
 	}()
 
 	// Since we cancel during handler execution, the final status depends on timing
@@ -2230,7 +3567,7 @@ func TestRunner_RepeatPolicyWithCancel(t *testing.T) {
 		if ready {
 			time.Sleep(platformTestDuration(50*time.Millisecond, 1*time.Second))
 		}
-		r.runner.Cancel(plan.Plan)
+		r.runner.Cancel(plan.Context, plan.Plan)
 	}()
 
 	result := plan.assertRun(t, core.Aborted)
@@ -2263,6 +3600,113 @@ func TestRunner_RepeatPolicyWithLimit(t *testing.T) {
 	assert.Equal(t, 3, node.State().DoneCount)
 }
 
+func TestRunner_RepeatPolicyWithMaxRepeats(t *testing.T) {
+	r := setupRunner(t)
+
+	// max_repeats counts repeats only, so it runs one more time than the
+	// same value given as limit.
+	plan := r.newPlan(t,
+		newStep("limit",
+			withCommand(test.Output("repeat")),
+			withRepeatPolicy(true, 100*time.Millisecond),
+			func(step *core.Step) {
+				step.RepeatPolicy.Limit = 3
+			},
+		),
+		newStep("max-repeats",
+			withCommand(test.Output("repeat")),
+			withRepeatPolicy(true, 100*time.Millisecond),
+			func(step *core.Step) {
+				step.RepeatPolicy.MaxRepeatsStr = "3"
+			},
+		),
+	)
+
+	result := plan.assertRun(t, core.Succeeded)
+	result.assertNodeStatus(t, "limit", core.NodeSucceeded)
+	result.assertNodeStatus(t, "max-repeats", core.NodeSucceeded)
+
+	limitNode := result.nodeByName(t, "limit")
+	maxRepeatsNode := result.nodeByName(t, "max-repeats")
+	// limit: 3 executes 3 times; max_repeats: 3 executes initial + 3 repeats
+	assert.Equal(t, 3, limitNode.State().DoneCount)
+	assert.Equal(t, 4, maxRepeatsNode.State().DoneCount)
+	assert.Equal(t, limitNode.State().DoneCount+1, maxRepeatsNode.State().DoneCount)
+}
+
+func TestRunner_RepeatPolicyConditionTimeout(t *testing.T) {
+	r := setupRunner(t)
+
+	plan := r.newPlan(t,
+		newStep("1",
+			withCommand("true"),
+			func(step *core.Step) {
+				step.RepeatPolicy.RepeatMode = core.RepeatModeWhile
+				step.RepeatPolicy.Interval = 10 * time.Millisecond
+				step.RepeatPolicy.Condition = &core.Condition{Condition: "sleep 10"}
+				step.RepeatPolicy.ConditionTimeout = 200 * time.Millisecond
+			},
+		),
+	)
+
+	start := time.Now()
+	result := plan.assertRun(t, core.Failed)
+	elapsed := time.Since(start)
+
+	result.assertNodeStatus(t, "1", core.NodeFailed)
+	node := result.nodeByName(t, "1")
+	assert.Equal(t, 1, node.State().DoneCount)
+	require.Error(t, node.State().Error)
+	assert.Contains(t, node.State().Error.Error(), "repeat condition timed out")
+	assert.Less(t, elapsed, 5*time.Second, "condition should be cut off by the timeout")
+}
+
+func TestRunner_RepeatPolicyConditionTimeoutNegated(t *testing.T) {
+	r := setupRunner(t)
+
+	// Killing a negated condition must not count as the condition being met.
+	plan := r.newPlan(t,
+		newStep("1",
+			withCommand("true"),
+			func(step *core.Step) {
+				step.RepeatPolicy.RepeatMode = core.RepeatModeUntil
+				step.RepeatPolicy.Interval = 10 * time.Millisecond
+				step.RepeatPolicy.Condition = &core.Condition{Condition: "sleep 10", Negate: true}
+				step.RepeatPolicy.ConditionTimeout = 200 * time.Millisecond
+			},
+		),
+	)
+
+	result := plan.assertRun(t, core.Failed)
+
+	result.assertNodeStatus(t, "1", core.NodeFailed)
+	node := result.nodeByName(t, "1")
+	assert.Equal(t, 1, node.State().DoneCount)
+	require.Error(t, node.State().Error)
+	assert.Contains(t, node.State().Error.Error(), "repeat condition timed out")
+}
+
+func TestRunner_RepeatPolicyConditionWithinTimeout(t *testing.T) {
+	r := setupRunner(t)
+
+	plan := r.newPlan(t,
+		newStep("1",
+			withCommand("true"),
+			func(step *core.Step) {
+				step.RepeatPolicy.RepeatMode = core.RepeatModeUntil
+				step.RepeatPolicy.Interval = 10 * time.Millisecond
+				step.RepeatPolicy.Condition = &core.Condition{Condition: "true"}
+				step.RepeatPolicy.ConditionTimeout = 5 * time.Second
+			},
+		),
+	)
+
+	result := plan.assertRun(t, core.Succeeded)
+
+	result.assertNodeStatus(t, "1", core.NodeSucceeded)
+	assert.Equal(t, 1, result.nodeByName(t, "1").State().DoneCount)
+}
+
 func TestRunner_RepeatPolicyWithLimitAndCondition(t *testing.T) {
 	r := setupRunner(t)
 
@@ -3068,6 +4512,35 @@ func TestRunner_EventHandlerStepIDAccess(t *testing.T) {
 		assert.Contains(t, output, ".err") // step3 stderr path
 	})
 
+	t.Run("OnExitHandlerWithStepDurationAndStatus", func(t *testing.T) {
+		r := setupRunner(t,
+			withOnExit(newHandlerStep(t, "exit_handler", "on_exit",
+				"echo 'duration=${main.duration} status=${main.status} unknown=${handler1.duration}'")),
+		)
+
+		plan := r.newPlan(t,
+			newStep("main_step",
+				withID("main"),
+				withCommand(test.Sleep(200*time.Millisecond)),
+			),
+		)
+
+		result := plan.assertRun(t, core.Succeeded)
+		result.assertNodeStatus(t, "exit_handler", core.NodeSucceeded)
+
+		handlerOutput, err := os.ReadFile(result.nodeByName(t, "exit_handler").GetStdout())
+		require.NoError(t, err)
+		output := strings.TrimSpace(string(handlerOutput))
+
+		matches := regexp.MustCompile(`duration=(\S+) status=(\S+) unknown=(\S+)`).FindStringSubmatch(output)
+		require.Len(t, matches, 4, "unexpected output %q", output)
+		duration, err := strconv.ParseFloat(matches[1], 64)
+		require.NoError(t, err, "duration is not numeric: %q", matches[1])
+		assert.GreaterOrEqual(t, duration, 0.2)
+		assert.Equal(t, "succeeded", matches[2])
+		assert.Equal(t, "${handler1.duration}", matches[3])
+	})
+
 	t.Run("HandlerWithoutIDCannotBeReferenced", func(t *testing.T) {
 		r := setupRunner(t,
 			withOnExit(newHandlerStep(t, "exit_handler_no_id", "", "echo 'Handler executed'")),
@@ -0,0 +1,96 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package runtime
+
+import (
+	"context"
+	"sync"
+)
+
+// StepSlots is the DAG-wide budget of concurrently active steps defined by
+// maxActiveSteps. The runner holds one slot per running node; executors that
+// fan out within a single node (e.g. parallel sub-DAG runs) acquire an extra
+// slot for every concurrent unit of work beyond the first, so their effective
+// concurrency is the tighter of their own limit and the remaining DAG budget.
+//
+// A nil *StepSlots is valid and represents an unlimited budget.
+type StepSlots struct {
+	mu       sync.Mutex
+	limit    int
+	used     int
+	released chan struct{}
+}
+
+// NewStepSlots creates a budget of limit slots. A limit of 0 or less means
+// unlimited, in which case nil is returned.
+func NewStepSlots(limit int) *StepSlots {
+	if limit <= 0 {
+		return nil
+	}
+	return &StepSlots{limit: limit, released: make(chan struct{})}
+}
+
+// TryAcquire takes a slot if one is available and reports whether it did.
+func (s *StepSlots) TryAcquire() bool {
+	if s == nil {
+		return true
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.used >= s.limit {
+		return false
+	}
+	s.used++
+	return true
+}
+
+// Release returns a slot to the budget and wakes up waiters.
+func (s *StepSlots) Release() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.used > 0 {
+		s.used--
+	}
+	close(s.released)
+	s.released = make(chan struct{})
+}
+
+// Available reports whether a slot can currently be acquired.
+func (s *StepSlots) Available() bool {
+	if s == nil {
+		return true
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.used < s.limit
+}
+
+// Released returns a channel that is closed the next time a slot is released.
+// It returns nil (blocking forever in a select) for an unlimited budget.
+func (s *StepSlots) Released() <-chan struct{} {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.released
+}
+
+// Context key for storing StepSlots in context
+type stepSlotsCtxKey struct{}
+
+// WithStepSlots returns a new context carrying the DAG-wide step budget.
+func WithStepSlots(ctx context.Context, s *StepSlots) context.Context {
+	return context.WithValue(ctx, stepSlotsCtxKey{}, s)
+}
+
+// GetStepSlots returns the DAG-wide step budget from the context, or nil
+// (unlimited) if none is set.
+func GetStepSlots(ctx context.Context) *StepSlots {
+	s, _ := ctx.Value(stepSlotsCtxKey{}).(*StepSlots)
+	return s
+}
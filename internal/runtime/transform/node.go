@@ -40,6 +40,8 @@ func ToNode(n *exec.Node) *runtime.Node {
 		Repeated:               n.Repeated,
 		SkippedByRetry:         n.SkippedByRetry,
 		Error:                  err,
+		CancelReason:           n.CancelReason,
+		OnErrorExitCode:        n.OnErrorExitCode,
 		SubRuns:                children,
 		SubRunsRepeated:        childrenRepeated,
 		OutputVariables:        n.OutputVariables,
@@ -86,6 +88,8 @@ func newNode(node runtime.NodeData) *exec.Node {
 		Repeated:               node.State.Repeated,
 		SkippedByRetry:         node.State.SkippedByRetry,
 		Error:                  errText,
+		CancelReason:           node.State.CancelReason,
+		OnErrorExitCode:        node.State.OnErrorExitCode,
 		SubRuns:                children,
 		SubRunsRepeated:        childrenRepeated,
 		OutputVariables:        node.State.OutputVariables,
@@ -19,6 +19,7 @@ import (
 func TestNodeFieldsRoundTrip(t *testing.T) {
 	outputVars := &collections.SyncMap{}
 	outputVars.Store("KEY", "KEY=value")
+	onErrorExitCode := 2
 
 	original := &exec.Node{
 		Step:            core.Step{Name: "test-step"},
@@ -32,6 +33,8 @@ func TestNodeFieldsRoundTrip(t *testing.T) {
 		DoneCount:       3,
 		Repeated:        true,
 		Error:           "test error",
+		CancelReason:    core.CancelReasonUpstreamFailed,
+		OnErrorExitCode: &onErrorExitCode,
 		SubRuns:         []exec.SubDAGRun{{DAGRunID: "sub-1", Params: "p1"}},
 		SubRunsRepeated: []exec.SubDAGRun{{DAGRunID: "sub-2", Params: "p2"}},
 		OutputVariables: outputVars,
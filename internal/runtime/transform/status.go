@@ -138,6 +138,33 @@ func WithOnWaitNode(node *runtime.Node) StatusOption {
 	}
 }
 
+// WithOnRetryNode returns a StatusOption that sets the retry handler node
+func WithOnRetryNode(node *runtime.Node) StatusOption {
+	return func(s *exec.DAGRunStatus) {
+		s.OnRetry = convertNodeIfPresent(node)
+	}
+}
+
+// WithHandlerChainNodes returns a StatusOption that records the nodes of the
+// handler steps that follow the first one. The first node is set by the
+// matching WithOn*Node option.
+func WithHandlerChainNodes(handler core.HandlerType, nodes []*runtime.Node) StatusOption {
+	return func(s *exec.DAGRunStatus) {
+		if len(nodes) < 2 {
+			delete(s.HandlerChains, handler)
+			return
+		}
+		if s.HandlerChains == nil {
+			s.HandlerChains = make(map[core.HandlerType][]*exec.Node)
+		}
+		chain := make([]*exec.Node, 0, len(nodes)-1)
+		for _, node := range nodes[1:] {
+			chain = append(chain, convertNodeIfPresent(node))
+		}
+		s.HandlerChains[handler] = chain
+	}
+}
+
 // WithLogFilePath returns a StatusOption that sets the log file path
 func WithLogFilePath(logFilePath string) StatusOption {
 	return func(s *exec.DAGRunStatus) {
@@ -166,6 +193,21 @@ func WithPreconditions(conditions []*core.Condition) StatusOption {
 	}
 }
 
+// WithFailedPrecondition returns a StatusOption that sets the DAG-level
+// precondition that was not met
+func WithFailedPrecondition(condition *core.Condition) StatusOption {
+	return func(s *exec.DAGRunStatus) {
+		s.FailedPrecondition = condition
+	}
+}
+
+// WithCancelReason returns a StatusOption that sets why the run was canceled
+func WithCancelReason(reason core.CancelReason) StatusOption {
+	return func(s *exec.DAGRunStatus) {
+		s.CancelReason = reason
+	}
+}
+
 // WithWorkerID returns a StatusOption that sets the worker ID
 func WithWorkerID(workerID string) StatusOption {
 	return func(s *exec.DAGRunStatus) {
@@ -54,10 +54,10 @@ func TestStatusBuilder(t *testing.T) {
 	dag := &core.DAG{
 		Name: "test-dag",
 		HandlerOn: core.HandlerOn{
-			Exit:    &core.Step{Name: "exit-handler"},
-			Success: &core.Step{Name: "success-handler"},
-			Failure: &core.Step{Name: "failure-handler"},
-			Abort:   &core.Step{Name: "abort-handler"},
+			Exit:    core.HandlerSteps{{Name: "exit-handler"}},
+			Success: core.HandlerSteps{{Name: "success-handler"}},
+			Failure: core.HandlerSteps{{Name: "failure-handler"}},
+			Abort:   core.HandlerSteps{{Name: "abort-handler"}},
 		},
 		Steps: []core.Step{
 			{Name: "step1", Commands: []core.CommandEntry{{Command: "echo", Args: []string{"hello"}}}},
@@ -239,7 +239,7 @@ func TestStatusBuilderPopulatesPendingStepRetriesFromHandlerNodes(t *testing.T)
 	dag := &core.DAG{
 		Name: "retrying-dag",
 		HandlerOn: core.HandlerOn{
-			Failure: &core.Step{Name: "onFailure"},
+			Failure: core.HandlerSteps{{Name: "onFailure"}},
 		},
 	}
 
@@ -274,10 +274,10 @@ func TestInitialStatus(t *testing.T) {
 	dag := &core.DAG{
 		Name: "initial-test",
 		HandlerOn: core.HandlerOn{
-			Exit:    &core.Step{Name: "exit"},
-			Success: &core.Step{Name: "success"},
-			Failure: &core.Step{Name: "failure"},
-			Abort:   &core.Step{Name: "abort"},
+			Exit:    core.HandlerSteps{{Name: "exit"}},
+			Success: core.HandlerSteps{{Name: "success"}},
+			Failure: core.HandlerSteps{{Name: "failure"}},
+			Abort:   core.HandlerSteps{{Name: "abort"}},
 		},
 		Steps: []core.Step{
 			{Name: "step1"},
@@ -5,8 +5,14 @@ package runtime
 
 import (
 	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
 	"io"
+	"os"
 	"sync"
+
+	"github.com/dagucloud/dagu/internal/cmn/fileutil"
 )
 
 // flushableMultiWriter creates a MultiWriter that can flush all underlying writers
@@ -80,3 +86,114 @@ func (s *safeBufferedWriter) Flush() error {
 	defer s.mu.Unlock()
 	return s.bw.Flush()
 }
+
+// rotatingFile is an append-only log file that never grows past maxSize.
+// Writes that do not fit are split, preferably after the last newline that
+// fits, and the rest goes to a fresh file. Rotated files are kept next to it
+// as path.1 (newest) through path.<keep>; older ones are removed.
+type rotatingFile struct {
+	mu      sync.Mutex
+	path    string
+	maxSize int64
+	keep    int
+	file    *os.File
+	size    int64
+}
+
+// newRotatingFile opens or creates the log file at path. Existing content,
+// such as the log of an earlier attempt, counts toward the size limit.
+func newRotatingFile(path string, maxSize int64, keep int) (*rotatingFile, error) {
+	file, err := fileutil.OpenOrCreateFile(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("failed to stat log file %s: %w", path, err)
+	}
+	return &rotatingFile{
+		path:    path,
+		maxSize: maxSize,
+		keep:    keep,
+		file:    file,
+		size:    info.Size(),
+	}, nil
+}
+
+func (r *rotatingFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var written int
+	for {
+		room := r.maxSize - r.size
+		if int64(len(p)) <= room {
+			n, err := r.file.Write(p)
+			r.size += int64(n)
+			return written + n, err
+		}
+
+		var chunk []byte
+		if room > 0 {
+			chunk = p[:room]
+			if i := bytes.LastIndexByte(chunk, '\n'); i >= 0 {
+				chunk = chunk[:i+1]
+			} else if r.size > 0 {
+				// Start the line in a fresh file instead of splitting it.
+				chunk = nil
+			}
+		}
+		if len(chunk) > 0 {
+			n, err := r.file.Write(chunk)
+			r.size += int64(n)
+			written += n
+			if err != nil {
+				return written, err
+			}
+			p = p[n:]
+		}
+		if err := r.rotate(); err != nil {
+			return written, err
+		}
+	}
+}
+
+// rotate shifts the rotated files by one, moves the current file to path.1
+// and starts a new, empty file.
+func (r *rotatingFile) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return fmt.Errorf("failed to close log file %s: %w", r.path, err)
+	}
+	if err := os.Remove(fmt.Sprintf("%s.%d", r.path, r.keep)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove rotated log file: %w", err)
+	}
+	for i := r.keep - 1; i >= 1; i-- {
+		from, to := fmt.Sprintf("%s.%d", r.path, i), fmt.Sprintf("%s.%d", r.path, i+1)
+		if err := os.Rename(from, to); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to rotate log file %s: %w", from, err)
+		}
+	}
+	if err := os.Rename(r.path, r.path+".1"); err != nil {
+		return fmt.Errorf("failed to rotate log file %s: %w", r.path, err)
+	}
+	file, err := fileutil.OpenOrCreateFile(r.path)
+	if err != nil {
+		return err
+	}
+	r.file = file
+	r.size = 0
+	return nil
+}
+
+func (r *rotatingFile) Sync() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Sync()
+}
+
+func (r *rotatingFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Close()
+}
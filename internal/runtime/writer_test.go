@@ -8,6 +8,9 @@ import (
 	"bytes"
 	"errors"
 	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -285,3 +288,74 @@ func (s *syncableWriter) Sync() error {
 	s.synced = true
 	return s.err
 }
+
+func TestRotatingFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "step.out")
+	f, err := newRotatingFile(path, 10, 2)
+	require.NoError(t, err)
+
+	for _, line := range []string{"aaaaaaaa\n", "bbbbbbbb\n", "cccccccc\n", "dddddddd\n"} {
+		n, err := f.Write([]byte(line))
+		require.NoError(t, err)
+		assert.Equal(t, len(line), n)
+	}
+	require.NoError(t, f.Sync())
+	require.NoError(t, f.Close())
+
+	read := func(p string) string {
+		data, err := os.ReadFile(p)
+		require.NoError(t, err)
+		return string(data)
+	}
+	assert.Equal(t, "dddddddd\n", read(path))
+	assert.Equal(t, "cccccccc\n", read(path+".1"))
+	assert.Equal(t, "bbbbbbbb\n", read(path+".2"))
+	// Only keep rotations are retained; the oldest one was removed.
+	assert.NoFileExists(t, path+".3")
+}
+
+func TestRotatingFile_ExistingContent(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "step.out")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Repeat("x", 8)), 0600))
+
+	f, err := newRotatingFile(path, 10, 1)
+	require.NoError(t, err)
+	_, err = f.Write([]byte("yyyy"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "yyyy", string(data))
+	assert.FileExists(t, path+".1")
+}
+
+func TestRotatingFile_SplitsLargeWrite(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "step.out")
+	f, err := newRotatingFile(path, 10, 3)
+	require.NoError(t, err)
+
+	input := "aaaa\nbbbb\ncccc\n" + strings.Repeat("d", 12)
+	n, err := f.Write([]byte(input))
+	require.NoError(t, err)
+	assert.Equal(t, len(input), n)
+	require.NoError(t, f.Close())
+
+	read := func(p string) string {
+		data, err := os.ReadFile(p)
+		require.NoError(t, err)
+		return string(data)
+	}
+	// Lines are kept whole where possible; a line longer than the limit is
+	// split at the limit.
+	assert.Equal(t, "aaaa\nbbbb\n", read(path+".3"))
+	assert.Equal(t, "cccc\n", read(path+".2"))
+	assert.Equal(t, "dddddddddd", read(path+".1"))
+	assert.Equal(t, "dd", read(path))
+}
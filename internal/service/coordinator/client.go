@@ -124,7 +124,8 @@ const grpcMaxMsgSize = 16 * 1024 * 1024
 
 // Errors
 var (
-	ErrMissingTLSConfig = fmt.Errorf("TLS enabled but no certificates provided")
+	ErrMissingTLSConfig     = fmt.Errorf("TLS enabled but no certificates provided")
+	ErrIncompleteClientCert = fmt.Errorf("client certificate and key must be provided together")
 )
 
 // New creates a new coordinator client with the given configuration
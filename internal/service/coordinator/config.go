@@ -39,6 +39,9 @@ func (c *Config) Validate() error {
 	if !c.Insecure && c.CertFile == "" && c.KeyFile == "" && c.CAFile == "" {
 		return ErrMissingTLSConfig
 	}
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return ErrIncompleteClientCert
+	}
 	if c.DialTimeout <= 0 {
 		c.DialTimeout = 10 * time.Second
 	}
@@ -75,6 +75,20 @@ func TestConfigValidate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "TLS enabled but no certificates provided",
 		},
+		{
+			name: "ClientCertWithoutKey",
+			config: &coordinator.Config{
+				Insecure:       false,
+				CertFile:       "/path/to/cert.pem",
+				CAFile:         "/path/to/ca.pem",
+				DialTimeout:    10 * time.Second,
+				RequestTimeout: 5 * time.Minute,
+				MaxRetries:     3,
+				RetryInterval:  time.Second,
+			},
+			wantErr: true,
+			errMsg:  "client certificate and key must be provided together",
+		},
 		{
 			name: "ZeroDialTimeout",
 			config: &coordinator.Config{
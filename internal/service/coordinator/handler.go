@@ -36,6 +36,7 @@ type workerInfo struct {
 	pollerID    string
 	taskChan    chan *coordinatorv1.Task
 	labels      map[string]string
+	tags        []string
 	connectedAt time.Time
 }
 
@@ -66,6 +67,7 @@ const (
 var (
 	errNoAvailableWorkers        = errors.New("no available workers")
 	errNoMatchingWorkers         = errors.New("no workers match the required selector")
+	errNoCapableWorkers          = errors.New("no workers satisfy the required capabilities")
 	errRunHeartbeatRepairSkipped = errors.New("run heartbeat repair skipped")
 )
 
@@ -96,6 +98,7 @@ type Handler struct {
 	workerHeartbeatStore      exec.WorkerHeartbeatStore      // Shared worker presence
 	dagRunLeaseStore          exec.DAGRunLeaseStore          // Shared distributed run leases
 	activeDistributedRunStore exec.ActiveDistributedRunStore // Shared active distributed attempt index
+	queueStore                exec.QueueStore                // Requeues runs of evicted workers
 
 	// Open attempts cache for status persistence
 	attemptsMu   sync.RWMutex
@@ -112,6 +115,9 @@ type Handler struct {
 	// Stale lease threshold - configurable
 	staleLeaseThreshold time.Duration
 
+	// Freshness lifetime of cached remote params schemas
+	paramsSchemaCacheTTL *time.Duration
+
 	// Zombie detector shutdown synchronization
 	zombieDetectorMu      sync.Mutex
 	zombieDetectorStarted bool
@@ -151,6 +157,10 @@ type HandlerConfig struct {
 	// active distributed attempt index used by zombie detection.
 	ActiveDistributedRunStore exec.ActiveDistributedRunStore
 
+	// QueueStore enables requeueing in-flight runs of evicted workers. When nil,
+	// runs orphaned by an unresponsive worker are only marked as failed.
+	QueueStore exec.QueueStore
+
 	// StaleHeartbeatThreshold is the duration after which a worker's heartbeat
 	// is considered stale. Defaults to 30 seconds if not set.
 	StaleHeartbeatThreshold time.Duration
@@ -159,6 +169,10 @@ type HandlerConfig struct {
 	// lease is considered stale (worker stopped pushing status). Defaults to 90 seconds.
 	StaleLeaseThreshold time.Duration
 
+	// ParamsSchemaCacheTTL is how long remote params schemas are cached when
+	// loading task definitions. Nil uses the spec package default.
+	ParamsSchemaCacheTTL *time.Duration
+
 	// EventService persists coordinator-originated event envelopes.
 	EventService *eventstore.Service
 
@@ -192,8 +206,10 @@ func NewHandler(cfg HandlerConfig) *Handler {
 		workerHeartbeatStore:      cfg.WorkerHeartbeatStore,
 		dagRunLeaseStore:          cfg.DAGRunLeaseStore,
 		activeDistributedRunStore: cfg.ActiveDistributedRunStore,
+		queueStore:                cfg.QueueStore,
 		staleHeartbeatThreshold:   cfg.StaleHeartbeatThreshold,
 		staleLeaseThreshold:       cfg.StaleLeaseThreshold,
+		paramsSchemaCacheTTL:      cfg.ParamsSchemaCacheTTL,
 		eventService:              cfg.EventService,
 		eventSourceInstance:       cfg.EventSourceInstance,
 	}
@@ -252,6 +268,7 @@ func (h *Handler) Poll(ctx context.Context, req *coordinatorv1.PollRequest) (*co
 			pollerID:    req.PollerId,
 			taskChan:    taskChan,
 			labels:      req.Labels,
+			tags:        req.Tags,
 			connectedAt: time.Now(),
 		}
 		h.mu.Unlock()
@@ -281,6 +298,7 @@ func (h *Handler) Poll(ctx context.Context, req *coordinatorv1.PollRequest) (*co
 			WorkerID:     req.WorkerId,
 			PollerID:     req.PollerId,
 			Labels:       req.Labels,
+			Tags:         req.Tags,
 			Owner:        h.owner,
 			ClaimTimeout: h.staleLeaseThreshold,
 		})
@@ -320,7 +338,7 @@ func (h *Handler) Dispatch(ctx context.Context, req *coordinatorv1.DispatchReque
 	)
 
 	if h.dispatchTaskStore == nil {
-		if err := h.ensureWaitingWorkerAvailability(req.Task.WorkerSelector); err != nil {
+		if err := h.ensureWaitingWorkerAvailability(req.Task); err != nil {
 			return nil, status.Error(dispatchErrorCode(err), err.Error())
 		}
 
@@ -355,6 +373,10 @@ func (h *Handler) Dispatch(ctx context.Context, req *coordinatorv1.DispatchReque
 	if len(req.Task.WorkerSelector) > 0 && !anyWorkerMatches(healthyWorkers, req.Task.WorkerSelector) {
 		return nil, status.Error(codes.FailedPrecondition, errNoMatchingWorkers.Error())
 	}
+	if !anyWorkerCapable(healthyWorkers, req.Task) {
+		err := noCapableWorkersError(req.Task.Requires)
+		return nil, status.Error(dispatchErrorCode(err), err.Error())
+	}
 
 	prepared, err := h.prepareAttemptForDispatch(ctx, req.Task)
 	if err != nil {
@@ -389,15 +411,24 @@ func staleQueueDispatchError(reason string) error {
 // createAttemptForTask creates a DAGRun attempt for a root-level task.
 // This is called when the coordinator receives a dispatch for a root-level DAG run
 // (not a sub-DAG), so it has a place to store status updates from the worker.
+// taskLoadOptions returns the options for loading the DAG definition of task.
+func (h *Handler) taskLoadOptions(task *coordinatorv1.Task) []spec.LoadOption {
+	loadOpts := []spec.LoadOption{spec.WithName(task.Target)}
+	if task.BaseConfig != "" {
+		loadOpts = append(loadOpts, spec.WithBaseConfigContent([]byte(task.BaseConfig)))
+	}
+	if h.paramsSchemaCacheTTL != nil {
+		loadOpts = append(loadOpts, spec.WithRemoteSchemaCacheTTL(*h.paramsSchemaCacheTTL))
+	}
+	return loadOpts
+}
+
 func (h *Handler) createAttemptForTask(ctx context.Context, task *coordinatorv1.Task) (*preparedDispatchAttempt, error) {
 	if h.dagRunStore == nil {
 		return nil, nil
 	}
 
-	loadOpts := []spec.LoadOption{spec.WithName(task.Target)}
-	if task.BaseConfig != "" {
-		loadOpts = append(loadOpts, spec.WithBaseConfigContent([]byte(task.BaseConfig)))
-	}
+	loadOpts := h.taskLoadOptions(task)
 	dag, err := spec.LoadYAML(ctx, []byte(task.Definition), loadOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse DAG definition: %w", err)
@@ -546,10 +577,7 @@ func (h *Handler) createSubAttemptForTask(ctx context.Context, task *coordinator
 		return nil, fmt.Errorf("failed to create sub-attempt: %w", err)
 	}
 
-	loadOpts := []spec.LoadOption{spec.WithName(task.Target)}
-	if task.BaseConfig != "" {
-		loadOpts = append(loadOpts, spec.WithBaseConfigContent([]byte(task.BaseConfig)))
-	}
+	loadOpts := h.taskLoadOptions(task)
 	dag, err := spec.LoadYAML(ctx, []byte(task.Definition), loadOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse DAG definition: %w", err)
@@ -652,34 +680,48 @@ func (h *Handler) prepareAttemptForDispatch(ctx context.Context, task *coordinat
 	return nil, nil
 }
 
-func (h *Handler) ensureWaitingWorkerAvailability(selector map[string]string) error {
+func (h *Handler) ensureWaitingWorkerAvailability(task *coordinatorv1.Task) error {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
-	matched := false
 	for _, worker := range h.waitingPollers {
-		if !matchesSelector(worker.labels, selector) {
-			continue
+		if workerAcceptsTask(worker, task) {
+			return nil
 		}
-		matched = true
-		break
 	}
-	if matched {
-		return nil
+	return h.unmatchedTaskErrorLocked(task, len(h.waitingPollers) > 0)
+}
+
+// unmatchedTaskErrorLocked explains why no waiting poller accepted task.
+// Workers that match the selector but lack the required capabilities yield a
+// transient error so that the run stays queued until a capable worker polls.
+// Callers must hold h.mu.
+func (h *Handler) unmatchedTaskErrorLocked(task *coordinatorv1.Task, hasPollers bool) error {
+	if len(task.Requires) > 0 {
+		for _, worker := range h.waitingPollers {
+			if matchesSelector(worker.labels, task.WorkerSelector) && core.MatchesWorkerTags(worker.tags, task.Tags) {
+				return noCapableWorkersError(task.Requires)
+			}
+		}
 	}
-	if len(selector) > 0 {
+	if len(task.WorkerSelector) > 0 || hasPollers {
 		return errNoMatchingWorkers
 	}
 	return errNoAvailableWorkers
 }
 
+func noCapableWorkersError(requires []string) error {
+	return fmt.Errorf("%w %v; the run remains queued until a capable worker is available", errNoCapableWorkers, requires)
+}
+
 func (h *Handler) dispatchToWaitingPoller(task *coordinatorv1.Task) error {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
+	hasPollers := len(h.waitingPollers) > 0
 	matched := false
 	for pollerID, worker := range h.waitingPollers {
-		if !matchesSelector(worker.labels, task.WorkerSelector) {
+		if !workerAcceptsTask(worker, task) {
 			continue
 		}
 		matched = true
@@ -691,8 +733,8 @@ func (h *Handler) dispatchToWaitingPoller(task *coordinatorv1.Task) error {
 			delete(h.waitingPollers, pollerID)
 		}
 	}
-	if len(task.WorkerSelector) > 0 && !matched {
-		return errNoMatchingWorkers
+	if !matched {
+		return h.unmatchedTaskErrorLocked(task, hasPollers)
 	}
 	return errNoAvailableWorkers
 }
@@ -702,6 +744,8 @@ func dispatchErrorCode(err error) codes.Code {
 	switch {
 	case errors.Is(err, errNoMatchingWorkers):
 		return codes.FailedPrecondition
+	case errors.Is(err, errNoCapableWorkers):
+		return codes.Unavailable
 	case errors.As(err, &staleErr):
 		return codes.FailedPrecondition
 	default:
@@ -870,6 +914,7 @@ func (h *Handler) Heartbeat(ctx context.Context, req *coordinatorv1.HeartbeatReq
 		if err := h.workerHeartbeatStore.Upsert(ctx, exec.WorkerHeartbeatRecord{
 			WorkerID:        req.WorkerId,
 			Labels:          req.Labels,
+			Tags:            req.Tags,
 			Stats:           req.Stats,
 			LastHeartbeatAt: receivedAt.UnixMilli(),
 		}); err != nil {
@@ -1213,6 +1258,32 @@ func anyWorkerMatches(workers []exec.WorkerHeartbeatRecord, selector map[string]
 	return false
 }
 
+// anyWorkerCapable reports whether a worker that matches the task's selector
+// and accepts its DAG tags advertises capabilities that satisfy its
+// requirements.
+func anyWorkerCapable(workers []exec.WorkerHeartbeatRecord, task *coordinatorv1.Task) bool {
+	if len(task.Requires) == 0 {
+		return true
+	}
+	for _, worker := range workers {
+		if matchesSelector(worker.Labels, task.WorkerSelector) &&
+			core.MatchesWorkerTags(worker.Tags, task.Tags) &&
+			core.CapabilitiesSatisfy(worker.Labels, task.Requires) {
+			return true
+		}
+	}
+	return false
+}
+
+// workerAcceptsTask reports whether a waiting poller matches the task's worker
+// selector, satisfies its capability requirements and, when the worker is
+// restricted to tags, the DAG's tags.
+func workerAcceptsTask(worker *workerInfo, task *coordinatorv1.Task) bool {
+	return matchesSelector(worker.labels, task.WorkerSelector) &&
+		core.MatchesWorkerTags(worker.tags, task.Tags) &&
+		core.CapabilitiesSatisfy(worker.labels, task.Requires)
+}
+
 func matchesSelector(workerLabels, selector map[string]string) bool {
 	if len(selector) == 0 {
 		return true
@@ -1585,6 +1656,11 @@ func (h *Handler) transformLogPaths(status *exec.DAGRunStatus) {
 	transformNode(status.OnFailure, "on_failure")
 	transformNode(status.OnAbort, "on_abort")
 	transformNode(status.OnWait, "on_wait")
+	transformNode(status.OnRetry, "on_retry")
+	forEachHandlerChainNode(status, func(node *exec.Node, stepName string) {
+		node.Stdout = computePath(stepName, coordinatorv1.LogStreamType_LOG_STREAM_TYPE_STDOUT)
+		node.Stderr = computePath(stepName, coordinatorv1.LogStreamType_LOG_STREAM_TYPE_STDERR)
+	})
 
 	// Transform scheduler log path
 	status.Log = filepath.Join(
@@ -1696,6 +1772,36 @@ func (h *Handler) persistChatMessages(ctx context.Context, attempt exec.DAGRunAt
 	persistNode(status.OnFailure, "on_failure")
 	persistNode(status.OnAbort, "on_abort")
 	persistNode(status.OnWait, "on_wait")
+	persistNode(status.OnRetry, "on_retry")
+	forEachHandlerChainNode(status, func(node *exec.Node, stepName string) {
+		if len(node.ChatMessages) == 0 {
+			return
+		}
+		if err := attempt.WriteStepMessages(ctx, stepName, node.ChatMessages); err != nil {
+			logger.Warn(ctx, "Failed to persist chat messages",
+				tag.Step(stepName),
+				tag.Error(err),
+			)
+		}
+	})
+}
+
+// forEachHandlerChainNode calls fn for the nodes of handler steps that follow
+// the first one.
+func forEachHandlerChainNode(status *exec.DAGRunStatus, fn func(node *exec.Node, stepName string)) {
+	for handler, nodes := range status.HandlerChains {
+		for i, node := range nodes {
+			if node == nil {
+				continue
+			}
+			stepName := node.Step.Name
+			if stepName == "" {
+				// The chain starts with the second step of the handler.
+				stepName = handler.StepName(i + 1)
+			}
+			fn(node, stepName)
+		}
+	}
 }
 
 func (h *Handler) syncDistributedRunTrackingFromStatus(
@@ -2289,6 +2395,7 @@ func (h *Handler) reconcileDistributedLease(ctx context.Context, lease exec.DAGR
 			tag.RunID(lease.DAGRun.ID),
 			slog.String("reason", staleDistributedLeaseReason(workerID)),
 		)
+		h.requeueEvictedRun(ctx, lease.DAGRun, attemptID, workerID)
 		return
 	}
 	if reconciledStatus == nil {
@@ -2369,6 +2476,7 @@ func (h *Handler) detectOrphanedDistributedStatuses(ctx context.Context, now tim
 				"Failed to delete orphaned distributed lease after confirmed failure",
 				"Failed to delete orphaned active distributed run after confirmed failure",
 			)
+			h.requeueEvictedRun(ctx, status.DAGRun(), leaseState.attemptID, status.WorkerID)
 			continue
 		}
 		if reconciledStatus == nil {
@@ -2468,6 +2576,7 @@ func (h *Handler) detectIndexedDistributedStatuses(ctx context.Context, now time
 				"Failed to delete stale indexed distributed lease after confirmed failure",
 				"Failed to delete stale indexed active distributed run after confirmed failure",
 			)
+			h.requeueEvictedRun(ctx, record.DAGRun, record.AttemptID, workerID)
 			continue
 		}
 		if reconciledStatus == nil {
@@ -2673,14 +2782,16 @@ func (h *Handler) markStatusLeaseRunFailed(
 	if status == nil {
 		return
 	}
-	h.failDistributedAttemptIfCurrent(
+	if h.failDistributedAttemptIfCurrent(
 		ctx,
 		status.DAGRun(),
 		attemptID,
 		attemptKey,
 		reason,
 		status.Status,
-	)
+	) {
+		h.requeueEvictedRun(ctx, status.DAGRun(), attemptID, status.WorkerID)
+	}
 }
 
 func (h *Handler) resolveAttemptIDForStatus(ctx context.Context, status *exec.DAGRunStatus) (string, error) {
@@ -2714,14 +2825,14 @@ func (h *Handler) failDistributedAttemptIfCurrent(
 	attemptKey string,
 	reason string,
 	expectedStatuses ...core.Status,
-) {
+) bool {
 	storeCtx := context.WithoutCancel(ctx)
 	if attemptID == "" {
 		logger.Error(ctx, "Skipping distributed stale-run repair due to missing attempt ID",
 			tag.DAG(dagRun.Name),
 			tag.RunID(dagRun.ID),
 		)
-		return
+		return false
 	}
 
 	mutate := func(status *exec.DAGRunStatus) error {
@@ -2766,7 +2877,7 @@ func (h *Handler) failDistributedAttemptIfCurrent(
 				slog.String("expected_status", expectedStatus.String()),
 				tag.Error(err),
 			)
-			return
+			return false
 		}
 		if swapped || status == nil || status.AttemptID != attemptID || status.Status == expectedStatus {
 			break
@@ -2778,17 +2889,17 @@ func (h *Handler) failDistributedAttemptIfCurrent(
 			"Failed to delete orphaned distributed lease",
 			"Failed to delete orphaned active distributed run",
 		)
-		return
+		return false
 	}
 	if status.AttemptID != attemptID || !status.Status.IsActive() && status.Status != core.NotStarted {
 		h.deleteDistributedTracking(ctx, storeCtx, dagRun, attemptKey,
 			"Failed to delete superseded distributed lease",
 			"Failed to delete superseded active distributed run",
 		)
-		return
+		return false
 	}
 	if !swapped {
-		return
+		return false
 	}
 
 	h.deleteDistributedTracking(ctx, storeCtx, dagRun, attemptKey,
@@ -2801,6 +2912,7 @@ func (h *Handler) failDistributedAttemptIfCurrent(
 		tag.RunID(dagRun.ID),
 		slog.String("reason", reason),
 	)
+	return true
 }
 
 func (h *Handler) deleteDistributedLease(
@@ -2856,10 +2968,10 @@ func (h *Handler) deleteDistributedTracking(
 
 // markRunFailed is kept for compatibility with older tests and non-lease based
 // cleanup paths. It marks the latest active attempt failed without requiring a
-// lease record.
-func (h *Handler) markRunFailed(ctx context.Context, dagName, dagRunID, reason string) {
+// lease record and reports whether the status was changed.
+func (h *Handler) markRunFailed(ctx context.Context, dagName, dagRunID, reason string) bool {
 	if h.dagRunStore == nil {
-		return
+		return false
 	}
 	storeCtx := context.WithoutCancel(ctx)
 
@@ -2883,7 +2995,7 @@ func (h *Handler) markRunFailed(ctx context.Context, dagName, dagRunID, reason s
 		if err != nil {
 			logger.Error(ctx, "Failed to find attempt for zombie cleanup",
 				tag.DAG(dagName), tag.RunID(dagRunID), tag.Error(err))
-			return
+			return false
 		}
 		attempt = foundAttempt
 		needsOpen = true
@@ -2893,11 +3005,11 @@ func (h *Handler) markRunFailed(ctx context.Context, dagName, dagRunID, reason s
 	if err != nil {
 		logger.Error(ctx, "Failed to read status for zombie cleanup",
 			tag.DAG(dagName), tag.RunID(dagRunID), tag.Error(err))
-		return
+		return false
 	}
 
 	if !dagRunStatus.Status.IsActive() && dagRunStatus.Status != core.NotStarted {
-		return
+		return false
 	}
 
 	finishedAt := stringutil.FormatTime(time.Now())
@@ -2917,7 +3029,7 @@ func (h *Handler) markRunFailed(ctx context.Context, dagName, dagRunID, reason s
 		if err := attempt.Open(storeCtx); err != nil {
 			logger.Error(ctx, "Failed to open attempt for zombie cleanup",
 				tag.DAG(dagName), tag.RunID(dagRunID), tag.Error(err))
-			return
+			return false
 		}
 		defer func() {
 			if err := attempt.Close(storeCtx); err != nil {
@@ -2931,11 +3043,12 @@ func (h *Handler) markRunFailed(ctx context.Context, dagName, dagRunID, reason s
 	if err := attempt.Write(storeCtx, *dagRunStatus); err != nil {
 		logger.Error(ctx, "Failed to write failed status for zombie cleanup",
 			tag.DAG(dagName), tag.RunID(dagRunID), tag.Error(err))
-		return
+		return false
 	}
 
 	logger.Warn(ctx, "Marked zombie run as FAILED",
 		tag.DAG(dagName), tag.RunID(dagRunID), slog.String("reason", reason))
+	return true
 }
 
 // markWorkerTasksFailed is kept for compatibility with tests that exercise the
@@ -2945,11 +3058,80 @@ func (h *Handler) markWorkerTasksFailed(ctx context.Context, info *heartbeatInfo
 		return
 	}
 	for _, task := range info.stats.RunningTasks {
-		if task == nil {
+		if task == nil || h.taskReassigned(ctx, task) {
+			continue
+		}
+		if !h.markRunFailed(ctx, task.DagName, task.DagRunId, fmt.Sprintf("worker %s became unresponsive", info.workerID)) {
+			continue
+		}
+		if task.RootDagRunId != "" && task.RootDagRunId != task.DagRunId {
 			continue
 		}
-		h.markRunFailed(ctx, task.DagName, task.DagRunId, fmt.Sprintf("worker %s became unresponsive", info.workerID))
+		h.requeueEvictedRun(ctx, exec.DAGRunRef{Name: task.DagName, ID: task.DagRunId}, "", info.workerID)
+	}
+}
+
+// taskReassigned reports whether a task last reported by a worker no longer
+// owns its DAG run, either because the run was requeued or because a newer
+// attempt has started elsewhere. Such tasks must not be failed again when the
+// reporting worker is evicted.
+func (h *Handler) taskReassigned(ctx context.Context, task *coordinatorv1.RunningTask) bool {
+	_, runStatus, err := h.resolveLatestAttemptForRunningTask(ctx, task)
+	if err != nil || runStatus == nil {
+		return false
+	}
+	if runStatus.Status == core.Queued {
+		return true
+	}
+	return task.AttemptKey != "" && runStatus.AttemptKey != "" && runStatus.AttemptKey != task.AttemptKey
+}
+
+// requeueEvictedRun enqueues a retry for a root DAG run that was failed
+// because its worker was evicted. Only the failed attempt identified by
+// attemptID (or the latest attempt when empty) is requeued, so repeated
+// detection passes or a late report from a slow worker never queue the run
+// twice.
+func (h *Handler) requeueEvictedRun(ctx context.Context, dagRun exec.DAGRunRef, attemptID, workerID string) {
+	if h.queueStore == nil || h.dagRunStore == nil {
+		return
+	}
+	storeCtx := context.WithoutCancel(ctx)
+
+	attempt, err := h.dagRunStore.FindAttempt(storeCtx, dagRun)
+	if errors.Is(err, exec.ErrDAGRunIDNotFound) {
+		// Sub-DAG runs are not addressable as root runs and are retried by
+		// their parent instead.
+		return
 	}
+	if err != nil {
+		logger.Warn(ctx, "Failed to find evicted run for requeue",
+			tag.DAG(dagRun.Name), tag.RunID(dagRun.ID), tag.Error(err))
+		return
+	}
+	runStatus, err := attempt.ReadStatus(storeCtx)
+	if err != nil {
+		logger.Warn(ctx, "Failed to read evicted run status for requeue",
+			tag.DAG(dagRun.Name), tag.RunID(dagRun.ID), tag.Error(err))
+		return
+	}
+	if runStatus.Status != core.Failed || !runStatus.Parent.Zero() {
+		return
+	}
+	if attemptID != "" && runStatus.AttemptID != attemptID {
+		return
+	}
+
+	err = exec.EnqueueRetry(h.eventContext(storeCtx), h.dagRunStore, h.queueStore, nil, runStatus, exec.EnqueueRetryOptions{})
+	if err != nil {
+		if !errors.Is(err, exec.ErrRetryStaleLatest) {
+			logger.Error(ctx, "Failed to requeue run of evicted worker",
+				tag.DAG(dagRun.Name), tag.RunID(dagRun.ID), tag.WorkerID(workerID), tag.Error(err))
+		}
+		return
+	}
+
+	logger.Warn(ctx, "Evicted unresponsive worker and requeued its run for retry",
+		tag.DAG(dagRun.Name), tag.RunID(dagRun.ID), tag.WorkerID(workerID))
 }
 
 // RequestCancel handles requests to cancel a DAG run.
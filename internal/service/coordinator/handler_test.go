@@ -18,6 +18,7 @@ import (
 	"github.com/dagucloud/dagu/internal/core/exec"
 	"github.com/dagucloud/dagu/internal/persis/filedagrun"
 	"github.com/dagucloud/dagu/internal/persis/filedistributed"
+	"github.com/dagucloud/dagu/internal/persis/filequeue"
 	"github.com/dagucloud/dagu/internal/proto/convert"
 	coordinatorv1 "github.com/dagucloud/dagu/proto/coordinator/v1"
 	"github.com/stretchr/testify/assert"
@@ -577,6 +578,134 @@ func TestHandler_Poll(t *testing.T) {
 		require.Contains(t, st.Message(), "no available workers")
 	})
 
+	t.Run("PollWithTagsOnlyReceivesTaggedDAGs", func(t *testing.T) {
+		t.Parallel()
+
+		h := NewHandler(HandlerConfig{})
+		ctx := context.Background()
+
+		pollDone := make(chan *coordinatorv1.PollResponse)
+		pollErr := make(chan error)
+		go func() {
+			resp, err := h.Poll(ctx, &coordinatorv1.PollRequest{
+				WorkerId: "gpu-worker",
+				PollerId: "poller1",
+				Tags:     []string{"gpu"},
+			})
+			if err != nil {
+				pollErr <- err
+			} else {
+				pollDone <- resp
+			}
+		}()
+
+		require.Eventually(t, func() bool {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			return len(h.waitingPollers) == 1
+		}, time.Second, 10*time.Millisecond)
+
+		// A DAG without the gpu tag is not handed to the gpu worker.
+		_, err := h.Dispatch(ctx, &coordinatorv1.DispatchRequest{
+			Task: &coordinatorv1.Task{
+				DagRunId:   "run-cpu",
+				Target:     "cpu-dag",
+				Definition: "name: cpu-dag\nsteps:\n  - name: step1\n    command: echo hello",
+				Tags:       []string{"batch"},
+			},
+		})
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, codes.FailedPrecondition, st.Code())
+		require.Contains(t, st.Message(), "no workers match")
+
+		// A DAG with the gpu tag is accepted.
+		_, err = h.Dispatch(ctx, &coordinatorv1.DispatchRequest{
+			Task: &coordinatorv1.Task{
+				DagRunId:   "run-gpu",
+				Target:     "gpu-dag",
+				Definition: "name: gpu-dag\nsteps:\n  - name: step1\n    command: echo hello",
+				Tags:       []string{"gpu", "batch"},
+			},
+		})
+		require.NoError(t, err)
+
+		select {
+		case resp := <-pollDone:
+			require.NotNil(t, resp.Task)
+			require.Equal(t, "run-gpu", resp.Task.DagRunId)
+		case err := <-pollErr:
+			t.Fatalf("Poll failed: %v", err)
+		case <-time.After(1 * time.Second):
+			t.Fatal("Poll timed out")
+		}
+	})
+
+	t.Run("DispatchRequiresCapableWorker", func(t *testing.T) {
+		t.Parallel()
+
+		h := NewHandler(HandlerConfig{})
+		ctx := context.Background()
+
+		pollDone := make(chan *coordinatorv1.PollResponse)
+		pollErr := make(chan error)
+		go func() {
+			resp, err := h.Poll(ctx, &coordinatorv1.PollRequest{
+				WorkerId: "gpu-worker",
+				PollerId: "poller1",
+				Labels:   map[string]string{"gpu": "true", "cuda": "11.8"},
+			})
+			if err != nil {
+				pollErr <- err
+			} else {
+				pollDone <- resp
+			}
+		}()
+
+		require.Eventually(t, func() bool {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			return len(h.waitingPollers) == 1
+		}, time.Second, 10*time.Millisecond)
+
+		// The worker's CUDA version is too old, so the run must stay queued.
+		_, err := h.Dispatch(ctx, &coordinatorv1.DispatchRequest{
+			Task: &coordinatorv1.Task{
+				DagRunId:   "run-cuda12",
+				Target:     "cuda12-dag",
+				Definition: "name: cuda12-dag\nsteps:\n  - name: step1\n    command: echo hello",
+				Requires:   []string{"gpu", "cuda>=12"},
+			},
+		})
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, codes.Unavailable, st.Code())
+		require.Contains(t, st.Message(), "no workers satisfy the required capabilities [gpu cuda>=12]")
+		require.Contains(t, st.Message(), "remains queued")
+
+		_, err = h.Dispatch(ctx, &coordinatorv1.DispatchRequest{
+			Task: &coordinatorv1.Task{
+				DagRunId:   "run-cuda11",
+				Target:     "cuda11-dag",
+				Definition: "name: cuda11-dag\nsteps:\n  - name: step1\n    command: echo hello",
+				Requires:   []string{"gpu", "cuda>=11"},
+			},
+		})
+		require.NoError(t, err)
+
+		select {
+		case resp := <-pollDone:
+			require.NotNil(t, resp.Task)
+			require.Equal(t, "run-cuda11", resp.Task.DagRunId)
+		case err := <-pollErr:
+			t.Fatalf("Poll failed: %v", err)
+		case <-time.After(1 * time.Second):
+			t.Fatal("Poll timed out")
+		}
+	})
+
 	t.Run("WriteInitialStatusPreservesScheduleTime", func(t *testing.T) {
 		t.Parallel()
 
@@ -1672,6 +1801,70 @@ func TestHandler_ZombieDetection(t *testing.T) {
 		require.False(t, exists)
 	})
 
+	t.Run("EvictedWorkerRunIsRequeuedExactlyOnce", func(t *testing.T) {
+		t.Parallel()
+
+		store := newMockDAGRunStore()
+		queueStore := filequeue.New(t.TempDir())
+		h := NewHandler(HandlerConfig{
+			DAGRunStore:             store,
+			QueueStore:              queueStore,
+			StaleHeartbeatThreshold: 50 * time.Millisecond,
+		})
+		ctx := context.Background()
+
+		ref := exec.DAGRunRef{Name: "test-dag", ID: "run-123"}
+		attempt := store.addAttempt(ref, &exec.DAGRunStatus{
+			Name:      "test-dag",
+			DAGRunID:  "run-123",
+			Status:    core.Running,
+			ProcGroup: "test-queue",
+			Nodes:     []*exec.Node{{Status: core.NodeRunning}},
+		})
+
+		// The fake worker heartbeats once while running the task, then goes silent.
+		heartbeat := func() {
+			_, err := h.Heartbeat(ctx, &coordinatorv1.HeartbeatRequest{
+				WorkerId: "fake-worker",
+				Stats: &coordinatorv1.WorkerStats{
+					RunningTasks: []*coordinatorv1.RunningTask{
+						{DagRunId: "run-123", DagName: "test-dag"},
+					},
+				},
+			})
+			require.NoError(t, err)
+		}
+		heartbeat()
+
+		// Within the grace period the worker is not evicted.
+		h.detectAndCleanupZombies(ctx)
+		st, err := attempt.ReadStatus(ctx)
+		require.NoError(t, err)
+		require.Equal(t, core.Running, st.Status)
+
+		time.Sleep(100 * time.Millisecond)
+		h.detectAndCleanupZombies(ctx)
+		h.detectAndCleanupZombies(ctx)
+
+		// A briefly slow worker resurfaces with the same task and goes silent again.
+		heartbeat()
+		time.Sleep(100 * time.Millisecond)
+		h.detectAndCleanupZombies(ctx)
+
+		st, err = attempt.ReadStatus(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, core.Queued, st.Status)
+		assert.Equal(t, core.TriggerTypeRetry, st.TriggerType)
+		assert.Contains(t, st.Error, "fake-worker")
+
+		items, err := queueStore.List(ctx, "test-queue")
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		queued, err := items[0].Data()
+		require.NoError(t, err)
+		assert.Equal(t, ref, *queued)
+	})
+
 	t.Run("StartZombieDetectorRunsPeriodically", func(t *testing.T) {
 		t.Parallel()
 
@@ -3268,6 +3461,39 @@ func TestMatchesSelector(t *testing.T) {
 	})
 }
 
+func TestAnyWorkerCapable(t *testing.T) {
+	t.Parallel()
+
+	gpuWorker := exec.WorkerHeartbeatRecord{
+		WorkerID: "gpu-worker",
+		Labels:   map[string]string{"gpu": "true"},
+		Tags:     []string{"ml"},
+	}
+	task := func(tags ...string) *coordinatorv1.Task {
+		return &coordinatorv1.Task{Requires: []string{"gpu"}, Tags: tags}
+	}
+
+	t.Run("TaggedWorkerAcceptsMatchingDAG", func(t *testing.T) {
+		t.Parallel()
+
+		require.True(t, anyWorkerCapable([]exec.WorkerHeartbeatRecord{gpuWorker}, task("ml")))
+	})
+
+	t.Run("TaggedWorkerRejectsOtherDAG", func(t *testing.T) {
+		t.Parallel()
+
+		require.False(t, anyWorkerCapable([]exec.WorkerHeartbeatRecord{gpuWorker}, task("etl")))
+	})
+
+	t.Run("UntaggedWorkerAcceptsAnyDAG", func(t *testing.T) {
+		t.Parallel()
+
+		untagged := gpuWorker
+		untagged.Tags = nil
+		require.True(t, anyWorkerCapable([]exec.WorkerHeartbeatRecord{untagged}, task("etl")))
+	})
+}
+
 func TestCalculateHealthStatus(t *testing.T) {
 	t.Parallel()
 
@@ -2608,6 +2608,8 @@ func (a *API) retryDAGRun(ctx context.Context, dagName, dagRunID, retryDagRunID,
 		// Create and dispatch retry task to coordinator
 		opts := []executor.TaskOption{
 			executor.WithWorkerSelector(dag.WorkerSelector),
+			executor.WithDAGTags(dag.Labels),
+			executor.WithRequires(dag.Requirements()),
 			executor.WithPreviousStatus(prevStatus),
 			executor.WithBaseConfig(executor.ResolveBaseConfig(dag.BaseConfigData, a.config.Paths.BaseConfig)),
 		}
@@ -3175,7 +3177,7 @@ func (a *API) enqueuePreparedDAGRun(
 	}
 
 	dagRun := exec.NewDAGRunRef(queuedDAG.Name, dagRunID)
-	if err := a.queueStore.Enqueue(ctx, queuedDAG.ProcGroup(), exec.QueuePriorityLow, dagRun); err != nil {
+	if err := a.queueStore.Enqueue(ctx, queuedDAG.ProcGroup(), exec.QueuePriorityForDAG(queuedDAG), dagRun); err != nil {
 		if closeErr != nil {
 			return errors.Join(
 				fmt.Errorf("failed to close queued dag-run attempt: %w", closeErr),
@@ -4391,6 +4393,7 @@ func (a *API) loadCurrentRescheduleDAG(ctx context.Context, sourceFile, nameOver
 		spec.WithBaseConfig(a.config.Paths.BaseConfig),
 		spec.WithWorkspaceBaseConfigDir(workspace.BaseConfigDir(a.config.Paths.DAGsDir)),
 		spec.WithDAGsDir(a.config.Paths.DAGsDir),
+		spec.WithRemoteSchemaCacheTTL(a.config.Core.ParamsSchemaCacheTTL),
 	}
 	if nameOverride != "" {
 		loadOpts = append(loadOpts, spec.WithName(nameOverride))
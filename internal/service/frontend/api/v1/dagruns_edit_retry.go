@@ -514,7 +514,7 @@ func (a *API) launchEditRetryDAGRun(ctx context.Context, plan *editRetryPlan) (q
 		if a.queueStore == nil {
 			return false, fmt.Errorf("queue store is not configured")
 		}
-		if err := a.queueStore.Enqueue(ctx, plan.editedDAG.ProcGroup(), exec.QueuePriorityLow, seedStatus.DAGRun()); err != nil {
+		if err := a.queueStore.Enqueue(ctx, plan.editedDAG.ProcGroup(), exec.QueuePriorityForDAG(plan.editedDAG), seedStatus.DAGRun()); err != nil {
 			return false, fmt.Errorf("failed to enqueue edit retry dag-run: %w", err)
 		}
 		return true, nil
@@ -785,6 +785,8 @@ func cleanEditRetryWorkDir(dir string) string {
 func (a *API) dispatchEditRetry(ctx context.Context, dag *core.DAG, status *exec.DAGRunStatus) error {
 	opts := []executor.TaskOption{
 		executor.WithWorkerSelector(dag.WorkerSelector),
+		executor.WithDAGTags(dag.Labels),
+		executor.WithRequires(dag.Requirements()),
 		executor.WithPreviousStatus(status),
 		executor.WithBaseConfig(executor.ResolveBaseConfig(dag.BaseConfigData, a.config.Paths.BaseConfig)),
 	}
@@ -236,6 +236,7 @@ func (a *API) GetDAGSpec(ctx context.Context, request api.GetDAGSpecRequestObjec
 		spec.WithAllowBuildErrors(),
 	)
 	var errs []string
+	var warnings *[]api.BuildWarning
 
 	var loadErrs core.ErrorList
 	if errors.As(err, &loadErrs) {
@@ -253,7 +254,7 @@ func (a *API) GetDAGSpec(ctx context.Context, request api.GetDAGSpecRequestObjec
 		}
 	} else {
 		errs = append(errs, extractBuildErrors(dag.BuildErrors)...)
-		errs = append(errs, dag.BuildWarnings...)
+		warnings = toBuildWarnings(dag.BuildWarnings)
 	}
 	if err := a.requireWorkspaceVisible(ctx, dagWorkspaceName(dag)); err != nil {
 		return nil, err
@@ -272,9 +273,10 @@ func (a *API) GetDAGSpec(ctx context.Context, request api.GetDAGSpecRequestObjec
 	}
 
 	return &api.GetDAGSpec200JSONResponse{
-		Dag:    details,
-		Spec:   yamlSpec,
-		Errors: errs,
+		Dag:      details,
+		Spec:     yamlSpec,
+		Errors:   errs,
+		Warnings: warnings,
 	}, nil
 }
 
@@ -471,6 +473,7 @@ func (a *API) getDAGDetailsData(ctx context.Context, fileName string) (api.GetDA
 		Suspended:    a.dagStore.IsSuspended(ctx, fileName),
 		LocalDags:    localDAGs,
 		Errors:       extractBuildErrors(dag.BuildErrors),
+		Warnings:     toBuildWarnings(dag.BuildWarnings),
 		Spec:         &yamlSpec,
 		EditorHints:  a.buildDAGEditorHints(ctx, dag, fileName),
 	}, nil
@@ -534,6 +537,22 @@ func extractBuildErrors(errs []error) []string {
 	return result
 }
 
+// toBuildWarnings converts build warnings to their API form. It returns nil
+// when there are none so the field is omitted.
+func toBuildWarnings(warnings []core.BuildWarning) *[]api.BuildWarning {
+	if len(warnings) == 0 {
+		return nil
+	}
+	result := make([]api.BuildWarning, 0, len(warnings))
+	for _, w := range warnings {
+		result = append(result, api.BuildWarning{
+			Code:    string(w.Code),
+			Message: w.Message,
+		})
+	}
+	return &result
+}
+
 func (a *API) readHistoryData(_ context.Context, dag *core.DAG, statusList []exec.DAGRunStatus) []api.DAGGridItem {
 	statusLen := len(statusList)
 	nodeData := make(map[string][]core.NodeStatus)
@@ -557,23 +576,32 @@ func (a *API) readHistoryData(_ context.Context, dag *core.DAG, statusList []exe
 			addStatus(nodeData, idx, node.Step.Name, node.Status)
 		}
 		// Key handlers by their type (onSuccess, onFailure, etc.) not step name
-		// to ensure consistent lookup later
+		// to ensure consistent lookup later. Chained handler steps are keyed
+		// by their position in the chain (onFailure_2, onFailure_3, etc.).
 		handlerPairs := []struct {
 			handlerType core.HandlerType
 			node        *exec.Node
 		}{
+			{core.HandlerOnInit, st.OnInit},
 			{core.HandlerOnSuccess, st.OnSuccess},
 			{core.HandlerOnFailure, st.OnFailure},
 			{core.HandlerOnAbort, st.OnAbort},
 			{core.HandlerOnExit, st.OnExit},
+			{core.HandlerOnWait, st.OnWait},
+			{core.HandlerOnRetry, st.OnRetry},
 		}
 		for _, h := range handlerPairs {
-			if h.node != nil {
-				if _, ok := originalIndex[h.handlerType.String()]; !ok {
-					originalIndex[h.handlerType.String()] = nextOriginalIndex
+			nodes := append([]*exec.Node{h.node}, st.HandlerChains[h.handlerType]...)
+			for i, node := range nodes {
+				if node == nil {
+					continue
+				}
+				name := h.handlerType.StepName(i)
+				if _, ok := originalIndex[name]; !ok {
+					originalIndex[name] = nextOriginalIndex
 					nextOriginalIndex++
 				}
-				addStatus(handlerData, idx, h.handlerType.String(), h.node.Status)
+				addStatus(handlerData, idx, name, node.Status)
 			}
 		}
 	}
@@ -691,10 +719,19 @@ func (a *API) readHistoryData(_ context.Context, dag *core.DAG, statusList []exe
 	})
 
 	for _, handlerType := range []core.HandlerType{
-		core.HandlerOnSuccess, core.HandlerOnFailure, core.HandlerOnAbort, core.HandlerOnExit,
+		core.HandlerOnInit, core.HandlerOnSuccess, core.HandlerOnFailure,
+		core.HandlerOnAbort, core.HandlerOnExit, core.HandlerOnWait, core.HandlerOnRetry,
 	} {
-		if statuses, ok := handlerData[handlerType.String()]; ok {
-			grid = append(grid, api.DAGGridItem{Name: handlerType.String(), History: toHistory(statuses)})
+		for i := 0; ; i++ {
+			name := handlerType.StepName(i)
+			statuses, ok := handlerData[name]
+			if !ok {
+				if i == 0 {
+					continue
+				}
+				break
+			}
+			grid = append(grid, api.DAGGridItem{Name: name, History: toHistory(statuses)})
 		}
 	}
 
@@ -1279,7 +1316,10 @@ func (a *API) waitForDAGStatusChange(ctx context.Context, dag *core.DAG, dagRunI
 // dispatchStartToCoordinator dispatches a DAG start operation to the coordinator
 // and waits for the DAG status to change from NotStarted within the given timeout.
 func (a *API) dispatchStartToCoordinator(ctx context.Context, dag *core.DAG, dagRunID string, timeout time.Duration, params, labels string) error {
-	var taskOpts []executor.TaskOption
+	taskOpts := []executor.TaskOption{
+		executor.WithDAGTags(dag.Labels),
+		executor.WithRequires(dag.Requirements()),
+	}
 	if len(dag.WorkerSelector) > 0 {
 		taskOpts = append(taskOpts, executor.WithWorkerSelector(dag.WorkerSelector))
 	}
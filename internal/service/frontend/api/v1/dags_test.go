@@ -166,6 +166,32 @@ func TestDAGWritesAllowedWhenGitSyncDisabled(t *testing.T) {
 	server.Client().Delete("/api/v1/dags/test_dag_gitsync_disabled").ExpectStatus(http.StatusNoContent).Send(t)
 }
 
+func TestGetDAGSpecReturnsWarningsSeparately(t *testing.T) {
+	server := test.SetupServer(t)
+
+	spec := "max_active_runs: 3\nsteps:\n  - command: echo ok\n"
+	dagName := "spec_with_build_warning"
+	server.Client().Post("/api/v1/dags", api.CreateNewDAGJSONRequestBody{
+		Name: dagName,
+		Spec: &spec,
+	}).ExpectStatus(http.StatusCreated).Send(t)
+	t.Cleanup(func() {
+		server.Client().Delete("/api/v1/dags/" + dagName).Send(t)
+	})
+
+	resp := server.Client().Get("/api/v1/dags/" + dagName + "/spec").
+		ExpectStatus(http.StatusOK).
+		Send(t)
+
+	var body api.GetDAGSpec200JSONResponse
+	resp.Unmarshal(t, &body)
+	require.Empty(t, body.Errors)
+	require.NotNil(t, body.Warnings)
+	require.Len(t, *body.Warnings, 1)
+	require.Equal(t, string(core.WarningDeprecatedMaxActiveRuns), (*body.Warnings)[0].Code)
+	require.Contains(t, (*body.Warnings)[0].Message, "max_active_runs=3 is deprecated")
+}
+
 func TestDAGSpecInheritsBaseGraphType(t *testing.T) {
 	server := test.SetupServer(t)
 
@@ -142,7 +142,7 @@ func (m *mockDocStore) Delete(_ context.Context, id string) error {
 	return nil
 }
 
-func (m *mockDocStore) Rename(_ context.Context, oldID, newID string) error {
+func (m *mockDocStore) Rename(_ context.Context, oldID, newID string, _ ...agent.RenameOpts) error {
 	if m.failAll {
 		return errForced
 	}
@@ -429,6 +429,20 @@ func (m *mockDocStore) ListFlat(_ context.Context, opts agent.ListDocsOptions) (
 	return &result, nil
 }
 
+func (m *mockDocStore) Move(_ context.Context, _, _ string) error {
+	if m.failAll {
+		return errForced
+	}
+	return nil
+}
+
+func (m *mockDocStore) ListAfter(_ context.Context, _ string, _ int) (*exec.CursorResult[agent.DocMetadata], error) {
+	if m.failAll {
+		return nil, errForced
+	}
+	return &exec.CursorResult[agent.DocMetadata]{Items: []agent.DocMetadata{}}, nil
+}
+
 // docTestSetup contains common test infrastructure for doc API tests.
 type docTestSetup struct {
 	api   *apiv1.API
@@ -170,7 +170,7 @@ func getOrCreateQueue(queueMap map[string]*queueInfo, queueName string, cfg *con
 	if cfg != nil {
 		if globalCfg := cfg.FindQueueConfig(queueName); globalCfg != nil {
 			queue.queueType = "global"
-			queue.maxConcurrency = globalCfg.MaxActiveRuns
+			queue.maxConcurrency = globalCfg.Concurrency()
 		} else {
 			// For DAG-based (local) queues, maxConcurrency is always 1 (FIFO processing).
 			// DAG's maxActiveRuns is deprecated and ignored for local queues.
@@ -207,7 +207,7 @@ func (a *API) collectQueues(ctx context.Context, onlyQueue string) (map[string]*
 			queueMap[queueCfg.Name] = &queueInfo{
 				name:           queueCfg.Name,
 				queueType:      "global",
-				maxConcurrency: queueCfg.MaxActiveRuns,
+				maxConcurrency: queueCfg.Concurrency(),
 				running:        []api.DAGRunSummary{},
 				queuedCount:    0,
 			}
@@ -262,6 +262,10 @@ func ToDAGRunDetails(s exec.DAGRunStatus) api.DAGRunDetails {
 	for i, n := range s.Nodes {
 		nodes[i] = toNode(n)
 	}
+	var failedPrecondition *api.Condition
+	if s.FailedPrecondition != nil {
+		failedPrecondition = ptrOf(toPrecondition(s.FailedPrecondition))
+	}
 
 	var autoRetryLimit *int
 	if s.AutoRetryLimit > 0 {
@@ -291,11 +295,16 @@ func ToDAGRunDetails(s exec.DAGRunStatus) api.DAGRunDetails {
 		WorkerId:           ptrOf(s.WorkerID),
 		TriggerType:        toTriggerType(s.TriggerType),
 		Preconditions:      ptrOf(preconditions),
+		FailedPrecondition: failedPrecondition,
 		Nodes:              nodes,
 		OnSuccess:          ptrOf(toNode(s.OnSuccess)),
 		OnFailure:          ptrOf(toNode(s.OnFailure)),
 		OnAbort:            ptrOf(toNode(s.OnAbort)),
 		OnExit:             ptrOf(toNode(s.OnExit)),
+		OnInit:             toNodeOrNil(s.OnInit),
+		OnWait:             toNodeOrNil(s.OnWait),
+		OnRetry:            toNodeOrNil(s.OnRetry),
+		HandlerChains:      toHandlerChainNodes(s.HandlerChains),
 		Labels:             &s.Labels,
 		Tags:               &s.Labels,
 	}
@@ -324,6 +333,34 @@ func hasArtifactEntries(archiveDir string) bool {
 	return false
 }
 
+// toNodeOrNil converts a handler node, returning nil when the handler is not
+// configured.
+func toNodeOrNil(node *exec.Node) *api.Node {
+	if node == nil {
+		return nil
+	}
+	return ptrOf(toNode(node))
+}
+
+// toHandlerChainNodes converts the nodes of the handler steps that follow the
+// first step of each handler.
+func toHandlerChainNodes(chains map[core.HandlerType][]*exec.Node) *map[string][]api.Node {
+	if len(chains) == 0 {
+		return nil
+	}
+	result := make(map[string][]api.Node, len(chains))
+	for handler, nodes := range chains {
+		converted := make([]api.Node, 0, len(nodes))
+		for _, node := range nodes {
+			if node != nil {
+				converted = append(converted, toNode(node))
+			}
+		}
+		result[handler.String()] = converted
+	}
+	return &result
+}
+
 func toNode(node *exec.Node) api.Node {
 	if node == nil {
 		return api.Node{}
@@ -606,17 +643,30 @@ func toParamScalarUint64(value uint64) (api.ParamScalar, bool) {
 
 func toHandlerOn(handlers core.HandlerOn) api.HandlerOn {
 	handlerOn := api.HandlerOn{}
-	if handlers.Failure != nil {
-		handlerOn.Failure = ptrOf(toStep(*handlers.Failure))
-	}
-	if handlers.Success != nil {
-		handlerOn.Success = ptrOf(toStep(*handlers.Success))
-	}
-	if handlers.Abort != nil {
-		handlerOn.Abort = ptrOf(toStep(*handlers.Abort))
+	chains := make(map[string][]api.Step)
+	for _, h := range []struct {
+		key   string
+		steps core.HandlerSteps
+		first **api.Step
+	}{
+		{"init", handlers.Init, &handlerOn.Init},
+		{"failure", handlers.Failure, &handlerOn.Failure},
+		{"success", handlers.Success, &handlerOn.Success},
+		{"abort", handlers.Abort, &handlerOn.Abort},
+		{"exit", handlers.Exit, &handlerOn.Exit},
+		{"wait", handlers.Wait, &handlerOn.Wait},
+		{"retry", handlers.Retry, &handlerOn.Retry},
+	} {
+		if len(h.steps) == 0 {
+			continue
+		}
+		*h.first = ptrOf(toStep(*h.steps[0]))
+		for _, step := range h.steps[1:] {
+			chains[h.key] = append(chains[h.key], toStep(*step))
+		}
 	}
-	if handlers.Exit != nil {
-		handlerOn.Exit = ptrOf(toStep(*handlers.Exit))
+	if len(chains) > 0 {
+		handlerOn.Chains = &chains
 	}
 	return handlerOn
 }
@@ -151,6 +151,8 @@ func (e *DAGExecutor) ExecuteDAG(
 		// Distributed execution: dispatch to coordinator
 		taskOpts := []executor.TaskOption{
 			executor.WithWorkerSelector(dag.WorkerSelector),
+			executor.WithDAGTags(dag.Labels),
+			executor.WithRequires(dag.Requirements()),
 			executor.WithPreviousStatus(previousStatus),
 			executor.WithBaseConfig(executor.ResolveBaseConfig(dag.BaseConfigData, e.baseConfigPath)),
 		}
@@ -258,7 +260,8 @@ func buildSnapshotBuilder(paths config.PathsConfig, dagStore exec.DAGStore) func
 	}
 }
 
-// Restart restarts a DAG unconditionally.
+// Restart runs the restart command for the DAG, which stops the current run
+// gracefully, honoring each step's signal_on_stop, and then starts a new one.
 func (e *DAGExecutor) Restart(ctx context.Context, dag *core.DAG, scheduleTime time.Time) error {
 	prepared, err := e.prepareDAGForSubprocess(ctx, dag, "")
 	if err != nil {
@@ -127,7 +127,7 @@ func EnqueueCatchupRun(
 		return fmt.Errorf("failed to close catchup attempt: %w", err)
 	}
 
-	if err := queueStore.Enqueue(ctx, dagCopy.ProcGroup(), exec.QueuePriorityLow, dagRun); err != nil {
+	if err := queueStore.Enqueue(ctx, dagCopy.ProcGroup(), exec.QueuePriorityForDAG(dagCopy), dagRun); err != nil {
 		return fmt.Errorf("failed to enqueue catchup run: %w", err)
 	}
 
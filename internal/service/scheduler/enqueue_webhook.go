@@ -107,7 +107,7 @@ func EnqueueWebhookRun(
 	if err := att.Close(ctx); err != nil {
 		return fmt.Errorf("failed to close webhook attempt: %w", err)
 	}
-	if err := queueStore.Enqueue(ctx, dagCopy.ProcGroup(), exec.QueuePriorityLow, dagRun); err != nil {
+	if err := queueStore.Enqueue(ctx, dagCopy.ProcGroup(), exec.QueuePriorityForDAG(dagCopy), dagRun); err != nil {
 		return fmt.Errorf("failed to enqueue webhook run: %w", err)
 	}
 
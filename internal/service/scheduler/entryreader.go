@@ -9,6 +9,7 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -52,18 +53,31 @@ type entryReaderImpl struct {
 	quit      chan struct{}
 	closeOnce sync.Once
 	events    chan DAGChangeEvent
+	loadOpts  []spec.LoadOption
 }
 
 // NewEntryReader creates a new DAG manager with the given configuration.
-func NewEntryReader(dir string, dagCli exec.DAGStore) EntryReader {
+// loadOpts are applied when loading the DAG files in dir.
+func NewEntryReader(dir string, dagCli exec.DAGStore, loadOpts ...spec.LoadOption) EntryReader {
 	return &entryReaderImpl{
 		targetDir: dir,
 		registry:  make(map[string]*core.DAG),
 		dagStore:  dagCli,
 		quit:      make(chan struct{}),
+		loadOpts:  loadOpts,
 	}
 }
 
+// metadataLoadOptions returns the options for loading the metadata of a DAG
+// file.
+func (er *entryReaderImpl) metadataLoadOptions() []spec.LoadOption {
+	return append(slices.Clone(er.loadOpts),
+		spec.OnlyMetadata(),
+		spec.WithoutEval(),
+		spec.SkipSchemaValidation(),
+	)
+}
+
 // setEvents wires the event channel used to notify the TickPlanner of DAG
 // changes. Must be called before Start().
 func (er *entryReaderImpl) setEvents(ch chan DAGChangeEvent) {
@@ -129,13 +143,7 @@ func (er *entryReaderImpl) handleFSEvent(ctx context.Context, event fsnotify.Eve
 
 	if event.Op&(fsnotify.Create|fsnotify.Write) != 0 {
 		filePath := filepath.Join(er.targetDir, fileName)
-		dag, err := spec.Load(
-			ctx,
-			filePath,
-			spec.OnlyMetadata(),
-			spec.WithoutEval(),
-			spec.SkipSchemaValidation(),
-		)
+		dag, err := spec.Load(ctx, filePath, er.metadataLoadOptions()...)
 		if err != nil {
 			logger.Error(ctx, "DAG load failed",
 				tag.Error(err),
@@ -246,13 +254,7 @@ func (er *entryReaderImpl) initialize(ctx context.Context) error {
 	var dags []string
 	for _, fi := range fis {
 		if fileutil.IsYAMLFile(fi.Name()) {
-			dag, err := spec.Load(
-				ctx,
-				filepath.Join(er.targetDir, fi.Name()),
-				spec.OnlyMetadata(),
-				spec.WithoutEval(),
-				spec.SkipSchemaValidation(),
-			)
+			dag, err := spec.Load(ctx, filepath.Join(er.targetDir, fi.Name()), er.metadataLoadOptions()...)
 			if err != nil {
 				logger.Error(ctx, "DAG load failed",
 					tag.Error(err),
@@ -86,6 +86,7 @@ type queue struct {
 	maxConcurrency int
 	isGlobal       bool // true if this queue is defined in config (global queue)
 	inflight       atomic.Int32
+	limiter        *startLimiter // nil when the queue has no start rate limit
 	mu             sync.Mutex
 }
 
@@ -176,10 +177,10 @@ func NewQueueProcessor(
 	}
 
 	for _, queueConfig := range queuesConfig.Config {
-		conc := max(queueConfig.MaxActiveRuns, 1)
 		p.queues.Store(queueConfig.Name, &queue{
-			maxConcurrency: conc,
+			maxConcurrency: queueConfig.Concurrency(),
 			isGlobal:       true, // Queues from config are global queues
+			limiter:        newStartLimiter(queueConfig.MaxStartsPerInterval, queueConfig.Interval),
 		})
 	}
 
@@ -363,6 +364,15 @@ func (p *QueueProcessor) ProcessQueueItems(ctx context.Context, queueName string
 		return
 	}
 
+	if q.limiter != nil {
+		available := q.limiter.Available()
+		if available <= 0 {
+			p.delayForRateLimit(ctx, q.limiter)
+			return
+		}
+		freeSlots = min(freeSlots, available)
+	}
+
 	runnableItems, err := p.selectRunnableQueueItems(ctx, items, freeSlots)
 	if err != nil {
 		logger.Error(ctx, "Failed to select runnable queue items", tag.Error(err), tag.Queue(queueName))
@@ -380,6 +390,10 @@ func (p *QueueProcessor) ProcessQueueItems(ctx context.Context, queueName string
 
 	var wg sync.WaitGroup
 	for _, item := range runnableItems {
+		if q.limiter != nil && !q.limiter.TryTake() {
+			p.delayForRateLimit(ctx, q.limiter)
+			break
+		}
 		wg.Add(1)
 		go func(queuedItem exec.QueuedItemData) {
 			defer wg.Done()
@@ -388,7 +402,18 @@ func (p *QueueProcessor) ProcessQueueItems(ctx context.Context, queueName string
 					logger.Error(ctx, "Queue item processing panicked", tag.Error(panicToError(r)))
 				}
 			}()
-			if !p.processDAG(ctx, queuedItem, queueName, q.incInflight, q.decInflight) {
+			// The start budget is only spent on runs that were actually
+			// launched; failed or discarded items give their token back.
+			var launched bool
+			incInflight := func() {
+				launched = true
+				q.incInflight()
+			}
+			processed := p.processDAG(ctx, queuedItem, queueName, incInflight, q.decInflight)
+			if q.limiter != nil && (!processed || !launched) {
+				q.limiter.Return()
+			}
+			if !processed {
 				return
 			}
 			data, err := queuedItem.Data()
@@ -641,6 +666,15 @@ func (p *QueueProcessor) dispatchAndWaitForStartup(
 	return started
 }
 
+// delayForRateLimit schedules a wake-up for when the queue's start budget
+// refills so that waiting items are retried without relying on the periodic
+// poll.
+func (p *QueueProcessor) delayForRateLimit(ctx context.Context, limiter *startLimiter) {
+	delay := limiter.NextTokenIn()
+	logger.Debug(ctx, "Start rate limit reached, delaying retry", slog.Duration("delay", delay))
+	time.AfterFunc(delay, p.wakeUp)
+}
+
 func (p *QueueProcessor) wakeUp() {
 	select {
 	case p.wakeUpCh <- struct{}{}:
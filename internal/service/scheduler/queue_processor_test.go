@@ -190,6 +190,15 @@ func TestQueueProcessor_GlobalQueue(t *testing.T) {
 	assert.Contains(t, f.logs(), "count=3")
 }
 
+func TestQueueProcessor_GlobalQueueNonPositiveLimit(t *testing.T) {
+	f := newQueueFixture(t).withDAG("global-dag", 1).withProcessor(config.Queues{
+		Enabled: true, Config: []config.QueueConfig{{Name: "unset-queue"}, {Name: "negative-queue", MaxActiveRuns: -1}},
+	})
+
+	assert.Equal(t, 1, f.getQueue("unset-queue").getMaxConcurrency())
+	assert.Equal(t, 1, f.getQueue("negative-queue").getMaxConcurrency())
+}
+
 func TestQueueProcessor_ItemsRemainOnFailure(t *testing.T) {
 	f := newQueueFixture(t).withDAG("fifo-dag", 1).enqueueRuns(2).
 		withProcessor(config.Queues{Enabled: true, Config: []config.QueueConfig{{Name: "fifo-dag", MaxActiveRuns: 1}}}).
@@ -669,3 +678,97 @@ func TestQueueProcessor_GlobalQueueViaLoop(t *testing.T) {
 	assert.Contains(t, f.logs(), "count=3", "Should process all 3 items")
 	assert.Contains(t, f.logs(), "max-concurrency=3", "maxConcurrency should be 3")
 }
+
+func TestQueueProcessor_StartRateLimit(t *testing.T) {
+	f := newQueueFixture(t).withDAG("paced-dag", 1).withProcessor(config.Queues{
+		Enabled: true,
+		Config: []config.QueueConfig{{
+			Name: "paced-queue", MaxActiveRuns: 10,
+			MaxStartsPerInterval: 2, Interval: time.Hour,
+		}},
+	})
+
+	f.processor.dagExecutor = NewDAGExecutor(&startingDispatcher{f: f}, nil, config.ExecutionModeDistributed, "", nil)
+
+	for i := 1; i <= 6; i++ {
+		f.enqueueToQueue("paced-queue", fmt.Sprintf("run-%d", i), exec.QueuePriorityHigh)
+	}
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := f.getQueue("paced-queue").limiter
+	require.NotNil(t, limiter)
+	limiter.now = func() time.Time { return now }
+	limiter.last = now
+
+	processRound := func() string {
+		before := len(f.logs())
+		f.processor.ProcessQueueItems(f.ctx, "paced-queue")
+		return f.logs()[before:]
+	}
+
+	// The first round may only start up to the bucket capacity.
+	assert.Contains(t, processRound(), "count=2")
+
+	// Without elapsed time, every remaining item waits.
+	round := processRound()
+	assert.NotContains(t, round, "Processing batch of items")
+	assert.Contains(t, round, "Start rate limit reached")
+
+	// Half an interval refills one start.
+	now = now.Add(30 * time.Minute)
+	assert.Contains(t, processRound(), "count=1")
+
+	// A full interval refills the whole budget, still capped at capacity.
+	now = now.Add(2 * time.Hour)
+	assert.Contains(t, processRound(), "count=2")
+}
+
+func TestQueueProcessor_StartRateLimitReturnsTokenOnFailedStart(t *testing.T) {
+	f := newQueueFixture(t).withDAG("unstartable-dag", 1).withProcessor(config.Queues{
+		Enabled: true,
+		Config: []config.QueueConfig{{
+			Name: "paced-queue", MaxActiveRuns: 10,
+			MaxStartsPerInterval: 1, Interval: time.Hour,
+		}},
+	})
+	f.enqueueToQueue("paced-queue", "run-1", exec.QueuePriorityHigh)
+
+	limiter := f.getQueue("paced-queue").limiter
+	require.NotNil(t, limiter)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time { return now }
+	limiter.last = now
+
+	// The fixture's executor cannot launch the DAG, so the start fails and
+	// must not consume the queue's start budget.
+	f.processor.ProcessQueueItems(f.ctx, "paced-queue")
+	assert.Contains(t, f.logs(), "Failed to execute DAG")
+	assert.Equal(t, 1, limiter.Available())
+}
+
+// startingDispatcher marks every dispatched run as running so the queue
+// processor observes a successful start.
+type startingDispatcher struct {
+	mockDispatcher
+	f *queueFixture
+}
+
+func (d *startingDispatcher) Dispatch(ctx context.Context, task *coordinatorv1.Task) error {
+	d.callCount.Add(1)
+	attempt, err := d.f.dagRunStore.FindAttempt(ctx, exec.NewDAGRunRef(d.f.dag.Name, task.GetDagRunId()))
+	if err != nil {
+		return err
+	}
+	status, err := attempt.ReadStatus(ctx)
+	if err != nil {
+		return err
+	}
+	status.Status = core.Running
+	if err := attempt.Open(ctx); err != nil {
+		return err
+	}
+	if err := attempt.Write(ctx, *status); err != nil {
+		return err
+	}
+	return attempt.Close(ctx)
+}
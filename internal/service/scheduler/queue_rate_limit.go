@@ -0,0 +1,85 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package scheduler
+
+import (
+	"sync"
+	"time"
+)
+
+// startLimiter is a token bucket that bounds how many queued runs may start
+// within a configured interval. The bucket holds up to capacity tokens and
+// refills continuously at capacity/interval.
+type startLimiter struct {
+	mu       sync.Mutex
+	capacity float64
+	rate     float64 // tokens per nanosecond
+	tokens   float64
+	last     time.Time
+	now      func() time.Time
+}
+
+// newStartLimiter returns a limiter allowing maxStarts starts per interval.
+// It returns nil when rate limiting is not configured.
+func newStartLimiter(maxStarts int, interval time.Duration) *startLimiter {
+	if maxStarts <= 0 || interval <= 0 {
+		return nil
+	}
+	l := &startLimiter{
+		capacity: float64(maxStarts),
+		rate:     float64(maxStarts) / float64(interval),
+		tokens:   float64(maxStarts),
+		now:      time.Now,
+	}
+	l.last = l.now()
+	return l
+}
+
+// refill adds tokens accrued since the last refill. Callers must hold mu.
+func (l *startLimiter) refill() {
+	now := l.now()
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens = min(l.capacity, l.tokens+float64(elapsed)*l.rate)
+	}
+	l.last = now
+}
+
+// Available returns the number of starts currently permitted.
+func (l *startLimiter) Available() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.refill()
+	return int(l.tokens)
+}
+
+// TryTake consumes one token and reports whether it was available.
+func (l *startLimiter) TryTake() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.refill()
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
+
+// Return gives back a token taken for a start that did not happen.
+func (l *startLimiter) Return() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.refill()
+	l.tokens = min(l.capacity, l.tokens+1)
+}
+
+// NextTokenIn returns how long until at least one token is available.
+func (l *startLimiter) NextTokenIn() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.refill()
+	if l.tokens >= 1 {
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.rate)
+}
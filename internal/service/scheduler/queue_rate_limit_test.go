@@ -0,0 +1,64 @@
+// Copyright (C) 2026 Yota Hamada
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartLimiter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("DisabledWhenUnset", func(t *testing.T) {
+		t.Parallel()
+		assert.Nil(t, newStartLimiter(0, time.Minute))
+		assert.Nil(t, newStartLimiter(5, 0))
+	})
+
+	t.Run("RefillsOverInterval", func(t *testing.T) {
+		t.Parallel()
+		now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		l := newStartLimiter(2, time.Minute)
+		require.NotNil(t, l)
+		l.now = func() time.Time { return now }
+		l.last = now
+
+		assert.True(t, l.TryTake())
+		assert.True(t, l.TryTake())
+		assert.False(t, l.TryTake())
+		assert.Equal(t, 30*time.Second, l.NextTokenIn())
+
+		now = now.Add(30 * time.Second)
+		assert.Equal(t, 1, l.Available())
+		assert.True(t, l.TryTake())
+		assert.False(t, l.TryTake())
+
+		// Tokens never accumulate beyond capacity.
+		now = now.Add(time.Hour)
+		assert.Equal(t, 2, l.Available())
+	})
+
+	t.Run("ReturnRestoresToken", func(t *testing.T) {
+		t.Parallel()
+		now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		l := newStartLimiter(1, time.Minute)
+		require.NotNil(t, l)
+		l.now = func() time.Time { return now }
+		l.last = now
+
+		assert.True(t, l.TryTake())
+		assert.False(t, l.TryTake())
+		l.Return()
+		assert.True(t, l.TryTake())
+
+		// Returning never exceeds capacity.
+		l.Return()
+		l.Return()
+		assert.Equal(t, 1, l.Available())
+	})
+}
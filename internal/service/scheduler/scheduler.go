@@ -180,6 +180,9 @@ func newScheduler(
 			)
 		},
 		Stop: func(ctx context.Context, dag *core.DAG) error {
+			if dag.StopSignal != "" {
+				return drm.StopWithSignal(ctx, dag, "", dag.StopSignal)
+			}
 			return drm.Stop(ctx, dag, "")
 		},
 		Restart: func(ctx context.Context, dag *core.DAG, scheduleTime time.Time) error {
@@ -6,6 +6,7 @@ package scheduler_test
 import (
 	"context"
 	"errors"
+	"net/http"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -13,6 +14,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dagucloud/dagu/internal/cmn/sock"
 	"github.com/dagucloud/dagu/internal/core"
 	"github.com/dagucloud/dagu/internal/core/exec"
 	"github.com/dagucloud/dagu/internal/service/scheduler"
@@ -45,21 +47,23 @@ func TestScheduler(t *testing.T) {
 		parsed, err := cronParser.Parse("0 * * * *")
 		require.NoError(t, err)
 
-		entryReader := newMockJobManager()
-		entryReader.LoadedDAGs = []*core.DAG{
-			{
-				Name: "restart-dag",
-				RestartSchedule: []core.Schedule{
-					{Expression: "0 * * * *", Parsed: parsed},
-				},
+		restartDAG := &core.DAG{
+			Name: "restart-dag",
+			RestartSchedule: []core.Schedule{
+				{Expression: "0 * * * *", Parsed: parsed},
 			},
 		}
+		entryReader := newMockJobManager()
+		entryReader.LoadedDAGs = []*core.DAG{restartDAG}
 
 		th := test.SetupScheduler(t)
 		sc, err := scheduler.New(th.Config, entryReader, th.DAGRunMgr, th.DAGRunStore, th.QueueStore, th.ProcStore, th.ServiceRegistry, th.CoordinatorCli, nil)
 		require.NoError(t, err)
 		sc.SetClock(func() time.Time { return now })
 
+		// Restart only acts on a running DAG.
+		simulateRunningDAG(t, th, restartDAG, "running-run")
+
 		// Track restart calls via the planner's Restart function
 		var restartCount atomic.Int32
 		restartScheduleTimeCh := make(chan time.Time, 1)
@@ -85,6 +89,47 @@ func TestScheduler(t *testing.T) {
 			t.Fatal("restart schedule time was not recorded")
 		}
 	})
+	t.Run("RestartSkippedWhenNotRunning", func(t *testing.T) {
+		ctx := context.Background()
+		now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		cronParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+		parsed, err := cronParser.Parse("0 * * * *")
+		require.NoError(t, err)
+		entryReader := newMockJobManager()
+		entryReader.LoadedDAGs = []*core.DAG{
+			{
+				Name:            "idle-restart-dag",
+				Schedule:        []core.Schedule{{Expression: "0 * * * *", Parsed: parsed}},
+				RestartSchedule: []core.Schedule{{Expression: "0 * * * *", Parsed: parsed}},
+			},
+		}
+
+		th := test.SetupScheduler(t)
+		sc, err := scheduler.New(th.Config, entryReader, th.DAGRunMgr, th.DAGRunStore, th.QueueStore, th.ProcStore, th.ServiceRegistry, th.CoordinatorCli, nil)
+		require.NoError(t, err)
+		sc.SetClock(func() time.Time { return now })
+
+		var restartCount, dispatchCount atomic.Int32
+		sc.SetRestartFunc(func(context.Context, *core.DAG, time.Time) error {
+			restartCount.Add(1)
+			return nil
+		})
+		sc.SetDispatchFunc(func(context.Context, *core.DAG, string, core.TriggerType, time.Time) error {
+			dispatchCount.Add(1)
+			return nil
+		})
+
+		errCh := startSchedulerAsync(t, sc, ctx)
+		defer stopSchedulerAndWait(t, sc, errCh, ctx)
+
+		// The start schedule shares the tick, so once it has been dispatched
+		// the restart schedule has been evaluated too.
+		require.Eventually(t, func() bool {
+			return dispatchCount.Load() >= int32(1)
+		}, 5*time.Second, 10*time.Millisecond, "dispatch should have been called for start schedule")
+		require.Zero(t, restartCount.Load(), "restart should be skipped when the DAG is not running")
+	})
 	t.Run("Start", func(t *testing.T) {
 		ctx := context.Background()
 		now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
@@ -263,6 +308,60 @@ func TestScheduler_StopSchedule(t *testing.T) {
 	}, 5*time.Second, 10*time.Millisecond, "stop function should have been called")
 }
 
+func TestScheduler_StopScheduleSignal(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cronParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	parsed, err := cronParser.Parse("0 * * * *")
+	require.NoError(t, err)
+
+	stopDAG := &core.DAG{
+		Name:         "stop-signal-dag",
+		StopSchedule: []core.Schedule{{Expression: "0 * * * *", Parsed: parsed}},
+		StopSignal:   "SIGUSR1",
+	}
+	entryReader := newMockJobManager()
+	entryReader.LoadedDAGs = []*core.DAG{stopDAG}
+
+	th := setupSchedulerWithoutDAGs(t)
+	sc, err := scheduler.New(th.Config, entryReader, th.DAGRunMgr, th.DAGRunStore, th.QueueStore, th.ProcStore, th.ServiceRegistry, th.CoordinatorCli, nil)
+	require.NoError(t, err)
+	sc.SetClock(func() time.Time { return now })
+	sc.SetGetLatestStatusFunc(func(_ context.Context, _ *core.DAG) (exec.DAGRunStatus, error) {
+		return exec.DAGRunStatus{Status: core.Running}, nil
+	})
+
+	// Stand in for the running agent's socket and record the stop request.
+	const runID = "running-run"
+	simulateRunningDAG(t, th, stopDAG, runID)
+	signals := make(chan string, 1)
+	srv, err := sock.NewServer(stopDAG.SockAddr(runID), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/stop" {
+			select {
+			case signals <- r.URL.Query().Get("signal"):
+			default:
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	require.NoError(t, err)
+	listen := make(chan error, 1)
+	go func() { _ = srv.Serve(th.Context, listen) }()
+	require.NoError(t, <-listen)
+	t.Cleanup(func() { _ = srv.Shutdown(context.Background()) })
+
+	ctx := context.Background()
+	errCh := startSchedulerAsync(t, sc, ctx)
+	defer stopSchedulerAndWait(t, sc, errCh, ctx)
+
+	select {
+	case sig := <-signals:
+		require.Equal(t, "SIGUSR1", sig)
+	case <-time.After(5 * time.Second):
+		t.Fatal("stop request was not sent to the running DAG")
+	}
+}
+
 func TestScheduler_GracefulShutdown(t *testing.T) {
 	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
 
@@ -592,3 +691,31 @@ type failingInitEntryReader struct {
 func (er *failingInitEntryReader) Init(context.Context) error {
 	return er.initErr
 }
+
+// simulateRunningDAG records a running dag-run for dag, backed by a fresh
+// process heartbeat so it is not repaired as stale.
+func simulateRunningDAG(t *testing.T, th *test.Scheduler, dag *core.DAG, dagRunID string) {
+	t.Helper()
+	ctx := th.Context
+
+	attempt, err := th.DAGRunStore.CreateAttempt(ctx, dag, time.Now(), dagRunID, exec.NewDAGRunAttemptOptions{})
+	require.NoError(t, err)
+	require.NoError(t, attempt.Open(ctx))
+	status := exec.InitialStatus(dag)
+	status.Status = core.Running
+	status.DAGRunID = dagRunID
+	status.AttemptID = attempt.ID()
+	require.NoError(t, attempt.Write(ctx, status))
+	require.NoError(t, attempt.Close(ctx))
+
+	proc, err := th.ProcStore.Acquire(ctx, dag.ProcGroup(), exec.ProcMeta{
+		StartedAt:    time.Now().Unix(),
+		Name:         dag.Name,
+		DAGRunID:     dagRunID,
+		AttemptID:    attempt.ID(),
+		RootName:     dag.Name,
+		RootDAGRunID: dagRunID,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = proc.Stop(context.Background()) })
+}
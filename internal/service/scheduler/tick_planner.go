@@ -63,10 +63,10 @@ type GetLatestStatusFunc func(ctx context.Context, dag *core.DAG) (exec.DAGRunSt
 // IsSuspendedFunc checks whether a DAG is currently suspended.
 type IsSuspendedFunc func(ctx context.Context, dagName string) bool
 
-// StopFunc stops a running DAG.
+// StopFunc stops a running DAG, sending dag.StopSignal when it is set.
 type StopFunc func(ctx context.Context, dag *core.DAG) error
 
-// RestartFunc restarts a DAG unconditionally.
+// RestartFunc stops the running DAG and starts it again.
 type RestartFunc func(ctx context.Context, dag *core.DAG, scheduleTime time.Time) error
 
 // EnqueueFunc enqueues a catchup run for the given DAG.
@@ -549,13 +549,7 @@ func (tp *TickPlanner) Plan(ctx context.Context, now time.Time) []PlannedRun {
 			}
 
 			// Guard: DAG must be running before issuing a stop
-			latestStatus, err := tp.cfg.GetLatestStatus(ctx, entry.dag)
-			if err != nil {
-				logger.Error(ctx, "Failed to fetch DAG status for stop schedule",
-					tag.DAG(dagName), tag.Error(err))
-				continue
-			}
-			if latestStatus.Status != core.Running {
+			if !tp.latestRunIsRunning(ctx, entry.dag, ScheduleTypeStop) {
 				continue
 			}
 
@@ -566,12 +560,18 @@ func (tp *TickPlanner) Plan(ctx context.Context, now time.Time) []PlannedRun {
 			})
 		}
 
-		// Evaluate restart schedules (no guard -- fires unconditionally).
+		// Evaluate restart schedules.
 		for _, schedule := range entry.dag.RestartSchedule {
 			next, due := scheduleDueAt(schedule, evalTime)
 			if !due {
 				continue
 			}
+
+			// Guard: restart stops the current run before starting a new
+			// one, so there is nothing to restart unless the DAG is running.
+			if !tp.latestRunIsRunning(ctx, entry.dag, ScheduleTypeRestart) {
+				continue
+			}
 			candidates = append(candidates, PlannedRun{
 				DAG:           entry.dag,
 				ScheduledTime: next,
@@ -584,6 +584,21 @@ func (tp *TickPlanner) Plan(ctx context.Context, now time.Time) []PlannedRun {
 	return candidates
 }
 
+// latestRunIsRunning reports whether the latest run of dag is running. It
+// guards stop and restart schedules, which act on the current run.
+func (tp *TickPlanner) latestRunIsRunning(ctx context.Context, dag *core.DAG, scheduleType ScheduleType) bool {
+	latestStatus, err := tp.cfg.GetLatestStatus(ctx, dag)
+	if err != nil {
+		logger.Error(ctx, "Failed to fetch DAG status for schedule",
+			tag.DAG(dag.Name),
+			slog.String("schedule_type", scheduleType.String()),
+			tag.Error(err),
+		)
+		return false
+	}
+	return latestStatus.Status == core.Running
+}
+
 // shouldRun checks all guards for a live scheduled run.
 func (tp *TickPlanner) shouldRun(ctx context.Context, dag *core.DAG, scheduledTime time.Time, schedule core.Schedule) bool {
 	// Guard 1: isRunning (uses process-level check)
@@ -842,7 +842,7 @@ func TestTickPlanner_PlanRestartSchedule(t *testing.T) {
 			return false
 		},
 		GetLatestStatus: func(_ context.Context, _ *core.DAG) (exec.DAGRunStatus, error) {
-			return exec.DAGRunStatus{}, nil
+			return exec.DAGRunStatus{Status: core.Running}, nil
 		},
 		IsRunning: func(_ context.Context, _ *core.DAG) (bool, error) {
 			return false, nil
@@ -871,6 +871,41 @@ func TestTickPlanner_PlanRestartSchedule(t *testing.T) {
 	assert.Equal(t, ScheduleTypeRestart, runs[0].ScheduleType)
 }
 
+func TestTickPlanner_PlanRestartSkipsNotRunning(t *testing.T) {
+	t.Parallel()
+
+	eventCh := make(chan DAGChangeEvent, 256)
+	tp := NewTickPlanner(TickPlannerConfig{
+		IsSuspended: func(_ context.Context, _ string) bool {
+			return false
+		},
+		GetLatestStatus: func(_ context.Context, _ *core.DAG) (exec.DAGRunStatus, error) {
+			return exec.DAGRunStatus{Status: core.Succeeded}, nil
+		},
+		IsRunning: func(_ context.Context, _ *core.DAG) (bool, error) {
+			return false, nil
+		},
+		GenRunID: func(_ context.Context) (string, error) {
+			return "run-id", nil
+		},
+		Clock: func() time.Time {
+			return time.Date(2026, 2, 7, 12, 0, 0, 0, time.UTC)
+		},
+		Location: time.UTC,
+		Events:   eventCh,
+	})
+
+	dag := &core.DAG{
+		Name:            "restart-dag-not-running",
+		RestartSchedule: []core.Schedule{mustParseSchedule(t, "0 * * * *")},
+	}
+	require.NoError(t, tp.Init(context.Background(), []*core.DAG{dag}))
+
+	now := time.Date(2026, 2, 7, 12, 0, 0, 0, time.UTC)
+	runs := tp.Plan(context.Background(), now)
+	assert.Len(t, runs, 0, "restart should be skipped when DAG is not running")
+}
+
 func TestTickPlanner_PlanSuspendedStopSkipped(t *testing.T) {
 	t.Parallel()
 
@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/dagucloud/dagu/internal/cmn/config"
 	"github.com/dagucloud/dagu/internal/cmn/fileutil"
@@ -32,14 +33,16 @@ var _ TaskHandler = (*taskHandler)(nil)
 // NewTaskHandler creates a new TaskHandler
 func NewTaskHandler(cfg *config.Config) TaskHandler {
 	return &taskHandler{
-		subCmdBuilder: runtime.NewSubCmdBuilder(cfg),
-		baseConfig:    cfg.Paths.BaseConfig,
+		subCmdBuilder:  runtime.NewSubCmdBuilder(cfg),
+		baseConfig:     cfg.Paths.BaseConfig,
+		schemaCacheTTL: cfg.Core.ParamsSchemaCacheTTL,
 	}
 }
 
 type taskHandler struct {
-	subCmdBuilder *runtime.SubCmdBuilder
-	baseConfig    string
+	subCmdBuilder  *runtime.SubCmdBuilder
+	baseConfig     string
+	schemaCacheTTL time.Duration
 }
 
 // Handle runs the task using the dagrun.Manager.
@@ -130,7 +133,7 @@ type subprocessHintSet struct {
 func (e *taskHandler) subprocessHints(ctx context.Context, task *coordinatorv1.Task, originalTarget string) (*subprocessHintSet, error) {
 	dagName := dagNameHint(originalTarget)
 
-	var loadOpts []spec.LoadOption
+	loadOpts := []spec.LoadOption{spec.WithRemoteSchemaCacheTTL(e.schemaCacheTTL)}
 	if dagName != "" {
 		loadOpts = append(loadOpts, spec.WithName(dagName))
 	}
@@ -25,6 +25,7 @@ type Poller struct {
 	handler        TaskHandler
 	index          int
 	labels         map[string]string
+	tags           []string
 }
 
 // NewPoller creates a new poller instance
@@ -38,6 +39,11 @@ func NewPoller(workerID string, coordinatorCli coordinator.Client, handler TaskH
 	}
 }
 
+// SetTags sets the DAG tags this poller accepts tasks for.
+func (p *Poller) SetTags(tags []string) {
+	p.tags = tags
+}
+
 // Run starts the polling loop
 func (p *Poller) Run(ctx context.Context) {
 	// Set up retry policy for poll failures only
@@ -154,6 +160,7 @@ func (p *Poller) pollForTask(ctx context.Context, policy backoff.RetryPolicy) (*
 		WorkerId: p.workerID,
 		PollerId: pollerID,
 		Labels:   p.labels,
+		Tags:     p.tags,
 	}
 
 	// Use coordinator client's Poll method which handles retries and failover
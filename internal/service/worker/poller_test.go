@@ -114,6 +114,27 @@ func TestPollerTaskDispatch(t *testing.T) {
 		assert.Equal(t, expectedTask.Target, executedTask.Target)
 	})
 
+	t.Run("PollRequestIncludesTags", func(t *testing.T) {
+		t.Parallel()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var pollReq *coordinatorv1.PollRequest
+		mockCoordinatorCli := newMockCoordinatorCli()
+		mockCoordinatorCli.PollFunc = func(_ context.Context, _ backoff.RetryPolicy, req *coordinatorv1.PollRequest) (*coordinatorv1.Task, error) {
+			pollReq = req
+			cancel()
+			return nil, nil
+		}
+
+		poller := worker.NewPoller("test-worker", mockCoordinatorCli, &mockHandler{}, 0, nil)
+		poller.SetTags([]string{"gpu", "heavy"})
+		poller.Run(ctx)
+
+		require.NotNil(t, pollReq)
+		assert.Equal(t, []string{"gpu", "heavy"}, pollReq.Tags)
+	})
+
 	t.Run("ContinuePollingAfterTaskExecution", func(t *testing.T) {
 		t.Parallel()
 
@@ -439,6 +439,7 @@ func (h *remoteTaskHandler) loadDAG(ctx context.Context, task *coordinatorv1.Tas
 	// 2. Shared-nothing workers should not access local DAG directories
 	loadOpts := []spec.LoadOption{
 		spec.WithName(task.Target), // Use original DAG name, not temp file path
+		spec.WithRemoteSchemaCacheTTL(h.config.Core.ParamsSchemaCacheTTL),
 	}
 
 	// Use embedded base config from the task if available (distributed mode).
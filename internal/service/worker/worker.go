@@ -31,6 +31,7 @@ type Worker struct {
 	coordinatorCli coordinator.Client
 	handler        TaskHandler
 	labels         map[string]string
+	tags           []string
 	cfg            *config.Config
 
 	// For tracking poller states and heartbeats
@@ -66,6 +67,12 @@ func (w *Worker) SetHandler(executor TaskHandler) {
 	w.handler = executor
 }
 
+// SetTags restricts the worker to tasks for DAGs that have at least one of
+// the given tags. An empty list accepts every DAG.
+func (w *Worker) SetTags(tags []string) {
+	w.tags = tags
+}
+
 // SetAfterTaskAckHook installs a hook that runs after a task claim has been
 // acknowledged but before the worker registers or executes the task. Returning
 // true abandons execution for that claimed task. This is intended for tests.
@@ -170,6 +177,7 @@ func (w *Worker) Start(ctx context.Context) (err error) {
 				handler:     w.handler,
 			}
 			poller := NewPoller(w.id, w.coordinatorCli, wrappedHandler, pollerIndex, w.labels)
+			poller.SetTags(w.tags)
 			poller.Run(internalCtx)
 		}(i)
 	}
@@ -571,6 +579,7 @@ func (w *Worker) sendHeartbeat(ctx context.Context) error {
 	req := &coordinatorv1.HeartbeatRequest{
 		WorkerId: w.id,
 		Labels:   w.labels,
+		Tags:     w.tags,
 		Stats: &coordinatorv1.WorkerStats{
 			TotalPollers: totalPollers,
 			BusyPollers:  busyCount32,
@@ -167,10 +167,12 @@ func (x LogStreamType) Number() protoreflect.EnumNumber {
 
 // Request message for polling a task.
 type PollRequest struct {
-	state         protoimpl.MessageState `protogen:"hybrid.v1"`
-	WorkerId      string                 `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
-	PollerId      string                 `protobuf:"bytes,2,opt,name=poller_id,json=pollerId,proto3" json:"poller_id,omitempty"`                                                       // Unique ID for this poll request
-	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Worker labels for task matching
+	state    protoimpl.MessageState `protogen:"hybrid.v1"`
+	WorkerId string                 `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	PollerId string                 `protobuf:"bytes,2,opt,name=poller_id,json=pollerId,proto3" json:"poller_id,omitempty"`                                                       // Unique ID for this poll request
+	Labels   map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Worker labels for task matching
+	// Worker tags; when set, only tasks whose DAG has one of these tags are assigned.
+	Tags          []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -221,6 +223,13 @@ func (x *PollRequest) GetLabels() map[string]string {
 	return nil
 }
 
+func (x *PollRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 func (x *PollRequest) SetWorkerId(v string) {
 	x.WorkerId = v
 }
@@ -233,12 +242,18 @@ func (x *PollRequest) SetLabels(v map[string]string) {
 	x.Labels = v
 }
 
+func (x *PollRequest) SetTags(v []string) {
+	x.Tags = v
+}
+
 type PollRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	WorkerId string
 	PollerId string
 	Labels   map[string]string
+	// Worker tags; when set, only tasks whose DAG has one of these tags are assigned.
+	Tags []string
 }
 
 func (b0 PollRequest_builder) Build() *PollRequest {
@@ -248,6 +263,7 @@ func (b0 PollRequest_builder) Build() *PollRequest {
 	x.WorkerId = b.WorkerId
 	x.PollerId = b.PollerId
 	x.Labels = b.Labels
+	x.Tags = b.Tags
 	return m0
 }
 
@@ -475,6 +491,11 @@ type Task struct {
 	SourceFile string `protobuf:"bytes,25,opt,name=source_file,json=sourceFile,proto3" json:"source_file,omitempty"`
 	// Opaque execution-scoped agent settings snapshot for distributed workers.
 	AgentSnapshot []byte `protobuf:"bytes,26,opt,name=agent_snapshot,json=agentSnapshot,proto3" json:"agent_snapshot,omitempty"`
+	// Tags of the DAG, used to match workers that only accept tagged DAGs.
+	Tags []string `protobuf:"bytes,27,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Capability requirements (e.g. "gpu", "cuda>=11") a worker's labels must
+	// satisfy to receive the task.
+	Requires      []string `protobuf:"bytes,28,rep,name=requires,proto3" json:"requires,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -686,6 +707,20 @@ func (x *Task) GetAgentSnapshot() []byte {
 	return nil
 }
 
+func (x *Task) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Task) GetRequires() []string {
+	if x != nil {
+		return x.Requires
+	}
+	return nil
+}
+
 func (x *Task) SetOperation(v Operation) {
 	x.Operation = v
 }
@@ -793,6 +828,14 @@ func (x *Task) SetAgentSnapshot(v []byte) {
 	x.AgentSnapshot = v
 }
 
+func (x *Task) SetTags(v []string) {
+	x.Tags = v
+}
+
+func (x *Task) SetRequires(v []string) {
+	x.Requires = v
+}
+
 func (x *Task) HasPreviousStatus() bool {
 	if x == nil {
 		return false
@@ -846,6 +889,11 @@ type Task_builder struct {
 	SourceFile string
 	// Opaque execution-scoped agent settings snapshot for distributed workers.
 	AgentSnapshot []byte
+	// Tags of the DAG, used to match workers that only accept tagged DAGs.
+	Tags []string
+	// Capability requirements (e.g. "gpu", "cuda>=11") a worker's labels must
+	// satisfy to receive the task.
+	Requires []string
 }
 
 func (b0 Task_builder) Build() *Task {
@@ -878,6 +926,8 @@ func (b0 Task_builder) Build() *Task {
 	x.ClaimToken = b.ClaimToken
 	x.SourceFile = b.SourceFile
 	x.AgentSnapshot = b.AgentSnapshot
+	x.Tags = b.Tags
+	x.Requires = b.Requires
 	return m0
 }
 
@@ -1157,10 +1207,12 @@ func (b0 WorkerInfo_builder) Build() *WorkerInfo {
 
 // Request message for heartbeat.
 type HeartbeatRequest struct {
-	state         protoimpl.MessageState `protogen:"hybrid.v1"`
-	WorkerId      string                 `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
-	Labels        map[string]string      `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Stats         *WorkerStats           `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	state    protoimpl.MessageState `protogen:"hybrid.v1"`
+	WorkerId string                 `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	Labels   map[string]string      `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Stats    *WorkerStats           `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	// Worker tags; when set, the worker only accepts tasks whose DAG has one of these tags.
+	Tags          []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1211,6 +1263,13 @@ func (x *HeartbeatRequest) GetStats() *WorkerStats {
 	return nil
 }
 
+func (x *HeartbeatRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 func (x *HeartbeatRequest) SetWorkerId(v string) {
 	x.WorkerId = v
 }
@@ -1223,6 +1282,10 @@ func (x *HeartbeatRequest) SetStats(v *WorkerStats) {
 	x.Stats = v
 }
 
+func (x *HeartbeatRequest) SetTags(v []string) {
+	x.Tags = v
+}
+
 func (x *HeartbeatRequest) HasStats() bool {
 	if x == nil {
 		return false
@@ -1240,6 +1303,8 @@ type HeartbeatRequest_builder struct {
 	WorkerId string
 	Labels   map[string]string
 	Stats    *WorkerStats
+	// Worker tags; when set, the worker only accepts tasks whose DAG has one of these tags.
+	Tags []string
 }
 
 func (b0 HeartbeatRequest_builder) Build() *HeartbeatRequest {
@@ -1249,6 +1314,7 @@ func (b0 HeartbeatRequest_builder) Build() *HeartbeatRequest {
 	x.WorkerId = b.WorkerId
 	x.Labels = b.Labels
 	x.Stats = b.Stats
+	x.Tags = b.Tags
 	return m0
 }
 
@@ -3104,11 +3170,12 @@ var File_proto_coordinator_v1_coordinator_proto protoreflect.FileDescriptor
 
 const file_proto_coordinator_v1_coordinator_proto_rawDesc = "" +
 	"\n" +
-	"&proto/coordinator/v1/coordinator.proto\x12\x0ecoordinator.v1\"\xc3\x01\n" +
+	"&proto/coordinator/v1/coordinator.proto\x12\x0ecoordinator.v1\"\xd7\x01\n" +
 	"\vPollRequest\x12\x1b\n" +
 	"\tworker_id\x18\x01 \x01(\tR\bworkerId\x12\x1b\n" +
 	"\tpoller_id\x18\x02 \x01(\tR\bpollerId\x12?\n" +
-	"\x06labels\x18\x03 \x03(\v2'.coordinator.v1.PollRequest.LabelsEntryR\x06labels\x1a9\n" +
+	"\x06labels\x18\x03 \x03(\v2'.coordinator.v1.PollRequest.LabelsEntryR\x06labels\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"8\n" +
@@ -3116,7 +3183,7 @@ const file_proto_coordinator_v1_coordinator_proto_rawDesc = "" +
 	"\x04task\x18\x01 \x01(\v2\x14.coordinator.v1.TaskR\x04task\";\n" +
 	"\x0fDispatchRequest\x12(\n" +
 	"\x04task\x18\x01 \x01(\v2\x14.coordinator.v1.TaskR\x04task\"\x12\n" +
-	"\x10DispatchResponse\"\xe0\b\n" +
+	"\x10DispatchResponse\"\x90\t\n" +
 	"\x04Task\x127\n" +
 	"\toperation\x18\x06 \x01(\x0e2\x19.coordinator.v1.OperationR\toperation\x12)\n" +
 	"\x11root_dag_run_name\x18\x01 \x01(\tR\x0erootDagRunName\x12%\n" +
@@ -3153,7 +3220,9 @@ const file_proto_coordinator_v1_coordinator_proto_rawDesc = "" +
 	"claimToken\x12\x1f\n" +
 	"\vsource_file\x18\x19 \x01(\tR\n" +
 	"sourceFile\x12%\n" +
-	"\x0eagent_snapshot\x18\x1a \x01(\fR\ragentSnapshot\x1aA\n" +
+	"\x0eagent_snapshot\x18\x1a \x01(\fR\ragentSnapshot\x12\x12\n" +
+	"\x04tags\x18\x1b \x03(\tR\x04tags\x12\x1a\n" +
+	"\brequires\x18\x1c \x03(\tR\brequires\x1aA\n" +
 	"\x13WorkerSelectorEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x13\n" +
@@ -3173,11 +3242,12 @@ const file_proto_coordinator_v1_coordinator_proto_rawDesc = "" +
 	"\rhealth_status\x18\t \x01(\x0e2\".coordinator.v1.WorkerHealthStatusR\fhealthStatus\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe3\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf7\x01\n" +
 	"\x10HeartbeatRequest\x12\x1b\n" +
 	"\tworker_id\x18\x01 \x01(\tR\bworkerId\x12D\n" +
 	"\x06labels\x18\x02 \x03(\v2,.coordinator.v1.HeartbeatRequest.LabelsEntryR\x06labels\x121\n" +
-	"\x05stats\x18\x03 \x01(\v2\x1b.coordinator.v1.WorkerStatsR\x05stats\x1a9\n" +
+	"\x05stats\x18\x03 \x01(\v2\x1b.coordinator.v1.WorkerStatsR\x05stats\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"X\n" +
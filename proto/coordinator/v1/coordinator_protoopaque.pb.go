@@ -171,6 +171,7 @@ type PollRequest struct {
 	xxx_hidden_WorkerId string                 `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3"`
 	xxx_hidden_PollerId string                 `protobuf:"bytes,2,opt,name=poller_id,json=pollerId,proto3"`
 	xxx_hidden_Labels   map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_Tags     []string               `protobuf:"bytes,4,rep,name=tags,proto3"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -221,6 +222,13 @@ func (x *PollRequest) GetLabels() map[string]string {
 	return nil
 }
 
+func (x *PollRequest) GetTags() []string {
+	if x != nil {
+		return x.xxx_hidden_Tags
+	}
+	return nil
+}
+
 func (x *PollRequest) SetWorkerId(v string) {
 	x.xxx_hidden_WorkerId = v
 }
@@ -233,12 +241,18 @@ func (x *PollRequest) SetLabels(v map[string]string) {
 	x.xxx_hidden_Labels = v
 }
 
+func (x *PollRequest) SetTags(v []string) {
+	x.xxx_hidden_Tags = v
+}
+
 type PollRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	WorkerId string
 	PollerId string
 	Labels   map[string]string
+	// Worker tags; when set, only tasks whose DAG has one of these tags are assigned.
+	Tags []string
 }
 
 func (b0 PollRequest_builder) Build() *PollRequest {
@@ -248,6 +262,7 @@ func (b0 PollRequest_builder) Build() *PollRequest {
 	x.xxx_hidden_WorkerId = b.WorkerId
 	x.xxx_hidden_PollerId = b.PollerId
 	x.xxx_hidden_Labels = b.Labels
+	x.xxx_hidden_Tags = b.Tags
 	return m0
 }
 
@@ -462,6 +477,8 @@ type Task struct {
 	xxx_hidden_ClaimToken           string                 `protobuf:"bytes,24,opt,name=claim_token,json=claimToken,proto3"`
 	xxx_hidden_SourceFile           string                 `protobuf:"bytes,25,opt,name=source_file,json=sourceFile,proto3"`
 	xxx_hidden_AgentSnapshot        []byte                 `protobuf:"bytes,26,opt,name=agent_snapshot,json=agentSnapshot,proto3"`
+	xxx_hidden_Tags                 []string               `protobuf:"bytes,27,rep,name=tags,proto3"`
+	xxx_hidden_Requires             []string               `protobuf:"bytes,28,rep,name=requires,proto3"`
 	unknownFields                   protoimpl.UnknownFields
 	sizeCache                       protoimpl.SizeCache
 }
@@ -673,6 +690,20 @@ func (x *Task) GetAgentSnapshot() []byte {
 	return nil
 }
 
+func (x *Task) GetTags() []string {
+	if x != nil {
+		return x.xxx_hidden_Tags
+	}
+	return nil
+}
+
+func (x *Task) GetRequires() []string {
+	if x != nil {
+		return x.xxx_hidden_Requires
+	}
+	return nil
+}
+
 func (x *Task) SetOperation(v Operation) {
 	x.xxx_hidden_Operation = v
 }
@@ -780,6 +811,14 @@ func (x *Task) SetAgentSnapshot(v []byte) {
 	x.xxx_hidden_AgentSnapshot = v
 }
 
+func (x *Task) SetTags(v []string) {
+	x.xxx_hidden_Tags = v
+}
+
+func (x *Task) SetRequires(v []string) {
+	x.xxx_hidden_Requires = v
+}
+
 func (x *Task) HasPreviousStatus() bool {
 	if x == nil {
 		return false
@@ -833,6 +872,11 @@ type Task_builder struct {
 	SourceFile string
 	// Opaque execution-scoped agent settings snapshot for distributed workers.
 	AgentSnapshot []byte
+	// Tags of the DAG, used to match workers that only accept tagged DAGs.
+	Tags []string
+	// Capability requirements (e.g. "gpu", "cuda>=11") a worker's labels must
+	// satisfy to receive the task.
+	Requires []string
 }
 
 func (b0 Task_builder) Build() *Task {
@@ -865,6 +909,8 @@ func (b0 Task_builder) Build() *Task {
 	x.xxx_hidden_ClaimToken = b.ClaimToken
 	x.xxx_hidden_SourceFile = b.SourceFile
 	x.xxx_hidden_AgentSnapshot = b.AgentSnapshot
+	x.xxx_hidden_Tags = b.Tags
+	x.xxx_hidden_Requires = b.Requires
 	return m0
 }
 
@@ -1151,6 +1197,7 @@ type HeartbeatRequest struct {
 	xxx_hidden_WorkerId string                 `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3"`
 	xxx_hidden_Labels   map[string]string      `protobuf:"bytes,2,rep,name=labels,proto3" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	xxx_hidden_Stats    *WorkerStats           `protobuf:"bytes,3,opt,name=stats,proto3"`
+	xxx_hidden_Tags     []string               `protobuf:"bytes,4,rep,name=tags,proto3"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -1201,6 +1248,13 @@ func (x *HeartbeatRequest) GetStats() *WorkerStats {
 	return nil
 }
 
+func (x *HeartbeatRequest) GetTags() []string {
+	if x != nil {
+		return x.xxx_hidden_Tags
+	}
+	return nil
+}
+
 func (x *HeartbeatRequest) SetWorkerId(v string) {
 	x.xxx_hidden_WorkerId = v
 }
@@ -1213,6 +1267,10 @@ func (x *HeartbeatRequest) SetStats(v *WorkerStats) {
 	x.xxx_hidden_Stats = v
 }
 
+func (x *HeartbeatRequest) SetTags(v []string) {
+	x.xxx_hidden_Tags = v
+}
+
 func (x *HeartbeatRequest) HasStats() bool {
 	if x == nil {
 		return false
@@ -1230,6 +1288,8 @@ type HeartbeatRequest_builder struct {
 	WorkerId string
 	Labels   map[string]string
 	Stats    *WorkerStats
+	// Worker tags; when set, the worker only accepts tasks whose DAG has one of these tags.
+	Tags []string
 }
 
 func (b0 HeartbeatRequest_builder) Build() *HeartbeatRequest {
@@ -1239,6 +1299,7 @@ func (b0 HeartbeatRequest_builder) Build() *HeartbeatRequest {
 	x.xxx_hidden_WorkerId = b.WorkerId
 	x.xxx_hidden_Labels = b.Labels
 	x.xxx_hidden_Stats = b.Stats
+	x.xxx_hidden_Tags = b.Tags
 	return m0
 }
 
@@ -3091,11 +3152,12 @@ var File_proto_coordinator_v1_coordinator_proto protoreflect.FileDescriptor
 
 const file_proto_coordinator_v1_coordinator_proto_rawDesc = "" +
 	"\n" +
-	"&proto/coordinator/v1/coordinator.proto\x12\x0ecoordinator.v1\"\xc3\x01\n" +
+	"&proto/coordinator/v1/coordinator.proto\x12\x0ecoordinator.v1\"\xd7\x01\n" +
 	"\vPollRequest\x12\x1b\n" +
 	"\tworker_id\x18\x01 \x01(\tR\bworkerId\x12\x1b\n" +
 	"\tpoller_id\x18\x02 \x01(\tR\bpollerId\x12?\n" +
-	"\x06labels\x18\x03 \x03(\v2'.coordinator.v1.PollRequest.LabelsEntryR\x06labels\x1a9\n" +
+	"\x06labels\x18\x03 \x03(\v2'.coordinator.v1.PollRequest.LabelsEntryR\x06labels\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"8\n" +
@@ -3103,7 +3165,7 @@ const file_proto_coordinator_v1_coordinator_proto_rawDesc = "" +
 	"\x04task\x18\x01 \x01(\v2\x14.coordinator.v1.TaskR\x04task\";\n" +
 	"\x0fDispatchRequest\x12(\n" +
 	"\x04task\x18\x01 \x01(\v2\x14.coordinator.v1.TaskR\x04task\"\x12\n" +
-	"\x10DispatchResponse\"\xe0\b\n" +
+	"\x10DispatchResponse\"\x90\t\n" +
 	"\x04Task\x127\n" +
 	"\toperation\x18\x06 \x01(\x0e2\x19.coordinator.v1.OperationR\toperation\x12)\n" +
 	"\x11root_dag_run_name\x18\x01 \x01(\tR\x0erootDagRunName\x12%\n" +
@@ -3140,7 +3202,9 @@ const file_proto_coordinator_v1_coordinator_proto_rawDesc = "" +
 	"claimToken\x12\x1f\n" +
 	"\vsource_file\x18\x19 \x01(\tR\n" +
 	"sourceFile\x12%\n" +
-	"\x0eagent_snapshot\x18\x1a \x01(\fR\ragentSnapshot\x1aA\n" +
+	"\x0eagent_snapshot\x18\x1a \x01(\fR\ragentSnapshot\x12\x12\n" +
+	"\x04tags\x18\x1b \x03(\tR\x04tags\x12\x1a\n" +
+	"\brequires\x18\x1c \x03(\tR\brequires\x1aA\n" +
 	"\x13WorkerSelectorEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x13\n" +
@@ -3160,11 +3224,12 @@ const file_proto_coordinator_v1_coordinator_proto_rawDesc = "" +
 	"\rhealth_status\x18\t \x01(\x0e2\".coordinator.v1.WorkerHealthStatusR\fhealthStatus\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe3\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf7\x01\n" +
 	"\x10HeartbeatRequest\x12\x1b\n" +
 	"\tworker_id\x18\x01 \x01(\tR\bworkerId\x12D\n" +
 	"\x06labels\x18\x02 \x03(\v2,.coordinator.v1.HeartbeatRequest.LabelsEntryR\x06labels\x121\n" +
-	"\x05stats\x18\x03 \x01(\v2\x1b.coordinator.v1.WorkerStatsR\x05stats\x1a9\n" +
+	"\x05stats\x18\x03 \x01(\v2\x1b.coordinator.v1.WorkerStatsR\x05stats\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"X\n" +
@@ -3525,6 +3525,13 @@ export interface components {
             tags?: string[];
             runConfig?: components["schemas"]["RunConfig"];
         };
+        /** @description Non-fatal issue detected while building a DAG */
+        BuildWarning: {
+            /** @description Stable warning code, e.g. deprecated_max_active_runs */
+            code: string;
+            /** @description Human-readable warning message */
+            message: string;
+        };
         /** @description Editor-only metadata used to synthesize per-document schema hints */
         DAGEditorHints: {
             /** @description Custom step types inherited from base config and available to the current DAG */
@@ -3596,10 +3603,17 @@ export interface components {
         };
         /** @description Configuration for event handlers in a DAG-run */
         HandlerOn: {
+            init?: components["schemas"]["Step"];
             failure?: components["schemas"]["Step"];
             success?: components["schemas"]["Step"];
             abort?: components["schemas"]["Step"];
             exit?: components["schemas"]["Step"];
+            wait?: components["schemas"]["Step"];
+            retry?: components["schemas"]["Step"];
+            /** @description Steps that follow the first step of handlers configured as a list, keyed by handler (init, failure, success, abort, exit, wait, retry). The first step is in the handler's own field. */
+            chains?: {
+                [key: string]: components["schemas"]["Step"][];
+            };
         };
         /** @description Current status of a DAG-run */
         DAGRunSummary: {
@@ -3648,12 +3662,20 @@ export interface components {
             log: string;
             /** @description Status of individual steps within the DAG-run */
             nodes: components["schemas"]["Node"][];
+            onInit?: components["schemas"]["Node"];
             onExit?: components["schemas"]["Node"];
             onSuccess?: components["schemas"]["Node"];
             onFailure?: components["schemas"]["Node"];
             onAbort?: components["schemas"]["Node"];
+            onWait?: components["schemas"]["Node"];
+            onRetry?: components["schemas"]["Node"];
+            /** @description Nodes of the handler steps that follow the first step of handlers configured as a list, keyed by handler (onInit, onExit, onSuccess, onFailure, onAbort, onWait, onRetry). The first node is in the handler's own field. */
+            handlerChains?: {
+                [key: string]: components["schemas"]["Node"][];
+            };
             /** @description List of preconditions that must be met before the DAG-run can start */
             preconditions?: components["schemas"]["Condition"][];
+            failedPrecondition?: components["schemas"]["Condition"];
             /** @description Whether this DAG-run still has a usable source file on disk, so reschedule can load the current spec from that file instead of the stored historical YAML snapshot. */
             specFromFile?: boolean;
             /** @description File name of the source DAG definition, derived from the DAG-run's source file path. Only set when the source file still exists on disk. Can be used to navigate to the DAG definition page. */
@@ -6394,6 +6416,8 @@ export interface operations {
                         suspended: boolean;
                         /** @description List of errors encountered during the request */
                         errors: string[];
+                        /** @description Non-fatal warnings detected while building the DAG */
+                        warnings?: components["schemas"]["BuildWarning"][];
                         /** @description The DAG specification in YAML format */
                         spec?: string;
                         editorHints?: components["schemas"]["DAGEditorHints"];
@@ -6794,6 +6818,8 @@ export interface operations {
                         spec: string;
                         /** @description List of errors in the spec */
                         errors: string[];
+                        /** @description Non-fatal warnings detected while building the DAG */
+                        warnings?: components["schemas"]["BuildWarning"][];
                     };
                 };
             };
@@ -147,6 +147,7 @@ function DAGSpec({ fileName, localDags, editorHints }: Props) {
       : {
           dag: next.dag,
           errors: next.errors ?? [],
+          warnings: next.warnings,
           spec: next.spec,
         }
   );
@@ -376,7 +377,8 @@ function DAGSpec({ fileName, localDags, editorHints }: Props) {
   // Helper function to render DAG content (Graph, Attributes, Steps, Errors)
   const renderDAGContent = (
     dag: components['schemas']['DAGDetails'],
-    errors?: string[]
+    errors?: string[],
+    warnings?: components['schemas']['BuildWarning'][]
   ) => {
     const selectedStep = selectedSpecStepName
       ? dag.steps?.find((step) => step.name === selectedSpecStepName)
@@ -409,6 +411,20 @@ function DAGSpec({ fileName, localDags, editorHints }: Props) {
           </div>
         ) : null}
 
+        {warnings?.length ? (
+          <div className="space-y-3">
+            {warnings.map((w, i) => (
+              <div
+                key={i}
+                className="p-3 bg-warning/10 rounded-md text-warning font-mono text-sm break-words flex items-start gap-2"
+              >
+                <AlertTriangle className="h-4 w-4 mt-0.5 flex-shrink-0" />
+                {w.code}: {w.message}
+              </div>
+            ))}
+          </div>
+        ) : null}
+
         {errors?.length || !dag.steps || dag.steps.length === 0 ? (
           <div className="py-8 px-4 text-center">
             <AlertTriangle className="h-12 w-12 text-warning mx-auto mb-4" />
@@ -530,7 +546,11 @@ function DAGSpec({ fileName, localDags, editorHints }: Props) {
                     return (
                       data?.dag && (
                         <div className="flex-shrink-0">
-                          {renderDAGContent(data.dag, data?.errors)}
+                          {renderDAGContent(
+                            data.dag,
+                            data?.errors,
+                            data?.warnings
+                          )}
                         </div>
                       )
                     );
@@ -617,17 +637,20 @@ function getHandlers(
     return steps;
   }
   const h = dag.handlerOn;
-  if (h?.success) {
-    steps.push(h.success);
-  }
-  if (h?.failure) {
-    steps.push(h?.failure);
-  }
-  if (h?.abort) {
-    steps.push(h?.abort);
-  }
-  if (h?.exit) {
-    steps.push(h?.exit);
+  const handlers = [
+    ['init', h?.init],
+    ['success', h?.success],
+    ['failure', h?.failure],
+    ['abort', h?.abort],
+    ['exit', h?.exit],
+    ['wait', h?.wait],
+    ['retry', h?.retry],
+  ] as const;
+  for (const [key, step] of handlers) {
+    if (step) {
+      steps.push(step);
+    }
+    steps.push(...(h?.chains?.[key] ?? []));
   }
   return steps;
 }
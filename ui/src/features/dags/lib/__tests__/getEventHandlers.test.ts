@@ -38,4 +38,30 @@ describe('getEventHandlers', () => {
       'onExit',
     ]);
   });
+
+  it('includes every handler kind and the chained handler steps', () => {
+    const dagRun = {
+      onInit: { step: { name: 'onInit' } },
+      onFailure: { step: { name: 'onFailure' } },
+      onWait: { step: { name: 'onWait' } },
+      onRetry: { step: { name: 'onRetry' } },
+      handlerChains: {
+        onFailure: [
+          { step: { name: 'onFailure_2' } },
+          { step: { name: 'onFailure_3' } },
+        ],
+      },
+    } as any;
+
+    const handlers = getEventHandlers(dagRun);
+
+    expect(handlers.map((h: any) => h.step.name)).toEqual([
+      'onInit',
+      'onFailure',
+      'onFailure_2',
+      'onFailure_3',
+      'onWait',
+      'onRetry',
+    ]);
+  });
 });
@@ -2,17 +2,20 @@ import { components } from '../../../api/v1/schema';
 
 export function getEventHandlers(s: components['schemas']['DAGRunDetails']) {
   const ret: components['schemas']['Node'][] = [];
-  if (s.onSuccess) {
-    ret.push(s.onSuccess);
-  }
-  if (s.onFailure) {
-    ret.push(s.onFailure);
-  }
-  if (s.onAbort) {
-    ret.push(s.onAbort);
-  }
-  if (s.onExit) {
-    ret.push(s.onExit);
+  const handlers = [
+    ['onInit', s.onInit],
+    ['onSuccess', s.onSuccess],
+    ['onFailure', s.onFailure],
+    ['onAbort', s.onAbort],
+    ['onExit', s.onExit],
+    ['onWait', s.onWait],
+    ['onRetry', s.onRetry],
+  ] as const;
+  for (const [key, node] of handlers) {
+    if (node) {
+      ret.push(node);
+    }
+    ret.push(...(s.handlerChains?.[key] ?? []));
   }
   return ret;
 }
@@ -72,6 +72,19 @@ export function updateDAGRunNodeStatus(
     onFailure: updateOptionalNodeStatus(dagRun.onFailure, stepName, status),
     onAbort: updateOptionalNodeStatus(dagRun.onAbort, stepName, status),
     onExit: updateOptionalNodeStatus(dagRun.onExit, stepName, status),
+    onInit: updateOptionalNodeStatus(dagRun.onInit, stepName, status),
+    onWait: updateOptionalNodeStatus(dagRun.onWait, stepName, status),
+    onRetry: updateOptionalNodeStatus(dagRun.onRetry, stepName, status),
+    handlerChains: dagRun.handlerChains
+      ? Object.fromEntries(
+          Object.entries(dagRun.handlerChains).map(([key, nodes]) => [
+            key,
+            nodes.map((node) =>
+              updateRequiredNodeStatus(node, stepName, status)
+            ),
+          ])
+        )
+      : undefined,
   };
 }
 
@@ -4,6 +4,7 @@ import { SSEState, useSSE } from './useSSE';
 type DAGDetails = components['schemas']['DAGDetails'];
 type DAGRunDetails = components['schemas']['DAGRunDetails'];
 type LocalDag = components['schemas']['LocalDag'];
+type BuildWarning = components['schemas']['BuildWarning'];
 
 interface DAGSSEResponse {
   dag: DAGDetails;
@@ -11,6 +12,7 @@ interface DAGSSEResponse {
   suspended: boolean;
   localDags: LocalDag[];
   errors: string[];
+  warnings?: BuildWarning[];
   spec?: string;
 }
 